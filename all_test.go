@@ -5,16 +5,21 @@
 package ql
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"crypto/md5"
 	"database/sql"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"math"
 	"math/big"
 	"math/rand"
 	"os"
+	"os/exec"
 	"path"
 	"path/filepath"
 	"runtime"
@@ -22,9 +27,12 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"syscall"
 	"testing"
 	"time"
 
+	"github.com/camlistore/lock"
+	"github.com/cznic/exp/lldb"
 	"github.com/cznic/strutil"
 )
 
@@ -106,6 +114,7 @@ type testDB interface {
 var (
 	_ testDB = (*fileTestDB)(nil)
 	_ testDB = (*memTestDB)(nil)
+	_ testDB = (*spillTestDB)(nil)
 )
 
 type memTestDB struct {
@@ -266,6 +275,30 @@ func TestOSFileStorage(t *testing.T) {
 	test(t, &osFileTestDB{})
 }
 
+// spillTestDB is osFileTestDB moving all temporary data from memory to temp
+// files.
+type spillTestDB struct {
+	osFileTestDB
+}
+
+func (m *spillTestDB) setup() (db *DB, err error) {
+	m.gmp0 = runtime.GOMAXPROCS(0)
+	f, err := ioutil.TempFile("", "ql-test-spill")
+	if err != nil {
+		return
+	}
+
+	if m.db, err = OpenFile("", &Options{OSFile: f, TempSpillThreshold: 1}); err != nil {
+		return
+	}
+
+	return m.db, nil
+}
+
+func TestSpillStorage(t *testing.T) {
+	test(t, &spillTestDB{})
+}
+
 var (
 	compiledCommit        = MustCompile("COMMIT;")
 	compiledCreate        = MustCompile("BEGIN TRANSACTION; CREATE TABLE t (i16 int16, s16 string, s string);")
@@ -2681,6 +2714,297 @@ func TestIssue73(t *testing.T) {
 	}
 }
 
+func TestArray(t *testing.T) {
+	f, err := ioutil.TempFile("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nm := f.Name()
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.Remove(nm)
+
+	mdb, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fdb, err := OpenFile(nm, &Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer fdb.Close()
+
+	tm := time.Date(2014, 9, 1, 12, 0, 0, 0, time.UTC)
+	a := []interface{}{
+		int8(-1), uint16(2), float32(.5), complex64(1i), "s", []byte("b"),
+		true, big.NewInt(42), big.NewRat(1, 3), tm, time.Duration(7),
+		strings.Repeat("x", 1000),
+	}
+	for _, db := range []*DB{mdb, fdb} {
+		if _, _, err = db.Run(NewRWCtx(), "BEGIN TRANSACTION; CREATE TABLE t (a array); COMMIT;"); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, v := range a {
+			if _, _, err = db.Run(NewRWCtx(), `
+			BEGIN TRANSACTION;
+				INSERT INTO t VALUES ($1);
+			COMMIT;`,
+				[]interface{}{v, v},
+			); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		rs, _, err := db.Run(nil, "SELECT a, len(a) FROM t ORDER BY id();")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := len(rows), len(a); g != e {
+			t.Fatal(g, e)
+		}
+
+		for i, row := range rows {
+			if g, e := fmt.Sprintf("%T %#v", row[0], row[1]), "[]interface {} 2"; g != e {
+				t.Fatalf("%d: got %s, expected %s", i, g, e)
+			}
+
+			g, e := row[0].([]interface{})[1], a[i]
+			if g, e := fmt.Sprintf("%T(%v)", g, g), fmt.Sprintf("%T(%v)", e, e); g != e {
+				t.Fatalf("%d: got %s, expected %s", i, g, e)
+			}
+		}
+
+		if _, _, err = db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE u (a array);
+			INSERT INTO u VALUES ($1), ($2), (NULL);
+		COMMIT;`,
+			[]interface{}{"s", "t"}, []interface{}{"u"},
+		); err != nil {
+			t.Fatal(err)
+		}
+
+		if rs, _, err = db.Run(nil, "SELECT count() FROM u WHERE $1 IN a;", "s"); err != nil {
+			t.Fatal(err)
+		}
+
+		row, err := rs[0].FirstRow()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := row[0], int64(1); g != e {
+			t.Fatal(g, e)
+		}
+
+		for _, v := range [][]interface{}{{nil}, {1, "a"}} {
+			if _, _, err = db.Run(NewRWCtx(), `
+			BEGIN TRANSACTION;
+				INSERT INTO t VALUES ($1);
+			COMMIT;`,
+				v,
+			); err == nil {
+				t.Fatalf("%v: expected error", v)
+			}
+		}
+	}
+}
+
+// tempDB opens a new DB in a temporary directory using opt. The returned
+// function closes the DB and removes the directory.
+func tempDB(t *testing.T, opt *Options) (*DB, func()) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := OpenFile(filepath.Join(dir, "ql.db"), opt)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+
+	return db, func() {
+		if err := db.Close(); err != nil {
+			t.Error(err)
+		}
+		os.RemoveAll(dir)
+	}
+}
+
+// reopenedDB creates a DB in a new temporary file, runs src in it and opens
+// the file again using opt after closing it. The returned function closes the
+// DB and removes the file.
+func reopenedDB(t *testing.T, opt *Options, src string) (*DB, func()) {
+	f, err := ioutil.TempFile("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nm := f.Name()
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := OpenFile(nm, opt)
+	if err != nil {
+		os.Remove(nm)
+		t.Fatal(err)
+	}
+
+	_, _, err = db.Run(NewRWCtx(), src)
+	if err2 := db.Close(); err == nil {
+		err = err2
+	}
+	if err == nil {
+		db, err = OpenFile(nm, opt)
+	}
+	if err != nil {
+		os.Remove(nm)
+		t.Fatal(err)
+	}
+
+	return db, func() {
+		if err := db.Close(); err != nil {
+			t.Error(err)
+		}
+		os.Remove(nm)
+	}
+}
+
+func TestReopenSchema(t *testing.T) {
+	for i, v := range []struct {
+		setup, src string   // Run before and after reopening the DB.
+		err        string   // Of src.
+		q          string   // Run after src.
+		e          []string // Rows of the recordsets of q.
+		info       string   // Primary key of the first table and whether it has no id().
+	}{
+		// Full text indices.
+		{
+			`BEGIN TRANSACTION;
+				CREATE TABLE t (s string);
+				CREATE FULLTEXT INDEX x ON t (s);
+				INSERT INTO t VALUES ("foo bar"), ("bar baz");
+			COMMIT;`,
+			`BEGIN TRANSACTION; INSERT INTO t VALUES ("baz qux"); DELETE FROM t WHERE s == "foo bar"; COMMIT;`, "",
+			`SELECT s FROM t WHERE s MATCH "baz" ORDER BY s; SELECT s FROM t WHERE s MATCH "foo";`,
+			[]string{"[[bar baz] [baz qux]]", "[]"}, "",
+		},
+		// Generated columns.
+		{
+			`BEGIN TRANSACTION;
+				CREATE TABLE t (a string, b string, c string AS (a + "|" + b), d int AS (len(a)) STORED);
+				CREATE TABLE u (i int, j int AS (i*i) STORED);
+				CREATE INDEX x ON u (j);
+				INSERT INTO t VALUES ("foo", "bar"), ("x", "y");
+				INSERT INTO u VALUES (-3), (2);
+			COMMIT;`,
+			`BEGIN TRANSACTION; INSERT INTO t VALUES ("quux", ""); INSERT INTO u VALUES (1); COMMIT;`, "",
+			"SELECT * FROM t ORDER BY d; SELECT * FROM u WHERE j >= 0;",
+			[]string{"[[x y x|y 1] [foo bar foo|bar 3] [quux  quux| 4]]", "[[1 1] [2 4] [-3 9]]"}, "",
+		},
+		{
+			`BEGIN TRANSACTION; CREATE TABLE t (a string, c string AS (a + "x")); COMMIT;`,
+			"BEGIN TRANSACTION; UPDATE t c = a; COMMIT;", "cannot assign to generated column c",
+			"", nil, "",
+		},
+		// Primary keys.
+		{
+			`BEGIN TRANSACTION;
+				CREATE TABLE t (a int, b string, c int, PRIMARY KEY (b, a));
+				INSERT INTO t VALUES (1, "x", 10), (2, "x", 20);
+			COMMIT;`,
+			`BEGIN TRANSACTION; INSERT INTO t VALUES (1, "y", 30); COMMIT;`, "",
+			`SELECT * FROM t ORDER BY c; SELECT c FROM t WHERE a == 1 && b == "y"; SELECT Schema FROM __Table;`,
+			[]string{"[[1 x 10] [2 x 20] [1 y 30]]", "[[30]]", "[[CREATE TABLE t (a int64, b string, c int64, PRIMARY KEY (b, a));]]"},
+			"[b a] false",
+		},
+		{
+			`BEGIN TRANSACTION;
+				CREATE TABLE t (a int, b string, c int, PRIMARY KEY (b, a));
+				INSERT INTO t VALUES (2, "x", 20);
+			COMMIT;`,
+			`BEGIN TRANSACTION; INSERT INTO t VALUES (2, "x", 40); COMMIT;`, "duplicate primary key (x, 2)",
+			"SELECT * FROM t;",
+			[]string{"[[2 x 20]]"}, "",
+		},
+		{
+			`BEGIN TRANSACTION;
+				CREATE TABLE t (k string, v int, PRIMARY KEY (k)) WITHOUT ROWID;
+				INSERT INTO t VALUES ("b", 2), ("c", 3);
+			COMMIT;`,
+			`BEGIN TRANSACTION; INSERT INTO t VALUES ("a", 1); COMMIT;`, "",
+			"SELECT id(), k, v FROM t; SELECT Schema FROM __Table;",
+			[]string{"[[<nil> a 1] [<nil> b 2] [<nil> c 3]]", "[[CREATE TABLE t (k string, v int64, PRIMARY KEY (k)) WITHOUT ROWID;]]"},
+			"[k] true",
+		},
+		// NOT NULL columns.
+		{
+			"BEGIN TRANSACTION; CREATE TABLE t (i int, s string NOT NULL); COMMIT;",
+			"BEGIN TRANSACTION; INSERT INTO t (i) VALUES (1); COMMIT;", "column s cannot be NULL",
+			"SELECT count() FROM t;",
+			[]string{"[[0]]"}, "",
+		},
+	} {
+		func() {
+			db, done := reopenedDB(t, &Options{}, v.setup)
+			defer done()
+
+			_, _, err := db.Run(NewRWCtx(), v.src)
+			switch {
+			case v.err == "" && err != nil:
+				t.Fatalf("%d: %v", i, err)
+			case v.err != "" && (err == nil || !strings.Contains(err.Error(), v.err)):
+				t.Fatalf("%d: got %v, expected %s", i, err, v.err)
+			}
+
+			if v.info != "" {
+				nfo, err := db.Info()
+				if err != nil {
+					t.Fatalf("%d: %v", i, err)
+				}
+
+				if g := fmt.Sprint(nfo.Tables[0].PrimaryKey, nfo.Tables[0].WithoutRowID); g != v.info {
+					t.Fatalf("%d: got %s, expected %s", i, g, v.info)
+				}
+			}
+
+			if v.q == "" {
+				return
+			}
+
+			rs, _, err := db.Run(nil, v.q)
+			if err != nil {
+				t.Fatalf("%d: %v", i, err)
+			}
+
+			for j, e := range v.e {
+				rows, err := rs[j].Rows(-1, 0)
+				if err != nil {
+					t.Fatalf("%d: %v", i, err)
+				}
+
+				if g := fmt.Sprint(rows); g != e {
+					t.Fatalf("%d: got %s, expected %s", i, g, e)
+				}
+			}
+		}()
+	}
+}
+
 func Example_id() {
 	db, err := OpenMem()
 	if err != nil {
@@ -2718,3 +3042,5138 @@ func Example_id() {
 	// [20 2 twenty]
 	// ----
 }
+
+func TestAllocatorOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	nm := filepath.Join(dir, "ql.db")
+	if _, err = OpenFile(nm, &Options{CanCreate: true, Allocator: AllocatorOptions{MinWAL: -1}}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if _, err = os.Stat(nm); !os.IsNotExist(err) {
+		t.Fatalf("DB file created by failed open: %v", err)
+	}
+
+	db, err := OpenFile(nm, &Options{
+		CanCreate: true,
+		Allocator: AllocatorOptions{DisableCompression: true, MinWAL: 4000},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (s string);
+		INSERT INTO t VALUES ($1);
+	COMMIT;`,
+		strings.Repeat("foo", 1000),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	fi, err := os.Stat(walName(nm))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fi.Size(), int64(4000); g < e {
+		t.Fatalf("WAL size %d, expected at least %d", g, e)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if db, err = OpenFile(nm, &Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	rs, _, err := db.Run(nil, "SELECT len(s) FROM t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[3000]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+}
+
+// TestCrashAfterCommit opens a DB file again after its process is killed
+// following a commit, with and without a WAL headroom.
+func TestCrashAfterCommit(t *testing.T) {
+	if nm := os.Getenv("QL_TEST_CRASH"); nm != "" {
+		// The process to be killed.
+		var minWAL int64
+		fmt.Sscan(os.Getenv("QL_TEST_MINWAL"), &minWAL)
+		db, err := OpenFile(nm, &Options{CanCreate: true, Allocator: AllocatorOptions{MinWAL: minWAL}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, _, err = db.Run(NewRWCtx(), "BEGIN TRANSACTION; CREATE TABLE t (i int); INSERT INTO t VALUES (42); COMMIT;"); err != nil {
+			t.Fatal(err)
+		}
+
+		fmt.Println("committed")
+		time.Sleep(time.Minute)
+		return
+	}
+
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	for _, minWAL := range []int64{0, 4000} {
+		nm := filepath.Join(dir, fmt.Sprintf("ql%d.db", minWAL))
+		cmd := exec.Command(os.Args[0], "-test.run=^TestCrashAfterCommit$")
+		cmd.Env = append(os.Environ(), "QL_TEST_CRASH="+nm, fmt.Sprintf("QL_TEST_MINWAL=%d", minWAL))
+		out, err := cmd.StdoutPipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = cmd.Start(); err != nil {
+			t.Fatal(err)
+		}
+
+		line, err := bufio.NewReader(out).ReadString('\n')
+		cmd.Process.Kill()
+		cmd.Wait()
+		if line != "committed\n" {
+			t.Fatalf("MinWAL %d: got %q, %v", minWAL, line, err)
+		}
+
+		fi, err := os.Stat(walName(nm))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := fi.Size() != 0, minWAL != 0; g != e {
+			t.Fatalf("MinWAL %d: WAL size %d", minWAL, fi.Size())
+		}
+
+		db, err := OpenFile(nm, &Options{Allocator: AllocatorOptions{MinWAL: minWAL}})
+		if err != nil {
+			t.Fatalf("MinWAL %d: %v", minWAL, err)
+		}
+
+		rs, _, err := db.Run(NewRWCtx(), "BEGIN TRANSACTION; INSERT INTO t VALUES (43); COMMIT; SELECT i FROM t ORDER BY i;")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := fmt.Sprint(rows), "[[42] [43]]"; g != e {
+			t.Fatalf("MinWAL %d: got %s, expected %s", minWAL, g, e)
+		}
+
+		if err = db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestWALCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	nm := filepath.Join(dir, "ql.db")
+	db, err := OpenFile(nm, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err = db.Run(NewRWCtx(), "BEGIN TRANSACTION; CREATE TABLE t (s string); COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 10; i++ {
+		if _, _, err = db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			INSERT INTO t VALUES ($1);
+		COMMIT;`,
+			strings.Repeat("foo", 10000),
+		); err != nil {
+			t.Fatal(err)
+		}
+
+		fi, err := os.Stat(walName(nm))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g := fi.Size(); g != 0 {
+			t.Fatalf("WAL size %d after commit %d, expected 0", g, i)
+		}
+	}
+}
+
+func TestOpenFileErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	nm := filepath.Join(dir, "ql.db")
+	db, err := OpenFile(nm, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	lck, err := lock.Lock(lockName(nm)) // Like another process.
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = OpenFile(nm, &Options{}); !errors.Is(err, ErrLocked) {
+		t.Fatalf("got %v, expected %v", err, ErrLocked)
+	}
+
+	if err = lck.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = ioutil.WriteFile(walName(nm), []byte("junk"), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = OpenFile(nm, &Options{}); !errors.Is(err, ErrStaleWAL) {
+		t.Fatalf("got %v, expected %v", err, ErrStaleWAL)
+	}
+
+	if s := walName(nm) + " exists: 4 bytes"; !strings.Contains(err.Error(), s) {
+		t.Errorf("error %q does not contain %q", err, s)
+	}
+
+	nm = filepath.Join(dir, "junk.db")
+	if err = ioutil.WriteFile(nm, []byte("not a DB file"), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = OpenFile(nm, &Options{})
+	if !errors.Is(err, ErrUnknownFormat) {
+		t.Fatalf("got %v, expected %v", err, ErrUnknownFormat)
+	}
+
+	for _, s := range []string{nm, "read 13 bytes |6e 6f 74 20", "|60 db 71 6c|", "truncated"} {
+		if !strings.Contains(err.Error(), s) {
+			t.Errorf("error %q does not contain %q", err, s)
+		}
+	}
+}
+
+func TestCorruptID(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	nm := filepath.Join(dir, "db")
+	db, err := OpenFile(nm, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := db.store.(*file)
+	if err = s.BeginTransaction(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = s.a.Realloc(2, []byte{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = s.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = OpenFile(nm, &Options{})
+	if !errors.Is(err, ErrCorruptID) {
+		t.Fatalf("got %v, expected %v", err, ErrCorruptID)
+	}
+
+	for _, s := range []string{nm, "handle 2", "read 3 bytes |01 02 03|", "expected 8 or 17 bytes"} {
+		if !strings.Contains(err.Error(), s) {
+			t.Errorf("error %q does not contain %q", err, s)
+		}
+	}
+}
+
+func TestLockWait(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	nm := filepath.Join(dir, "ql.db")
+	db, err := OpenFile(nm, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	lck, err := lock.Lock(lockName(nm)) // Like another process.
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t0 := time.Now()
+	if _, err = OpenFile(nm, &Options{LockWait: 50 * time.Millisecond}); !errors.Is(err, ErrLocked) {
+		t.Fatalf("got %v, expected %v", err, ErrLocked)
+	}
+
+	if d := time.Since(t0); d < 50*time.Millisecond {
+		t.Fatalf("gave up after %v", d)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	if _, err = OpenFileContext(ctx, nm, &Options{LockWait: time.Hour}); !errors.Is(err, ErrLocked) {
+		t.Fatalf("got %v, expected %v", err, ErrLocked)
+	}
+
+	time.AfterFunc(50*time.Millisecond, func() { lck.Close() })
+	if db, err = OpenFile(nm, &Options{LockWait: 10 * time.Second}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = OpenFile(nm, &Options{LockWait: -1}); err == nil {
+		t.Fatal("unexpected success")
+	}
+}
+
+func TestOpenFileShared(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	nm := filepath.Join(dir, "ql.db")
+	db, err := OpenFile(nm, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int);
+		INSERT INTO t VALUES (42);
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	db2, err := OpenFile(filepath.Join(dir, ".", "ql.db"), &Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if db2 != db {
+		t.Fatal("DB not shared")
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, _, err := db2.Run(nil, "SELECT * FROM t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if row, err := rs[0].FirstRow(); err != nil || len(row) != 1 || row[0] != int64(42) {
+		t.Fatal(row, err)
+	}
+
+	if err = db2.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The lock is released.
+	if db, err = OpenFile(nm, &Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if db == db2 {
+		t.Fatal("closed DB shared")
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Concurrent opens share the DB.
+	var wg sync.WaitGroup
+	dbs := make([]*DB, 8)
+	errs := make([]error, len(dbs))
+	for i := range dbs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			dbs[i], errs[i] = OpenFile(nm, &Options{})
+		}(i)
+	}
+	wg.Wait()
+	for i, db := range dbs {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+
+		if db != dbs[0] {
+			t.Fatal("DB not shared")
+		}
+	}
+
+	for _, db := range dbs {
+		if err = db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRecordFormat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	nm := filepath.Join(dir, "ql.db")
+	db, err := OpenFile(nm, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := db.store.(*file).format, recordFormat; g != e {
+		t.Fatalf("got record format %d, expected %d", g, e)
+	}
+
+	// Mark the DB file as written by a future version.
+	s := db.store.(*file)
+	if err = s.BeginTransaction(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = s.Update(1, int64(0), int64(recordFormat+1)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = s.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = OpenFile(nm, &Options{}); !errors.Is(err, ErrUnknownFormat) {
+		t.Fatalf("got %v, expected %v", err, ErrUnknownFormat)
+	}
+}
+
+func TestExecuteTimeout(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err = db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int);
+		INSERT INTO t VALUES (1), (2), (3);
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	l := MustCompile("SELECT * FROM t ORDER BY i;")
+	rs, _, err := db.ExecuteTimeout(time.Nanosecond, nil, l)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(time.Millisecond)
+	if _, err = rs[0].Rows(-1, 0); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if _, ok := err.(*TimeoutError); !ok {
+		t.Fatalf("unexpected error %T(%v)", err, err)
+	}
+
+	if rs, _, err = db.ExecuteTimeout(time.Hour, nil, l); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[1] [2] [3]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+
+	ctx := NewRWCtx()
+	_, _, err = db.ExecuteTimeout(time.Nanosecond, ctx, MustCompile(`
+	BEGIN TRANSACTION;
+		UPDATE t i = i+10;
+	COMMIT;`,
+	))
+	if _, ok := err.(*TimeoutError); !ok {
+		t.Fatalf("unexpected error %T(%v)", err, err)
+	}
+
+	if rs, _, err = db.Run(nil, "SELECT sum(i) FROM t;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if rows, err = rs[0].Rows(-1, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[6]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+}
+
+func TestDefaultQueryTimeout(t *testing.T) {
+	db, done := tempDB(t, &Options{CanCreate: true, DefaultQueryTimeout: time.Nanosecond})
+	defer done()
+
+	if _, _, err := db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int);
+		INSERT INTO t VALUES (1);
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, _, err := db.Run(nil, "SELECT * FROM t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(time.Millisecond)
+	if _, err = rs[0].Rows(-1, 0); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if _, ok := err.(*TimeoutError); !ok {
+		t.Fatalf("unexpected error %T(%v)", err, err)
+	}
+}
+
+func TestStableOrder(t *testing.T) {
+	db, done := tempDB(t, &Options{CanCreate: true, StableOrder: true})
+	defer done()
+
+	if _, _, err := db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (s string);
+		INSERT INTO t VALUES ("a");
+		INSERT INTO t VALUES ("b"), ("c");
+		INSERT INTO t VALUES ("d");
+		DELETE FROM t WHERE s == "b";
+		UPDATE t s = "x" WHERE s == "a";
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	check := func() {
+		for _, v := range []struct {
+			q, e string
+		}{
+			{"SELECT id(), s FROM t;", "[[1 x] [3 c] [4 d]]"},
+			{"SELECT s FROM t LIMIT 2;", "[[x] [c]]"},
+			{"SELECT s FROM t WHERE s != \"c\";", "[[x] [d]]"},
+		} {
+			rs, _, err := db.Run(nil, v.q)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rows, err := rs[0].Rows(-1, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if g, e := fmt.Sprint(rows), v.e; g != e {
+				t.Fatalf("%s: got %s, expected %s", v.q, g, e)
+			}
+		}
+	}
+
+	check()
+	if _, _, err := db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE INDEX tID ON t (id());
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	check() // Walks the index.
+}
+
+func TestMalformedRecord(t *testing.T) {
+	f, err := ioutil.TempFile("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nm := f.Name()
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.Remove(nm)
+
+	db, err := OpenFile(nm, &Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err = db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int8, s string);
+		INSERT INTO t VALUES (42, "foo");
+		CREATE TABLE u (s string);
+		CREATE INDEX x ON u (s);
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	s := db.store.(*file)
+	tab := db.root.tables["t"]
+	h := tab.head
+	if err = s.BeginTransaction(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = s.Update(h, int64(0), int64(1), "bad", "foo"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = s.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, _, err := db.Run(nil, "SELECT * FROM t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = rs[0].Rows(-1, 0)
+	if err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	if g, e := err.Error(), fmt.Sprintf("handle %d, column i", h); !strings.Contains(g, e) {
+		t.Fatalf("error %q does not contain %q", g, e)
+	}
+
+	// A malformed key of a B+Tree makes the collation fail.
+	x := db.root.tables["u"].indices[1].x.(*fileIndex)
+	if err = s.BeginTransaction(); err != nil {
+		t.Fatal(err)
+	}
+
+	k, err := lldb.EncodeScalars("foobar", int64(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = x.t.Set(k[:len(k)-3], gbZeroInt64); err != nil {
+		t.Fatal(err)
+	}
+
+	err = x.Create("bar", 1)
+	if err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	if g, e := err.Error(), "cannot collate"; !strings.Contains(g, e) {
+		t.Fatalf("error %q does not contain %q", g, e)
+	}
+
+	// The B+Tree may be half updated, the transaction can only be rolled
+	// back.
+	if _, err = s.Create(int64(1)); err == nil || !strings.Contains(err.Error(), "file-038") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if err = x.Delete("foo", 1); err == nil || !strings.Contains(err.Error(), "file-038") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if err = s.Commit(); err == nil || !strings.Contains(err.Error(), "file-038") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// Commit rolled the transaction back.
+	if err = s.BeginTransaction(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = x.Create("bar", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = s.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = s.Verify(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTempCollateError(t *testing.T) {
+	f, err := ioutil.TempFile("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nm := f.Name()
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.Remove(nm)
+
+	db, err := OpenFile(nm, &Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	tmp, err := db.store.(*file).CreateTemp(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer tmp.Drop()
+
+	x := tmp.(*fileTemp)
+	k, err := lldb.EncodeScalars("foobar")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A malformed key of the B+Tree makes the collation fail.
+	if err = x.t.Set(k[:len(k)-3], nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = x.Set([]interface{}{"foo"}, []interface{}{int64(1)}); err == nil || !strings.Contains(err.Error(), "cannot collate") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if _, err = x.SeekFirst(); err == nil || !strings.Contains(err.Error(), "cannot collate") {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestTempSpillThreshold(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	var n int
+	db, err := OpenFile(filepath.Join(dir, "ql.db"), &Options{
+		CanCreate: true,
+		TempFile: func(dir, prefix string) (lldb.OSFile, error) {
+			n++
+			return ioutil.TempFile(dir, prefix)
+		},
+		TempSpillThreshold: 1 << 14,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err = db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int, s string);
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	query := func(e int) {
+		rs, _, err := db.Run(nil, "SELECT s, count(), sum(i) FROM t GROUP BY s ORDER BY s;")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := len(rows), e; g != e {
+			t.Fatalf("got %d groups, expected %d", g, e)
+		}
+
+		sum := int64(0)
+		for _, row := range rows {
+			sum += row[2].(int64)
+		}
+		if g, e := sum, int64(e*(e-1)/2); g != e {
+			t.Fatalf("got sum %d, expected %d", g, e)
+		}
+	}
+
+	ins := MustCompile("INSERT INTO t VALUES ($1, $2);")
+	insert := func(from, to int) {
+		ctx := NewRWCtx()
+		if _, _, err := db.Run(ctx, "BEGIN TRANSACTION;"); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := from; i < to; i++ {
+			if _, _, err := db.Execute(ctx, ins, int64(i), fmt.Sprintf("%08d", i)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, _, err := db.Run(ctx, "COMMIT;"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	insert(0, 10)
+	query(10)
+	if n != 0 {
+		t.Fatalf("unexpected temp files: %d", n)
+	}
+
+	insert(10, 1000)
+	query(1000)
+	if n == 0 {
+		t.Fatal("no temp files")
+	}
+}
+
+func TestOrderBySpill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	var n int
+	db, err := OpenFile(filepath.Join(dir, "ql.db"), &Options{
+		CanCreate: true,
+		TempFile: func(dir, prefix string) (lldb.OSFile, error) {
+			n++
+			return ioutil.TempFile(dir, prefix)
+		},
+		TempSpillThreshold: 1 << 12,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	ctx := NewRWCtx()
+	if _, _, err = db.Run(ctx, `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int, s string);`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	const N = 10000
+	ins := MustCompile("INSERT INTO t VALUES ($1, $2);")
+	rng := rand.New(rand.NewSource(42))
+	for i := 0; i < N; i++ {
+		if _, _, err := db.Execute(ctx, ins, rng.Int63n(N/2), fmt.Sprintf("%08d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := db.Run(ctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, desc := range []bool{false, true} {
+		n = 0
+		q := "SELECT i, s FROM t ORDER BY i"
+		if desc {
+			q += " DESC"
+		}
+		rs, _, err := db.Run(nil, q+";")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := len(rows), N; g != e {
+			t.Fatalf("%s: got %d rows, expected %d", q, g, e)
+		}
+
+		seen := map[string]bool{}
+		for i, row := range rows {
+			seen[row[1].(string)] = true
+			if i == 0 {
+				continue
+			}
+
+			a, b := rows[i-1][0].(int64), row[0].(int64)
+			if desc {
+				a, b = b, a
+			}
+			if a > b {
+				t.Fatalf("%s: rows %d and %d out of order: %v %v", q, i-1, i, rows[i-1], row)
+			}
+		}
+		if g, e := len(seen), N; g != e {
+			t.Fatalf("%s: got %d distinct rows, expected %d", q, g, e)
+		}
+
+		if n <= sortFanIn {
+			t.Fatalf("%s: got %d sorted runs, expected more than %d", q, n, sortFanIn)
+		}
+	}
+}
+
+func TestTempFilePool(t *testing.T) {
+	var mu sync.Mutex
+	var names []string
+	db, done := tempDB(t, &Options{
+		CanCreate: true,
+		TempFile: func(dir, prefix string) (lldb.OSFile, error) {
+			f, err := ioutil.TempFile(dir, prefix)
+			if err == nil {
+				mu.Lock()
+				names = append(names, f.Name())
+				mu.Unlock()
+			}
+			return f, err
+		},
+		TempSpillThreshold: -1,
+		TempFilePoolSize:   1,
+	})
+	defer done()
+
+	s := db.store.(*file)
+	idle := func(d time.Duration) {
+		s.tmu.Lock()
+		tempPoolIdle = d
+		s.tmu.Unlock()
+	}
+	defer idle(tempPoolIdle)
+	idle(time.Hour)
+
+	pooled := func() (n int, removed bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		_, err := os.Stat(names[0])
+		return len(names), os.IsNotExist(err)
+	}
+
+	if _, _, err := db.Run(NewRWCtx(), "BEGIN TRANSACTION; CREATE TABLE t (i int); INSERT INTO t VALUES (2), (3), (1); COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	query := func() {
+		rs, _, err := db.Run(nil, "SELECT i FROM t ORDER BY i;")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = rs[0].Rows(-1, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		query()
+	}
+	if n, removed := pooled(); n != 1 || removed {
+		t.Fatalf("got %d temp files, removed %v, expected 1 pooled temp file", n, removed)
+	}
+
+	idle(time.Millisecond)
+	query()
+	for i := 0; ; i++ {
+		if _, removed := pooled(); removed {
+			break
+		}
+
+		if i == 500 {
+			t.Fatal("idle pooled temp file not removed")
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Closing the DB removes the temp files still in use.
+	f, err := s.getTempFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = os.Stat(f.Name()); !os.IsNotExist(err) {
+		t.Fatalf("temp file in use not removed: %v", err)
+	}
+}
+
+type testMetrics struct {
+	mu  sync.Mutex
+	inc map[Metric]int64
+	obs map[Metric]int
+}
+
+func (m *testMetrics) Inc(k Metric, n int64) {
+	m.mu.Lock()
+	m.inc[k] += n
+	m.mu.Unlock()
+}
+
+func (m *testMetrics) Observe(k Metric, v float64) {
+	m.mu.Lock()
+	m.obs[k]++
+	m.mu.Unlock()
+}
+
+func TestMetrics(t *testing.T) {
+	m := &testMetrics{inc: map[Metric]int64{}, obs: map[Metric]int{}}
+	db, done := tempDB(t, &Options{
+		CanCreate:          true,
+		TempSpillThreshold: -1,
+		Metrics:            m,
+	})
+	defer done()
+
+	m.inc = map[Metric]int64{} // Creating the DB commits.
+	if _, _, err := db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int, s string);
+		INSERT INTO t VALUES (1, "a"), (2, "b"), (3, "c");
+		UPDATE t SET s = "d" WHERE i == 2;
+		DELETE FROM t WHERE i == 3;
+	COMMIT;
+	BEGIN TRANSACTION;
+		INSERT INTO t VALUES (4, "e");
+	ROLLBACK;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, _, err := db.Run(nil, "SELECT * FROM t ORDER BY i;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = rs[0].Rows(-1, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, v := range []struct {
+		m Metric
+		n int64
+	}{
+		{MetricQueries, 6},
+		{MetricRowsWritten, 6},
+		{MetricCommits, 1},
+		{MetricRollbacks, 1},
+		{MetricTempFiles, 1},
+	} {
+		if g, e := m.inc[v.m], v.n; g != e {
+			t.Errorf("%s: got %d, expected %d", v.m, g, e)
+		}
+	}
+
+	// The UPDATE and DELETE scans read 3 rows each, the SELECT 2.
+	if g, e := m.inc[MetricRowsRead], int64(8); g < e {
+		t.Errorf("%s: got %d, expected at least %d", MetricRowsRead, g, e)
+	}
+
+	if m.inc[MetricBytesAllocated] == 0 || m.inc[MetricBytesFreed] == 0 {
+		t.Errorf("bytes allocated %d, freed %d", m.inc[MetricBytesAllocated], m.inc[MetricBytesFreed])
+	}
+
+	if g, e := m.obs[MetricQueryDuration], 6; g != e {
+		t.Errorf("%s: got %d observations, expected %d", MetricQueryDuration, g, e)
+	}
+
+	if g, e := Metric(-1).String(), "Metric(-1)"; g != e {
+		t.Errorf("got %q, expected %q", g, e)
+	}
+}
+
+func TestSlowQuery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	var db *DB
+	var a []string
+	nested := false
+	db, err = OpenFile(filepath.Join(dir, "ql.db"), &Options{
+		CanCreate:          true,
+		SlowQueryThreshold: time.Nanosecond,
+		OnSlowQuery: func(sql string, d time.Duration) {
+			if nested {
+				return
+			}
+
+			if d <= 0 {
+				t.Errorf("%s: invalid duration %v", sql, d)
+			}
+
+			// The executor must not hold any lock here.
+			nested = true
+			if _, _, err := db.Run(nil, "SELECT count() FROM __Table;"); err != nil {
+				t.Error(err)
+			}
+			nested = false
+			a = append(a, sql)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err = db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int);
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	a = nil
+	if _, _, err = db.Run(nil, "SELECT * FROM t WHERE i > 42;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := strings.Join(a, "\n"), "SELECT * FROM t WHERE i>42;"; g != e {
+		t.Fatalf("got\n%s\nexpected\n%s", g, e)
+	}
+}
+
+func TestFileNameSize(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "ql.db")
+	fdb, err := OpenFile(name, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer fdb.Close()
+
+	mdb, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer mdb.Close()
+
+	for _, v := range []struct {
+		db   *DB
+		name string
+	}{
+		{fdb, name},
+		{mdb, ""},
+	} {
+		db := v.db
+		if g, e := db.FileName(), v.name; g != e {
+			t.Fatalf("got %q, expected %q", g, e)
+		}
+
+		n0, err := db.FileSize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, _, err = db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (s string);
+			INSERT INTO t VALUES ($1);
+		COMMIT;`,
+			strings.Repeat("x", 1<<15),
+		); err != nil {
+			t.Fatal(err)
+		}
+
+		n, err := db.FileSize()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if n <= n0 {
+			t.Fatalf("%q: size did not grow: %d -> %d", db.Name(), n0, n)
+		}
+	}
+}
+
+// enospcFile fails writes beyond limit as if the volume were full.
+type enospcFile struct {
+	*os.File
+	limit int64
+}
+
+func (f *enospcFile) WriteAt(b []byte, off int64) (int, error) {
+	if off+int64(len(b)) > f.limit {
+		return 0, &os.PathError{Op: "write", Path: f.Name(), Err: syscall.ENOSPC}
+	}
+
+	return f.File.WriteAt(b, off)
+}
+
+// failingCommitFiler fails to commit a transaction before writing the WAL.
+type failingCommitFiler struct {
+	lldb.Filer
+}
+
+func (f failingCommitFiler) EndUpdate() error { return fmt.Errorf("commit failed") }
+
+func TestCommitENOSPC(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "ql.db")
+	f0, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := &enospcFile{f0, math.MaxInt64}
+	db, err := OpenFile(name, &Options{OSFile: f})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int, b blob);
+		INSERT INTO t VALUES (1, blob("foo"));
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(e string) {
+		rs, _, err := db.Run(nil, "SELECT i FROM t ORDER BY i;")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g := fmt.Sprint(rows); g != e {
+			t.Fatalf("got %s, expected %s", g, e)
+		}
+	}
+
+	f.limit = fi.Size() + 100
+	_, _, err = db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		INSERT INTO t VALUES (2, $1);
+	COMMIT;`,
+		make([]byte, 1<<17),
+	)
+	if err == nil || !strings.Contains(err.Error(), syscall.ENOSPC.Error()) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	check("[[1]]")
+	if _, err = db.store.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The DB file grown for a commit failing before it reaches the WAL is
+	// truncated back.
+	f.limit = math.MaxInt64
+	fs := db.store.(*file)
+	fs.f = failingCommitFiler{fs.f}
+	_, _, err = db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		INSERT INTO t VALUES (2, $1);
+	COMMIT;`,
+		make([]byte, 1<<17),
+	)
+	if err == nil || !strings.Contains(err.Error(), "commit failed") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	fi2, err := f.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fi2.Size(), fi.Size(); g != e {
+		t.Fatalf("got DB file size %d, expected %d", g, e)
+	}
+
+	check("[[1]]")
+	if _, err = db.store.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		INSERT INTO t VALUES (3, $1);
+	COMMIT;`,
+		make([]byte, 1<<17),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	check("[[1] [3]]")
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if db, err = OpenFile(name, &Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	check("[[1] [3]]")
+}
+
+func TestFreeSpace(t *testing.T) {
+	db, done := tempDB(t, &Options{CanCreate: true})
+	defer done()
+
+	if _, _, err := db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int, s string);
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := NewRWCtx()
+	if _, _, err := db.Run(ctx, "BEGIN TRANSACTION;"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100; i++ {
+		if _, _, err := db.Run(ctx, "INSERT INTO t VALUES ($1, $2);", int64(i), strings.Repeat("x", 100)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, _, err := db.Run(ctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	si0, err := db.FreeSpace()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if si0.TotalAtoms == 0 {
+		t.Fatalf("%+v", si0)
+	}
+
+	if _, _, err = db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		DELETE FROM t WHERE i%2 == 0;
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	si, err := db.FreeSpace()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if si.FreeAtoms <= si0.FreeAtoms || si.FreeBlocks == 0 || si.LargestFreeAtoms == 0 || si.LargestFreeAtoms > si.FreeAtoms {
+		t.Fatalf("%+v", si)
+	}
+
+	if g, e := si.Fragmentation, 1-float64(si.LargestFreeAtoms)/float64(si.FreeAtoms); g != e || g <= 0 || g >= 1 {
+		t.Fatalf("got %v, expected %v", g, e)
+	}
+
+	mdb, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer mdb.Close()
+
+	if si, err = mdb.FreeSpace(); err != nil || *si != (SpaceInfo{}) {
+		t.Fatalf("%+v %v", si, err)
+	}
+}
+
+// textCodec is a Codec storing values in their text form.
+type textCodec struct {
+	n int // Number of Encode calls.
+}
+
+func (c *textCodec) Name() string { return "text" }
+
+func (c *textCodec) Encode(v interface{}) ([]byte, error) {
+	c.n++
+	switch x := v.(type) {
+	case *big.Int:
+		return []byte(x.String()), nil
+	case *big.Rat:
+		return []byte(x.String()), nil
+	case time.Time:
+		return x.MarshalText()
+	case time.Duration:
+		return []byte(x.String()), nil
+	default:
+		return nil, fmt.Errorf("unexpected %T", v)
+	}
+}
+
+func (c *textCodec) Decode(b []byte, v interface{}) (err error) {
+	switch x := v.(type) {
+	case *big.Int:
+		if _, ok := x.SetString(string(b), 10); !ok {
+			return fmt.Errorf("invalid bigint %q", b)
+		}
+	case *big.Rat:
+		if _, ok := x.SetString(string(b)); !ok {
+			return fmt.Errorf("invalid bigrat %q", b)
+		}
+	case *time.Time:
+		return x.UnmarshalText(b)
+	case *time.Duration:
+		*x, err = time.ParseDuration(string(b))
+	default:
+		return fmt.Errorf("unexpected %T", v)
+	}
+	return
+}
+
+func TestCodec(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "ql.db")
+	codec := &textCodec{}
+	db, err := OpenFile(name, &Options{CanCreate: true, Codec: codec})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i bigint, r bigrat, m time, d duration, a array);
+		INSERT INTO t VALUES (
+			bigint("12345678901234567890"),
+			bigrat("1/3"),
+			date(2015, 1, 2, 3, 4, 5, 6, "UTC"),
+			duration("1h2m"),
+			array(bigint(-7), bigint(8)),
+		);
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if codec.n == 0 {
+		t.Fatal("codec not used")
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = OpenFile(name, &Options{}); err == nil || !strings.Contains(err.Error(), `uses codec "text", not "gob"`) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if db, err = OpenFile(name, &Options{Codec: &textCodec{}}); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, _, err := db.Run(nil, "SELECT * FROM t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[12345678901234567890 1/3 2015-01-02 03:04:05.000000006 +0000 UTC 1h2m0s [-7 8]]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	name2 := filepath.Join(dir, "ql2.db")
+	if db, err = OpenFile(name2, &Options{CanCreate: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = OpenFile(name2, &Options{Codec: &textCodec{}}); err == nil || !strings.Contains(err.Error(), `uses codec "gob", not "text"`) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if _, err = OpenFile(filepath.Join(dir, "ql3.db"), &Options{CanCreate: true, Codec: &gobCoder{}}); err == nil || !strings.Contains(err.Error(), "reserved") {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	ctx := NewRWCtx()
+	if _, _, err = db.Run(ctx, `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int, s string);
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(ctx, "BEGIN TRANSACTION;"); err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 1000
+	for i := 0; i < n; i++ {
+		s := interface{}(fmt.Sprint(i))
+		if i%10 == 0 {
+			s = nil
+		}
+		if _, _, err = db.Run(ctx, "INSERT INTO t VALUES ($1, $2);", int64(i), s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, _, err = db.Run(ctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query(nil, "SELECT i, s FROM t ORDER BY i;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows.Columns()), "[i s]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+
+	var c int64
+	for rows.Next() {
+		var i int64
+		var s interface{}
+		if err = rows.Scan(&i, &s); err != nil {
+			t.Fatal(err)
+		}
+
+		if i != c {
+			t.Fatalf("got %d, expected %d", i, c)
+		}
+
+		if g, e := s == nil, i%10 == 0; g != e {
+			t.Fatalf("%d: got %v, expected %v", i, s, e)
+		}
+
+		c++
+	}
+	if err = rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if c != n {
+		t.Fatalf("got %d rows, expected %d", c, n)
+	}
+
+	// Close early, the DB must be usable afterwards.
+	if rows, err = db.Query(nil, "SELECT s FROM t WHERE i > 5;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if !rows.Next() {
+		t.Fatal(rows.Err())
+	}
+
+	var i int64
+	if err = rows.Scan(&i); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	if err = rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if rows.Next() {
+		t.Fatal("unexpected row")
+	}
+
+	if _, _, err = db.Run(NewRWCtx(), "BEGIN TRANSACTION; DELETE FROM t; COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = db.Query(nil, "SELECT * FROM nonexistent;"); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	if _, err = db.Query(ctx, "BEGIN TRANSACTION; COMMIT;"); err == nil {
+		t.Fatal("unexpected success")
+	}
+}
+
+func TestLockTimeout(t *testing.T) {
+	const d = 50 * time.Millisecond
+	db, done := tempDB(t, &Options{CanCreate: true, LockTimeout: d})
+	defer done()
+
+	ctx := NewRWCtx()
+	if _, _, err := db.Run(ctx, `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int);
+		INSERT INTO t VALUES (1), (2);
+	COMMIT;
+	BEGIN TRANSACTION;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if g := db.Locks(); !g.Writer || g.WriterSince.IsZero() || g.Readers != 0 {
+		t.Fatalf("%+v", g)
+	}
+
+	check := func(err error, write bool) {
+		e, ok := err.(*LockTimeoutError)
+		if !ok {
+			t.Fatalf("expected *LockTimeoutError, got %T(%v)", err, err)
+		}
+
+		if g, e := e.Write, write; g != e {
+			t.Fatalf("%v: got Write %v, expected %v", err, g, e)
+		}
+
+		if e.Timeout != d {
+			t.Fatal(e.Timeout)
+		}
+	}
+
+	t0 := time.Now()
+	_, _, err := db.Run(nil, "SELECT * FROM t;")
+	check(err, false)
+	if time.Since(t0) < d {
+		t.Fatal(time.Since(t0))
+	}
+
+	_, _, err = db.Run(NewRWCtx(), "BEGIN TRANSACTION;")
+	check(err, true)
+
+	if _, _, err = db.Run(ctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query(nil, "SELECT * FROM t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g := db.Locks(); g.Writer || g.Readers != 1 {
+		t.Fatalf("%+v", g)
+	}
+
+	_, _, err = db.Run(NewRWCtx(), "BEGIN TRANSACTION;")
+	check(err, true)
+	if g := err.(*LockTimeoutError).Locks; g.Readers != 1 || g.WaitingWriters != 0 {
+		t.Fatalf("%+v", g)
+	}
+
+	if err = rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if g := db.Locks(); g != (LockInfo{}) {
+		t.Fatalf("%+v", g)
+	}
+
+	if _, _, err = db.Run(NewRWCtx(), "BEGIN TRANSACTION; INSERT INTO t VALUES (3); COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUserVersion(t *testing.T) {
+	db, done := reopenedDB(t, &Options{}, "PRAGMA user_version = -12345;")
+	defer done()
+
+	if g, e := db.UserVersion(), int32(-12345); g != e {
+		t.Fatal(g, e)
+	}
+
+	rs, _, err := db.Run(nil, "PRAGMA user_version;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row, err := rs[0].FirstRow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := row[0], int32(-12345); g != e {
+		t.Fatal(g, e)
+	}
+}
+
+func TestApplicationID(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	nm := filepath.Join(dir, "ql.db")
+	db, err := OpenFile(nm, &Options{CanCreate: true, ApplicationID: 0x514c4150})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := db.ApplicationID(), int32(0x514c4150); g != e {
+		t.Fatal(g, e)
+	}
+
+	if _, _, err = db.Run(nil, "PRAGMA user_version = 3;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = OpenFile(nm, &Options{ApplicationID: 42}); err == nil || !strings.Contains(err.Error(), "file-026") {
+		t.Fatal(err)
+	}
+
+	for _, id := range []int32{0, 0x514c4150} {
+		if db, err = OpenFile(nm, &Options{ApplicationID: id}); err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := db.ApplicationID(), int32(0x514c4150); g != e {
+			t.Fatal(g, e)
+		}
+
+		if g, e := db.UserVersion(), int32(3); g != e {
+			t.Fatal(g, e)
+		}
+
+		if err = db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCreateIndexPopulated(t *testing.T) {
+	m := &testMetrics{inc: map[Metric]int64{}, obs: map[Metric]int{}}
+	db, done := tempDB(t, &Options{CanCreate: true, Metrics: m})
+	defer done()
+
+	const n = 1000
+	ctx := NewRWCtx()
+	if _, _, err := db.Run(ctx, "BEGIN TRANSACTION; CREATE TABLE t (i int);"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < n; i++ {
+		if _, _, err := db.Run(ctx, "INSERT INTO t VALUES ($1);", int64(i%(n-1))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, _, err := db.Run(ctx, "CREATE UNIQUE INDEX x ON t (i);"); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	// The failed index must not be left behind.
+	if _, _, err := db.Run(ctx, "CREATE INDEX x ON t (i); COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	m.mu.Lock()
+	g := m.inc[MetricIndexRows]
+	m.mu.Unlock()
+	if g <= n || g >= 2*n {
+		t.Fatalf("%s: got %d, expected more than %d and less than %d", MetricIndexRows, g, n, 2*n)
+	}
+
+	rs, _, err := db.Run(nil, "SELECT count() FROM t WHERE i == 0;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row, err := rs[0].FirstRow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := row[0], int64(2); g != e {
+		t.Fatal(g, e)
+	}
+
+	rs, _, err = db.Run(nil, "SELECT Name, IsUnique FROM __Index WHERE TableName == \"t\";")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[x false]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+}
+
+func TestDropIndexFrees(t *testing.T) {
+	db, done := tempDB(t, &Options{CanCreate: true})
+	defer done()
+
+	ctx := NewRWCtx()
+	if _, _, err := db.Run(ctx, "BEGIN TRANSACTION; CREATE TABLE t (i int, s string);"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 1000; i++ {
+		if _, _, err := db.Run(ctx, "INSERT INTO t VALUES ($1, $2);", int64(i), fmt.Sprint(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, _, err := db.Run(ctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	a0, err := db.store.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []string{
+		"CREATE INDEX x ON t (i);",
+		"CREATE INDEX x ON t (i); CREATE INDEX y ON t (id()); CREATE FULLTEXT INDEX z ON t (s);",
+	} {
+		if _, _, err = db.Run(ctx, "BEGIN TRANSACTION; "+v+" COMMIT;"); err != nil {
+			t.Fatal(err)
+		}
+
+		a, err := db.store.Verify()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a <= a0 {
+			t.Fatal(a, a0)
+		}
+
+		if _, _, err = db.Run(ctx, `
+		BEGIN TRANSACTION;
+			DROP INDEX x;
+			DROP INDEX IF EXISTS y;
+			DROP INDEX IF EXISTS z;
+		COMMIT;`,
+		); err != nil {
+			t.Fatal(err)
+		}
+
+		if a, err = db.store.Verify(); err != nil {
+			t.Fatal(err)
+		}
+
+		if a != a0 {
+			t.Fatalf("%s: got %d allocated atoms, expected %d", v, a, a0)
+		}
+	}
+
+	rs, _, err := db.Run(nil, "SELECT count() FROM __Index;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row, err := rs[0].FirstRow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := row[0], int64(0); g != e {
+		t.Fatal(g, e)
+	}
+}
+
+func TestReindex(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	ctx := NewRWCtx()
+	if _, _, err = db.Run(ctx, `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int);
+		INSERT INTO t VALUES (1), (2), (3);
+		CREATE INDEX x ON t (i);
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	count := func() int64 {
+		rs, _, err := db.Run(nil, "SELECT count() FROM t WHERE i == 2;")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		row, err := rs[0].FirstRow()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return row[0].(int64)
+	}
+
+	if g, e := count(), int64(1); g != e {
+		t.Fatal(g, e)
+	}
+
+	// Make the index stale.
+	if _, _, err = db.Run(ctx, "BEGIN TRANSACTION;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.root.tables["t"].indices[1].x.Clear(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(ctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := count(), int64(0); g != e {
+		t.Fatal(g, e)
+	}
+
+	rs, _, err := db.Run(ctx, "BEGIN TRANSACTION; REINDEX t; COMMIT;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[x 3]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+
+	if g, e := count(), int64(1); g != e {
+		t.Fatal(g, e)
+	}
+}
+
+func TestEnforceConversionError(t *testing.T) {
+	val := []interface{}{int64(42), "foo"}
+	err := enforce(val, []*col{{name: "a", typ: qInt64}, {typ: qBool}})
+	e, ok := err.(*ConversionError)
+	if !ok {
+		t.Fatalf("%T(%v)", err, err)
+	}
+
+	if e.Column != "" || e.Index != 1 || e.Value != "foo" || e.Type != Bool {
+		t.Fatalf("%+v", e)
+	}
+
+	if g, e := err.Error(), "column #1: cannot convert foo (type string) to type bool"; g != e {
+		t.Fatalf("got %q, expected %q", g, e)
+	}
+}
+
+func TestParameterizedLimitOffset(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err = db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int);
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100; i++ {
+		if _, _, err = db.Run(NewRWCtx(), "BEGIN TRANSACTION; INSERT INTO t VALUES ($1); COMMIT;", int64(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, v := range []struct {
+		lim, off interface{}
+		e        string
+	}{
+		{int64(3), int64(10), "[[10] [11] [12]]"},
+		{uint8(2), int64(0), "[[0] [1]]"},
+		{int64(0), int64(5), "[]"},
+		{int64(10), int32(98), "[[98] [99]]"},
+		{int64(-1), int64(0), "must be non-negative"},
+		{int64(1), int64(-1), "must be non-negative"},
+		{"1", int64(0), "non-integer"},
+		{int64(1), 1.5, "non-integer"},
+	} {
+		rs, _, err := db.Run(nil, "SELECT i FROM t ORDER BY i LIMIT $1 OFFSET $2;", v.lim, v.off)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		g := fmt.Sprint(rows)
+		if err != nil {
+			g = err.Error()
+		}
+		if !strings.Contains(g, v.e) {
+			t.Fatalf("LIMIT %v OFFSET %v: got %s, expected %s", v.lim, v.off, g, v.e)
+		}
+	}
+}
+
+func TestTx(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = tx.Run("CREATE TABLE t (i int); INSERT INTO t VALUES (1), (2);"); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := tx.Ctx().RowsAffected, int64(2); g != e {
+		t.Fatal(g, e)
+	}
+
+	rows, err := tx.Query("SELECT sum(i) FROM t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var n int64
+	if !rows.Next() {
+		t.Fatal(rows.Err())
+	}
+
+	if err = rows.Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := n, int64(3); g != e {
+		t.Fatal(g, e)
+	}
+
+	if _, _, err = tx.Run("COMMIT;"); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = tx.Commit(); err != errTxDone {
+		t.Fatal(err)
+	}
+
+	if _, _, err = tx.Run("INSERT INTO t VALUES (3);"); err != errTxDone {
+		t.Fatal(err)
+	}
+
+	if tx, err = db.Begin(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = tx.Run("INSERT INTO t VALUES (3);"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = tx.Commit(); err != errTxDone {
+		t.Fatal(err)
+	}
+
+	rs, _, err := db.Run(nil, "SELECT count() FROM t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row, err := rs[0].FirstRow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := row[0], int64(2); g != e {
+		t.Fatal(g, e)
+	}
+}
+
+func TestReadYourWrites(t *testing.T) {
+	db, done := tempDB(t, &Options{CanCreate: true})
+	defer done()
+
+	if _, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int, s string);
+			CREATE INDEX x ON t (i);
+			INSERT INTO t VALUES (1, "a");
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := NewRWCtx()
+	for _, v := range []struct {
+		q, e string
+	}{
+		{`
+		BEGIN TRANSACTION;
+			INSERT INTO t VALUES (2, "b"), (3, "c");
+			UPDATE t s = "A" WHERE i == 1;
+			SELECT i, s FROM t ORDER BY i;`,
+			"[[1 A] [2 b] [3 c]]",
+		},
+		{`
+			DELETE FROM t WHERE i == 2;
+			SELECT s FROM t WHERE i >= 2;`,
+			"[[c]]",
+		},
+		{`
+		ROLLBACK;
+		SELECT i, s FROM t;`,
+			"[[1 a]]",
+		},
+	} {
+		rs, _, err := db.Run(ctx, v.q)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g := fmt.Sprint(rows); g != v.e {
+			t.Fatalf("%s: got %s, expected %s", v.q, g, v.e)
+		}
+	}
+}
+
+func TestQuotedIdentifiers(t *testing.T) {
+	for _, v := range []struct{ s, e string }{
+		{"a", "a"},
+		{"_x1", "_x1"},
+		{"comment", "comment"}, // Not reserved.
+		{"Order", "`Order`"},
+		{"int8", "`int8`"},
+		{"1x", "`1x`"},
+		{"a b", "`a b`"},
+		{"é", "`é`"},
+	} {
+		if g := quoteIdent(v.s); g != v.e {
+			t.Errorf("quoteIdent(%q): got %s, expected %s", v.s, g, v.e)
+		}
+	}
+
+	l, err := CompileQuoted(`CREATE TABLE "order" ("select" int, s int AS ("select" + 1) STORED); SELECT "select" AS "by" FROM "order" WHERE s == `+"`select`;", '"')
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := l.String()
+	if e := "CREATE TABLE `order` (`select` int64, s int64 AS (`select`+1) STORED);\nSELECT `select` AS `by` FROM `order` WHERE s==\"select\";\n"; g != e {
+		t.Fatalf("got\n%s\nexpected\n%s", g, e)
+	}
+
+	if l, err = CompileQuoted(g, '`'); err != nil {
+		t.Fatal(err)
+	}
+
+	if s := l.String(); s != g {
+		t.Fatalf("got\n%s\nexpected\n%s", s, g)
+	}
+
+	for _, v := range []struct {
+		src   string
+		quote rune
+		err   string
+	}{
+		{"SELECT * FROM t;", '\'', "invalid identifier quote"},
+		{`SELECT "" FROM t;`, '"', "invalid quoted identifier"},
+		{`SELECT "t.c" FROM t;`, '"', "invalid quoted identifier"},
+		{"SELECT order FROM t;", '"', "syntax error"},
+	} {
+		if _, err := CompileQuoted(v.src, v.quote); err == nil || !strings.Contains(err.Error(), v.err) {
+			t.Fatalf("%s: got %v, expected %s", v.src, err, v.err)
+		}
+	}
+
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	nm := filepath.Join(dir, "ql.db")
+	db, err := OpenFile(nm, &Options{CanCreate: true, IdentifierQuote: '"'})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE "order" ("select" int, n int AS ("select" * 2) STORED COMMENT `+"`doubled`"+`);
+			INSERT INTO "order" ("select") VALUES (21);
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The schema quotes the identifiers as the DB, so it can be run by it.
+	rs, _, err := db.Run(nil, "SELECT Schema FROM __Table WHERE Name == `order`;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row, err := rs[0].FirstRow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schema := row[0].(string)
+	if e := `CREATE TABLE "order" ("select" int64, n int64 AS ("select"*2) STORED COMMENT ` + "`doubled`);"; schema != e {
+		t.Fatalf("got %s, expected %s", schema, e)
+	}
+
+	db2, err := OpenFile(filepath.Join(dir, "ql2.db"), &Options{CanCreate: true, IdentifierQuote: '"'})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db2.Close()
+
+	if _, _, err = db2.Run(NewRWCtx(), "BEGIN TRANSACTION; "+schema+" COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if db, err = OpenFile(nm, &Options{IdentifierQuote: '`'}); err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if rs, _, err = db.Run(nil, "SELECT `select`, n FROM `order`; SELECT Schema FROM __Table WHERE Name == \"order\";"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, e := range []string{
+		"[[21 42]]",
+		"[[CREATE TABLE `order` (`select` int64, n int64 AS (`select`*2) STORED COMMENT \"doubled\");]]",
+	} {
+		rows, err := rs[i].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g := fmt.Sprint(rows); g != e {
+			t.Fatalf("got %s, expected %s", g, e)
+		}
+	}
+
+	if _, err = OpenFile(nm, &Options{IdentifierQuote: '\''}); err == nil {
+		t.Fatal("unexpected success")
+	}
+}
+
+func TestAttach(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	ref := filepath.Join(dir, "ref.db")
+	rdb, err := OpenFile(ref, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = rdb.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE lookup (code int, name string);
+			CREATE INDEX x ON lookup (code);
+			INSERT INTO lookup VALUES (1, "one"), (2, "two");
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = rdb.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	ctx := NewRWCtx()
+	if _, _, err = db.Run(ctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int, code int);
+			INSERT INTO t VALUES (10, 2), (20, 1), (30, 3);
+		COMMIT;
+		ATTACH DATABASE $1 AS ref;
+	`, ref); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []struct {
+		q, e string
+	}{
+		{`
+			SELECT t.i, lookup.name
+			FROM main.t, ref.lookup
+			WHERE t.code == lookup.code
+			ORDER BY t.i;`,
+			"[[10 two] [20 one]]",
+		},
+		{"SELECT name FROM ref.lookup WHERE code == 2;", "[[two]]"},
+		{"SELECT l.name, t.i FROM ref.lookup AS l, t WHERE l.code == t.code ORDER BY t.i;", "[[two 10] [one 20]]"},
+		{"SELECT Name FROM ref.__Table;", "[[lookup]]"},
+		{"SELECT count() FROM t WHERE code IN (SELECT code FROM ref.lookup);", "[[2]]"},
+	} {
+		rs, _, err := db.Run(nil, v.q)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g := fmt.Sprint(rows); g != v.e {
+			t.Fatalf("%s: got %s, expected %s", v.q, g, v.e)
+		}
+	}
+
+	for _, v := range []struct {
+		q, e string
+	}{
+		{"ATTACH DATABASE $1 AS ref;", "database ref already exists"},
+		{"ATTACH DATABASE $1 AS main;", "database main already exists"},
+		{"BEGIN TRANSACTION; INSERT INTO ref.lookup VALUES (3, \"three\"); COMMIT;", "syntax error"},
+		{"BEGIN TRANSACTION; DETACH DATABASE ref; COMMIT;", "cannot execute DETACH DATABASE ref; in a transaction"},
+		{"DETACH DATABASE other;", "database other is not attached"},
+	} {
+		if _, _, err := db.Run(NewRWCtx(), v.q, ref); err == nil || !strings.Contains(err.Error(), v.e) {
+			t.Fatalf("%s: got %v, expected %s", v.q, err, v.e)
+		}
+	}
+
+	if _, err = db.Query(nil, "SELECT * FROM other.lookup;"); err == nil || !strings.Contains(err.Error(), "database other is not attached") {
+		t.Fatalf("got %v", err)
+	}
+
+	if _, _, err = db.Run(nil, "DETACH DATABASE ref;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = db.Query(nil, "SELECT * FROM ref.lookup;"); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	l, err := Compile("ATTACH DATABASE \"ref.db\" AS ref; SELECT * FROM ref.lookup AS l, main.t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := l.String(), "ATTACH DATABASE \"ref.db\" AS ref;\nSELECT * FROM ref.lookup AS l, main.t;\n"; g != e {
+		t.Fatalf("got %q, expected %q", g, e)
+	}
+}
+
+func TestStrictConversions(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err = db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i8 int8, i16 int16, i32 int32, u8 uint8, u16 uint16, u32 uint32, i int);
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	ins := "BEGIN TRANSACTION; INSERT INTO t VALUES (int8($1), int16($2), int32($3), uint8($4), uint16($5), uint32($6), int($7)); COMMIT;"
+	for _, v := range []struct {
+		arg []interface{}
+		err string // In strict mode.
+	}{
+		{[]interface{}{int64(-128), int64(-32768), int64(1<<31 - 1), int64(255), int64(65535), int64(1<<32 - 1), 2.0}, ""},
+		{[]interface{}{int64(128), int64(0), int64(0), int64(0), int64(0), int64(0), 0.0}, "int8 overflows"},
+		{[]interface{}{int64(0), int64(-32769), int64(0), int64(0), int64(0), int64(0), 0.0}, "int16 overflows"},
+		{[]interface{}{int64(0), int64(0), int64(1 << 31), int64(0), int64(0), int64(0), 0.0}, "int32 overflows"},
+		{[]interface{}{int64(0), int64(0), int64(0), int64(-1), int64(0), int64(0), 0.0}, "uint8 overflows"},
+		{[]interface{}{int64(0), int64(0), int64(0), int64(0), int64(65536), int64(0), 0.0}, "uint16 overflows"},
+		{[]interface{}{int64(0), int64(0), int64(0), int64(0), int64(0), int64(1 << 32), 0.0}, "uint32 overflows"},
+		{[]interface{}{int64(0), int64(0), int64(0), int64(0), int64(0), int64(0), -0.5}, "discards the fraction"},
+		{[]interface{}{300.0, int64(0), int64(0), int64(0), int64(0), int64(0), 0.0}, "int8 overflows"},
+	} {
+		for _, strict := range []bool{false, true} {
+			if _, _, err = db.Run(nil, "PRAGMA strict_conversions = $1;", strict); err != nil {
+				t.Fatal(err)
+			}
+
+			_, _, err := db.Run(NewRWCtx(), ins, v.arg...)
+			switch {
+			case !strict || v.err == "":
+				if err != nil {
+					t.Fatalf("%v, strict %v: %v", v.arg, strict, err)
+				}
+			case err == nil || !strings.Contains(err.Error(), v.err):
+				t.Fatalf("%v: got %v, expected %s", v.arg, err, v.err)
+			}
+		}
+	}
+
+	rs, _, err := db.Run(nil, "PRAGMA strict_conversions; SELECT count() FROM t; SELECT i8, u8, i FROM t WHERE i == 2;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, e := range []string{"[[true]]", "[[10]]", "[[-128 255 2] [-128 255 2]]"} {
+		rows, err := rs[i].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g := fmt.Sprint(rows); g != e {
+			t.Fatalf("got %s, expected %s", g, e)
+		}
+	}
+}
+
+func TestInsertOr(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err = db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int, s string);
+			CREATE UNIQUE INDEX x ON t (i);
+			CREATE UNIQUE INDEX y ON t (s);
+			INSERT INTO t VALUES (1, "a"), (2, "b"), (3, "c");
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []struct {
+		src                         string
+		affected, ignored, replaced int64
+	}{
+		{`INSERT OR IGNORE INTO t VALUES (1, "x"), (4, "d"), (5, "a");`, 1, 2, 0},
+		{`INSERT OR REPLACE INTO t VALUES (1, "x"), (6, "e");`, 2, 0, 1},
+		{`INSERT OR REPLACE INTO t VALUES (2, "c");`, 1, 0, 1},
+		{`INSERT OR REPLACE INTO t SELECT i, s+"!" FROM t WHERE i < 3;`, 2, 0, 2},
+		{`INSERT INTO t VALUES (7, "f");`, 1, 0, 0},
+		{`INSERT INTO t VALUES (7, "g"), (8, "h") ON CONFLICT (i) DO UPDATE s = excluded.s;`, 2, 0, 0},
+		{`INSERT INTO t VALUES (8, "i") ON CONFLICT (i) DO UPDATE s = excluded.s WHERE false;`, 0, 0, 0},
+	} {
+		ctx := NewRWCtx()
+		if _, _, err = db.Run(ctx, "BEGIN TRANSACTION; "+v.src+" COMMIT;"); err != nil {
+			t.Fatalf("%s: %v", v.src, err)
+		}
+
+		if g, e := [3]int64{ctx.RowsAffected, ctx.RowsIgnored, ctx.RowsReplaced}, [3]int64{v.affected, v.ignored, v.replaced}; g != e {
+			t.Fatalf("%s: got affected, ignored, replaced %v, expected %v", v.src, g, e)
+		}
+	}
+
+	rs, _, err := db.Run(nil, "SELECT i, s FROM t ORDER BY i;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[1 x!] [2 c!] [4 d] [6 e] [7 g] [8 h]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+
+	if _, _, err = db.Run(NewRWCtx(), `BEGIN TRANSACTION; INSERT INTO t VALUES (7, "g"); COMMIT;`); err == nil {
+		t.Fatal("unexpected success")
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	const composed, decomposed = "caf\u00e9", "cafe\u0301"
+	nfc := func(s string) string { return strings.Replace(s, decomposed, composed, -1) }
+	db, done := tempDB(t, &Options{CanCreate: true, Normalize: nfc})
+	defer done()
+
+	if _, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (s string, n int);
+			CREATE UNIQUE INDEX x ON t (s);
+			INSERT INTO t VALUES ($1, 1);
+		COMMIT;
+	`, decomposed); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := db.Run(NewRWCtx(), `BEGIN TRANSACTION; INSERT INTO t VALUES ("`+composed+`", 2); COMMIT;`); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	for _, v := range []struct {
+		src string
+		arg []interface{}
+	}{
+		{"SELECT s, n FROM t WHERE s == $1;", []interface{}{composed}},
+		{"SELECT s, n FROM t WHERE s == $1;", []interface{}{decomposed}},
+		{`SELECT s, n FROM t WHERE s == "` + decomposed + `";`, nil},
+	} {
+		rs, _, err := db.Run(nil, v.src, v.arg...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := fmt.Sprintf("%q", rows), fmt.Sprintf("%q", [][]interface{}{{composed, int64(1)}}); g != e {
+			t.Fatalf("%s %q: got %s, expected %s", v.src, v.arg, g, e)
+		}
+	}
+}
+
+func TestGroupByIndex(t *testing.T) {
+	m := &testMetrics{inc: map[Metric]int64{}, obs: map[Metric]int{}}
+	db, done := tempDB(t, &Options{
+		CanCreate:          true,
+		TempSpillThreshold: -1,
+		Metrics:            m,
+	})
+	defer done()
+
+	if _, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (c int, s string, n int);
+			CREATE TABLE u (c int, s string, n int);
+			CREATE INDEX x ON t (c);
+			CREATE INDEX y ON t (s);
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100; i++ {
+		var s interface{}
+		if i%7 != 0 {
+			s = fmt.Sprint(i % 5)
+		}
+		if _, _, err := db.Run(NewRWCtx(), "BEGIN TRANSACTION; INSERT INTO t VALUES ($1, $2, $3); INSERT INTO u VALUES ($1, $2, $3); COMMIT;", int64(i%13), s, int64(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tempFiles := func() int64 {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		return m.inc[MetricTempFiles]
+	}
+
+	for _, q := range []string{
+		"SELECT c, count(), sum(n), min(s), max(n) FROM %s GROUP BY c;",
+		"SELECT s, count(), avg(n) FROM %s GROUP BY s;",
+		"SELECT c FROM %s GROUP BY c;",
+		"SELECT count() AS k, s FROM %s GROUP BY s LIMIT 2;",
+	} {
+		var a [2]string
+		var tmp [2]int64
+		for i, tn := range []string{"t", "u"} {
+			n := tempFiles()
+			rs, _, err := db.Run(nil, fmt.Sprintf(q, tn))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rows, err := rs[0].Rows(-1, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			a[i] = fmt.Sprint(rows)
+			tmp[i] = tempFiles() - n
+		}
+		if a[0] != a[1] {
+			t.Fatalf("%s: got %s, expected %s", q, a[0], a[1])
+		}
+
+		if tmp[0] != 0 || tmp[1] == 0 {
+			t.Fatalf("%s: temp files created, using index %d, not using index %d", q, tmp[0], tmp[1])
+		}
+	}
+}
+
+func TestFieldInfo(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err = db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int NOT NULL, s string, f float64);
+			CREATE TABLE u (b bool, t time);
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []struct {
+		src string
+		e   string
+	}{
+		{"SELECT * FROM t;", "[{i int64 false} {s string true} {f float64 true}]"},
+		{"SELECT s AS x, i, int8(f), 42, i+1 FROM t WHERE i > 0 ORDER BY s LIMIT 1;", "[{x string true} {i int64 false} { int8 true} { int64 false} {  true}]"},
+		{"SELECT s, count(), max(i) AS m FROM t GROUP BY s;", "[{s string true} { int64 false} {m int64 true}]"},
+		{"SELECT * FROM t, u;", "[{t.i int64 false} {t.s string true} {t.f float64 true} {u.b bool true} {u.t time true}]"},
+		{"SELECT x.s, y.t FROM (SELECT DISTINCT s FROM t) AS x, u AS y;", "[{x.s string true} {y.t time true}]"},
+		{"SELECT Name FROM __Table;", "[{Name  true}]"},
+	} {
+		rs, _, err := db.Run(nil, v.src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		fi, err := rs[0].FieldInfo()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g := fmt.Sprint(fi); g != v.e {
+			t.Fatalf("%s: got %s, expected %s", v.src, g, v.e)
+		}
+	}
+}
+
+func TestSelectForUpdate(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	l, err := Compile("SELECT * FROM t WHERE i > 1 FOR UPDATE;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := l.String(), "SELECT * FROM t WHERE i>1 FOR UPDATE;\n"; g != e {
+		t.Fatalf("got %q, expected %q", g, e)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = tx.Run("CREATE TABLE t (i int); INSERT INTO t VALUES (1), (2), (3);"); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := tx.Query(l.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := 0
+	for rows.Next() {
+		n++
+	}
+	if err = rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := n, 2; g != e {
+		t.Fatal(g, e)
+	}
+
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Execute(nil, l); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	if _, _, err = db.Execute(NewRWCtx(), l); err == nil {
+		t.Fatal("unexpected success")
+	}
+}
+
+func TestRepair(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err = db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int, s string);
+			CREATE INDEX x ON t (i);
+			CREATE UNIQUE INDEX y ON t (id());
+			CREATE FULLTEXT INDEX z ON t (s);
+			INSERT INTO t VALUES (1, "foo bar"), (2, "baz");
+		COMMIT;
+	`); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := db.Repair(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := len(r.Dangling), 0; g != e {
+		t.Fatal(r.Dangling)
+	}
+
+	tab := db.root.tables["t"]
+	if err = db.store.BeginTransaction(); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, v := range []interface{}{int64(42), int64(3), "qux"} {
+		if err = tab.indices[i].x.Create(v, 1<<40); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = db.store.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	e := "map[x:1 y:1 z:1]"
+	for _, fix := range []bool{false, true, false} {
+		if r, err = db.Repair(fix); err != nil {
+			t.Fatal(err)
+		}
+
+		if g := fmt.Sprint(r.Dangling); g != e || r.Fixed != fix {
+			t.Fatalf("fix %v: got %s %v, expected %s", fix, g, r.Fixed, e)
+		}
+
+		if fix {
+			e = "map[]"
+		}
+	}
+
+	rs, _, err := db.Run(nil, "SELECT i FROM t WHERE i > 0 ORDER BY i;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[1] [2]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+}
+
+func TestOnChange(t *testing.T) {
+	var a []string
+	onChange := func(e ChangeEvent) { a = append(a, fmt.Sprintf("%s %v %d %v %v", e.Table, e.Op, e.ID, e.Old, e.New)) }
+	db, done := tempDB(t, &Options{CanCreate: true, OnChange: onChange})
+	defer done()
+
+	for _, v := range []struct {
+		src string
+		e   string
+	}{
+		{`
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int, s string, v int AS (i*2));
+			CREATE UNIQUE INDEX x ON t (i);
+			INSERT INTO t VALUES (1, "a"), (2, "b");
+		COMMIT;`,
+			"[t insert 1 [] [1 a 2] t insert 2 [] [2 b 4]]",
+		},
+		{`
+		BEGIN TRANSACTION;
+			UPDATE t s = "c" WHERE i == 1;
+			BEGIN TRANSACTION;
+				DELETE FROM t;
+			ROLLBACK;
+			BEGIN TRANSACTION;
+				DELETE FROM t WHERE i == 2;
+			COMMIT;
+		COMMIT;`,
+			"[t update 1 [1 a 2] [1 c 2] t delete 2 [2 b 4] []]",
+		},
+		{`
+		BEGIN TRANSACTION;
+			INSERT INTO t VALUES (3, "d");
+		ROLLBACK;`,
+			"[]",
+		},
+		{`
+		BEGIN TRANSACTION;
+			INSERT OR REPLACE INTO t VALUES (1, "e");
+			INSERT INTO t VALUES (1, "f") ON CONFLICT (i) DO UPDATE s = excluded.s;
+			TRUNCATE TABLE t;
+		COMMIT;`,
+			"[t delete 1 [1 c 2] [] t insert 4 [] [1 e 2] t update 4 [1 e 2] [1 f 2] t delete 4 [1 f 2] []]",
+		},
+	} {
+		a = nil
+		if _, _, err := db.Run(NewRWCtx(), v.src); err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := fmt.Sprint(a), v.e; g != e {
+			t.Fatalf("%s\ngot %s\nexp %s", v.src, g, e)
+		}
+	}
+}
+
+func TestChanges(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	nm := filepath.Join(dir, "ql.db")
+	db, err := OpenFile(nm, &Options{CanCreate: true, ChangeLog: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, err = db.Changes(1, 0); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	live, err := db.Changes(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ch := make(chan string)
+	go func() {
+		var a []string
+		for e := range live.C {
+			a = append(a, fmt.Sprintf("%d %v %v", e.Seq, e.Op, e.New))
+		}
+		ch <- fmt.Sprint(a)
+	}()
+
+	for _, v := range []string{
+		"BEGIN TRANSACTION; CREATE TABLE t (i int); COMMIT;",
+		"BEGIN TRANSACTION; INSERT INTO t VALUES (1), (2); COMMIT;",
+		"BEGIN TRANSACTION; INSERT INTO t VALUES (3); ROLLBACK;",
+		"BEGIN TRANSACTION; UPDATE t i = i+10 WHERE i == 1; COMMIT;",
+		"BEGIN TRANSACTION; INSERT INTO t VALUES (4); COMMIT;",
+	} {
+		if _, _, err = db.Run(NewRWCtx(), v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = live.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := <-ch, "[1 insert [1] 1 insert [2] 2 update [11] 3 insert [4]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+
+	// The log keeps only commits 2 and 3, 3 events would split commit 1.
+	if _, err = db.Changes(0, 0); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	s, err := db.Changes(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var a []string
+	for len(a) < 2 {
+		e := <-s.C
+		a = append(a, fmt.Sprintf("%d %v", e.Seq, e.New))
+	}
+	if g, e := fmt.Sprint(a), "[2 [11] 3 [4]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := <-s.C; ok {
+		t.Fatal("stream not closed")
+	}
+
+	// The sequence numbers continue after reopening, the changes before it
+	// are not available.
+	if db, err = OpenFile(nm, &Options{ChangeLog: 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = db.Changes(2, 0); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	if s, err = db.Changes(3, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(NewRWCtx(), "BEGIN TRANSACTION; INSERT INTO t VALUES (5); COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if e := <-s.C; e.Seq != 4 {
+		t.Fatalf("got sequence number %d, expected 4", e.Seq)
+	}
+}
+
+// readCountFile counts the reads of the DB file.
+type readCountFile struct {
+	*os.File
+	reads int
+}
+
+func (f *readCountFile) ReadAt(b []byte, off int64) (int, error) {
+	f.reads++
+	return f.File.ReadAt(b, off)
+}
+
+// scanReadAhead creates the DB file name having a table of n records, if it
+// does not exist, and returns the rows and the count of the reads of a full
+// scan of the table after opening the DB file with read ahead readAhead.
+func scanReadAhead(name string, n, readAhead int) (rows string, reads int, err error) {
+	if _, err := os.Stat(name); os.IsNotExist(err) {
+		db, err := OpenFile(name, &Options{CanCreate: true})
+		if err != nil {
+			return "", 0, err
+		}
+
+		if _, _, err = db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int, s string);
+		COMMIT;`); err != nil {
+			return "", 0, err
+		}
+
+		ctx := NewRWCtx()
+		for i := 0; i < n; i += 100 {
+			if _, _, err = db.Run(ctx, "BEGIN TRANSACTION;"); err != nil {
+				return "", 0, err
+			}
+
+			for j := i; j < i+100 && j < n; j++ {
+				if _, _, err = db.Run(ctx, "INSERT INTO t VALUES ($1, $2);", int64(j), strings.Repeat("x", 100)); err != nil {
+					return "", 0, err
+				}
+			}
+			if _, _, err = db.Run(ctx, "COMMIT;"); err != nil {
+				return "", 0, err
+			}
+		}
+		if err = db.Close(); err != nil {
+			return "", 0, err
+		}
+	}
+
+	f0, err := os.OpenFile(name, os.O_RDWR, 0666)
+	if err != nil {
+		return "", 0, err
+	}
+
+	f := &readCountFile{File: f0}
+	db, err := OpenFile(name, &Options{OSFile: f, ReadAhead: readAhead})
+	if err != nil {
+		return "", 0, err
+	}
+
+	defer db.Close()
+
+	f.reads = 0
+	rs, _, err := db.Run(nil, "SELECT i, s FROM t;")
+	if err != nil {
+		return "", 0, err
+	}
+
+	var cnt, sum, sumLen int64
+	if err = rs[0].Do(false, func(data []interface{}) (bool, error) {
+		cnt++
+		sum += data[0].(int64)
+		sumLen += int64(len(data[1].(string)))
+		return true, nil
+	}); err != nil {
+		return "", 0, err
+	}
+
+	return fmt.Sprint(cnt, sum, sumLen), f.reads, nil
+}
+
+func TestReadAhead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "ql.db")
+	if _, err = OpenFile(name, &Options{CanCreate: true, ReadAhead: -1}); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	e, r0, err := scanReadAhead(name, 1000, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g, r, err := scanReadAhead(name, 1000, 1<<16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+
+	if r*10 > r0 {
+		t.Fatalf("got %d reads with read ahead, %d reads without", r, r0)
+	}
+}
+
+func benchmarkReadAhead(b *testing.B, readAhead int) {
+	dir, err := ioutil.TempDir("", "ql-bench-")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "ql.db")
+	if _, _, err = scanReadAhead(name, 1e4, readAhead); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err = scanReadAhead(name, 1e4, readAhead); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkScanReadAhead0(b *testing.B) { benchmarkReadAhead(b, 0) }
+
+func BenchmarkScanReadAhead64k(b *testing.B) { benchmarkReadAhead(b, 1<<16) }
+
+func BenchmarkScanFileAllocs(b *testing.B) {
+	dir, err := ioutil.TempDir("", "ql-bench-")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "ql.db")
+	if _, _, err = scanReadAhead(name, 1e4, 0); err != nil {
+		b.Fatal(err)
+	}
+
+	db, err := OpenFile(name, &Options{})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	defer db.Close()
+
+	l := MustCompile("SELECT i, s FROM t;")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rs, _, err := db.Execute(nil, l)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if err = rs[0].Do(false, func(data []interface{}) (bool, error) { return true, nil }); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestApproxPercentile(t *testing.T) {
+	const n = 100000
+	rng := rand.New(rand.NewSource(42))
+	var k gkSketch
+	a := make([]float64, n)
+	for i := range a {
+		a[i] = rng.NormFloat64()
+		k.insert(a[i])
+	}
+	sort.Float64s(a)
+	for _, p := range []float64{0, 0.01, 0.25, 0.5, 0.9, 0.95, 0.99, 0.999, 1} {
+		v := k.quantile(p)
+		r := sort.SearchFloat64s(a, v)
+		if d := math.Abs(float64(r) - p*n); d > quantileEpsilon*n+1 {
+			t.Errorf("p %v: got rank %d, expected %v±%v", p, r, p*n, quantileEpsilon*n)
+		}
+	}
+	if g, e := len(k.s), n/10; g > e {
+		t.Errorf("sketch has %d tuples, expected at most %d", g, e)
+	}
+}
+
+func TestKV(t *testing.T) {
+	db, done := tempDB(t, &Options{CanCreate: true})
+	defer done()
+
+	if _, ok, err := db.KVGet(nil, []byte("a")); ok || err != nil {
+		t.Fatal(ok, err)
+	}
+
+	ctx := NewRWCtx()
+	if _, _, err := db.Run(ctx, `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int);
+		INSERT INTO t VALUES (1);`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.KVDelete(ctx, []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []string{"b/2", "a", "b/1", "c", "b"} {
+		if err := db.KVSet(ctx, []byte(v), []byte("v"+v)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.KVSet(ctx, []byte("a"), []byte("A")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := db.Run(ctx, "ROLLBACK;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok, err := db.KVGet(nil, []byte("a")); ok || err != nil {
+		t.Fatal(ok, err)
+	}
+
+	if _, _, err := db.Run(ctx, `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int);
+		INSERT INTO t VALUES (1);`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []string{"b/2", "a", "b/1", "c", "b"} {
+		if err := db.KVSet(ctx, []byte(v), []byte("v"+v)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.KVSet(ctx, []byte("a"), []byte("A")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.KVDelete(ctx, []byte("c")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := db.Run(ctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, ok, err := db.KVGet(nil, []byte("a")); !ok || err != nil || string(v) != "A" {
+		t.Fatal(string(v), ok, err)
+	}
+
+	if _, ok, err := db.KVGet(nil, []byte("c")); ok || err != nil {
+		t.Fatal(ok, err)
+	}
+
+	var a []string
+	if err := db.KVScan(nil, []byte("b"), func(k, v []byte) (bool, error) {
+		a = append(a, string(k)+"="+string(v))
+		return true, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := strings.Join(a, " "), "b=vb b/1=vb/1 b/2=vb/2"; g != e {
+		t.Fatalf("got %q, expected %q", g, e)
+	}
+
+	rs, _, err := db.Run(nil, "SELECT count() FROM __KV; SELECT i FROM t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, e := range []int64{4, 1} {
+		row, err := rs[i].FirstRow()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g := row[0]; g != e {
+			t.Fatalf("got %v, expected %v", g, e)
+		}
+	}
+}
+
+func TestMinCompress(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	if _, err := OpenFile(filepath.Join(dir, "bad"), &Options{CanCreate: true, Allocator: AllocatorOptions{MinCompress: -1}}); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	nm := filepath.Join(dir, "db")
+	for i, v := range []AllocatorOptions{{}, {MinCompress: 1}, {MinCompress: 1 << 20}, {}} {
+		db, err := OpenFile(nm, &Options{CanCreate: true, Allocator: v})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if i == 0 {
+			s := db.store.(*file)
+			for _, v := range []struct {
+				n int
+				e bool
+			}{
+				{defaultMinCompress - 1, false},
+				{defaultMinCompress, true},
+			} {
+				restore := s.compressFor(v.n)
+				if g, e := s.a.Compress, v.e; g != e {
+					t.Fatalf("%d: got %v, expected %v", v.n, g, e)
+				}
+
+				restore()
+				if !s.a.Compress {
+					t.Fatal("compression not restored")
+				}
+			}
+		}
+
+		// Records of either size written with every setting remain
+		// readable.
+		ctx := NewRWCtx()
+		if _, _, err = db.Run(ctx, `
+			BEGIN TRANSACTION;
+				CREATE TABLE IF NOT EXISTS t (s string);
+				INSERT INTO t VALUES ($1), ($2);
+			COMMIT;`,
+			strings.Repeat("a", 10), strings.Repeat("b", 1000),
+		); err != nil {
+			t.Fatal(err)
+		}
+
+		rs, _, err := db.Run(nil, "SELECT len(s) AS n, count() FROM t GROUP BY s ORDER BY n;")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := fmt.Sprint(rows), fmt.Sprintf("[[10 %d] [1000 %[1]d]]", i+1); g != e {
+			t.Fatalf("got %s, expected %s", g, e)
+		}
+
+		if err = db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCloseOpenTransaction(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	nm := filepath.Join(dir, "db")
+	db, err := OpenFile(nm, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := NewRWCtx()
+	if _, _, err = db.Run(ctx, "BEGIN TRANSACTION; CREATE TABLE t (i int); COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(ctx, "BEGIN TRANSACTION; INSERT INTO t VALUES (1);"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Close(); !errors.Is(err, ErrOpenTransaction) {
+		t.Fatalf("got %v, expected %v", err, ErrOpenTransaction)
+	}
+
+	// The DB remains open.
+	if _, _, err = db.Run(ctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if db, err = OpenFile(nm, &Options{RollbackOnClose: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx = NewRWCtx()
+	if _, _, err = db.Run(ctx, `
+		BEGIN TRANSACTION;
+			INSERT INTO t VALUES (2);
+			BEGIN TRANSACTION;
+				INSERT INTO t VALUES (3);`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if db, err = OpenFile(nm, &Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	rs, _, err := db.Run(nil, "SELECT i FROM t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[1]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+}
+
+func TestRand(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	ctx := NewRWCtx()
+	if _, _, err = db.Run(ctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int);
+			INSERT INTO t VALUES (1), (2), (3), (4), (5), (6), (7), (8);
+		COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	draw := func() string {
+		rs, _, err := db.Run(nil, `
+			SELECT random_seed(42) FROM t WHERE i == 1;
+			SELECT i, rand(), randInt(-10, 10) FROM t ORDER BY i;`,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, v := range rs[:1] {
+			if _, err = v.Rows(-1, 0); err != nil {
+				t.Fatal(err)
+			}
+		}
+		rows, err := rs[1].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, v := range rows {
+			if f := v[1].(float64); f < 0 || f >= 1 {
+				t.Fatalf("rand() out of range: %v", f)
+			}
+
+			if n := v[2].(int64); n < -10 || n >= 10 {
+				t.Fatalf("randInt(-10, 10) out of range: %v", n)
+			}
+		}
+		return fmt.Sprint(rows)
+	}
+
+	if g, e := draw(), draw(); g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+}
+
+func TestTableSample(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	ctx := NewRWCtx()
+	if _, _, err = db.Run(ctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int);
+			CREATE TABLE u (i int, PRIMARY KEY (i)) WITHOUT ROWID;
+		COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(ctx, "BEGIN TRANSACTION;"); err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 1000
+	for i := 0; i < n; i++ {
+		if _, _, err = db.Run(ctx, "INSERT INTO t VALUES ($1); INSERT INTO u VALUES ($1);", int64(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err = db.Run(ctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	sample := func(q string) string {
+		rs, _, err := db.Run(nil, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g := len(rows); g < n/20 || g > n/5 {
+			t.Fatalf("%s: got %d rows, expected about %d", q, g, n/10)
+		}
+
+		return fmt.Sprint(rows)
+	}
+
+	for i, v := range []string{
+		"SELECT i FROM t TABLESAMPLE (10 PERCENT) REPEATABLE (42);",
+		"CREATE INDEX tID ON t (id());",
+		"SELECT i FROM t TABLESAMPLE (10 PERCENT) REPEATABLE (42);",
+		"SELECT i FROM u TABLESAMPLE (10 PERCENT) REPEATABLE (42);",
+	} {
+		if i == 1 {
+			if _, _, err = db.Run(ctx, "BEGIN TRANSACTION; "+v+" COMMIT;"); err != nil {
+				t.Fatal(err)
+			}
+
+			continue
+		}
+
+		if g, e := sample(v), sample(v); g != e {
+			t.Fatalf("%s: got %s, expected %s", v, g, e)
+		}
+	}
+
+	// Without REPEATABLE the rows are sampled by the generator of the DB.
+	q := "SELECT random_seed(7) FROM t WHERE i == 0; SELECT i FROM t TABLESAMPLE (10 PERCENT);"
+	s := func() string {
+		rs, _, err := db.Run(nil, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var a []string
+		for _, v := range rs {
+			rows, err := v.Rows(-1, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			a = append(a, fmt.Sprint(rows))
+		}
+		return strings.Join(a, "|")
+	}
+	if g, e := s(), s(); g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+
+	l, err := Compile("SELECT * FROM t TABLESAMPLE (1.5 PERCENT) REPEATABLE ($1) AS x, u;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := l.String(), "SELECT * FROM t TABLESAMPLE (1.5 PERCENT) REPEATABLE ($1) AS x, u;\n"; g != e {
+		t.Fatalf("got %q, expected %q", g, e)
+	}
+}
+
+func TestNaN(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	for _, index := range []bool{false, true} {
+		db, err := OpenMem()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctx := NewRWCtx()
+		if _, _, err = db.Run(ctx, `
+			BEGIN TRANSACTION;
+				CREATE TABLE t (f float64);
+				INSERT INTO t VALUES (3.0), ($1), (NULL), ($2), (1.0), ($3), ($1);
+			COMMIT;`,
+			nan, inf, -inf,
+		); err != nil {
+			t.Fatal(err)
+		}
+
+		if index {
+			if _, _, err = db.Run(ctx, "BEGIN TRANSACTION; CREATE INDEX x ON t (f); COMMIT;"); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		query := func(q string, arg ...interface{}) string {
+			rs, _, err := db.Run(nil, q, arg...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rows, err := rs[0].Rows(-1, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			return fmt.Sprint(rows)
+		}
+
+		for _, v := range []struct {
+			q    string
+			arg  []interface{}
+			want string
+		}{
+			{"SELECT f FROM t ORDER BY f;", nil, "[[<nil>] [-Inf] [1] [3] [+Inf] [NaN] [NaN]]"},
+			{"SELECT f FROM t ORDER BY f DESC;", nil, "[[NaN] [NaN] [+Inf] [3] [1] [-Inf] [<nil>]]"},
+			{"SELECT f FROM t WHERE f == $1;", []interface{}{nan}, "[]"},
+			{"SELECT f FROM t WHERE f >= $1;", []interface{}{nan}, "[]"},
+			{"SELECT f FROM t WHERE f != f;", nil, "[[NaN] [NaN]]"},
+			{"SELECT f FROM t WHERE f > 2.0 ORDER BY f;", nil, "[[3] [+Inf]]"},
+			{"SELECT f FROM t WHERE f < 2.0 ORDER BY f;", nil, "[[-Inf] [1]]"},
+			{"SELECT DISTINCT f FROM t WHERE f != f;", nil, "[[NaN]]"},
+			{"SELECT min(f), max(f) FROM t;", nil, "[[-Inf NaN]]"},
+		} {
+			if g, e := query(v.q, v.arg...), v.want; g != e {
+				t.Errorf("index %v, %s\ngot  %s\nwant %s", index, v.q, g, e)
+			}
+		}
+
+		if _, _, err = db.Run(nil, "PRAGMA strict_floats = true;"); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, v := range []interface{}{nan, inf, -inf, float32(inf)} {
+			for _, q := range []string{
+				"BEGIN TRANSACTION; INSERT INTO t VALUES (float64($1)); COMMIT;",
+				"BEGIN TRANSACTION; UPDATE t f = float64($1) WHERE f == 1.0; COMMIT;",
+			} {
+				if _, _, err = db.Run(NewRWCtx(), q, v); err == nil || !strings.Contains(err.Error(), "strict_floats") {
+					t.Fatalf("%s %v: unexpected error %v", q, v, err)
+				}
+			}
+		}
+
+		if _, _, err = db.Run(NewRWCtx(), "BEGIN TRANSACTION; INSERT INTO t VALUES (2.0); COMMIT;"); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestDeleteIndexed(t *testing.T) {
+	m := &testMetrics{inc: map[Metric]int64{}, obs: map[Metric]int{}}
+	db, done := tempDB(t, &Options{CanCreate: true, Metrics: m})
+	defer done()
+
+	const n = 1000
+	ctx := NewRWCtx()
+	if _, _, err := db.Run(ctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE events (ts time, s string);
+			CREATE INDEX x ON events (ts);
+			CREATE INDEX y ON events (s);
+		COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < n; i++ {
+		if _, _, err := db.Run(ctx, "BEGIN TRANSACTION; INSERT INTO events VALUES ($1, $2); COMMIT;", time.Unix(int64(i), 0), fmt.Sprint(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m.mu.Lock()
+	m.inc = map[Metric]int64{}
+	m.mu.Unlock()
+	if _, _, err := db.Run(ctx, "BEGIN TRANSACTION; DELETE FROM events WHERE ts < $1; COMMIT;", time.Unix(10, 0)); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := ctx.RowsAffected, int64(10); g != e {
+		t.Fatalf("rows affected: got %d, expected %d", g, e)
+	}
+
+	m.mu.Lock()
+	// The index finds 10 rows. Removing a row reads it and the preceding
+	// row, whose link is updated. A full scan would read all the n rows.
+	if g, e := m.inc[MetricRowsRead], int64(30); g != e {
+		t.Errorf("%s: got %d, expected %d", MetricRowsRead, g, e)
+	}
+	m.mu.Unlock()
+
+	rs, _, err := db.Run(nil, `
+		SELECT count(), min(ts) == $1 FROM events;
+		SELECT count() FROM events WHERE s == "5";
+		SELECT count() FROM events WHERE s == "15";`,
+		time.Unix(10, 0),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var a []string
+	for _, v := range rs {
+		rows, err := v.Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		a = append(a, fmt.Sprint(rows))
+	}
+	if g, e := strings.Join(a, " "), "[[990 true]] [[0]] [[1]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+}
+
+func TestTempLimits(t *testing.T) {
+	db, done := tempDB(t, &Options{
+		CanCreate:          true,
+		TempSpillThreshold: -1,
+		TempFilePoolSize:   1,
+		MaxTempFiles:       1,
+		MaxTempBytes:       1 << 16,
+	})
+	defer done()
+
+	ctx := NewRWCtx()
+	if _, _, err := db.Run(ctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int, s string);
+		COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100; i++ {
+		if _, _, err := db.Run(ctx, "BEGIN TRANSACTION; INSERT INTO t VALUES ($1, $2); COMMIT;", int64(i), strings.Repeat("x", 1000)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	query := func(q string) error {
+		rs, _, err := db.Run(nil, q)
+		if err != nil {
+			return err
+		}
+
+		_, err = rs[0].Rows(-1, 0)
+		return err
+	}
+
+	s := db.store.(*file)
+	for _, v := range []struct {
+		q     string
+		files bool // Exceeds MaxTempFiles, otherwise MaxTempBytes.
+	}{
+		{"SELECT i FROM t GROUP BY i ORDER BY i;", true},
+		{"SELECT s FROM t ORDER BY s;", false},
+	} {
+		err := query(v.q)
+		var e *TempLimitError
+		if !errors.As(err, &e) || e.Files != v.files {
+			t.Fatalf("%s: unexpected error %v", v.q, err)
+		}
+
+		s.tmu.Lock()
+		n, sz := s.tempFiles, s.tempBytes
+		s.tmu.Unlock()
+		if n != 0 || sz != 0 {
+			t.Fatalf("%s: temp files %d, temp bytes %d, expected none", v.q, n, sz)
+		}
+	}
+
+	if err := query("SELECT i FROM t ORDER BY i;"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	m := &testMetrics{inc: map[Metric]int64{}, obs: map[Metric]int{}}
+	db, done := tempDB(t, &Options{CanCreate: true, Metrics: m})
+	defer done()
+
+	const n = 1000
+	ctx := NewRWCtx()
+	if _, _, err := db.Run(ctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (flag bool, i int);
+			CREATE INDEX x ON t (flag);
+			CREATE INDEX y ON t (i);
+		COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < n; i++ {
+		if _, _, err := db.Run(ctx, "BEGIN TRANSACTION; INSERT INTO t VALUES ($1, $2); COMMIT;", i != 0, int64(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	query := func() (rows int64) {
+		m.mu.Lock()
+		m.inc = map[Metric]int64{}
+		m.mu.Unlock()
+		rs, _, err := db.Run(nil, "SELECT i FROM t WHERE flag == true && i == 42;")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if row, err := rs[0].FirstRow(); err != nil || len(row) != 1 || row[0] != int64(42) {
+			t.Fatalf("unexpected result %v, %v", row, err)
+		}
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		return m.inc[MetricRowsRead]
+	}
+
+	// Without statistics the index of flag, written first, is used.
+	if g, e := query(), int64(n-1); g != e {
+		t.Errorf("%s: got %d, expected %d", MetricRowsRead, g, e)
+	}
+
+	if _, _, err := db.Run(ctx, "BEGIN TRANSACTION; ANALYZE; COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := ctx.RowsAffected, int64(0); g != e {
+		t.Errorf("rows affected: got %d, expected %d", g, e)
+	}
+
+	// The index of i is estimated to find a single row. The statistics are
+	// read from the two rows of __Stats.
+	if g, e := query(), int64(3); g != e {
+		t.Errorf("%s: got %d, expected %d", MetricRowsRead, g, e)
+	}
+}
+
+func TestRegisterCollation(t *testing.T) {
+	// Collates strings by their length, then by their bytes.
+	RegisterCollation("ByLength", func(s string) string { return fmt.Sprintf("%08d%s", len(s), s) })
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected a panic")
+			}
+		}()
+
+		RegisterCollation("bylength", strings.ToUpper)
+	}()
+
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	rs, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (s string);
+			INSERT INTO t VALUES ("ccc"), ("a"), ("bb"), ("d");
+		COMMIT;
+		SELECT s FROM t WHERE s COLLATE bylength < "zz" ORDER BY s COLLATE bylength;`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[a] [d] [bb]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	m := &testMetrics{inc: map[Metric]int64{}, obs: map[Metric]int{}}
+	db, done := tempDB(t, &Options{CanCreate: true, Metrics: m})
+	defer done()
+
+	ctx := NewRWCtx()
+	if _, _, err := db.Run(ctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE log (ts time, msg string) PARTITION BY RANGE (ts);
+			ALTER TABLE log ADD PARTITION y2023 VALUES LESS THAN (parseTime("2006-01-02", "2024-01-01"));
+			ALTER TABLE log ADD PARTITION y2024 VALUES LESS THAN (parseTime("2006-01-02", "2025-01-01"));
+		COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	t0 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 100; i++ {
+		if _, _, err := db.Run(ctx, "BEGIN TRANSACTION; INSERT INTO log VALUES ($1, $2); COMMIT;", t0.Add(time.Duration(i)*96*time.Hour), fmt.Sprint(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	count := func(where string) (n, rows int64) {
+		m.mu.Lock()
+		m.inc = map[Metric]int64{}
+		m.mu.Unlock()
+		rs, _, err := db.Run(nil, "SELECT count() FROM log "+where+";")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		row, err := rs[0].FirstRow()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		return row[0].(int64), m.inc[MetricRowsRead]
+	}
+
+	// 92 rows are in y2023 and 8 rows are in y2024. The partitioning of log
+	// is cached by the inserts, so the queries do not read __Partition.
+	if n, rows := count(""); n != 100 || rows != 100 {
+		t.Errorf("got %d rows, %d read, expected 100 rows, 100 read", n, rows)
+	}
+
+	if n, rows := count(`WHERE ts >= parseTime("2006-01-02", "2024-01-01")`); n != 8 || rows != 8 {
+		t.Errorf("got %d rows, %d read, expected 8 rows, 8 read", n, rows)
+	}
+
+	if n, rows := count(`WHERE ts < parseTime("2006-01-02", "2023-02-01") && msg != "0"`); n != 7 || rows != 92 {
+		t.Errorf("got %d rows, %d read, expected 7 rows, 92 read", n, rows)
+	}
+
+	if _, _, err := db.Run(ctx, "BEGIN TRANSACTION; ALTER TABLE log DROP PARTITION y2023; COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The partitioning changed, so the query reads again the two rows of
+	// __Partition describing log.
+	if n, rows := count(""); n != 8 || rows != 10 {
+		t.Errorf("got %d rows, %d read, expected 8 rows, 10 read", n, rows)
+	}
+
+	// A quoted identifier cannot name the table of a partition.
+	l, err := CompileQuoted(
+		"BEGIN TRANSACTION; CREATE TABLE `log$y2025` (ts time, msg string); "+
+			`ALTER TABLE log ADD PARTITION y2025 VALUES LESS THAN (parseTime("2006-01-02", "2026-01-01")); COMMIT;`,
+		'`',
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Execute(ctx, l); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = CompileQuoted("DROP TABLE `log.y2024`;", '`'); err == nil {
+		t.Fatal("unexpected success")
+	}
+}
+
+func TestForEach(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err = db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int, s string);
+			INSERT INTO t VALUES (1, "a"), (2, "b"), (3, "c"), (4, "d");
+		COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	var sum int64
+	var ids []int64
+	if err = db.ForEach("t", func(id int64, row []interface{}) error {
+		ids = append(ids, id)
+		sum += row[0].(int64)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := sum, int64(10); g != e {
+		t.Errorf("sum: got %d, expected %d", g, e)
+	}
+
+	if g, e := len(ids), 4; g != e || ids[0] == 0 {
+		t.Errorf("ids: got %v, expected %d non zero ids", ids, e)
+	}
+
+	stop := errors.New("stop")
+	n := 0
+	if err = db.ForEach("t", func(id int64, row []interface{}) error {
+		if n++; n == 2 {
+			return stop
+		}
+
+		return nil
+	}); err != stop {
+		t.Errorf("got error %v, expected %v", err, stop)
+	}
+
+	if n != 2 {
+		t.Errorf("got %d calls, expected 2", n)
+	}
+
+	if err = db.ForEach("u", func(int64, []interface{}) error { return nil }); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestIDPolicy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	ids := func(db *DB, q string) string {
+		rs, _, err := db.Run(NewRWCtx(), q+"SELECT id() FROM t ORDER BY id();")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := rs[len(rs)-1].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return fmt.Sprint(rows)
+	}
+
+	open := func(nm string, opt *Options) *DB {
+		opt.CanCreate = true
+		db, err := OpenFile(filepath.Join(dir, nm), opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return db
+	}
+
+	for _, test := range []struct {
+		policy IDPolicy
+		base   int64
+		e1, e2 string // After the first open, after reopening.
+	}{
+		// Dropping the last table restarts the ids.
+		{IDMonotonic, 0, "[[1] [2] [3]]", "[[1] [2]]"},
+		{IDMonotonic, 100, "[[100] [101] [102]]", "[[100] [101]]"},
+		{IDNeverReuse, 0, "[[1] [2] [3]]", "[[4] [5]]"},
+		{IDNeverReuse, 10, "[[10] [11] [12]]", "[[13] [14]]"},
+		{IDReuseLowest, 0, "[[1] [2] [3]]", "[[4] [5]]"}, // No index on id().
+	} {
+		nm := fmt.Sprintf("%v-%d", test.policy, test.base)
+		db := open(nm, &Options{IDPolicy: test.policy, IDBase: test.base})
+		if g, e := ids(db, "BEGIN TRANSACTION; CREATE TABLE t (i int); INSERT INTO t VALUES (1), (2), (3); COMMIT;"), test.e1; g != e {
+			t.Errorf("%s: got %s, expected %s", nm, g, e)
+		}
+
+		// Rolling back the drop of the last table keeps the ids.
+		if _, _, err := db.Run(NewRWCtx(), "BEGIN TRANSACTION; DROP TABLE t; ROLLBACK;"); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, _, err := db.Run(NewRWCtx(), "BEGIN TRANSACTION; DROP TABLE t; COMMIT;"); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		// The policy and the base are kept in the DB file.
+		db = open(nm, &Options{})
+		if g, e := ids(db, "BEGIN TRANSACTION; CREATE TABLE t (i int); INSERT INTO t VALUES (1), (2); COMMIT;"), test.e2; g != e {
+			t.Errorf("%s: got %s, expected %s", nm, g, e)
+		}
+
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The counter persists across reopening.
+	db := open("monotonic", &Options{})
+	ids(db, "BEGIN TRANSACTION; CREATE TABLE t (i int); INSERT INTO t VALUES (1), (2); COMMIT;")
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db = open("monotonic", &Options{})
+	if g, e := ids(db, "BEGIN TRANSACTION; DELETE FROM t WHERE id() == 1; INSERT INTO t VALUES (3); COMMIT;"), "[[2] [3]]"; g != e {
+		t.Errorf("got %s, expected %s", g, e)
+	}
+
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reusing the lowest free ids needs an index on id(), without it the ids
+	// are not reused.
+	for _, test := range []struct {
+		index  string
+		e1, e2 string
+	}{
+		{"", "[[6] [8] [9]]", "[[6] [8] [9] [10] [11]]"},
+		{"CREATE INDEX x ON t (id());", "[[5] [6] [8]]", "[[5] [6] [7] [8] [9]]"},
+	} {
+		index := test.index
+		db := open("lowest"+fmt.Sprint(index != ""), &Options{IDPolicy: IDReuseLowest, IDBase: 5})
+		q := `
+			BEGIN TRANSACTION;
+				CREATE TABLE t (i int);` + index + `
+				INSERT INTO t VALUES (1), (2), (3), (4);
+				DELETE FROM t WHERE id() == 5 OR id() == 7;
+				INSERT INTO t VALUES (5);
+			COMMIT;`
+		if g, e := ids(db, q), test.e1; g != e {
+			t.Errorf("index %q: got %s, expected %s", index, g, e)
+		}
+
+		if g, e := ids(db, "BEGIN TRANSACTION; INSERT INTO t VALUES (6), (7); COMMIT;"), test.e2; g != e {
+			t.Errorf("index %q: got %s, expected %s", index, g, e)
+		}
+
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, opt := range []*Options{{IDPolicy: IDDefault - 1}, {IDPolicy: IDReuseLowest + 1}, {IDBase: -1}} {
+		if _, err := OpenFile(filepath.Join(dir, "invalid"), opt); err == nil {
+			t.Errorf("%+v: expected an error", opt)
+		}
+	}
+}
+
+func TestExecuteResults(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	l, err := Compile(`
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int);
+			INSERT INTO t VALUES (1), (2), (3);
+			UPDATE t i = i*10 WHERE i < 3;
+			DELETE FROM t WHERE i == 20;
+			SELECT * FROM t ORDER BY i;
+			SELECT count() FROM t;
+		COMMIT;`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, _, err := db.ExecuteResults(NewRWCtx(), l)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var a []string
+	for _, v := range r {
+		s := fmt.Sprintf("%d %d", v.Index, v.RowsAffected)
+		if v.Recordset != nil {
+			rows, err := v.Recordset.Rows(-1, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s += fmt.Sprint(" ", rows)
+		}
+		a = append(a, s)
+	}
+	if g, e := strings.Join(a, "|"), "0 0|1 0|2 3|3 2|4 1|5 0 [[3] [10]]|6 0 [[2]]|7 0"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+
+	if g, e := r[1].Statement, "CREATE TABLE t (i int64);"; g != e {
+		t.Errorf("got %q, expected %q", g, e)
+	}
+
+	// The results of the statements executed before an error.
+	if l, err = Compile("SELECT * FROM t; INSERT INTO t VALUES (4);"); err != nil {
+		t.Fatal(err)
+	}
+
+	r, index, err := db.ExecuteResults(nil, l)
+	if err == nil || index != 1 || len(r) != 1 || r[0].Recordset == nil {
+		t.Fatalf("got %v, %d, %v, expected one result and an error at index 1", r, index, err)
+	}
+}
+
+func TestMeta(t *testing.T) {
+	db, err := OpenMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	ctx := NewRWCtx()
+	if _, _, err = db.Run(ctx, `
+		BEGIN TRANSACTION;
+			CREATE TABLE t (i int COMMENT "widget count", s string) COMMENT "widgets";
+		COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(ctx, "BEGIN TRANSACTION;"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range [][4]string{
+		{"t", "", "owner", "inventory"},
+		{"t", "s", "unit", "none"},
+		{"t", "s", "ui", "hidden"},
+	} {
+		if err = db.SetMeta(ctx, v[0], v[1], v[2], v[3]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = db.DeleteMeta(ctx, "t", "s", "unit"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.DeleteMeta(ctx, "t", "s", "nonexistent"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(ctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Rolled back with the transaction.
+	if _, _, err = db.Run(ctx, "BEGIN TRANSACTION;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.SetMeta(ctx, "t", "i", "comment", "changed"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(ctx, "ROLLBACK;"); err != nil {
+		t.Fatal(err)
+	}
+
+	nfo, err := db.Info()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ti *TableInfo
+	for i, v := range nfo.Tables {
+		if v.Name == "t" {
+			ti = &nfo.Tables[i]
+		}
+	}
+	if ti == nil {
+		t.Fatal("table t not found")
+	}
+
+	if g, e := fmt.Sprintf("%q %v", ti.Comment, ti.Meta), `"widgets" map[comment:widgets owner:inventory]`; g != e {
+		t.Errorf("got %s, expected %s", g, e)
+	}
+
+	if g, e := fmt.Sprintf("%q %v", ti.Columns[0].Comment, ti.Columns[0].Meta), `"widget count" map[comment:widget count]`; g != e {
+		t.Errorf("got %s, expected %s", g, e)
+	}
+
+	if g, e := fmt.Sprintf("%q %v", ti.Columns[1].Comment, ti.Columns[1].Meta), `"" map[ui:hidden]`; g != e {
+		t.Errorf("got %s, expected %s", g, e)
+	}
+
+	for _, v := range [][2]string{{"u", ""}, {"t", "x"}} {
+		if err = db.SetMeta(ctx, v[0], v[1], "k", "v"); err == nil {
+			t.Errorf("%v: expected an error", v)
+		}
+	}
+
+	if err = db.SetMeta(nil, "t", "", "k", "v"); err == nil {
+		t.Error("expected an error outside of a transaction")
+	}
+}
+
+// Dropping a table next to __Meta must keep the table list of the DB file
+// intact.
+func TestMetaDropTable(t *testing.T) {
+	q := `BEGIN TRANSACTION; CREATE TABLE t (i int COMMENT "c"); COMMIT;`
+	_, done := reopenedDB(t, &Options{}, q+"BEGIN TRANSACTION; DROP TABLE t; COMMIT;"+q)
+	done()
+}
+
+func TestFlush(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	nm := filepath.Join(dir, "db")
+	db, err := OpenFile(nm, &Options{CanCreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = db.Run(NewRWCtx(), "BEGIN TRANSACTION; CREATE TABLE t (i int); INSERT INTO t VALUES (1); COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Flush waits for the transaction of another goroutine.
+	ctx := NewRWCtx()
+	if _, _, err = db.Run(ctx, "BEGIN TRANSACTION; INSERT INTO t VALUES (2);"); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error)
+	go func() { done <- db.Flush() }()
+	select {
+	case err = <-done:
+		t.Fatalf("Flush returned %v during a transaction", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if _, _, err = db.Run(ctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	if db, err = OpenMem(); err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if err = db.Flush(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// temporaryError is an error of a remote storage which may go away when
+// retried.
+type temporaryError struct{}
+
+func (temporaryError) Error() string   { return "temporary failure" }
+func (temporaryError) Temporary() bool { return true }
+
+// flakyFile fails the reads and the writes of the DB file with err while fails
+// is positive, decrementing it.
+type flakyFile struct {
+	*os.File
+	err   error
+	fails int
+}
+
+func (f *flakyFile) fail(op string) error {
+	if f.fails <= 0 {
+		return nil
+	}
+
+	f.fails--
+	return &os.PathError{Op: op, Path: f.Name(), Err: f.err}
+}
+
+func (f *flakyFile) ReadAt(b []byte, off int64) (int, error) {
+	if err := f.fail("read"); err != nil {
+		return 0, err
+	}
+
+	return f.File.ReadAt(b, off)
+}
+
+func (f *flakyFile) WriteAt(b []byte, off int64) (int, error) {
+	if err := f.fail("write"); err != nil {
+		return 0, err
+	}
+
+	return f.File.WriteAt(b, off)
+}
+
+func TestMaxRetries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "ql.db")
+	if _, err = OpenFile(name, &Options{CanCreate: true, MaxRetries: -1}); err == nil || !strings.Contains(err.Error(), "file-036") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	f0, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := &flakyFile{File: f0, err: temporaryError{}}
+	db, err := OpenFile(name, &Options{OSFile: f, MaxRetries: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	if _, _, err = db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int);
+		INSERT INTO t VALUES (1);
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	insert := func(i int64) error {
+		_, _, err := db.Run(NewRWCtx(), `
+		BEGIN TRANSACTION;
+			INSERT INTO t VALUES ($1);
+		COMMIT;`,
+			i,
+		)
+		return err
+	}
+
+	f.fails = 3 // Within MaxRetries.
+	if err = insert(2); err != nil {
+		t.Fatal(err)
+	}
+
+	if f.fails != 0 {
+		t.Fatalf("got %d failures left, expected 0", f.fails)
+	}
+
+	rs, _, err := db.Run(nil, "SELECT i FROM t ORDER BY i;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.fails = 2
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[1] [2]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+
+	f.fails = 4 // Exceeds MaxRetries.
+	if err = insert(3); err == nil || !strings.Contains(err.Error(), "temporary failure") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	f.err, f.fails = syscall.EIO, 1 // Not retried.
+	if err = insert(4); err == nil || !strings.Contains(err.Error(), syscall.EIO.Error()) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	f.fails = 0
+	if _, err = db.store.Verify(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPushWhere(t *testing.T) {
+	m := &testMetrics{inc: map[Metric]int64{}, obs: map[Metric]int{}}
+	db, done := tempDB(t, &Options{CanCreate: true, Metrics: m})
+	defer done()
+
+	ctx := NewRWCtx()
+	if _, _, err := db.Run(ctx, `
+	BEGIN TRANSACTION;
+		CREATE TABLE big (k int, v string);
+		CREATE INDEX xk ON big (k);
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := db.Run(ctx, "BEGIN TRANSACTION;"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100; i++ {
+		if _, _, err := db.Run(ctx, "INSERT INTO big VALUES ($1, $2);", int64(i), fmt.Sprint(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := db.Run(ctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, v := range []struct {
+		q     string
+		rows  string
+		reads int64 // Maximum.
+		s     string
+	}{
+		{
+			"SELECT * FROM (SELECT * FROM big) WHERE k == 5;",
+			"[[5 5]]", 1,
+			"SELECT * FROM (SELECT * FROM big;) WHERE k==5;",
+		},
+		{
+			"SELECT * FROM (SELECT * FROM big) AS b WHERE k == $1;",
+			"[[7 7]]", 1,
+			"SELECT * FROM (SELECT * FROM big;) AS b WHERE k==$1;",
+		},
+		{
+			"SELECT v FROM (SELECT k, v FROM big WHERE v > \"4\") WHERE k < 50 ORDER BY v;",
+			"[[40] [41] [42] [43] [44] [45] [46] [47] [48] [49] [5] [6] [7] [8] [9]]", 50,
+			"SELECT v AS v FROM (SELECT k AS k, v AS v FROM big WHERE v>\"4\";) WHERE k<50 ORDER BY v;",
+		},
+		{
+			"SELECT * FROM (SELECT k AS v, v AS k FROM big) WHERE k == \"5\";",
+			"[[5 5]]", 100,
+			"SELECT * FROM (SELECT k AS v, v AS k FROM big;) WHERE k==\"5\";",
+		},
+		{
+			"SELECT * FROM (SELECT * FROM big ORDER BY k LIMIT 3) WHERE k == 50;",
+			"[]", 100,
+			"SELECT * FROM (SELECT * FROM big ORDER BY k LIMIT 3;) WHERE k==50;",
+		},
+		{
+			"SELECT * FROM (SELECT * FROM big) WHERE id() == 5;",
+			"[[4 4]]", 100,
+			"SELECT * FROM (SELECT * FROM big;) WHERE id()==5;",
+		},
+	} {
+		l, err := Compile(v.q)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		for j := 0; j < 2; j++ { // The compiled statement is not modified.
+			m.mu.Lock()
+			m.inc[MetricRowsRead] = 0
+			m.mu.Unlock()
+			rs, _, err := db.Execute(nil, l, int64(7))
+			if err != nil {
+				t.Fatal(i, j, err)
+			}
+
+			rows, err := rs[0].Rows(-1, 0)
+			if err != nil {
+				t.Fatal(i, j, err)
+			}
+
+			if g, e := fmt.Sprint(rows), v.rows; g != e {
+				t.Errorf("%d.%d: got %s, expected %s", i, j, g, e)
+			}
+
+			m.mu.Lock()
+			n := m.inc[MetricRowsRead]
+			m.mu.Unlock()
+			if n > v.reads {
+				t.Errorf("%d.%d: %d rows read, expected at most %d", i, j, n, v.reads)
+			}
+
+			if g, e := l.l[0].String(), v.s; g != e {
+				t.Errorf("%d.%d: got\n%s\nexpected\n%s", i, j, g, e)
+			}
+		}
+	}
+}
+
+func TestSimplifyWhere(t *testing.T) {
+	m := &testMetrics{inc: map[Metric]int64{}, obs: map[Metric]int{}}
+	db, done := tempDB(t, &Options{CanCreate: true, Metrics: m})
+	defer done()
+
+	if _, _, err := db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int, s string);
+		INSERT INTO t VALUES (1, "a"), (2, "b"), (3, "c");
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, v := range []struct {
+		q, s  string
+		rows  string
+		reads int64
+	}{
+		{"SELECT * FROM t WHERE 1 == 0;", "SELECT * FROM t WHERE false;", "[]", 0},
+		{"SELECT * FROM t WHERE i > $1 && 1 == 0;", "SELECT * FROM t WHERE false;", "[]", 0},
+		{"SELECT * FROM t WHERE (s == \"a\" && false) OR NULL;", "SELECT * FROM t WHERE NULL;", "[]", 0},
+		{"SELECT i AS i FROM t WHERE true && i > $1 ORDER BY i;", "SELECT i AS i FROM t WHERE i>$1 ORDER BY i;", "[[2] [3]]", 3},
+		{"SELECT i AS i FROM t WHERE (i > $1 OR false) && 2 > 1 ORDER BY i;", "SELECT i AS i FROM t WHERE (i>$1) ORDER BY i;", "[[2] [3]]", 3},
+		{"SELECT i AS i FROM t WHERE i > $1 OR 1 == 1 ORDER BY i;", "SELECT i AS i FROM t WHERE true ORDER BY i;", "[[1] [2] [3]]", 3},
+	} {
+		l, err := Compile(v.q)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		if g, e := l.String(), v.s+"\n"; g != e {
+			t.Errorf("%d: got %q, expected %q", i, g, e)
+		}
+
+		m.mu.Lock()
+		m.inc[MetricRowsRead] = 0
+		m.mu.Unlock()
+		rs, _, err := db.Execute(nil, l, int64(1))
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		if g, e := fmt.Sprint(rows), v.rows; g != e {
+			t.Errorf("%d: got %s, expected %s", i, g, e)
+		}
+
+		m.mu.Lock()
+		n := m.inc[MetricRowsRead]
+		m.mu.Unlock()
+		if n != v.reads {
+			t.Errorf("%d: %d rows read, expected %d", i, n, v.reads)
+		}
+	}
+}
+
+func TestReturning(t *testing.T) {
+	db, done := tempDB(t, &Options{CanCreate: true})
+	defer done()
+
+	big := make([]byte, 1<<17)
+	big[0] = 42
+	ctx := NewRWCtx()
+	if _, _, err := db.Run(ctx, `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (i int, s string, b blob);
+		INSERT INTO t VALUES (1, "a", NULL), (2, "b", $1), (3, "c", NULL);
+	COMMIT;`,
+		big,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, v := range []struct {
+		q      string
+		fields string
+		rows   string
+	}{
+		{"UPDATE t SET i = i+10 WHERE i == 1 RETURNING i, s AS name, id() AS id;", "[i name id]", "[[11 a 1]]"},
+		{"UPDATE t i = i*2 WHERE i < 0 RETURNING *;", "[i s b]", "[]"},
+		{"UPDATE t s = s+s WHERE i == 3 RETURNING *;", "[i s b]", "[[3 cc <nil>]]"},
+		{"DELETE FROM t WHERE i == 2 RETURNING i, b;", "[i b]", "[[2 BIG]]"},
+		{"DELETE FROM t RETURNING s;", "[s]", "[[a] [cc]]"},
+	} {
+		if _, _, err := db.Run(ctx, "BEGIN TRANSACTION;"); err != nil {
+			t.Fatal(i, err)
+		}
+
+		rs, _, err := db.Run(ctx, v.q)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		if g, e := ctx.RowsAffected, int64(strings.Count(v.rows, "[")-1); g != e {
+			t.Errorf("%d: RowsAffected %d, expected %d", i, g, e)
+		}
+
+		if _, _, err = db.Run(ctx, "COMMIT;"); err != nil {
+			t.Fatal(i, err)
+		}
+
+		if len(rs) != 1 {
+			t.Fatalf("%d: got %d record sets, expected 1", i, len(rs))
+		}
+
+		f, err := rs[0].Fields()
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		if g, e := fmt.Sprint(f), v.fields; g != e {
+			t.Errorf("%d: got fields %s, expected %s", i, g, e)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		for _, row := range rows {
+			for j, v := range row {
+				if b, ok := v.([]byte); ok && bytes.Equal(b, big) {
+					row[j] = "BIG"
+				}
+			}
+		}
+		sort.Slice(rows, func(i, j int) bool { return fmt.Sprint(rows[i]) < fmt.Sprint(rows[j]) })
+		if g, e := fmt.Sprint(rows), v.rows; g != e {
+			t.Errorf("%d: got %s, expected %s", i, g, e)
+		}
+	}
+
+	l := MustCompile("DELETE FROM t WHERE i > $1 RETURNING i+1 AS j;")
+	if g, e := l.String(), "DELETE FROM t WHERE i>$1 RETURNING i+1 AS j;\n"; g != e {
+		t.Fatalf("got %q, expected %q", g, e)
+	}
+}
+
+func TestIndexOnlyScan(t *testing.T) {
+	m := &testMetrics{inc: map[Metric]int64{}, obs: map[Metric]int{}}
+	db, done := tempDB(t, &Options{CanCreate: true, Metrics: m})
+	defer done()
+
+	ctx := NewRWCtx()
+	if _, _, err := db.Run(ctx, `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (k int, v string, b bool);
+		CREATE INDEX xk ON t (k);
+		CREATE INDEX xb ON t (b);
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := db.Run(ctx, "BEGIN TRANSACTION;"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100; i++ {
+		if _, _, err := db.Run(ctx, "INSERT INTO t VALUES ($1, $2, $3);", int64(i), fmt.Sprint(i), i%10 == 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := db.Run(ctx, "COMMIT;"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, v := range []struct {
+		q     string
+		rows  string
+		reads int64
+	}{
+		{"SELECT k FROM t WHERE k == 5;", "[[5]]", 0},
+		{"SELECT k*2 FROM t WHERE k > 96;", "[[198] [196] [194]]", 0},
+		{"SELECT count() AS n, max(k) AS m FROM t WHERE k < $1;", "[[7 6]]", 0},
+		{"SELECT k FROM t WHERE k >= 3 && k < 6 ORDER BY k;", "[[3] [4] [5]]", 0},
+		{"SELECT k FROM t WHERE k IN (1, 3, 200);", "[[1] [3]]", 0},
+		{"SELECT k FROM t WHERE k > 90 && k%2 == 0;", "[[98] [96] [94] [92]]", 0},
+		{"SELECT count() AS n FROM t WHERE b;", "[[10]]", 0},
+		{"SELECT k FROM t WHERE k == 5 GROUP BY k;", "[[5]]", 0},
+		{"SELECT v FROM t WHERE k == 5;", "[[5]]", 1},
+		{"SELECT k, v FROM t WHERE k == 5;", "[[5 5]]", 1},
+		{"SELECT * FROM t WHERE k == 5;", "[[5 5 false]]", 1},
+		{"SELECT k FROM t WHERE k == 5 && v == \"5\";", "[[5]]", 1},
+		{"SELECT id() > 0 FROM t WHERE k == 5;", "[[true]]", 1},
+	} {
+		m.mu.Lock()
+		m.inc[MetricRowsRead] = 0
+		m.mu.Unlock()
+		rs, _, err := db.Run(nil, v.q, int64(7))
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+
+		if g, e := fmt.Sprint(rows), v.rows; g != e {
+			t.Errorf("%d: got %s, expected %s", i, g, e)
+		}
+
+		m.mu.Lock()
+		n := m.inc[MetricRowsRead]
+		m.mu.Unlock()
+		if n != v.reads {
+			t.Errorf("%d: %d rows read, expected %d", i, n, v.reads)
+		}
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	values := []string{strings.Repeat("a", 100), strings.Repeat("b", 100), strings.Repeat("c", 100)}
+	allocated := map[string]int64{}
+	for _, dict := range []string{"", " DICTIONARY"} {
+		m := &testMetrics{inc: map[Metric]int64{}, obs: map[Metric]int{}}
+		name := filepath.Join(dir, fmt.Sprintf("ql%d.db", len(dict)))
+		db, err := OpenFile(name, &Options{CanCreate: true, Metrics: m})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		allocs0, err := db.store.Verify()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctx := NewRWCtx()
+		if _, _, err = db.Run(ctx, fmt.Sprintf("BEGIN TRANSACTION; CREATE TABLE t (i int, s string%s); COMMIT;", dict)); err != nil {
+			t.Fatal(err)
+		}
+
+		m.mu.Lock()
+		m.inc[MetricBytesAllocated] = 0
+		m.mu.Unlock()
+		if _, _, err = db.Run(ctx, "BEGIN TRANSACTION;"); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 300; i++ {
+			if _, _, err = db.Run(ctx, "INSERT INTO t VALUES ($1, $2);", int64(i), values[i%len(values)]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, _, err = db.Run(ctx, "COMMIT;"); err != nil {
+			t.Fatal(err)
+		}
+
+		m.mu.Lock()
+		allocated[dict] = m.inc[MetricBytesAllocated]
+		m.mu.Unlock()
+		if err = db.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		if db, err = OpenFile(name, &Options{}); err != nil {
+			t.Fatal(err)
+		}
+
+		rs, _, err := db.Run(nil, "SELECT count() AS n, s FROM t WHERE i%3 == 1 GROUP BY s;")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := rs[0].Rows(-1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := fmt.Sprint(rows), fmt.Sprintf("[[100 %s]]", values[1]); g != e {
+			t.Errorf("%q: got %s, expected %s", dict, g, e)
+		}
+
+		if _, _, err = db.Run(ctx, `
+		BEGIN TRANSACTION;
+			UPDATE t SET s = $1 WHERE i%3 == 0;
+			DELETE FROM t WHERE i%3 == 2;
+		COMMIT;`,
+			values[1],
+		); err != nil {
+			t.Fatal(err)
+		}
+
+		if d := db.root.tables["t"].dicts[1]; d != nil {
+			// Only the value still used is kept.
+			it, err := d.x.SeekFirst()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var n int
+			for {
+				if _, _, err = it.Next(); err != nil {
+					break
+				}
+
+				n++
+			}
+			if n != 1 {
+				t.Errorf("%q: got %d dictionary values, expected 1", dict, n)
+			}
+		}
+
+		if _, _, err = db.Run(ctx, "BEGIN TRANSACTION; DROP TABLE t; COMMIT;"); err != nil {
+			t.Fatal(err)
+		}
+
+		allocs, err := db.store.Verify()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := allocs, allocs0; g != e {
+			t.Errorf("%q: STORAGE LEAK: allocs: got %d, exp %d", dict, g, e)
+		}
+
+		if err = db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if g, e := allocated[" DICTIONARY"], allocated[""]; g*4 > e {
+		t.Errorf("DICTIONARY column allocated %d bytes, ordinary column %d bytes", g, e)
+	}
+}
+
+func TestPing(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	RegisterDriver()
+	RegisterMemDriver()
+	for _, v := range []struct{ driver, name string }{
+		{"ql", filepath.Join(dir, "ping.db")},
+		{"ql-mem", "TestPing"},
+	} {
+		sdb, err := sql.Open(v.driver, v.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = sdb.Ping(); err != nil {
+			t.Fatal(v.driver, err)
+		}
+
+		// Ping on a connection having an open transaction does not
+		// wait for the lock held by the transaction.
+		conn, err := sdb.Conn(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tx, err := conn.BeginTx(context.Background(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = conn.PingContext(context.Background()); err != nil {
+			t.Fatal(v.driver, err)
+		}
+
+		if err = tx.Rollback(); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = conn.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = sdb.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	db, err := OpenFile(filepath.Join(dir, "ql.db"), &Options{CanCreate: true, LockTimeout: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := NewRWCtx()
+	if _, _, err = db.Run(ctx, "BEGIN TRANSACTION;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.ping(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.ping(nil); !errors.As(err, new(*LockTimeoutError)) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if _, _, err = db.Run(ctx, "ROLLBACK;"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A failing DB file.
+	if err = db.store.(*file).f0.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = db.ping(nil); err == nil {
+		t.Fatal("unexpected success")
+	}
+
+	db.Close()
+	if err = db.ping(nil); err != errDBClosed {
+		t.Fatalf("got %v, expected %v", err, errDBClosed)
+	}
+}
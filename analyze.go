@@ -0,0 +1,377 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// statsTable is the table holding the statistics collected by ANALYZE.
+	statsTable = "__Stats"
+
+	// statsBuckets is the maximum number of the histogram buckets of a
+	// column.
+	statsBuckets = 16
+)
+
+var (
+	statsCreate = MustCompile(`
+		CREATE TABLE IF NOT EXISTS __Stats (
+			TableName      string,
+			ColumnName     string,
+			Rows           int64,
+			Nulls          int64,
+			DistinctValues int64,
+			Bounds         array,
+			PRIMARY KEY (TableName, ColumnName)
+		) WITHOUT ROWID;`,
+	)
+	statsDelete = MustCompile("DELETE FROM __Stats WHERE TableName == $1;")
+	statsGet    = MustCompile("SELECT Rows, Nulls, DistinctValues, Bounds FROM __Stats WHERE TableName == $1 && ColumnName == $2;")
+	statsInsert = MustCompile("INSERT INTO __Stats VALUES ($1, $2, $3, $4, $5, $6);")
+)
+
+type analyzeStmt struct {
+	tableName string // All tables if empty.
+}
+
+func (s *analyzeStmt) String() string {
+	if s.tableName == "" {
+		return "ANALYZE;"
+	}
+
+	return fmt.Sprintf("ANALYZE %s;", quoteIdent(s.tableName))
+}
+
+func (s *analyzeStmt) isUpdating() bool { return true }
+
+func (s *analyzeStmt) exec(ctx *execCtx) (_ Recordset, err error) {
+	root := ctx.db.root
+	var tables []*table
+	switch {
+	case s.tableName != "":
+		t := root.tables[s.tableName]
+		if t == nil {
+			return nil, fmt.Errorf("ANALYZE: table %s does not exist", s.tableName)
+		}
+
+		tables = append(tables, t)
+	default:
+		for t := root.thead; t != nil; t = t.tnext {
+			if !strings.HasPrefix(t.name, "__") {
+				tables = append(tables, t)
+			}
+		}
+	}
+
+	if err = ctx.run(statsCreate); err != nil {
+		return
+	}
+
+	for _, t := range tables {
+		if err = s.analyze(ctx, t); err != nil {
+			return nil, fmt.Errorf("ANALYZE %s: %v", t.name, err)
+		}
+	}
+	return
+}
+
+// analyze replaces the statistics of the columns of t.
+func (s *analyzeStmt) analyze(ctx *execCtx, t *table) (err error) {
+	var cols []*col
+	for _, c := range t.cols {
+		if c.typ != qArray {
+			cols = append(cols, c)
+		}
+	}
+
+	// The records of xs[i] are keyed by the values of cols[i] and they
+	// hold the number of the rows having the value.
+	xs := make([]temp, len(cols))
+	defer func() {
+		for _, x := range xs {
+			if x == nil {
+				continue
+			}
+
+			if e := x.Drop(); e != nil && err == nil {
+				err = e
+			}
+		}
+	}()
+
+	for i := range xs {
+		if xs[i], err = ctx.db.store.CreateTemp(true); err != nil {
+			return
+		}
+	}
+
+	var n int64
+	nulls := make([]int64, len(cols))
+	distinct := make([]int64, len(cols))
+	for h := t.head; h > 0; {
+		if err = ctx.check(); err != nil {
+			return
+		}
+
+		if h, err = tableRset("").doOne(t, h, func(_ interface{}, data []interface{}) (bool, error) {
+			if err := expand(data); err != nil {
+				return false, err
+			}
+
+			n++
+			for i, c := range cols {
+				v := data[c.index]
+				if v == nil {
+					nulls[i]++
+					continue
+				}
+
+				k := []interface{}{v}
+				r, err := xs[i].Get(k)
+				if err != nil {
+					return false, err
+				}
+
+				var m int64
+				switch {
+				case len(r) == 0:
+					distinct[i]++
+				default:
+					m = r[0].(int64)
+				}
+				if err = xs[i].Set(k, []interface{}{m + 1}); err != nil {
+					return false, err
+				}
+			}
+			return true, nil
+		}); err != nil {
+			return
+		}
+	}
+
+	if err = ctx.run(statsDelete, t.name); err != nil {
+		return
+	}
+
+	for i, c := range cols {
+		bounds, err := statsBounds(xs[i], n-nulls[i])
+		if err != nil {
+			return err
+		}
+
+		var b interface{} // NULL if the column has no values.
+		if bounds != nil {
+			b = bounds
+		}
+		if err = ctx.run(statsInsert, t.name, c.name, n, nulls[i], distinct[i], b); err != nil {
+			return err
+		}
+	}
+	return
+}
+
+// statsBounds returns the bounds of the histogram of the values counted in x,
+// having the total number n. The bounds are the minimum, the maximum and the
+// values closest to dividing the n values into statsBuckets parts of equal
+// size. It returns nil if n is zero.
+func statsBounds(x temp, n int64) (r []interface{}, err error) {
+	if n == 0 {
+		return nil, nil
+	}
+
+	it, err := x.SeekFirst()
+	if err != nil {
+		return nil, noEOF(err)
+	}
+
+	var cum int64
+	next := int64(1)
+	for {
+		k, v, err := it.Next()
+		if err != nil {
+			return r, noEOF(err)
+		}
+
+		if cum == 0 {
+			r = append(r, k[0])
+		}
+
+		cum += v[0].(int64)
+		crossed := false
+		for ; next <= statsBuckets && cum*statsBuckets >= next*n; next++ {
+			crossed = true
+		}
+		if crossed && collate1(r[len(r)-1], k[0]) != 0 {
+			r = append(r, k[0])
+		}
+	}
+}
+
+// colStats are the statistics of a column collected by ANALYZE.
+type colStats struct {
+	rows     int64
+	nulls    int64
+	distinct int64
+	bounds   []interface{}
+}
+
+// colStats returns the statistics of the column c of t or nil if there are
+// none.
+func (db *DB) colStats(ctx *execCtx, t *table, c *col) (r *colStats, err error) {
+	if db.root.tables[statsTable] == nil {
+		return nil, nil
+	}
+
+	cc := *ctx
+	cc.db, cc.arg, cc.outer, cc.corr = db, []interface{}{t.name, c.name}, nil, false
+	rs, err := statsGet.l[0].exec(&cc)
+	if err != nil {
+		return
+	}
+
+	row, err := rs.FirstRow()
+	if row == nil || err != nil {
+		return
+	}
+
+	r = &colStats{}
+	var ok [3]bool
+	r.rows, ok[0] = row[0].(int64)
+	r.nulls, ok[1] = row[1].(int64)
+	r.distinct, ok[2] = row[2].(int64)
+	r.bounds, _ = row[3].([]interface{})
+	if !ok[0] || !ok[1] || !ok[2] {
+		return nil, fmt.Errorf("invalid statistics of %s.%s", t.name, c.name)
+	}
+
+	return r, nil
+}
+
+// estimate returns the estimated number of the rows of t for which ex is
+// true, if ex is a comparison of an analyzed column, or id(), to a fixed
+// value. It returns ok == false if the number cannot be estimated.
+func (r *whereRset) estimate(ctx *execCtx, db *DB, t *table, ex expression) (n float64, ok bool) {
+	x, ok := ex.(*binaryOperation)
+	if !ok {
+		return 0, false
+	}
+
+	c, op, v, ok, err := r.indexBound(ctx, t, x)
+	if !ok || err != nil {
+		return 0, false
+	}
+
+	if c == nil { // id() is unique
+		return 1, op == eq
+	}
+
+	s, err := db.colStats(ctx, t, c)
+	if s == nil || err != nil {
+		return 0, false
+	}
+
+	data := []interface{}{v}
+	cc := *c
+	cc.index = 0
+	if typeCheck(data, []*col{&cc}) != nil {
+		return 0, false
+	}
+
+	if data[0] == nil { // Comparing to NULL is never true.
+		return 0, true
+	}
+
+	values := float64(s.rows - s.nulls)
+	if op == eq {
+		if s.distinct == 0 {
+			return 0, true
+		}
+
+		return values / float64(s.distinct), true
+	}
+
+	// The fraction of the values less than v.
+	var below int
+	for _, b := range s.bounds {
+		if collate1(b, data[0]) < 0 {
+			below++
+		}
+	}
+	var f float64
+	switch nb := len(s.bounds); {
+	case below == 0:
+		f = 0
+	case below == nb:
+		f = 1
+	default:
+		f = (float64(below) - 0.5) / float64(nb-1)
+	}
+	if op == '>' || op == ge {
+		f = 1 - f
+	}
+	return f * values, true
+}
+
+// tryAnd handles WHERE expressions of the form
+//
+//	expr1 && expr2
+//
+// where expr1 or expr2 can use an index. The one estimated to match fewer
+// rows is tried first, expr1 if the estimates are not known or equal. The
+// rows found by the index are filtered by the whole expression.
+func (r *whereRset) tryAnd(ctx *execCtx, db *DB, t *table, ex *binaryOperation, f func(id interface{}, data []interface{}) (more bool, err error)) (bool, error) {
+	exprs := []expression{ex.l, ex.r}
+	nl, okl := r.estimate(ctx, db, t, ex.l)
+	nr, okr := r.estimate(ctx, db, t, ex.r)
+	if okl && okr && nr < nl {
+		exprs[0], exprs[1] = exprs[1], exprs[0]
+	}
+
+	m := map[interface{}]interface{}{"$ctx": ctx}
+	var flds []*fld
+	hdr := true
+	filter := func(rid interface{}, in []interface{}) (more bool, err error) {
+		if hdr {
+			hdr = false
+			flds = in[0].([]*fld)
+			return f(nil, in)
+		}
+
+		for i, fld := range flds {
+			if nm := fld.name; nm != "" {
+				m[nm] = in[i]
+			}
+		}
+		m["$id"] = rid
+		val, err := ex.eval(m, ctx.arg)
+		if err != nil {
+			return false, err
+		}
+
+		if val == nil {
+			return true, nil
+		}
+
+		x, ok := val.(bool)
+		if !ok {
+			return false, fmt.Errorf("invalid WHERE expression %s (value of type %T)", val, val)
+		}
+
+		if !x {
+			return true, nil
+		}
+
+		return f(rid, in)
+	}
+	for _, e := range exprs {
+		if ok, err := (&whereRset{expr: e, src: r.src, only: r.only}).tryUseIndex(ctx, filter); ok || err != nil {
+			return ok, err
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// mainDB is the name qualifying the tables of the DB itself, as opposed to
+// the tables of its attached databases.
+const mainDB = "main"
+
+// attach opens the DB file named file and attaches it to db as name. db.rwmu
+// must be held for writing.
+func (db *DB) attach(file, name string) error {
+	if name == mainDB || db.attached[name] != nil {
+		return fmt.Errorf("ATTACH: database %s already exists", name)
+	}
+
+	a, err := openFile(context.Background(), file, &Options{}, false)
+	if err != nil {
+		return fmt.Errorf("ATTACH: %v", err)
+	}
+
+	if db.attached == nil {
+		db.attached = map[string]*DB{}
+	}
+	db.attached[name] = a
+	return nil
+}
+
+// detach closes and detaches the database attached to db as name. db.rwmu
+// must be held for writing.
+func (db *DB) detach(name string) error {
+	a := db.attached[name]
+	if a == nil {
+		return fmt.Errorf("DETACH: database %s is not attached", name)
+	}
+
+	delete(db.attached, name)
+	return a.Close()
+}
+
+// detachAll closes and detaches all databases attached to db.
+func (db *DB) detachAll() (err error) {
+	for nm, a := range db.attached {
+		if e := a.Close(); e != nil && err == nil {
+			err = e
+		}
+		delete(db.attached, nm)
+	}
+	return
+}
+
+// resolve returns the database having the table named name and the name of
+// the table in that database. The name may be qualified by the name of an
+// attached database or by "main", denoting db itself.
+func (db *DB) resolve(name string) (*DB, string, error) {
+	i := strings.IndexByte(name, '.')
+	if i < 0 {
+		return db, name, nil
+	}
+
+	dbName, name := name[:i], name[i+1:]
+	if dbName == mainDB {
+		return db, name, nil
+	}
+
+	a := db.attached[dbName]
+	if a == nil {
+		return nil, "", fmt.Errorf("database %s is not attached", dbName)
+	}
+
+	return a, name, nil
+}
+
+// unqualified returns the table name part of the possibly qualified table
+// name s.
+func unqualified(s string) string {
+	return s[strings.IndexByte(s, '.')+1:]
+}
+
+// runAttach executes s, an ATTACH or DETACH statement, while no other
+// statement uses db or its attached databases. db.mu must be held, runAttach
+// releases it.
+func (db *DB) runAttach(pc *TCtx, tmo *timeout, s stmt, arg []interface{}) (Recordset, error) {
+	if db.rw && pc != nil && pc == db.cc {
+		db.mu.Unlock()
+		return nil, fmt.Errorf("cannot execute %s in a transaction", s)
+	}
+
+	db.mu.Unlock()
+	if err := db.rwmu.Lock(db.lockTimeout); err != nil {
+		return nil, err
+	}
+
+	defer db.rwmu.Unlock()
+	return db.exec(s, arg, tmo)
+}
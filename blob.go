@@ -7,10 +7,14 @@ package ql
 import (
 	"bytes"
 	"encoding/gob"
+	"fmt"
 	"log"
 	"math/big"
+	"strings"
 	"sync"
 	"time"
+
+	"github.com/cznic/exp/lldb"
 )
 
 const shortBlob = 256 // bytes
@@ -34,6 +38,45 @@ func init() {
 	newGobCoder()
 }
 
+// Codec serializes the values of the bigint, bigrat, time and duration types,
+// including array elements of such types, stored in a DB file. Values of the
+// other types are stored using lldb.EncodeScalars regardless of the Codec.
+// See Options.Codec.
+type Codec interface {
+	// Name identifies the codec. It is recorded in the header of the DB
+	// file. The name must have 1 to 4 bytes, none of them zero, and it
+	// must not be "gob", which is the name of the default codec.
+	Name() string
+
+	// Encode returns the serialization of v, which is a *big.Int,
+	// *big.Rat, time.Time or time.Duration. The result is not retained
+	// by the caller beyond the next call of Encode or Decode.
+	Encode(v interface{}) ([]byte, error)
+
+	// Decode deserializes b, returned by Encode, to v, which is a
+	// *big.Int, *big.Rat, *time.Time or *time.Duration.
+	Decode(b []byte, v interface{}) error
+}
+
+// gobCodec is the name of the default Codec.
+const gobCodec = "gob"
+
+// checkCodec reports whether c is usable as Options.Codec.
+func checkCodec(c Codec) error {
+	if c == nil {
+		return nil
+	}
+
+	switch nm := c.Name(); {
+	case nm == "" || len(nm) > hdrAppID-len(magic) || strings.IndexByte(nm, 0) >= 0:
+		return fmt.Errorf("(file-024) invalid codec name %q", nm)
+	case nm == gobCodec:
+		return fmt.Errorf("(file-024) codec name %q is reserved", nm)
+	}
+	return nil
+}
+
+// gobCoder is the default Codec. It uses encoding/gob.
 type gobCoder struct {
 	buf bytes.Buffer
 	dec *gob.Decoder
@@ -84,57 +127,174 @@ func newGobCoder() (g *gobCoder) {
 	return
 }
 
-func (g *gobCoder) encode(v interface{}) (b []byte, err error) {
+func (g *gobCoder) Name() string { return gobCodec }
+
+func (g *gobCoder) Encode(v interface{}) (b []byte, err error) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 
 	g.buf.Reset()
 	switch x := v.(type) {
-	case []byte:
-		return x, nil
-	case *big.Int:
-		err = g.enc.Encode(x)
-	case *big.Rat:
-		err = g.enc.Encode(x)
-	case time.Time:
-		err = g.enc.Encode(x)
 	case time.Duration:
 		err = g.enc.Encode(int64(x))
 	default:
-		//dbg("%T(%v)", v, v)
-		log.Panic("internal error 002")
+		err = g.enc.Encode(x)
 	}
-	b = g.buf.Bytes()
-	return
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(nil), g.buf.Bytes()...), nil
 }
 
-func (g *gobCoder) decode(b []byte, typ int) (v interface{}, err error) {
+func (g *gobCoder) Decode(b []byte, v interface{}) (err error) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 
 	g.buf.Reset()
 	g.buf.Write(b)
+	switch x := v.(type) {
+	case *time.Duration:
+		var n int64
+		err = g.dec.Decode(&n)
+		*x = time.Duration(n)
+	default:
+		err = g.dec.Decode(x)
+	}
+	return
+}
+
+// valueCoder encodes the values stored by the file back end which are not
+// lldb scalars. It uses a Codec for the values of the types lldb.EncodeScalars
+// does not support.
+type valueCoder struct {
+	c Codec
+}
+
+// newValueCoder returns a valueCoder using c or the default Codec if c is nil.
+func newValueCoder(c Codec) *valueCoder {
+	if c == nil {
+		c = newGobCoder()
+	}
+	return &valueCoder{c}
+}
+
+func (g *valueCoder) encode(v interface{}) (b []byte, err error) {
+	switch x := v.(type) {
+	case []byte:
+		return x, nil
+	case *big.Int, *big.Rat, time.Time, time.Duration:
+		return g.c.Encode(x)
+	case []interface{}:
+		return g.encodeArray(x)
+	default:
+		//dbg("%T(%v)", v, v)
+		log.Panic("internal error 002")
+	}
+	return
+}
+
+func (g *valueCoder) decode(b []byte, typ int) (v interface{}, err error) {
 	switch typ {
 	case qBlob:
 		return b, nil
 	case qBigInt:
 		x := big.NewInt(0)
-		err = g.dec.Decode(&x)
+		err = g.c.Decode(b, x)
 		v = x
 	case qBigRat:
 		x := big.NewRat(1, 1)
-		err = g.dec.Decode(&x)
+		err = g.c.Decode(b, x)
 		v = x
 	case qTime:
 		var x time.Time
-		err = g.dec.Decode(&x)
+		err = g.c.Decode(b, &x)
 		v = x
 	case qDuration:
-		var x int64
-		err = g.dec.Decode(&x)
-		v = time.Duration(x)
+		var x time.Duration
+		err = g.c.Decode(b, &x)
+		v = x
+	case qArray:
+		return g.decodeArray(b)
 	default:
 		log.Panic("internal error 003")
 	}
 	return
 }
+
+// encodeArray encodes a as lldb scalars: the number of elements followed by
+// a type tag and a value for every element. Elements of types not supported by
+// lldb.EncodeScalars are encoded by the Codec.
+func (g *valueCoder) encodeArray(a []interface{}) (b []byte, err error) {
+	s := make([]interface{}, 1, 2*len(a)+1)
+	s[0] = int64(len(a))
+	for _, v := range a {
+		tag := elemType(v)
+		switch x := v.(type) {
+		case *big.Int, *big.Rat, time.Time:
+			var p []byte
+			if p, err = g.c.Encode(x); err != nil {
+				return
+			}
+
+			v = append([]byte(nil), p...)
+		case time.Duration:
+			v = int64(x)
+		default:
+			if tag == 0 {
+				return nil, fmt.Errorf("invalid array element %v (type %T)", v, v)
+			}
+		}
+		s = append(s, int64(tag), v)
+	}
+	return lldb.EncodeScalars(s...)
+}
+
+// decodeArray is the inverse of encodeArray.
+func (g *valueCoder) decodeArray(b []byte) (v interface{}, err error) {
+	s, err := lldb.DecodeScalars(b)
+	if err != nil {
+		return
+	}
+
+	if len(s) == 0 {
+		return nil, fmt.Errorf("corrupted DB: array data")
+	}
+
+	n, ok := s[0].(int64)
+	if !ok || int64(len(s)) != 2*n+1 {
+		return nil, fmt.Errorf("corrupted DB: array length")
+	}
+
+	a := make([]interface{}, n)
+	for i := range a {
+		tag, ok := s[2*i+1].(int64)
+		if _, known := type2Str[int(tag)]; !ok || !known || tag == qArray {
+			return nil, fmt.Errorf("corrupted DB: array element tag")
+		}
+
+		switch x := s[2*i+2]; tag {
+		case qBigInt, qBigRat, qTime:
+			p, ok := x.([]byte)
+			if !ok {
+				return nil, fmt.Errorf("corrupted DB: array element of type %T", x)
+			}
+
+			if a[i], err = g.decode(p, int(tag)); err != nil {
+				return
+			}
+		case qDuration:
+			y, ok := x.(int64)
+			if !ok {
+				return nil, fmt.Errorf("corrupted DB: array element of type %T", x)
+			}
+
+			a[i] = time.Duration(y)
+		default:
+			if a[i], err = convert(x, int(tag)); err != nil {
+				return
+			}
+		}
+	}
+	return a, nil
+}
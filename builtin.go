@@ -5,12 +5,20 @@
 package ql
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"math"
 	"math/rand"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/cznic/mathutil"
 )
 
 //TODO agg bigint, bigrat, time, duration
@@ -18,44 +26,60 @@ import (
 var builtin = map[string]struct {
 	f           func([]interface{}, map[interface{}]interface{}) (interface{}, error)
 	minArgs     int
-	maxArgs     int
+	maxArgs     int // < 0: no limit
 	isStatic    bool
 	isAggregate bool
 }{
-	"__testBlob":   {builtinTestBlob, 1, 1, true, false},
-	"__testString": {builtinTestString, 1, 1, true, false},
-	"avg":          {builtinAvg, 1, 1, false, true},
-	"complex":      {builtinComplex, 2, 2, true, false},
-	"contains":     {builtinContains, 2, 2, true, false},
-	"count":        {builtinCount, 0, 1, false, true},
-	"date":         {builtinDate, 8, 8, true, false},
-	"day":          {builtinDay, 1, 1, true, false},
-	"formatTime":   {builtinFormatTime, 2, 2, true, false},
-	"hasPrefix":    {builtinHasPrefix, 2, 2, true, false},
-	"hasSuffix":    {builtinHasSuffix, 2, 2, true, false},
-	"hour":         {builtinHour, 1, 1, true, false},
-	"hours":        {builtinHours, 1, 1, true, false},
-	"id":           {builtinID, 0, 1, false, false},
-	"imag":         {builtinImag, 1, 1, true, false},
-	"len":          {builtinLen, 1, 1, true, false},
-	"max":          {builtinMax, 1, 1, false, true},
-	"min":          {builtinMin, 1, 1, false, true},
-	"minute":       {builtinMinute, 1, 1, true, false},
-	"minutes":      {builtinMinutes, 1, 1, true, false},
-	"month":        {builtinMonth, 1, 1, true, false},
-	"nanosecond":   {builtinNanosecond, 1, 1, true, false},
-	"nanoseconds":  {builtinNanoseconds, 1, 1, true, false},
-	"now":          {builtinNow, 0, 0, false, false},
-	"parseTime":    {builtinParseTime, 2, 2, true, false},
-	"real":         {builtinReal, 1, 1, true, false},
-	"second":       {builtinSecond, 1, 1, true, false},
-	"seconds":      {builtinSeconds, 1, 1, true, false},
-	"since":        {builtinSince, 1, 1, false, false},
-	"sum":          {builtinSum, 1, 1, false, true},
-	"timeIn":       {builtinTimeIn, 2, 2, true, false},
-	"weekday":      {builtinWeekday, 1, 1, true, false},
-	"year":         {builtinYear, 1, 1, true, false},
-	"yearDay":      {builtinYearday, 1, 1, true, false},
+	"__testBlob":        {builtinTestBlob, 1, 1, true, false},
+	"__testString":      {builtinTestString, 1, 1, true, false},
+	"approx_percentile": {builtinApproxPercentile, 2, 2, false, true},
+	"avg":               {builtinAvg, 1, 1, false, true},
+	"coalesce":          {builtinCoalesce, 1, -1, true, false},
+	"complex":           {builtinComplex, 2, 2, true, false},
+	"contains":          {builtinContains, 2, 2, true, false},
+	"count":             {builtinCount, 0, 1, false, true},
+	"date":              {builtinDate, 8, 8, true, false},
+	"date_bin":          {builtinDateBin, 2, 3, true, false},
+	"day":               {builtinDay, 1, 1, true, false},
+	"formatFloat":       {builtinFormatFloat, 1, 2, true, false},
+	"formatTime":        {builtinFormatTime, 2, 3, true, false},
+	"fromBase64":        {builtinFromBase64, 1, 1, true, false},
+	"hasPrefix":         {builtinHasPrefix, 2, 2, true, false},
+	"hasSuffix":         {builtinHasSuffix, 2, 2, true, false},
+	"hex":               {builtinHex, 1, 1, true, false},
+	"hour":              {builtinHour, 1, 1, true, false},
+	"hours":             {builtinHours, 1, 1, true, false},
+	"id":                {builtinID, 0, 1, false, false},
+	"ifnull":            {builtinIfNull, 2, 2, true, false},
+	"imag":              {builtinImag, 1, 1, true, false},
+	"len":               {builtinLen, 1, 1, true, false},
+	"max":               {builtinMax, 1, 1, false, true},
+	"min":               {builtinMin, 1, 1, false, true},
+	"minute":            {builtinMinute, 1, 1, true, false},
+	"minutes":           {builtinMinutes, 1, 1, true, false},
+	"month":             {builtinMonth, 1, 1, true, false},
+	"nanosecond":        {builtinNanosecond, 1, 1, true, false},
+	"nanoseconds":       {builtinNanoseconds, 1, 1, true, false},
+	"now":               {builtinNow, 0, 0, false, false},
+	"nullif":            {builtinNullIf, 2, 2, true, false},
+	"parseFloat":        {builtinParseFloat, 1, 1, true, false},
+	"parseInt":          {builtinParseInt, 1, 2, true, false},
+	"parseTime":         {builtinParseTime, 2, 2, true, false},
+	"percentile_cont":   {builtinPercentileCont, 2, 2, false, true},
+	"rand":              {builtinRand, 0, 0, false, false},
+	"randInt":           {builtinRandInt, 2, 2, false, false},
+	"random_seed":       {builtinRandomSeed, 1, 1, false, false},
+	"real":              {builtinReal, 1, 1, true, false},
+	"second":            {builtinSecond, 1, 1, true, false},
+	"seconds":           {builtinSeconds, 1, 1, true, false},
+	"since":             {builtinSince, 1, 1, false, false},
+	"sum":               {builtinSum, 1, 1, false, true},
+	"timeIn":            {builtinTimeIn, 2, 2, true, false},
+	"toBase64":          {builtinToBase64, 1, 1, true, false},
+	"unhex":             {builtinUnhex, 1, 1, true, false},
+	"weekday":           {builtinWeekday, 1, 1, true, false},
+	"year":              {builtinYear, 1, 1, true, false},
+	"yearDay":           {builtinYearday, 1, 1, true, false},
 }
 
 func badNArgs(min int, s string, arg []interface{}) error {
@@ -103,6 +127,40 @@ func builtinTestString(arg []interface{}, ctx map[interface{}]interface{}) (v in
 	return string(b), nil
 }
 
+func builtinApproxPercentile(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	type percentile struct {
+		p float64
+		k gkSketch
+	}
+
+	if _, ok := ctx["$agg0"]; ok {
+		return
+	}
+
+	fn := ctx["$fn"]
+	if _, ok := ctx["$agg"]; ok {
+		data, ok := ctx[fn].(*percentile)
+		if !ok {
+			return
+		}
+
+		return data.k.quantile(data.p), nil
+	}
+
+	x, p, ok, err := percentileArgs("approx_percentile", arg)
+	if !ok || err != nil {
+		return nil, err
+	}
+
+	data, _ := ctx[fn].(*percentile)
+	if data == nil {
+		data = &percentile{p: p}
+		ctx[fn] = data
+	}
+	data.k.insert(x)
+	return
+}
+
 func builtinAvg(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	type avg struct {
 		sum interface{}
@@ -193,6 +251,15 @@ func builtinAvg(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 	return
 }
 
+func builtinCoalesce(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	for _, v := range arg {
+		if v != nil {
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
 func builtinComplex(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
 	re, im := arg[0], arg[1]
 	if re == nil || im == nil {
@@ -324,12 +391,68 @@ func builtinDate(arg []interface{}, _ map[interface{}]interface{}) (v interface{
 	), nil
 }
 
+func builtinDateBin(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	var d time.Duration
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case time.Duration:
+		d = x
+	case string:
+		if d, err = time.ParseDuration(x); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, invArg(x, "date_bin")
+	}
+
+	if d <= 0 {
+		return nil, fmt.Errorf("invalid interval %v for date_bin", d)
+	}
+
+	var t time.Time
+	switch x := arg[1].(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		t = x
+	default:
+		return nil, invArg(x, "date_bin")
+	}
+
+	loc := time.UTC
+	if len(arg) == 3 {
+		switch x := arg[2].(type) {
+		case nil:
+			return nil, nil
+		case string:
+			switch x {
+			case "local":
+				loc = time.Local
+			default:
+				if loc, err = time.LoadLocation(x); err != nil {
+					return
+				}
+			}
+		default:
+			return nil, invArg(x, "date_bin")
+		}
+	}
+
+	// Bin the wall clock of t in loc.
+	t = t.In(loc)
+	w := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC).Truncate(d)
+	return time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), w.Nanosecond(), loc), nil
+}
+
 func builtinLen(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
 	switch x := arg[0].(type) {
 	case nil:
 		return nil, nil
 	case string:
 		return int64(len(x)), nil
+	case []interface{}:
+		return int64(len(x)), nil
 	default:
 		return nil, invArg(x, "len")
 	}
@@ -346,11 +469,83 @@ func builtinDay(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 	}
 }
 
+// maxFloatPrec is the largest precision of formatFloat, the same limit as the
+// one of package fmt.
+const maxFloatPrec = 1e6
+
+func builtinFormatFloat(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	prec := int64(-1)
+	if len(arg) == 2 {
+		switch x := arg[1].(type) {
+		case nil:
+			return nil, nil
+		case idealInt:
+			prec = int64(x)
+		case idealUint:
+			prec = int64(mathutil.MinUint64(uint64(x), math.MaxInt64))
+		case int8:
+			prec = int64(x)
+		case int16:
+			prec = int64(x)
+		case int32:
+			prec = int64(x)
+		case int64:
+			prec = x
+		case uint8:
+			prec = int64(x)
+		case uint16:
+			prec = int64(x)
+		case uint32:
+			prec = int64(x)
+		case uint64:
+			prec = int64(mathutil.MinUint64(x, math.MaxInt64))
+		default:
+			return nil, invArg(x, "formatFloat")
+		}
+	}
+
+	if prec < -1 || prec > maxFloatPrec {
+		return nil, fmt.Errorf("invalid precision %d for formatFloat", prec)
+	}
+
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case idealFloat:
+		return strconv.FormatFloat(float64(x), 'f', int(prec), 64), nil
+	case float32:
+		return strconv.FormatFloat(float64(x), 'f', int(prec), 32), nil
+	case float64:
+		return strconv.FormatFloat(x, 'f', int(prec), 64), nil
+	default:
+		return nil, invArg(x, "formatFloat")
+	}
+}
+
 func builtinFormatTime(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	switch x := arg[0].(type) {
 	case nil:
 		return nil, nil
 	case time.Time:
+		if len(arg) == 3 {
+			switch z := arg[2].(type) {
+			case nil:
+				return nil, nil
+			case string:
+				loc := time.Local
+				switch z {
+				case "local":
+				default:
+					if loc, err = time.LoadLocation(z); err != nil {
+						return
+					}
+				}
+				x = x.In(loc)
+			default:
+				return nil, invArg(z, "formatTime")
+			}
+		}
+
 		switch y := arg[1].(type) {
 		case nil:
 			return nil, nil
@@ -364,6 +559,22 @@ func builtinFormatTime(arg []interface{}, ctx map[interface{}]interface{}) (v in
 	}
 }
 
+func builtinFromBase64(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		b, err := base64.StdEncoding.DecodeString(x)
+		if err != nil {
+			return nil, fmt.Errorf("fromBase64(%q): %v", x, err)
+		}
+
+		return b, nil
+	default:
+		return nil, invArg(x, "fromBase64")
+	}
+}
+
 func builtinHasPrefix(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
 	switch s := arg[0].(type) {
 	case nil:
@@ -400,6 +611,17 @@ func builtinHasSuffix(arg []interface{}, _ map[interface{}]interface{}) (v inter
 	}
 }
 
+func builtinHex(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return strings.ToUpper(hex.EncodeToString(x)), nil
+	default:
+		return nil, invArg(x, "hex")
+	}
+}
+
 func builtinHour(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	switch x := arg[0].(type) {
 	case nil:
@@ -435,11 +657,14 @@ func builtinID(arg []interface{}, ctx map[interface{}]interface{}) (v interface{
 			return nil, fmt.Errorf("value not available: id(%s)", tab)
 		}
 
-		if _, ok := id.(int64); ok {
+		switch id.(type) {
+		case nil, int64: // NULL in a table WITHOUT ROWID.
 			return id, nil
 		}
 
 		return nil, fmt.Errorf("value not available: id(%s)", tab)
+	case nil: // Table WITHOUT ROWID.
+		return nil, nil
 	case int64:
 		return x, nil
 	default:
@@ -447,6 +672,13 @@ func builtinID(arg []interface{}, ctx map[interface{}]interface{}) (v interface{
 	}
 }
 
+func builtinIfNull(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	if v = arg[0]; v == nil {
+		v = arg[1]
+	}
+	return
+}
+
 func builtinImag(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
 	switch x := arg[0].(type) {
 	case nil:
@@ -476,70 +708,46 @@ func builtinMax(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 		return nil, nil
 	}
 
-	max := ctx[fn]
-	y := arg[0]
+	if ctx[fn], err = minMax("max", ctx[fn], arg[0]); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// minMax returns the new value of the max or min aggregate function, named
+// name, having the current value cur, after considering y. The values are
+// compared using collate1, so the result agrees with ORDER BY and with the
+// order of indices.
+func minMax(name string, cur, y interface{}) (interface{}, error) {
 	if y == nil {
-		return
+		return cur, nil
 	}
-	switch x := max.(type) {
-	case nil:
-		switch y := y.(type) {
-		case float32, float64, string, int8, int16, int32, int64, uint8, uint16, uint32, uint64, time.Time:
-			max = y
-		default:
-			return nil, fmt.Errorf("max: cannot accept %v (value if type %T)", y, y)
-		}
-	case float32:
-		if y := y.(float32); y > x {
-			max = y
-		}
-	case float64:
-		if y := y.(float64); y > x {
-			max = y
-		}
-	case string:
-		if y := y.(string); y > x {
-			max = y
-		}
-	case int8:
-		if y := y.(int8); y > x {
-			max = y
-		}
-	case int16:
-		if y := y.(int16); y > x {
-			max = y
-		}
-	case int32:
-		if y := y.(int32); y > x {
-			max = y
-		}
-	case int64:
-		if y := y.(int64); y > x {
-			max = y
-		}
-	case uint8:
-		if y := y.(uint8); y > x {
-			max = y
-		}
-	case uint16:
-		if y := y.(uint16); y > x {
-			max = y
-		}
-	case uint32:
-		if y := y.(uint32); y > x {
-			max = y
-		}
-	case uint64:
-		if y := y.(uint64); y > x {
-			max = y
-		}
-	case time.Time:
-		if y := y.(time.Time); y.After(x) {
-			max = y
-		}
+
+	switch y.(type) {
+	case float32, float64, string, int8, int16, int32, int64, uint8, uint16, uint32, uint64, time.Time:
+		// ok
+	default:
+		return nil, fmt.Errorf("%s: cannot accept %v (value if type %T)", name, y, y)
 	}
-	ctx[fn] = max
-	return
+
+	if cur == nil {
+		return y, nil
+	}
+
+	if g, e := reflect.TypeOf(y), reflect.TypeOf(cur); g != e {
+		return nil, fmt.Errorf("%s: mismatched types %v and %v", name, e, g)
+	}
+
+	c := collate1(y, cur)
+	if name == "min" {
+		c = -c
+	}
+	if c > 0 {
+		return y, nil
+	}
+
+	return cur, nil
 }
 
 func builtinMin(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
@@ -556,69 +764,10 @@ func builtinMin(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 		return nil, nil
 	}
 
-	min := ctx[fn]
-	y := arg[0]
-	if y == nil {
-		return
-	}
-	switch x := min.(type) {
-	case nil:
-		switch y := y.(type) {
-		case float32, float64, string, int8, int16, int32, int64, uint8, uint16, uint32, uint64, time.Time:
-			min = y
-		default:
-			return nil, fmt.Errorf("min: cannot accept %v (value if type %T)", y, y)
-		}
-	case float32:
-		if y := y.(float32); y < x {
-			min = y
-		}
-	case float64:
-		if y := y.(float64); y < x {
-			min = y
-		}
-	case string:
-		if y := y.(string); y < x {
-			min = y
-		}
-	case int8:
-		if y := y.(int8); y < x {
-			min = y
-		}
-	case int16:
-		if y := y.(int16); y < x {
-			min = y
-		}
-	case int32:
-		if y := y.(int32); y < x {
-			min = y
-		}
-	case int64:
-		if y := y.(int64); y < x {
-			min = y
-		}
-	case uint8:
-		if y := y.(uint8); y < x {
-			min = y
-		}
-	case uint16:
-		if y := y.(uint16); y < x {
-			min = y
-		}
-	case uint32:
-		if y := y.(uint32); y < x {
-			min = y
-		}
-	case uint64:
-		if y := y.(uint64); y < x {
-			min = y
-		}
-	case time.Time:
-		if y := y.(time.Time); y.Before(x) {
-			min = y
-		}
+	if ctx[fn], err = minMax("min", ctx[fn], arg[0]); err != nil {
+		return nil, err
 	}
-	ctx[fn] = min
+
 	return
 }
 
@@ -681,6 +830,130 @@ func builtinNow(arg []interface{}, ctx map[interface{}]interface{}) (v interface
 	return time.Now(), nil
 }
 
+func builtinNullIf(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	x, y := arg[0], arg[1]
+	if x == nil || y == nil {
+		return x, nil
+	}
+
+	b, err := newBinaryOperation(eq, value{x}, value{y})
+	if err != nil {
+		return nil, err
+	}
+
+	same, err := b.eval(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if same.(bool) {
+		return nil, nil
+	}
+
+	return x, nil
+}
+
+func builtinPercentileCont(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	type percentile struct {
+		p      float64
+		values []float64
+	}
+
+	if _, ok := ctx["$agg0"]; ok {
+		return
+	}
+
+	fn := ctx["$fn"]
+	if _, ok := ctx["$agg"]; ok {
+		data, ok := ctx[fn].(*percentile)
+		if !ok {
+			return
+		}
+
+		a := data.values
+		sort.Float64s(a)
+		pos := data.p * float64(len(a)-1)
+		i := int(pos)
+		if i == len(a)-1 {
+			return a[i], nil
+		}
+
+		return a[i] + (pos-float64(i))*(a[i+1]-a[i]), nil
+	}
+
+	x, p, ok, err := percentileArgs("percentile_cont", arg)
+	if !ok || err != nil {
+		return nil, err
+	}
+
+	data, _ := ctx[fn].(*percentile)
+	if data == nil {
+		data = &percentile{p: p}
+		ctx[fn] = data
+	}
+	data.values = append(data.values, x)
+	return
+}
+
+// strconvErr returns the reason of the strconv parsing failure err.
+func strconvErr(err error) error {
+	if e, ok := err.(*strconv.NumError); ok {
+		return e.Err
+	}
+
+	return err
+}
+
+func builtinParseFloat(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		f, err := strconv.ParseFloat(x, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parseFloat(%q): %v", x, strconvErr(err))
+		}
+
+		return f, nil
+	default:
+		return nil, invArg(x, "parseFloat")
+	}
+}
+
+func builtinParseInt(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	base := int64(10)
+	if len(arg) == 2 {
+		switch x := arg[1].(type) {
+		case nil:
+			return nil, nil
+		case int64:
+			base = x
+		case idealInt:
+			base = int64(x)
+		default:
+			return nil, invArg(x, "parseInt")
+		}
+	}
+
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if base == 1 || base < 0 || base > 36 {
+			return nil, fmt.Errorf("invalid base %d for parseInt", base)
+		}
+
+		n, err := strconv.ParseInt(x, int(base), 64)
+		if err != nil {
+			return nil, fmt.Errorf("parseInt(%q, %d): %v", x, base, strconvErr(err))
+		}
+
+		return n, nil
+	default:
+		return nil, invArg(x, "parseInt")
+	}
+}
+
 func builtinParseTime(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	var a [2]string
 	for i, v := range arg {
@@ -712,6 +985,90 @@ func builtinParseTime(arg []interface{}, ctx map[interface{}]interface{}) (v int
 	return time.ParseInLocation(a[0], a[1], l)
 }
 
+// dbRand is the random number generator of a DB used by the built-in
+// functions rand and randInt. It is seeded by the time of its first use,
+// unless random_seed sets the seed before.
+type dbRand struct {
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+// globalRand is used by the random functions evaluated outside of a
+// statement of a DB.
+var globalRand dbRand
+
+// ctxRand returns the random number generator of the DB executing the
+// statement of ctx.
+func ctxRand(ctx map[interface{}]interface{}) *dbRand {
+	if x, _ := ctx["$ctx"].(*execCtx); x != nil && x.db != nil {
+		return &x.db.rng
+	}
+
+	return &globalRand
+}
+
+func (r *dbRand) seed(n int64) {
+	r.mu.Lock()
+	r.rng = rand.New(rand.NewSource(n))
+	r.mu.Unlock()
+}
+
+// lock locks r and returns its generator, seeding it if necessary.
+func (r *dbRand) lock() *rand.Rand {
+	r.mu.Lock()
+	if r.rng == nil {
+		r.rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return r.rng
+}
+
+func builtinRand(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	r := ctxRand(ctx)
+	v = r.lock().Float64()
+	r.mu.Unlock()
+	return v, nil
+}
+
+func builtinRandInt(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	var a [2]int64
+	for i, v := range arg {
+		switch x := v.(type) {
+		case nil:
+			return nil, nil
+		case int64:
+			a[i] = x
+		case idealInt:
+			a[i] = int64(x)
+		default:
+			return nil, invArg(x, "randInt")
+		}
+	}
+
+	lo, hi := a[0], a[1]
+	if hi <= lo || hi-lo < 0 { // The range overflows int64.
+		return nil, fmt.Errorf("invalid range [%d, %d) for randInt", lo, hi)
+	}
+
+	r := ctxRand(ctx)
+	v = lo + r.lock().Int63n(hi-lo)
+	r.mu.Unlock()
+	return v, nil
+}
+
+func builtinRandomSeed(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case int64:
+		ctxRand(ctx).seed(x)
+	case idealInt:
+		ctxRand(ctx).seed(int64(x))
+	default:
+		return nil, invArg(x, "random_seed")
+	}
+	return nil, nil
+}
+
 func builtinReal(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
 	switch x := arg[0].(type) {
 	case nil:
@@ -844,6 +1201,33 @@ func builtinTimeIn(arg []interface{}, ctx map[interface{}]interface{}) (v interf
 	}
 }
 
+func builtinToBase64(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return base64.StdEncoding.EncodeToString(x), nil
+	default:
+		return nil, invArg(x, "toBase64")
+	}
+}
+
+func builtinUnhex(arg []interface{}, _ map[interface{}]interface{}) (v interface{}, err error) {
+	switch x := arg[0].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		b, err := hex.DecodeString(x)
+		if err != nil {
+			return nil, fmt.Errorf("unhex(%q): %v", x, err)
+		}
+
+		return b, nil
+	default:
+		return nil, invArg(x, "unhex")
+	}
+}
+
 func builtinWeekday(arg []interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
 	switch x := arg[0].(type) {
 	case nil:
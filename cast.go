@@ -0,0 +1,168 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cast is CAST(val AS typ), or its shorthand val::typ.
+type cast struct {
+	typ int
+	val expression
+}
+
+func (c *cast) isStatic() bool {
+	return c.val.isStatic()
+}
+
+func (c *cast) String() string {
+	return fmt.Sprintf("CAST(%s AS %s)", c.val, typeStr(c.typ))
+}
+
+func (c *cast) eval(ctx map[interface{}]interface{}, arg []interface{}) (v interface{}, err error) {
+	val, err := expand1(c.val.eval(ctx, arg))
+	if err != nil {
+		return
+	}
+
+	return castValue(val, c.typ)
+}
+
+// castTimeFormats are the formats of the strings cast to time.
+var castTimeFormats = []string{
+	"2006-01-02 15:04:05.999999999 -0700 MST", // Of string(time).
+	time.RFC3339Nano,
+	"2006-01-02",
+}
+
+// castValue returns val converted to typ by the rules of CAST, which extend
+// the rules of convert.
+func castValue(val interface{}, typ int) (v interface{}, err error) { //NTYPE
+	switch x := ideal(val).(type) {
+	case nil:
+		return nil, nil
+	case string:
+		s := strings.TrimSpace(x)
+		switch typ {
+		case qBool:
+			v, err = strconv.ParseBool(s)
+		case qComplex64:
+			var c complex128
+			c, err = strconv.ParseComplex(s, 64)
+			v = complex64(c)
+		case qComplex128:
+			v, err = strconv.ParseComplex(s, 128)
+		case qFloat32:
+			var f float64
+			f, err = strconv.ParseFloat(s, 32)
+			v = float32(f)
+		case qFloat64:
+			v, err = strconv.ParseFloat(s, 64)
+		case qInt8, qInt16, qInt32, qInt64:
+			var n int64
+			if n, err = strconv.ParseInt(s, 10, intBits(typ)); err == nil {
+				return convert(n, typ)
+			}
+		case qUint8, qUint16, qUint32, qUint64:
+			var n uint64
+			if n, err = strconv.ParseUint(s, 10, intBits(typ)); err == nil {
+				return convert(n, typ)
+			}
+		case qTime:
+			for _, f := range castTimeFormats {
+				var t time.Time
+				if t, err = time.Parse(f, s); err == nil {
+					return t, nil
+				}
+			}
+			return nil, castError(val, typ, errors.New("invalid time"))
+		default:
+			if v, err = convert(x, typ); err != nil {
+				return nil, castError(val, typ, nil)
+			}
+
+			return v, nil
+		}
+		if err != nil {
+			return nil, castError(val, typ, strconvErr(err))
+		}
+
+		return v, nil
+	case bool:
+		if typ == qString {
+			return strconv.FormatBool(x), nil
+		}
+	case complex64:
+		if typ == qString {
+			return strconv.FormatComplex(complex128(x), 'g', -1, 64), nil
+		}
+	case complex128:
+		if typ == qString {
+			return strconv.FormatComplex(x, 'g', -1, 128), nil
+		}
+	case float32:
+		if typ == qString {
+			return strconv.FormatFloat(float64(x), 'g', -1, 32), nil
+		}
+	case float64:
+		if typ == qString {
+			return strconv.FormatFloat(x, 'g', -1, 64), nil
+		}
+	case int8, int16, int32, int64, uint8, uint16, uint32, uint64:
+		if typ == qString {
+			return fmt.Sprint(x), nil
+		}
+	}
+
+	if v, err = convert(ideal(val), typ); err != nil {
+		return nil, castError(val, typ, nil)
+	}
+
+	switch typ {
+	case qInt8, qInt16, qInt32, qInt64, qUint8, qUint16, qUint32, qUint64:
+		if err = checkLossless(ideal(val), v, typ); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// intBits returns the size in bits of the integer type typ.
+func intBits(typ int) int {
+	switch typ {
+	case qInt8, qUint8:
+		return 8
+	case qInt16, qUint16:
+		return 16
+	case qInt32, qUint32:
+		return 32
+	default:
+		return 64
+	}
+}
+
+// castError returns the error of casting val to typ, failing for the reason
+// err, if not nil.
+func castError(val interface{}, typ int, err error) error {
+	src := "array"
+	if t := elemType(ideal(val)); t != 0 {
+		src = typeStr(t)
+	}
+	s := fmt.Sprint(val)
+	if _, ok := val.(string); ok {
+		s = strconv.Quote(s)
+	}
+	msg := fmt.Sprintf("cannot cast %s (type %s) to %s", s, src, typeStr(typ))
+	if err != nil {
+		return fmt.Errorf("%s: %v", msg, err)
+	}
+
+	return errors.New(msg)
+}
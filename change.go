@@ -0,0 +1,253 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ChangeOp is the kind of a row change, see ChangeEvent.
+type ChangeOp int
+
+// Values of ChangeEvent.Op.
+const (
+	ChangeInsert ChangeOp = iota + 1
+	ChangeUpdate
+	ChangeDelete
+)
+
+func (o ChangeOp) String() string {
+	switch o {
+	case ChangeInsert:
+		return "insert"
+	case ChangeUpdate:
+		return "update"
+	case ChangeDelete:
+		return "delete"
+	default:
+		return fmt.Sprintf("ChangeOp(%d)", int(o))
+	}
+}
+
+// ChangeEvent describes a committed change of a table row, see
+// Options.OnChange. The rows hold the values of all columns of the table, in
+// the order of SELECT * FROM Table.
+type ChangeEvent struct {
+	Seq   int64 // The sequence number of the commit, see DB.Changes.
+	Table string
+	Op    ChangeOp
+	ID    int64         // The id() of the row, zero in a table WITHOUT ROWID.
+	Old   []interface{} // The row before an update or a delete, nil otherwise.
+	New   []interface{} // The row after an insert or an update, nil otherwise.
+}
+
+// changeRow returns the row of the record data of t as reported by
+// ChangeEvent, or nil if db does not report changes. The fields of data are
+// laid out as described at insertIntoStmt.insert.
+func (db *DB) changeRow(t *table, data []interface{}) ([]interface{}, error) {
+	if !db.cdc {
+		return nil, nil
+	}
+
+	return t.row(data)
+}
+
+// row returns the values of the columns of t, in the order of t.cols, of the
+// record data laid out as described at insertIntoStmt.insert. The values are
+// expanded and the virtual generated columns are computed.
+func (t *table) row(data []interface{}) ([]interface{}, error) {
+	row := make([]interface{}, len(t.cols0))
+	copy(row, data[2:])
+	if err := expand(row); err != nil {
+		return nil, err
+	}
+
+	if t.hasGen(false) {
+		if err := t.genRow(row, false); err != nil {
+			return nil, err
+		}
+	}
+
+	r := make([]interface{}, len(t.cols))
+	for i, c := range t.cols {
+		r[i] = row[c.index]
+	}
+	return r, nil
+}
+
+// change records the change op of the row of t having the id id in the
+// current transaction, if db reports changes.
+func (db *DB) change(t *table, op ChangeOp, id interface{}, old, new []interface{}) {
+	if !db.cdc {
+		return
+	}
+
+	n, _ := id.(int64)
+	db.root.changes = append(db.root.changes, ChangeEvent{Table: t.name, Op: op, ID: n, Old: old, New: new})
+}
+
+// deleted records the deletion of the record of t having the handle h, if db
+// reports changes, and returns the handle of the next record of t.
+func (db *DB) deleted(t *table, h int64) (int64, error) {
+	// Read can return lazily expanded chunks
+	data, err := t.store.Read(nil, h, t.cols...)
+	if err != nil {
+		return 0, err
+	}
+
+	if n := len(t.cols0) + 2 - len(data); n > 0 {
+		data = append(data, make([]interface{}, n)...)
+	}
+	old, err := db.changeRow(t, data)
+	if err != nil {
+		return 0, err
+	}
+
+	db.change(t, ChangeDelete, data[1], old, nil)
+	return data[0].(int64), nil
+}
+
+// commitSeq records the sequence number of the commit of the outermost
+// transaction in the root record, if the transaction reports changes, so the
+// sequence numbers continue after the DB is opened again. db.mu must be held.
+func (db *DB) commitSeq() error {
+	if db.tnl != 1 || !db.cdc || len(db.root.changes) == 0 {
+		return nil
+	}
+
+	db.root.seq = db.seq + 1
+	return db.root.updated()
+}
+
+// changed reports the changes recorded by the committed outermost transaction
+// to Options.OnChange and to the streams of DB.Changes. db.mu must be held.
+func (db *DB) changed() {
+	a := db.root.changes
+	db.root.changes = nil
+	if len(a) == 0 {
+		return
+	}
+
+	db.seq++
+	for i := range a {
+		a[i].Seq = db.seq
+	}
+	if n := db.changeLogSize; n > 0 {
+		db.changeLog = append(db.changeLog, a...)
+		if i := len(db.changeLog) - n; i > 0 {
+			// Keep only whole commits.
+			for i < len(db.changeLog) && db.changeLog[i].Seq == db.changeLog[i-1].Seq {
+				i++
+			}
+			db.changeLog = append([]ChangeEvent(nil), db.changeLog[i:]...)
+		}
+	}
+	for _, v := range a {
+		if db.onChange != nil {
+			db.onChange(v)
+		}
+		for s := range db.streams {
+			s.send(v)
+		}
+	}
+}
+
+// ChangeStream is a stream of committed change events, see DB.Changes.
+type ChangeStream struct {
+	C <-chan ChangeEvent // Receives the change events, closed by Close.
+
+	c    chan ChangeEvent
+	db   *DB
+	done chan struct{}
+	once sync.Once
+}
+
+// Changes returns a stream of the change events of the transactions committed
+// after the commit having the sequence number seq, or of the transactions
+// committed from now on if seq is negative. It requires Options.ChangeLog to
+// be positive.
+//
+// Every commit of an outermost transaction changing some rows gets the next
+// sequence number, starting from 1. The last sequence number is kept in the
+// DB and it is updated by the committed transaction, so the sequence numbers
+// continue when the DB is opened again. Resuming from seq is possible as long
+// as the events of the commits after seq are among the Options.ChangeLog most
+// recent events kept in memory, so resuming a stream after the DB is opened
+// again fails unless seq is the last commit.
+//
+// The stream has room for buffer events. A commit waits for every stream to
+// accept its events while it holds the lock of the DB, so a slow consumer
+// slows down the writers of the DB. The goroutine consuming a stream must not
+// use the DB while the stream may be full, otherwise it deadlocks. Closing
+// the stream or the DB ends the stream.
+func (db *DB) Changes(seq int64, buffer int) (*ChangeStream, error) {
+	if db.changeLogSize <= 0 {
+		return nil, fmt.Errorf("Changes: the change log is not enabled")
+	}
+
+	if buffer < 0 {
+		return nil, fmt.Errorf("Changes: invalid buffer size %d", buffer)
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.store == nil {
+		return nil, fmt.Errorf("Changes: DB is closed")
+	}
+
+	var a []ChangeEvent
+	switch {
+	case seq > db.seq:
+		return nil, fmt.Errorf("Changes: invalid sequence number %d, the last commit is %d", seq, db.seq)
+	case seq >= 0 && seq < db.seq:
+		if len(db.changeLog) == 0 || db.changeLog[0].Seq > seq+1 {
+			return nil, fmt.Errorf("Changes: the changes after sequence number %d are no longer available", seq)
+		}
+
+		for i, v := range db.changeLog {
+			if v.Seq > seq {
+				a = db.changeLog[i:]
+				break
+			}
+		}
+	}
+
+	c := make(chan ChangeEvent, buffer+len(a))
+	for _, v := range a {
+		c <- v
+	}
+	s := &ChangeStream{C: c, c: c, db: db, done: make(chan struct{})}
+	if db.streams == nil {
+		db.streams = map[*ChangeStream]bool{}
+	}
+	db.streams[s] = true
+	return s, nil
+}
+
+// send waits until s accepts e or s is closed.
+func (s *ChangeStream) send(e ChangeEvent) {
+	select {
+	case s.c <- e:
+	case <-s.done:
+	}
+}
+
+// Close ends s. It waits for a commit in progress to complete and then closes
+// s.C, the events it already holds can still be received. Successful Close is
+// idempotent.
+func (s *ChangeStream) Close() error {
+	s.once.Do(func() {
+		close(s.done)
+		db := s.db
+		db.mu.Lock()
+		if db.streams[s] {
+			delete(db.streams, s)
+			close(s.c)
+		}
+		db.mu.Unlock()
+	})
+	return nil
+}
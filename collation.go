@@ -0,0 +1,124 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var (
+	collationsMu sync.RWMutex
+	collations   = map[string]func(string) string{
+		"binary": func(s string) string { return s },
+		"nocase": strings.ToLower,
+		"rtrim":  func(s string) string { return strings.TrimRight(s, " ") },
+	}
+)
+
+// RegisterCollation makes a collation available by name to the COLLATE
+// clause of expressions. Strings collate by the order of their keys returned
+// by key. Collation names are case insensitive. RegisterCollation panics if
+// key is nil or if it is called twice for the same name, including the
+// names of the predeclared collations binary, nocase and rtrim.
+func RegisterCollation(name string, key func(s string) string) {
+	if key == nil {
+		panic("RegisterCollation: key is nil")
+	}
+
+	name = strings.ToLower(name)
+	collationsMu.Lock()
+	defer collationsMu.Unlock()
+	if _, ok := collations[name]; ok {
+		panic(fmt.Sprintf("RegisterCollation: collation %s registered twice", name))
+	}
+
+	collations[name] = key
+}
+
+// collateExpr is expr COLLATE name.
+type collateExpr struct {
+	expr expression
+	key  func(string) string
+	name string
+}
+
+func newCollateExpr(expr expression, name string) (*collateExpr, error) {
+	collationsMu.RLock()
+	key := collations[strings.ToLower(name)]
+	collationsMu.RUnlock()
+	if key == nil {
+		return nil, fmt.Errorf("unknown collation %s", name)
+	}
+
+	return &collateExpr{expr: expr, key: key, name: name}, nil
+}
+
+// isStatic reports false so that x COLLATE name is not replaced by the value
+// of x, which would lose the collation.
+func (c *collateExpr) isStatic() bool { return false }
+
+func (c *collateExpr) String() string {
+	return fmt.Sprintf("%s COLLATE %s", c.expr, c.name)
+}
+
+func (c *collateExpr) eval(ctx map[interface{}]interface{}, arg []interface{}) (v interface{}, err error) {
+	return c.expr.eval(ctx, arg)
+}
+
+// collated returns the key of v if v is a string and the bytes of v, as a
+// string, if v is a blob. Other values are returned unchanged.
+func (c *collateExpr) collated(v interface{}) interface{} {
+	switch x := v.(type) {
+	case string:
+		return c.key(x)
+	case []byte:
+		return string(x)
+	}
+
+	return v
+}
+
+// collation returns the collation of the first of exprs having the form
+// x COLLATE name, possibly parenthesized, or nil if there is none.
+func collation(exprs ...expression) *collateExpr {
+	for _, e := range exprs {
+		for {
+			p, ok := e.(*pexpr)
+			if !ok {
+				break
+			}
+
+			e = p.expr
+		}
+		if c, ok := e.(*collateExpr); ok {
+			return c
+		}
+	}
+	return nil
+}
+
+// evalCollated evaluates the comparison o, one of whose operands has the
+// collation c, by comparing the keys of string operands.
+func (o *binaryOperation) evalCollated(c *collateExpr, ctx map[interface{}]interface{}, arg []interface{}) (interface{}, error) {
+	l, err := expand1(o.l.eval(ctx, arg))
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := expand1(o.r.eval(ctx, arg))
+	if err != nil {
+		return nil, err
+	}
+
+	_, lb := l.([]byte)
+	_, rb := r.([]byte)
+	if lb != rb && l != nil && r != nil { // Not comparable, report the mismatched types.
+		return (&binaryOperation{o.op, value{l}, value{r}}).eval(ctx, arg)
+	}
+
+	return (&binaryOperation{o.op, value{c.collated(l)}, value{c.collated(r)}}).eval(ctx, arg)
+}
@@ -0,0 +1,425 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Values of insertIntoStmt.conflict, the resolution of a new row having the
+// value of a unique index column of an existing row.
+const (
+	conflictAbort   = iota // The statement fails.
+	conflictIgnore         // The new row is not inserted.
+	conflictReplace        // The existing rows are replaced by the new row.
+)
+
+// conflictString returns the OR clause of INSERT INTO having the conflict
+// resolution c.
+func conflictString(c int) string {
+	switch c {
+	case conflictIgnore:
+		return " OR IGNORE"
+	case conflictReplace:
+		return " OR REPLACE"
+	default:
+		return ""
+	}
+}
+
+// conflicts returns the handles of the records of t having the same non NULL
+// value of a column with a unique index as the record data. The fields of
+// data are laid out as described at insertIntoStmt.insert.
+func (t *table) conflicts(data []interface{}) (r []int64, err error) {
+	for i, v := range t.indices {
+		if i == 0 || v == nil || !v.unique {
+			continue
+		}
+
+		h, err := t.seekUnique(i, data[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		if h == 0 {
+			continue
+		}
+
+		dup := false
+		for _, v := range r {
+			dup = dup || v == h
+		}
+		if !dup {
+			r = append(r, h)
+		}
+	}
+	return
+}
+
+// seekUnique returns the handle of the record of t having the value v in the
+// column with the unique index t.indices[i], or zero if there is no such
+// record or v is NULL.
+func (t *table) seekUnique(i int, v interface{}) (int64, error) {
+	if v == nil {
+		return 0, nil
+	}
+
+	it, hit, err := t.indices[i].x.Seek(v)
+	if err != nil {
+		return 0, noEOF(err)
+	}
+
+	if !hit {
+		return 0, nil
+	}
+
+	_, h, err := it.Next()
+	return h, err
+}
+
+// replaceRecord overwrites the record of t having the handle h by data, a new
+// record laid out as described at insertIntoStmt.insert, and updates the
+// indices of t.
+// The record keeps its place in the record list of t but it gets a new id.
+// Fields 0 and 1 of data are set by replaceRecord.
+func (t *table) replaceRecord(h int64, data []interface{}) (id interface{}, err error) {
+	old, err := t.store.Read(nil, h, t.cols...)
+	if err != nil {
+		return
+	}
+
+	if n := len(t.cols0) + 2 - len(old); n > 0 {
+		old = append(old, make([]interface{}, n)...)
+	}
+	for i, v := range t.indices {
+		if v == nil {
+			continue
+		}
+
+		if err = v.x.Delete(old[i+1], h); err != nil {
+			return
+		}
+	}
+
+	if id, err = t.nextID(); err != nil {
+		return
+	}
+
+	data[0], data[1] = old[0], id
+	if err = t.updateRow(h, t.blobCols(), data); err != nil {
+		return
+	}
+
+	for i, v := range t.indices {
+		if v == nil {
+			continue
+		}
+
+		if err = v.x.Create(data[i+1], h); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// deleteRecord removes the record of t having the handle h from the record
+// list of t starting at head and from the indices of t. It returns the new
+// head of the list.
+func (t *table) deleteRecord(head, h int64) (int64, error) {
+	var ph int64
+	for x := head; x != h; {
+		if x == 0 {
+			return head, fmt.Errorf("internal error: record %d of table %s not found", h, t.name)
+		}
+
+		rec, err := t.store.Read(nil, x)
+		if err != nil {
+			return head, err
+		}
+
+		ph, x = x, rec[0].(int64)
+	}
+
+	data, err := t.store.Read(nil, h, t.cols...)
+	if err != nil {
+		return head, err
+	}
+
+	if n := len(t.cols0) + 2 - len(data); n > 0 {
+		data = append(data, make([]interface{}, n)...)
+	}
+	for i, v := range t.indices {
+		if v == nil {
+			continue
+		}
+
+		// overflow chunks left in place
+		if err = v.x.Delete(data[i+1], h); err != nil {
+			return head, err
+		}
+	}
+
+	// overflow chunks freed here
+	if err = t.deleteRow(h, t.blobCols()); err != nil {
+		return head, err
+	}
+
+	nh := data[0].(int64)
+	if ph == 0 {
+		return nh, nil
+	}
+
+	pdata, err := t.store.Read(nil, ph, t.cols...)
+	if err != nil {
+		return head, err
+	}
+
+	for i, v := range pdata {
+		if x, ok := v.(chunk); ok {
+			pdata[i] = x.b
+		}
+	}
+	pdata[0] = nh
+	return head, t.updateRow(ph, nil, pdata)
+}
+
+// resolve inserts the record data to t, which conflicts with the records of
+// t having the handles hs, according to the conflict resolution of s. head is
+// the handle of the first record of t. It returns the new head of the record
+// list of t.
+func (s *insertIntoStmt) resolve(ctx *execCtx, t *table, head int64, data []interface{}, hs []int64) (_ int64, err error) {
+	cc := ctx.db.cc
+	switch s.conflict {
+	case conflictIgnore:
+		cc.RowsIgnored++
+		return head, nil
+	case conflictReplace:
+		if ctx.db.cdc {
+			for _, h := range hs {
+				if _, err = ctx.db.deleted(t, h); err != nil {
+					return
+				}
+			}
+		}
+
+		for _, h := range hs[1:] {
+			if head, err = t.deleteRecord(head, h); err != nil {
+				return
+			}
+		}
+
+		id, err := t.replaceRecord(hs[0], data)
+		if err != nil {
+			return head, err
+		}
+
+		row, err := ctx.db.changeRow(t, data)
+		if err != nil {
+			return head, err
+		}
+
+		ctx.db.change(t, ChangeInsert, id, nil, row)
+		cc.RowsAffected++
+		cc.RowsReplaced++
+		if !t.withoutRowID {
+			ctx.db.root.lastInsertID = id.(int64)
+		}
+		return head, nil
+	default:
+		return head, fmt.Errorf("internal error 074: conflict resolution %d", s.conflict)
+	}
+}
+
+// upsert is the ON CONFLICT clause of INSERT INTO.
+type upsert struct {
+	colNames []string     // The conflict target.
+	list     []assignment // DO UPDATE SET
+	where    expression   // DO UPDATE WHERE, if any.
+}
+
+func (u *upsert) String() string {
+	if u == nil {
+		return ""
+	}
+
+	a := make([]string, len(u.list))
+	for i, v := range u.list {
+		a[i] = v.String()
+	}
+	w := ""
+	if u.where != nil {
+		w = fmt.Sprintf(" WHERE %s", u.where)
+	}
+	return fmt.Sprintf(" ON CONFLICT (%s) DO UPDATE %s%s", quoteIdentList(u.colNames), strings.Join(a, ", "), w)
+}
+
+// check verifies the conflict target of u has a unique index in t and that u
+// assigns only to ordinary columns of t.
+func (u *upsert) check(t *table) error {
+	m := map[string]bool{}
+	for _, nm := range u.colNames {
+		if m[nm] {
+			return fmt.Errorf("ON CONFLICT: duplicate column %s", nm)
+		}
+
+		m[nm] = true
+	}
+	if u.target(t) == 0 {
+		return fmt.Errorf("ON CONFLICT: no unique index or primary key on (%s)", strings.Join(u.colNames, ", "))
+	}
+
+	_, err := u.cols(t)
+	return err
+}
+
+// target returns the index into t.indices of the unique index of t on the
+// conflict target of u, that is a column having a unique index or the key
+// columns of the primary key of t, in any order. It returns zero if there is
+// no such index.
+func (u *upsert) target(t *table) int {
+	if len(u.colNames) == 1 && t.hasIndices() {
+		if c := findCol(t.cols, u.colNames[0]); c != nil {
+			if x := t.indices[c.index+1]; x != nil && x.unique && !x.fulltext {
+				return c.index + 1
+			}
+		}
+	}
+
+	c := t.pkCol()
+	if c == nil || len(c.pk) != len(u.colNames) {
+		return 0
+	}
+
+	for _, nm := range u.colNames {
+		d := findCol(t.cols, nm)
+		if d == nil {
+			return 0
+		}
+
+		found := false
+		for _, i := range c.pk {
+			found = found || i == d.index
+		}
+		if !found {
+			return 0
+		}
+	}
+	return c.index + 1
+}
+
+// cols returns the columns of t assigned by u.
+func (u *upsert) cols(t *table) ([]*col, error) {
+	r := make([]*col, len(u.list))
+	for i, asgn := range u.list {
+		c := findCol(t.cols, asgn.colName)
+		switch {
+		case c == nil:
+			return nil, fmt.Errorf("ON CONFLICT: unknown column %s", asgn.colName)
+		case c.gen != nil:
+			return nil, fmt.Errorf("ON CONFLICT: cannot assign to generated column %s", asgn.colName)
+		}
+
+		r[i] = c
+	}
+	return r, nil
+}
+
+// find returns the handle of the record of t conflicting with the new record
+// data on the conflict target of u, or zero if there is no such record.
+func (u *upsert) find(t *table, data []interface{}) (int64, error) {
+	i := u.target(t)
+	return t.seekUnique(i, data[i+1])
+}
+
+// update performs the DO UPDATE clause of u on the record of t having the
+// handle h, which conflicts with the new record excluded. The expressions of
+// u can refer to the columns of the existing record by their plain or table
+// qualified names and to the columns of the new record by their names
+// qualified by excluded or EXCLUDED.
+func (u *upsert) update(ctx *execCtx, t *table, h int64, excluded []interface{}) error {
+	cols, err := u.cols(t)
+	if err != nil {
+		return err
+	}
+
+	// Read can return lazily expanded chunks
+	data, err := t.store.Read(nil, h, t.cols...)
+	if err != nil {
+		return err
+	}
+
+	if n := len(t.cols0) + 2 - len(data); n > 0 {
+		data = append(data, make([]interface{}, n)...)
+	}
+	ex := excluded[2:]
+	if t.hasGen(false) {
+		ex = append([]interface{}(nil), ex...)
+		if err = t.genRow(ex, false); err != nil {
+			return err
+		}
+
+		if err = t.genRow(data[2:], false); err != nil {
+			return err
+		}
+	}
+
+	m := map[interface{}]interface{}{"$ctx": ctx, "$id": data[1]}
+	for _, c := range t.cols {
+		v := data[2+c.index]
+		m[c.name] = v
+		m[t.name+"."+c.name] = v
+		m["excluded."+c.name] = ex[c.index]
+		m["EXCLUDED."+c.name] = ex[c.index]
+	}
+	if u.where != nil {
+		val, err := u.where.eval(m, ctx.arg)
+		if err != nil {
+			return err
+		}
+
+		if val == nil {
+			return nil
+		}
+
+		x, ok := val.(bool)
+		if !ok {
+			return fmt.Errorf("invalid WHERE expression %s (value of type %T)", val, val)
+		}
+
+		if !x {
+			return nil
+		}
+	}
+
+	vals := make([]interface{}, len(u.list))
+	for i, asgn := range u.list {
+		if vals[i], err = asgn.expr.eval(m, ctx.arg); err != nil {
+			return err
+		}
+	}
+	ctx.db.normalizeRow(vals)
+	if err = ctx.db.checkFloats(vals); err != nil {
+		return err
+	}
+
+	old, err := ctx.db.changeRow(t, data)
+	if err != nil {
+		return err
+	}
+
+	if err = t.updateRecord(h, data, cols, vals); err != nil {
+		return err
+	}
+
+	row, err := ctx.db.changeRow(t, data)
+	if err != nil {
+		return err
+	}
+
+	ctx.db.change(t, ChangeUpdate, data[1], old, row)
+	ctx.db.cc.RowsAffected++
+	return nil
+}
@@ -31,18 +31,20 @@ there are no tables in the DB.
 
 Table meta data
 
-Table meta data are a 6-scalar.
-
-	+---+---------+--------+--------------------------+
-	| # | Name    | Type   |      Description         |
-	+---+---------+--------+--------------------------+
-	| 0 | next    | handle | Next table meta data.    |
-	| 1 | scols   | string | Column defintitions      |
-	| 2 | hhead   | handle | -> head -> first record  |
-	| 3 | name    | string | Table name               |
-	| 4 | indices | string | Index definitions        |
-	| 5 | hxroots | handle | Index B+Trees roots list |
-	+---+---------+--------+--------------------------+
+Table meta data are a 6-scalar, optionally followed by one scalar per column
+of scols.
+
+	+----+---------+--------+--------------------------+
+	| #  | Name    | Type   |      Description         |
+	+----+---------+--------+--------------------------+
+	| 0  | next    | handle | Next table meta data.    |
+	| 1  | scols   | string | Column defintitions      |
+	| 2  | hhead   | handle | -> head -> first record  |
+	| 3  | name    | string | Table name               |
+	| 4  | indices | string | Index definitions        |
+	| 5  | hxroots | handle | Index B+Trees roots list |
+	| 6+ | gen     | string | Generated column, if any |
+	+----+---------+--------+--------------------------+
 
 Fields #4 and #5 are optional for backward compatibility with existing
 databases.  OTOH, forward compatibility will not work. Once any indices are
@@ -71,6 +73,7 @@ type tag and the column name. The type tags are
 	uint16     'v'
 	uint32     'w'
 	uint64     'x', alias uint
+	array      'A'
 	bigInt     'I'
 	bigRat     'R'
 	blob       'B'
@@ -135,11 +138,31 @@ to) head.
 
 The table name is stored in field #3 (name).
 
+Generated columns
+
+Fields #6 and following are present only if the table has any generated
+columns. There is one such field for every column of scols, in the same order.
+The field of an ordinary column is an empty string. The field of a generated
+column is its generating expression prefixed by "s" for a stored generated
+column or by "v" for a virtual one. For example
+
+	CREATE TABLE t (a int, b int AS (a*2) STORED, c string AS (string(a)));
+
+produces the fields #6, #7 and #8
+
+	"", "sa*2", "vstring(a)"
+
+The values of stored generated columns are stored in the table records like the
+values of ordinary columns. The values of virtual generated columns are never
+stored. If the table has generated columns but no indices then field #4 is an
+empty string and field #5 is zero.
+
 Indices
 
 Consider an index named N, indexing column named C.  The encoding of this
 particular index is a string "<tag>N". <tag> is a string "n" for non unique
-indices and "u" for unique indices. There is this index information for the
+indices, "u" for unique indices and "f" for full text indices. The keys of a
+full text index are the distinct words of the indexed values. There is this index information for the
 index possibly indexing the record id() and for all other columns of scols.
 Where the column is not indexed, the index info is an empty string. Infos for
 all indexes are joined with "|". For example
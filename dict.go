@@ -0,0 +1,308 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+)
+
+// dictionary holds the distinct values of a DICTIONARY column. Every value
+// is stored once, in a record of its own, and the records of the table store
+// the handle of that record, the code of the value, instead of the value. The
+// dictionary is a unique index of the value records by their values, used to
+// find the code of a value. A code is resolved by reading the value record,
+// see file.dictValue.
+//
+// A value record is {value, handle of its count record} and the count record
+// is {number of the records of the table storing the code}. A value is
+// removed from the dictionary once no record stores its code. The count is
+// kept apart from the value, so that counting a reference to a long value
+// does not rewrite it.
+type dictionary struct {
+	h int64 // Root of x.
+	x btreeIndex
+}
+
+// dictString returns the DICTIONARY clause of c, if any.
+func (c *col) dictString() string {
+	if !c.dict {
+		return ""
+	}
+
+	return " DICTIONARY"
+}
+
+// checkDict verifies c is of type string if it is a DICTIONARY column.
+func checkDict(c *col) error {
+	if c.dict && c.typ != qString {
+		return fmt.Errorf("DICTIONARY column %s must be of type string, not %s", c.name, typeStr(c.typ))
+	}
+
+	return nil
+}
+
+// createDicts creates the dictionaries of the DICTIONARY columns of t having
+// none.
+func (t *table) createDicts() error {
+	for i, c := range t.cols0 {
+		if !c.dict || c.name == "" || t.dicts[i] != nil {
+			continue
+		}
+
+		h, x, err := t.store.CreateIndex(true)
+		if err != nil {
+			return err
+		}
+
+		if t.dicts == nil {
+			t.dicts = map[int]*dictionary{}
+		}
+		t.dicts[i] = &dictionary{h, x}
+	}
+	return nil
+}
+
+// dropDict removes the dictionary of the column of t having the index i, if
+// any.
+func (t *table) dropDict(i int) error {
+	d := t.dicts[i]
+	if d == nil {
+		return nil
+	}
+
+	if err := d.clear(t.store); err != nil {
+		return err
+	}
+
+	delete(t.dicts, i)
+	return d.x.Drop()
+}
+
+// clearDicts removes all values of the dictionaries of t.
+func (t *table) clearDicts() error {
+	for _, d := range t.dicts {
+		if err := d.clear(t.store); err != nil {
+			return err
+		}
+
+		if err := d.x.Clear(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeDicts replaces the values of the DICTIONARY columns in the record data
+// of t, laid out as described at insertIntoStmt.insert, by their codes. Values
+// not yet in their dictionary are added to it. old is the stored record
+// replaced by data, if any, its codes not stored by data are released. The
+// returned function puts the values back to data, so that they can be used
+// for the indices of t once the record is written.
+func (t *table) encodeDicts(data, old []interface{}) (restore func(), err error) {
+	var saved []interface{} // Pairs of field index and value.
+	restore = func() {
+		for i := 0; i < len(saved); i += 2 {
+			data[saved[i].(int)] = saved[i+1]
+		}
+	}
+	for i, d := range t.dicts {
+		j := i + 2
+		var code0, code int64
+		if j < len(old) {
+			code0, _ = old[j].(int64)
+		}
+		if j < len(data) && data[j] != nil {
+			if code, err = d.ref(t.store, data[j], code0); err != nil {
+				restore()
+				return nil, err
+			}
+
+			saved = append(saved, j, data[j])
+			data[j] = code
+		}
+		if code0 != 0 && code0 != code {
+			if err = d.count(t.store, code0, -1); err != nil {
+				restore()
+				return nil, err
+			}
+		}
+	}
+	return restore, nil
+}
+
+// create writes the new record data of t, laid out as described at
+// insertIntoStmt.insert, and returns its handle.
+func (t *table) create(data []interface{}) (h int64, err error) {
+	restore, err := t.encodeDicts(data, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	h, err = t.store.Create(data...)
+	restore()
+	return h, err
+}
+
+// updateRow overwrites the record of t having the handle h by data, laid out
+// as described at insertIntoStmt.insert.
+func (t *table) updateRow(h int64, blobCols []*col, data []interface{}) error {
+	var old []interface{}
+	if len(t.dicts) != 0 {
+		var err error
+		if old, err = t.store.Read(nil, h); err != nil {
+			return err
+		}
+	}
+
+	restore, err := t.encodeDicts(data, old)
+	if err != nil {
+		return err
+	}
+
+	err = t.store.UpdateRow(h, blobCols, data...)
+	restore()
+	return err
+}
+
+// deleteRow deletes the record of t having the handle h, releasing the codes
+// it stores.
+func (t *table) deleteRow(h int64, blobCols []*col) error {
+	if len(t.dicts) != 0 {
+		old, err := t.store.Read(nil, h)
+		if err != nil {
+			return err
+		}
+
+		for i, d := range t.dicts {
+			if j := i + 2; j < len(old) {
+				if code, ok := old[j].(int64); ok {
+					if err = d.count(t.store, code, -1); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return t.store.Delete(h, blobCols...)
+}
+
+// find returns the code of v, zero if v is not in d.
+func (d *dictionary) find(v interface{}) (int64, error) {
+	it, hit, err := d.x.Seek(v)
+	if err = noEOF(err); err != nil || !hit {
+		return 0, err
+	}
+
+	_, h, err := it.Next()
+	return h, err
+}
+
+// ref returns the code of v, adding v to d if it is not there yet. A new
+// reference to v is counted unless its code is old, the code replaced by v.
+func (d *dictionary) ref(store storage, v interface{}, old int64) (int64, error) {
+	h, err := d.find(v)
+	switch {
+	case err != nil:
+		return 0, err
+	case h != 0 && h == old:
+		return h, nil
+	case h != 0:
+		return h, d.count(store, h, 1)
+	}
+
+	hn, err := store.Create(int64(1))
+	if err != nil {
+		return 0, err
+	}
+
+	if h, err = store.Create(v, hn); err != nil {
+		return 0, err
+	}
+
+	return h, d.x.Create(v, h)
+}
+
+// value returns the value and the handle of the count record of the value
+// record h.
+func (d *dictionary) value(store storage, h int64) (v interface{}, hn int64, err error) {
+	rec, err := store.Read(nil, h)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(rec) == 2 {
+		if hn, ok := rec[1].(int64); ok {
+			return rec[0], hn, nil
+		}
+	}
+
+	return nil, 0, fmt.Errorf("corrupted DB: invalid dictionary value of handle %d", h)
+}
+
+// count adds delta to the number of references to the value having the code h
+// and removes the value from d if there are none left.
+func (d *dictionary) count(store storage, h, delta int64) error {
+	v, hn, err := d.value(store, h)
+	if err != nil {
+		return err
+	}
+
+	rec, err := store.Read(nil, hn)
+	if err != nil {
+		return err
+	}
+
+	n, ok := int64(0), len(rec) == 1
+	if ok {
+		n, ok = rec[0].(int64)
+	}
+	if !ok {
+		return fmt.Errorf("corrupted DB: invalid dictionary count of handle %d", hn)
+	}
+
+	if n += delta; n > 0 {
+		return store.Update(hn, n)
+	}
+
+	if err = d.x.Delete(v, h); err != nil {
+		return err
+	}
+
+	if err = store.Delete(hn); err != nil {
+		return err
+	}
+
+	return store.Delete(h)
+}
+
+// clear deletes the value and count records of d. The caller clears or drops
+// d.x.
+func (d *dictionary) clear(store storage) error {
+	it, err := d.x.SeekFirst()
+	if err != nil {
+		return noEOF(err)
+	}
+
+	for {
+		_, h, err := it.Next()
+		if err != nil {
+			return noEOF(err)
+		}
+
+		_, hn, err := d.value(store, h)
+		if err != nil {
+			return err
+		}
+
+		if err = store.Delete(hn); err != nil {
+			return err
+		}
+
+		if err = store.Delete(h); err != nil {
+			return err
+		}
+	}
+}
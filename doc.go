@@ -179,30 +179,55 @@
 // 	_tmp42
 // 	Sales
 //
-// No identifiers are predeclared, however note that no keyword can be used as
-// an identifier.  Identifiers starting with two underscores are used for meta
-// data virtual tables names. For forward compatibility, users should generally
-// avoid using any identifiers starting with two underscores. For example
+// No identifiers are predeclared, however note that no reserved keyword can
+// be used as an identifier. Identifiers starting with two underscores are used
+// for meta data virtual tables names. For forward compatibility, users should
+// generally avoid using any identifiers starting with two underscores. For
+// example
 //
 //	__Column
 //	__Index
 //	__Table
 //
+// Statements compiled by CompileQuoted, or by a DB opened with the
+// IdentifierQuote option, can use quoted identifiers. A quoted identifier is
+// a string literal enclosed in the configured quote character, either double
+// quotes or back quotes, and it can be any non empty string not containing a
+// dot, including a keyword. For example, with double quotes
+//
+//	SELECT "order", price FROM "select" ORDER BY "order";
+//
+// String literals then must use the other kind of quotes. QL quotes the names
+// which need it by back quotes wherever it produces the text of a statement.
+// The schemas of tables in the __Table system table use the quote character
+// of the DB, so they compile as statements of the DB.
+//
 // Keywords
 //
 // The following keywords are reserved and may not be used as identifiers.
 //
-//	ADD      BY          duration  INDEX   NULL    TRUNCATE
-//	ALTER    byte        EXISTS    INSERT  OFFSET  uint
-//	AND      COLUMN      false     int     ON      uint16
-//	AS       complex128  float     int16   ORDER   uint32
-//	ASC      complex64   float32   int32   SELECT  uint64
-//	BETWEEN  CREATE      float64   int64   SET     uint8
-//	bigint   DELETE      FROM      int8    string  UNIQUE
-//	bigrat   DESC        GROUP     INTO    TABLE   UPDATE
-//	blob     DISTINCT    IF        LIMIT   time    VALUES
-//	bool     DROP        IN        LIKE    true    WHERE
-//	                               NOT     OR
+//	ADD      byte        false    int16   OR        uint32
+//	ALTER    COLUMN      float    int32   ORDER     uint64
+//	AND      complex128  float32  int64   SELECT    uint8
+//	AS       complex64   float64  int8    SET       UNIQUE
+//	ASC      CREATE      FROM     INTO    string    UPDATE
+//	BETWEEN  DELETE      GROUP    LIKE    TABLE     VALUES
+//	bigint   DESC        IF       LIMIT   time      WHERE
+//	bigrat   DISTINCT    IN       NOT     true
+//	blob     DROP        INDEX    NULL    TRUNCATE
+//	bool     duration    INSERT   OFFSET  uint
+//	BY       EXISTS      int      ON      uint16
+//
+// The following keywords are not reserved. They have a special meaning only
+// where the grammar expects them and can be used as identifiers elsewhere, for
+// example as names of tables and columns.
+//
+//	ANALYZE  CONFLICT    FOR       LESS        PRIMARY     ROWID
+//	array    DATABASE    FULLTEXT  MATCH       RANGE       STORED
+//	ATTACH   DETACH      HASH      PARTITION   REINDEX     TABLESAMPLE
+//	CAST     DICTIONARY  IGNORE    PARTITIONS  REPEATABLE  THAN
+//	COLLATE  DO          ILIKE     PERCENT     REPLACE     VIRTUAL
+//	COMMENT  ESCAPE      KEY       PRAGMA      RETURNING   WITHOUT
 //
 // Keywords are not case sensitive.
 //
@@ -213,7 +238,7 @@
 //
 // 	+    &    &&    ==    !=    (    )
 // 	-    |    ||    <     <=    [    ]
-// 	*    ^          >     >=    ,    ;
+// 	*    ^    ::    >     >=    ,    ;
 // 	/    <<         =           .
 // 	%    >>         !
 // 	     &^
@@ -223,6 +248,7 @@
 //
 //  andand = "&&" .
 //  andnot = "&^" .
+//  dcolon = "::" .
 //  lsh    = "<<" .
 //  le     = "<=" .
 //  eq     = "==" .
@@ -428,6 +454,21 @@
 // if placed in a rune literal (it is not a single code point), and will appear
 // as two code points if placed in a string literal.
 //
+// Blob literals
+//
+// A blob literal represents a blob constant. It consists of the letter X or x
+// followed by an even number of hexadecimal digits between single quotes, each
+// pair of digits specifying one byte of the value.
+//
+//  blob_lit = ( "X" | "x" ) "'" { hex_digit hex_digit } "'" .
+//
+// For example
+//
+// 	X'DEADBEEF'
+// 	x'00ff'
+// 	X''      // the empty blob
+// 	X'ABC'   // illegal: odd number of hexadecimal digits
+//
 // QL parameters
 //
 // Literals are assigned their values from the respective text representation
@@ -463,7 +504,8 @@
 // A type determines the set of values and operations specific to values of
 // that type. A type is specified by a type name.
 //
-//  Type = "bigint"      // http://golang.org/pkg/math/big/#Int
+//  Type = "array"       // []interface{}
+//       | "bigint"      // http://golang.org/pkg/math/big/#Int
 //       | "bigrat"      // http://golang.org/pkg/math/big/#Rat
 //       | "blob"        // []byte
 //       | "bool"
@@ -495,6 +537,29 @@
 // On 32 bit platforms this limits the size which the implementation can handle
 // to 2G.
 //
+// Array types
+//
+// An array type represents the set of sequences of values of the other types,
+// for example a short list of tags. The values of an array, its elements, are
+// numbered from zero and all of them have the same type. An element cannot be
+// NULL and arrays cannot be nested. Arrays of different element types may be
+// stored in the same column. The predeclared array type is array.
+//
+// The number of elements of an array can be discovered using the built-in
+// function len. Arrays are constructed by conversions
+//
+//	array()			// empty array
+//	array("go", "sql")	// array of two strings
+//
+// Arrays are not comparable using the comparison operators, but they are
+// ordered lexicographically by ORDER BY, with elements compared as defined in
+// "Comparison operators". Non empty arrays of different element types are
+// ordered by the element type in an unspecified, but consistent order.
+// Membership of a value in an array is tested by the IN predicate.
+//
+// Note: An array is exchanged between the back end and the API as
+// []interface{} with elements of the respective Go types.
+//
 // Boolean types
 //
 // A boolean type represents the set of Boolean truth values denoted by the
@@ -562,13 +627,18 @@
 //
 // The following functions are implicitly declared
 //
-//	avg          complex     contains   count      date
-//	day          formatTime  hasPrefix  hasSuffix  hour
-//	hours        id          imag       len        max
-//	min          minute      minutes    month      nanosecond
-//	nanoseconds  now         parseTime  real       second
-//	seconds      since       sum        timeIn     weekday
-//	year         yearDay
+//	approx_percentile  avg                complex            contains
+//	count              date               date_bin           day
+//	formatFloat        formatTime         fromBase64         hasPrefix
+//	hasSuffix          hex                hour               hours
+//	id                 imag               len                max
+//	min                minute             minutes            month
+//	nanosecond         nanoseconds        now                parseFloat
+//	parseInt           parseTime          percentile_cont    rand
+//	randInt            random_seed        real               second
+//	seconds            since              sum                timeIn
+//	toBase64           unhex              weekday            year
+//	yearDay
 //
 // Expressions
 //
@@ -579,13 +649,27 @@
 //
 // Operands denote the elementary values in an expression. An operand may be a
 // literal, a (possibly qualified) identifier denoting a constant or a function
-// or a table/record set column, or a parenthesized expression.
+// or a table/record set column, a parenthesized expression or a scalar
+// subquery.
 //
-//  Operand = Literal | QualifiedIdent | "(" Expression ")" .
+//  Operand = Literal | QualifiedIdent | "(" Expression ")"
+//  	| "(" SelectStmt [ ";" ] ")" .
 //  Literal = "FALSE" | "NULL" | "TRUE"
-//  	| float_lit | imaginary_lit | int_lit | rune_lit | string_lit
+//  	| blob_lit | float_lit | imaginary_lit | int_lit | rune_lit | string_lit
 //  	| ql_parameter .
 //
+// A scalar subquery is a SELECT statement producing exactly one column. Its
+// value is the value of the field of the only row produced by the SELECT
+// statement or NULL if it produces no rows. It is an error if the SELECT
+// statement produces more than one row. For example
+//
+//	SELECT name, (SELECT count() FROM child WHERE parent_id == pid) AS n
+//	FROM parent;
+//
+// A scalar subquery may refer to fields of the current row of the enclosing
+// query in the same way as the SELECT statement of the EXISTS predicate, see
+// "Predicates".
+//
 // Qualified identifiers
 //
 // A qualified identifier is an identifier qualified with a table/record set
@@ -603,9 +687,12 @@
 //
 //  PrimaryExpression = Operand
 //              | Conversion
+//              | Cast
 //              | PrimaryExpression Index
 //              | PrimaryExpression Slice
-//              | PrimaryExpression Call .
+//              | PrimaryExpression Call
+//              | PrimaryExpression dcolon Type
+//              | PrimaryExpression "COLLATE" identifier .
 //
 //  Call  = "(" [ ExpressionList ] ")" .
 //  Index = "[" Expression "]" .
@@ -618,6 +705,9 @@
 // 	(s + ".txt")
 // 	f(3.1415, true)
 // 	s[i : j + 1]
+// 	CAST(s AS int)
+// 	s::int
+// 	s COLLATE nocase
 //
 // Index expressions
 //
@@ -699,7 +789,9 @@
 //  Expression = Term { ( oror | "OR" ) Term } .
 //
 //  ExpressionList = Expression { "," Expression } [ "," ].
-//  Factor =  PrimaryFactor  { ( ge | ">" | le | "<" | neq | eq | "LIKE" ) PrimaryFactor } [ Predicate ] .
+//  Factor =  PrimaryFactor  { ( ge | ">" | le | "<" | neq | eq | "MATCH" ) PrimaryFactor
+//  	| ( "LIKE" | "ILIKE" ) PrimaryFactor [ "ESCAPE" PrimaryFactor ] } [ Predicate ]
+//  	| [ "NOT" ] "EXISTS" "(" SelectStmt [ ";" ] ")" .
 //  PrimaryFactor = PrimaryTerm  { ( "^" | "|" | "-" | "+" ) PrimaryTerm } .
 //  PrimaryTerm = UnaryExpr { ( andnot | "&" | lsh | rsh | "%" | "/" | "*" ) UnaryExpr } .
 //  Term = Factor { ( andand | "AND" ) Factor } .
@@ -728,7 +820,43 @@
 //
 // yeild a boolean value true if expr2, a regular expression, matches expr1
 // (see also [6]).  Both expression must be of type string. If any one of the
-// expressions is NULL the result is NULL.
+// expressions is NULL the result is NULL. Note that the pattern is not an SQL
+// one, the characters '%' and '_' have no special meaning.
+//
+// ILIKE is like LIKE except that letters are matched without regard to case,
+// using Unicode case folding.
+//
+//	expr1 ILIKE expr2
+//
+// Either form may be followed by an escape clause
+//
+//	expr1 LIKE expr2 ESCAPE expr3
+//
+// where expr3 is a string of exactly one character, the escape character.
+// Any character of expr2 preceded by the escape character matches itself
+// literally, even if it has a special meaning in a regular expression. The
+// escape character matches itself when doubled. It is an error if expr2 ends
+// with an unpaired escape character. If expr3 is NULL the result is NULL. For
+// example
+//
+//	SELECT * FROM t WHERE price LIKE "^5!.0!*$" ESCAPE "!";
+//	SELECT * FROM t WHERE name ILIKE "^élan";
+//
+// Expressions of the form
+//
+//	expr1 MATCH expr2
+//
+// yield a boolean value true if every word of expr2, the query, is also a word
+// of expr1. Words are the maximal sequences of letters and digits, compared
+// without regard to case. A query having no words matches nothing. Both
+// expressions must be of type string. If any one of the expressions is NULL
+// the result is NULL. For example
+//
+//	SELECT * FROM docs WHERE body MATCH "quick fox";
+//
+// selects the documents having both the word "quick" and the word "fox" in
+// their body, in any order and position. A full text index on the column
+// may speed up the evaluation of such queries, see CREATE INDEX.
 //
 // Predicates
 //
@@ -751,6 +879,49 @@
 //
 // Expressions of the form
 //
+//	expr IN ( SELECT ... )		// case A
+//
+//	expr NOT IN ( SELECT ... )	// case B
+//
+// yield a boolean value true if the value of expr is (case A) or is not (case
+// B) among the non NULL values produced by the SELECT statement. The SELECT
+// statement must produce exactly one column, its type must be comparable with
+// the type of expr as defined in "Comparison operators". If expr is NULL the
+// result is false in case A and true in case B.
+//
+// Expressions of the form
+//
+//	expr IN arr		// case A
+//
+//	expr NOT IN arr		// case B
+//
+// where arr is a field or a QL parameter of type array, are equivalent to the
+// respective first form listing the elements of arr as expr1, expr2, ... If
+// arr is NULL the result is false in case A and true in case B. Elements of a
+// type not comparable with the type of expr are not equal to expr, so a
+// column may hold arrays of different element types.
+//
+// For example
+//
+//	SELECT * FROM post WHERE "go" IN tags;
+//
+// Expressions of the form
+//
+//	EXISTS ( SELECT ... )		// case A
+//
+//	NOT EXISTS ( SELECT ... )	// case B
+//
+// yield a boolean value true if the SELECT statement produces at least one
+// row (case A) or no rows at all (case B).
+//
+// The SELECT statement of both forms may refer to fields of the current row of
+// the enclosing query by their unqualified names, if not shadowed by the
+// fields of the SELECT statement itself. Such correlated SELECT statements are
+// evaluated for every row of the enclosing query, others are evaluated only
+// once.
+//
+// Expressions of the form
+//
 //	expr BETWEEN low AND high	// case A
 //
 //	expr NOT BETWEEN low AND high	// case B
@@ -767,9 +938,12 @@
 //  Predicate = (
 //  			[ "NOT" ] (
 //  			  "IN" "(" ExpressionList ")"
+//  			| "IN" "(" SelectStmt [ ";" ] ")"
+//  			| "IN" ( QualifiedIdent | ql_parameter )
 //  			| "BETWEEN" PrimaryFactor "AND" PrimaryFactor
 //  			)
 //              |       "IS" [ "NOT" ] "NULL"
+//              |       "IS" [ "NOT" ] "DISTINCT" "FROM" PrimaryFactor
 //  	).
 //
 // Expressions of the form
@@ -782,6 +956,23 @@
 // if expr has a specific type (case B). In other cases the result is a boolean
 // value false.
 //
+// Expressions of the form
+//
+//	expr1 IS DISTINCT FROM expr2		// case A
+//
+//	expr1 IS NOT DISTINCT FROM expr2	// case B
+//
+// are a NULL safe variant of expr1 != expr2 (case A) and expr1 == expr2 (case
+// B). While comparing NULL to any value, including NULL, using the comparison
+// operators yields NULL, these predicates always yield a boolean value. Two
+// NULL values are not distinct, a NULL value and a non NULL value are
+// distinct. If neither expression is NULL the result is the same as of the
+// respective comparison operator. For example
+//
+//	SELECT * FROM t WHERE a IS NOT DISTINCT FROM $1;
+//
+// selects also the rows having a NULL value in column a when $1 is NULL.
+//
 // Operator precedence
 //
 // Unary operators have the highest precedence.
@@ -954,7 +1145,8 @@
 // durations are integers.
 //
 // - Floating point values are comparable and ordered, as defined by the
-// IEEE-754 standard.
+// IEEE-754 standard. In particular, any comparison having a NaN operand is
+// false, except that NaN != x is true, even if x is NaN.
 //
 // - Rational values are comparable and ordered, in the usual way.
 //
@@ -1006,7 +1198,13 @@
 // Conversions are expressions of the form T(x) where T is a type and x is an
 // expression that can be converted to type T.
 //
-//  Conversion = Type "(" Expression ")" .
+//  Conversion = Type "(" [ ExpressionList ] ")" .
+//
+// Only conversions to the array type may have a number of arguments other than
+// one. The conversion array(x1, x2, ...) yields an array of its arguments,
+// converted to their default type if they are untyped constants, or NULL if
+// any of the arguments is NULL. The conversion array(x) where x is an array
+// yields x.
 //
 // A constant value x can be converted to type T in any of these cases:
 //
@@ -1074,6 +1272,15 @@
 // if the result type cannot represent the value the conversion succeeds but
 // the result value is implementation-dependent.
 //
+// If the strict_conversions setting is enabled, see PRAGMA, a conversion to an
+// integer type fails instead of truncating the value according to the rules 1
+// and 2 when the result differs from the converted value, for example
+//
+//	int8(300)        // error: overflows int8
+//	int64(2.5)       // error: discards the fraction
+//	uint8(-1)        // error: overflows uint8
+//	int8(int64(100)) // 100
+//
 // Conversions to and from a string type
 //
 // 1. Converting a signed or unsigned integer value to a string type yields a
@@ -1148,6 +1355,76 @@
 //
 //	"2006-01-02 15:04:05.999999999 -0700 MST"
 //
+// Casts
+//
+// Casts are expressions of the form CAST(x AS T), or the equivalent shorthand
+// x::T, where T is a type and x is an expression.
+//
+//  Cast = "CAST" "(" Expression "AS" Type ")" .
+//
+// A cast converts x to type T like the conversion T(x), except for these
+// rules
+//
+// 1. Casting a value of a string type to an integer, floating point, complex
+// or bool type yields the value represented by the string, parsed as a
+// decimal integer, a floating point number, a complex number or one of
+// "true" and "false". Leading and trailing white space is ignored. An error
+// occurs if the string does not represent a value of type T.
+//
+//	CAST("42" AS int8)       // 42
+//	" 2.5 "::float64         // 2.5
+//	"true"::bool             // true
+//	CAST("300" AS int8)      // error: out of range
+//
+// 2. Casting a value of an integer, floating point, complex or bool type to a
+// string type yields its decimal representation. Floating point values use
+// the shortest representation which parses back to the same value.
+//
+//	CAST(42 AS string)       // "42", unlike string(42) == "*"
+//	2.5::string              // "2.5"
+//
+// 3. Casting a value of a string type to time yields the time represented by
+// the string in the format of casting time to string, see the conversion
+// rule 10 above, in the RFC 3339 format, or in the format "2006-01-02" as a
+// date in UTC.
+//
+//	CAST("2017-05-01" AS time)
+//	"2017-05-01T12:00:00Z"::time
+//
+// 4. Casting a value to an integer type fails if the result differs from the
+// value, as if the strict_conversions setting is enabled.
+//
+//	CAST(int64(300) AS int8) // error: overflows int8
+//	2.5::int                 // error: discards the fraction
+//
+// Other casts fail where the conversion T(x) fails. The error of a failing
+// cast names the type of x and T. A cast of NULL to any type yields NULL.
+//
+// Collations
+//
+// The expression x COLLATE name has the value of x. If it is an operand of a
+// comparison or an expression of an ORDER BY clause, string values are
+// compared, or ordered, using the named collation instead of by their bytes.
+// If both operands of a comparison have a collation, the left one is used.
+// The predeclared collations are
+//
+//	binary  // the bytes of strings, the default
+//	nocase  // strings converted to lower case
+//	rtrim   // strings without trailing spaces
+//
+// Other collations can be registered by RegisterCollation. Collation names
+// are case insensitive. Comparisons using a collation do not use indices.
+//
+//	SELECT * FROM t WHERE name COLLATE nocase == "joe";
+//	SELECT * FROM t ORDER BY name COLLATE nocase;
+//
+// Blobs, which are otherwise not ordered, are compared and ordered by their
+// bytes if the comparison or the ORDER BY expression has a collation, whatever
+// it is. A blob can be compared only to another blob.
+//
+//	SELECT * FROM t WHERE key COLLATE binary == x'00ff';
+//	SELECT * FROM t ORDER BY key COLLATE binary;
+//
 // Order of evaluation
 //
 // When evaluating the operands of an expression or of function calls,
@@ -1168,9 +1445,10 @@
 //
 // Statements control execution.
 //
-//  Statement =  EmptyStmt | AlterTableStmt | BeginTransactionStmt | CommitStmt
-//  	| CreateIndexStmt | CreateTableStmt | DeleteFromStmt | DropIndexStmt
-//  	| DropTableStmt | InsertIntoStmt | RollbackStmt | SelectStmt
+//  Statement =  EmptyStmt | AlterTableStmt | AnalyzeStmt | AttachStmt
+//  	| BeginTransactionStmt | CommitStmt | CreateIndexStmt | CreateTableStmt
+//  	| DeleteFromStmt | DetachStmt | DropIndexStmt | DropTableStmt
+//  	| InsertIntoStmt | PragmaStmt | ReindexStmt | RollbackStmt | SelectStmt
 //  	| TruncateTableStmt | UpdateStmt .
 //
 //  StatementList = Statement { ";" Statement } .
@@ -1189,7 +1467,10 @@
 // must be not the only (last) column of the table. IOW, there cannot be a
 // table with no columns.
 //
-//  AlterTableStmt = "ALTER" "TABLE" TableName ( "ADD" ColumnDef | "DROP" "COLUMN"  ColumnName ) .
+//  AlterTableStmt = "ALTER" "TABLE" TableName ( "ADD" ColumnDef | "DROP" "COLUMN"  ColumnName
+//  	| "ADD" "PARTITION" PartitionName "VALUES" "LESS" "THAN" "(" Expression ")"
+//  	| "DROP" "PARTITION" PartitionName ) .
+//  PartitionName = identifier .
 //
 // For example
 //
@@ -1198,6 +1479,94 @@
 // 		ALTER TABLE Income DROP COLUMN Taxes;
 //	COMMIT;
 //
+// The ADD PARTITION and DROP PARTITION clauses add and remove partitions of a
+// table partitioned by range, see Partitioned tables. Columns cannot be added
+// to or dropped from a partitioned table.
+//
+// ANALYZE
+//
+// The analyze statement collects statistics of the values of the columns of a
+// table, or of all tables if no table name is given, and stores them in the
+// table
+//
+//	CREATE TABLE __Stats (
+//		TableName      string,
+//		ColumnName     string,
+//		Rows           int64,  // Number of rows of the table.
+//		Nulls          int64,  // Number of NULL values of the column.
+//		DistinctValues int64,  // Number of distinct non NULL values.
+//		Bounds         array,  // Bounds of the histogram buckets.
+//		PRIMARY KEY (TableName, ColumnName)
+//	) WITHOUT ROWID;
+//
+// created by the first analyze statement. Bounds are the minimum and the
+// maximum of the non NULL values of the column and the values in between
+// dividing them into at most 16 buckets having about the same number of
+// values. Columns of type array are not analyzed. Tables whose names start
+// with "__" are analyzed only if named. The table must exist.
+//
+//  AnalyzeStmt = "ANALYZE" [ TableName ] .
+//
+// The statistics are used to choose which of the conditions of a WHERE
+// clause of the form
+//
+//	condition1 && condition2
+//
+// uses an index, if both could. The condition estimated to match fewer rows
+// of the table is looked up in its index and the rows found are then filtered
+// by the whole expression. Without statistics every condition is estimated
+// to match all rows of the table and condition1 is used. The statistics are
+// not updated by changes of the table, analyzing it again replaces them.
+// Dropping the table deletes them.
+//
+// For example
+//
+//	BEGIN TRANSACTION;
+//		ANALYZE Orders;
+//	COMMIT;
+//	SELECT * FROM Orders WHERE Customer == $1 && Status == "open";
+//
+// ATTACH DATABASE
+//
+// Attach database statements open another DB file and make its tables
+// available to the SELECT statements executed by the DB under the given
+// database name. The expression must evaluate to the name of an existing DB
+// file. The database name must not be "main", which denotes the DB itself, or
+// the name of another attached database. Detach database statements close the
+// attached database having the given name.
+//
+//  AttachStmt = "ATTACH" "DATABASE" Expression "AS" DatabaseName .
+//  DetachStmt = "DETACH" "DATABASE" DatabaseName .
+//  DatabaseName = identifier .
+//
+// For example
+//
+//	ATTACH DATABASE "ref.ql" AS ref;
+//	SELECT o.ID, l.Name
+//	FROM main.Orders AS o, ref.Lookup AS l
+//	WHERE o.Code == l.Code;
+//	DETACH DATABASE ref;
+//
+// The tables of an attached database are referred to by their name qualified
+// by the database name in the RecordSetList of a SELECT statement, see SELECT
+// FROM. Attached databases are read only, the statements updating the DB can
+// refer only to the tables of the DB itself. An attached database is opened
+// with the default Options and it has its own lock and WAL files, so the same
+// DB file cannot be attached to more than one DB at a time. Attached databases
+// are detached by closing the DB.
+//
+// The attach and detach database statements cannot be executed in a
+// transaction. They wait until the DB is not used by other statements or
+// transactions.
+//
+// Attaching databases is the way to spread the tables of a data set over
+// several DB files, for example to place them on different disks or to back
+// them up independently. A DB does not place some of its own tables in other
+// files: every DB file commits its transactions atomically using its own WAL,
+// and two WALs cannot be committed atomically together, so a transaction
+// updating tables in more than one file could be left partially committed by
+// a crash.
+//
 // BEGIN TRANSACTION
 //
 // Begin transactions statements introduce a new transaction level. Every
@@ -1239,7 +1608,10 @@
 // A database is effectively read only outside of a transaction. Statements not
 // requiring a transaction
 //
+//	ATTACH DATABASE
 //	BEGIN TRANSACTION
+//	DETACH DATABASE
+//	PRAGMA
 //	SELECT FROM
 //
 // COMMIT
@@ -1265,7 +1637,13 @@
 // as any of the existing tables and it also cannot be the same as of any
 // column name of the table the index is on.
 //
-//  CreateIndexStmt = "CREATE" [ "UNIQUE" ] "INDEX" [ "IF" "NOT" "EXISTS" ]
+// Creating an index on a table having rows fills the index with the values of
+// all of the rows. If that fails, for example because a UNIQUE index would get
+// duplicate values, the index is not created. The execution timeout, if any,
+// is checked for every row and the rows added to the index are reported to
+// Options.Metrics as MetricIndexRows.
+//
+//  CreateIndexStmt = "CREATE" [ "UNIQUE" | "FULLTEXT" ] "INDEX" [ "IF" "NOT" "EXISTS" ]
 //  	IndexName "ON" TableName "(" ( ColumnName | "id" Call ) ")" .
 //
 // For example
@@ -1285,6 +1663,20 @@
 //
 // The UNIQUE modifier requires the indexed values to be unique or NULL.
 //
+// The FULLTEXT modifier creates an index of the words of the values of a
+// column of type string instead of the values themselves. Such an index is
+// used only by the MATCH operator in a WHERE clause of the form
+//
+//	WHERE column MATCH query
+//
+// where query is a string constant or a QL parameter. For example
+//
+//	BEGIN TRANSACTION;
+//		CREATE TABLE docs (title string, body string);
+//		CREATE FULLTEXT INDEX docsBody ON docs (body);
+//	COMMIT;
+//	SELECT title FROM docs WHERE body MATCH $1;
+//
 // The optional IF NOT EXISTS clause makes the statement a no operation if the
 // index already exists.
 //
@@ -1295,10 +1687,16 @@
 // Neither a table or an index of the same name may exist in the DB.
 //
 //  CreateTableStmt = "CREATE" "TABLE" [ "IF" "NOT" "EXISTS" ] TableName
-//  	"(" ColumnDef { "," ColumnDef } [ "," ] ")" .
+//  	"(" ColumnDef { "," ColumnDef } [ "," [ PrimaryKey [ "," ] ] ] ")"
+//  	[ "WITHOUT" "ROWID" ] [ PartitionBy ] [ Comment ] .
 //
-//  ColumnDef = ColumnName Type .
+//  ColumnDef = ColumnName Type [ "DICTIONARY" | "AS" "(" Expression ")" [ "STORED" | "VIRTUAL" ] ]
+//  	[ "NOT" "NULL" ] [ Comment ] .
 //  ColumnName = identifier .
+//  Comment = "COMMENT" string_lit .
+//  PartitionBy = "PARTITION" "BY" ( "RANGE" "(" ColumnName ")"
+//  	| "HASH" "(" ColumnName ")" "PARTITIONS" int_lit ) .
+//  PrimaryKey = "PRIMARY" "KEY" "(" ColumnNameList ")" .
 //  TableName = identifier .
 //
 // For example
@@ -1317,11 +1715,185 @@
 // The optional IF NOT EXISTS clause makes the statement a no operation if the
 // table already exists.
 //
+// A column declared NOT NULL cannot be NULL. Inserting a row having NULL in
+// such a column, including by omitting the column from the column list of
+// INSERT INTO, fails and so does updating the column to NULL. Columns have no
+// default values, so every INSERT INTO must provide a value of a NOT NULL
+// column. A NOT NULL column can be added by ALTER TABLE only to a table having
+// no rows, unless it is a stored generated column. A virtual generated column
+// cannot be declared NOT NULL.
+//
+// The COMMENT clause of a column definition, or of the table, documents the
+// column or the table. It has no other effect. The comments are kept in the
+// __Meta table, see DB.SetMeta, and the CREATE TABLE statements of the __Table
+// system table include them.
+//
+//	CREATE TABLE widget (
+//		name  string COMMENT "display name",
+//		count int COMMENT "widget count",
+//	) COMMENT "widgets in stock";
+//
+// Dictionary columns
+//
+// A string column declared DICTIONARY stores every distinct value only once,
+// in a dictionary of the column, and the rows store a reference to the value
+// instead. This saves space if the column has few distinct values repeated in
+// many rows, at the cost of a lookup whenever a row is written or read. The
+// encoding is invisible to SQL: the column behaves as any other string column
+// and it can be indexed, compared, updated and so on. A value is removed from
+// the dictionary once no row uses it. Only columns of type string can be
+// declared DICTIONARY.
+//
+// The reference stored in a row is an integer taking up to 9 bytes, while a
+// string stored in the row takes one byte more than its length, so strings of
+// up to about 8 bytes take less space stored in the rows than in the
+// dictionary. Every distinct value also takes a record and an index entry of
+// the dictionary and the number of the rows using the value is updated
+// whenever a row is written or deleted.
+//
+//	CREATE TABLE request (
+//		url    string,
+//		status string DICTIONARY NOT NULL,
+//	);
+//
+// Generated columns
+//
+// A column definition having the AS clause declares a generated column. Its
+// value is the value of the expression, which may refer to other columns of
+// the same table by their names, converted to the column type as in an
+// assignment. The expression may not refer to other generated columns and it
+// may not use parameters, subqueries, aggregate functions or id().
+//
+// The value of a VIRTUAL generated column, which is the default, is computed
+// whenever the row is read and it is not stored in the DB. The value of a
+// STORED generated column is computed whenever the row is inserted or updated
+// and it is stored in the DB. Only stored generated columns can be indexed.
+// Generated columns cannot be assigned to by the INSERT INTO and UPDATE
+// statements and they are excluded from the implicit column list of INSERT
+// INTO.
+//
+// For example
+//
+//	BEGIN TRANSACTION;
+//		CREATE TABLE person (
+//			first     string,
+//			last      string,
+//			full_name string AS (first + " " + last),
+//			initials  string AS (first[:1] + last[:1]) STORED,
+//		);
+//		CREATE INDEX xinitials ON person (initials);
+//		INSERT INTO person VALUES ("John", "Doe");
+//	COMMIT;
+//
+// A column referred to by a generated column cannot be dropped.
+//
+// Primary key
+//
+// The optional PRIMARY KEY clause declares the primary key of the table, a
+// list of one or more columns whose combined values must be unique. The key
+// columns cannot be NULL. Inserting or updating a row such that its key
+// equals the key of another row fails.
+//
+// The primary key is backed by a unique index, which is created together with
+// the table and is named __pk_ followed by the table name. The index is listed
+// in the __Index system table. Neither the index nor the key columns can be
+// dropped. A WHERE clause consisting of equality comparisons of all of the key
+// columns with constants or parameters, combined by the && operator, uses the
+// index to find the row.
+//
+// The primary key has no clustering semantics: rows are stored in the same
+// way as in a table without a primary key and a table scan does not produce
+// them in the key order. The implicit id() of the rows exists and works
+// regardless of the primary key. The key columns can be of any type except
+// array and they cannot be virtual generated columns.
+//
+// A table declared WITHOUT ROWID must have a primary key. Its rows have no
+// id(), which is NULL for them, and no id is allocated for them on insert. A
+// table scan produces its rows in the key order, which is the order of the
+// first key column, then the second one and so on, except that the order of
+// complex and bigrat key columns is unspecified. An index on id() cannot be
+// created for such a table and LastInsertId is not updated by inserting into
+// it.
+//
+// For example
+//
+//	BEGIN TRANSACTION;
+//		CREATE TABLE measurement (
+//			sensor int,
+//			taken  time,
+//			value  float64,
+//			PRIMARY KEY (sensor, taken),
+//		) WITHOUT ROWID;
+//	COMMIT;
+//	SELECT value FROM measurement WHERE sensor == $1 && taken == $2;
+//
+// Partitioned tables
+//
+// The optional PARTITION BY clause divides the rows of the table among
+// partitions by the value of the partitioning column, the partition key.
+// Every partition is stored in a table of its own, named by the table name,
+// a dot and the partition name. No identifier, not even a quoted one, can
+// contain a dot, so such tables cannot be named in statements. They are not
+// listed in the __Table system table.
+//
+// A table partitioned by RANGE has initially no partitions. They are added by
+// ALTER TABLE ADD PARTITION, every one having a bound greater than the bounds
+// of the existing partitions. A partition holds the rows whose keys are less
+// than its bound and not less than the bound of the preceding partition. The
+// first partition holds also all the keys less than its bound. Inserting a row
+// whose key is not less than the bound of the last partition fails. Rows
+// having a NULL key are stored in the first partition. ALTER TABLE DROP
+// PARTITION removes a partition together with all its rows by dropping its
+// table, without removing the rows one by one.
+//
+// A table partitioned by HASH has the given number of partitions, p0, p1 and
+// so on, up to 1024 of them. A row is stored in the partition selected by the
+// hash of its key. Partitions of such a table cannot be added or dropped.
+//
+// The WHERE clause of SELECT, UPDATE and DELETE statements reads only the
+// partitions which can have matching rows, if it is a comparison of the
+// partition key to a constant or a parameter, possibly combined with other
+// expressions by the && operator. For a table partitioned by HASH only the ==
+// comparison limits the partitions read. The rows of a partitioned table are
+// produced partition after partition, so PRAGMA stable_order holds within
+// every partition only.
+//
+// A partitioned table cannot have a primary key, its columns cannot be added
+// or dropped and it cannot be indexed. The partition key cannot be a generated
+// column or a column of type array and it cannot be updated. The partitions
+// are recorded in the table
+//
+//	CREATE TABLE __Partition (
+//		TableName  string,
+//		Name       string,  // Partition name, "" for the table itself.
+//		Method     string,  // "range" or "hash".
+//		ColumnName string,  // The partitioning column.
+//		Bound      array,   // The bound, or the number of a hash partition.
+//		PRIMARY KEY (TableName, Name)
+//	) WITHOUT ROWID;
+//
+// Dropping a partitioned table drops its partitions as well. Dropping the
+// __Partition table drops the partitions of all partitioned tables, which
+// then become tables having no rows.
+//
+// For example
+//
+//	BEGIN TRANSACTION;
+//		CREATE TABLE log (ts time, msg string) PARTITION BY RANGE (ts);
+//		ALTER TABLE log ADD PARTITION y2023 VALUES LESS THAN (parseTime("2006-01-02", "2024-01-01"));
+//		ALTER TABLE log ADD PARTITION y2024 VALUES LESS THAN (parseTime("2006-01-02", "2025-01-01"));
+//		INSERT INTO log VALUES (now(), "started");
+//	COMMIT;
+//	SELECT * FROM log WHERE ts >= parseTime("2006-01-02", "2024-06-01"); // Reads only log.y2024.
+//	BEGIN TRANSACTION;
+//		ALTER TABLE log DROP PARTITION y2023;
+//	COMMIT;
+//
 // DELETE FROM
 //
 // Delete from statements remove rows from a table, which must exist.
 //
-//  DeleteFromStmt = "DELETE" "FROM" TableName [ WhereClause ] .
+//  DeleteFromStmt = "DELETE" "FROM" TableName [ WhereClause ] [ Returning ] .
 //
 // For example
 //
@@ -1331,7 +1903,26 @@
 //	COMMIT;
 //
 // If the WHERE clause is not present then all rows are removed and the
-// statement is equivalent to the TRUNCATE TABLE statement.
+// statement is equivalent to the TRUNCATE TABLE statement, unless it has a
+// RETURNING clause.
+//
+// The RETURNING clause makes the statement produce a record set of the
+// removed rows, as they were before they were removed, selecting the fields
+// of the clause as SELECT does from the table. It saves reading the rows by
+// a separate SELECT, which could see other rows if it is not in the same
+// transaction.
+//
+//  Returning = "RETURNING" ( "*" | FieldList ) .
+//
+// For example
+//
+//	BEGIN TRANSACTION;
+//		DELETE FROM Orders WHERE Shipped RETURNING OrderID, id() AS ID;
+//	COMMIT;
+//
+// A WHERE clause which can use an index in a SELECT statement uses it in the
+// DELETE statement as well. The rows found by the index are then removed
+// without evaluating the WHERE clause for the other rows of the table.
 //
 // DROP INDEX
 //
@@ -1378,10 +1969,31 @@
 // assigned to a column must be the same as is the column's type or the value
 // must be NULL.
 //
-//  InsertIntoStmt = "INSERT" "INTO" TableName [ "(" ColumnNameList ")" ] ( Values | SelectStmt ) .
+// A new row having the same value as an existing row in a column with a
+// unique index conflicts with that row and the statement fails. With the OR
+// IGNORE clause such a new row is not inserted and the other rows are. With
+// the OR REPLACE clause the existing row is replaced by the new row. The
+// replaced row gets a new id. If the new row conflicts with more than one
+// existing row, all of them are replaced by the new row. NULL values do not
+// conflict. See also TCtx.RowsIgnored and TCtx.RowsReplaced.
+//
+// The ON CONFLICT clause names a conflict target, either a column having a
+// unique index or the key columns of the primary key of the table. A new row
+// conflicting with an existing row on the conflict target is not inserted,
+// the existing row is updated instead as if by an UPDATE statement having
+// the assignments and the optional WHERE clause of the DO UPDATE clause. If
+// the WHERE expression is not true, the existing row is left unchanged.
+// The expressions of the DO UPDATE clause refer to the columns of the
+// existing row by their names, possibly qualified by the table name, and to
+// the proposed columns of the new row by their names qualified by excluded
+// or EXCLUDED. Conflicts on other unique indices are handled according to
+// the OR clause. Updated rows are included in TCtx.RowsAffected.
+//
+//  InsertIntoStmt = "INSERT" [ "OR" ( "IGNORE" | "REPLACE" ) ] "INTO" TableName [ "(" ColumnNameList ")" ] ( Values | SelectStmt ) [ OnConflict ] .
 //
 //  ColumnNameList = ColumnName { "," ColumnName } [ "," ] .
 //  Values = "VALUES" "(" ExpressionList ")" { "," "(" ExpressionList ")" } [ "," ] .
+//  OnConflict = "ON" "CONFLICT" "(" ColumnNameList ")" "DO" "UPDATE" [ "SET" ] AssignmentList [ WhereClause ] .
 //
 // For example
 //
@@ -1409,6 +2021,73 @@
 //		FROM department;
 //	COMMIT;
 //
+//	BEGIN TRANSACTION;
+//		INSERT OR REPLACE INTO department VALUES (42, "Research");
+//	COMMIT;
+//
+//	BEGIN TRANSACTION;
+//		INSERT INTO stock (item, qty) VALUES ("apple", 3)
+//		ON CONFLICT (item) DO UPDATE SET qty = qty + excluded.qty;
+//	COMMIT;
+//
+// PRAGMA
+//
+// Pragma statements read or change settings of the DB. The settings, except
+// application_id and user_version, are not persisted, they last until the DB
+// is closed. With the assignment form the expression is evaluated and the
+// setting is updated. Otherwise the statement produces a record set of one
+// row with one field, named after the setting, holding its current value.
+// Using an unknown setting name is an error. Assigning a setting is not
+// transactional, it takes effect immediately and it is not undone by a
+// ROLLBACK of an enclosing transaction.
+//
+//  PragmaStmt = "PRAGMA" identifier [ "=" Expression ] .
+//
+// The settings are
+//
+//	application_id	int32		see DB.ApplicationID
+//	query_timeout	duration	see DB.ExecuteTimeout, zero or negative
+//				values disable the timeout
+//	stable_order	bool		see Options.StableOrder
+//	strict_conversions	bool	see Options.StrictConversions
+//	strict_floats	bool		see Options.StrictFloats
+//	user_version	int32		see DB.UserVersion
+//
+// The application id and the user version are recorded in the header of the
+// DB file, initially the user version is zero. They are not used by QL,
+// applications can use the user version, for example, to keep track of the
+// schema migrations applied to the DB. Setting either of them writes it to the
+// DB file immediately, it is not affected by transactions.
+//
+// There is no setting of the sync mode. A commit of a DB file always syncs its
+// write ahead log, or the journal of an encrypted DB file, and the DB file.
+// The crash recovery relies on it and a memory DB has nothing to sync.
+//
+// For example
+//
+//	PRAGMA stable_order = true;
+//	PRAGMA query_timeout = duration("5s");
+//	PRAGMA query_timeout;
+//	PRAGMA user_version = 3;
+//
+// REINDEX
+//
+// The reindex statement rebuilds all indices of a table, including the index
+// of its primary key, from the rows of the table. It can be used to repair an
+// index which became inconsistent with the table. The statement produces a
+// record set of one row per index, having the fields Index, the name of the
+// index, and Rows, the number of rows added to the index. If rebuilding an
+// index fails, for example because a UNIQUE index would get duplicate values,
+// the transaction should be rolled back. The table must exist.
+//
+//  ReindexStmt = "REINDEX" TableName .
+//
+// For example
+//
+//	BEGIN TRANSACTION;
+//		REINDEX Orders;
+//	COMMIT;
+//
 // ROLLBACK
 //
 // The rollback statement closes the innermost transaction nesting level
@@ -1441,16 +2120,32 @@
 // resulting fields are returned ('*') or only those named in FieldList.
 //
 // RecordSetList is a list of table names or parenthesized select statements,
-// optionally (re)named using the AS clause.
+// optionally (re)named using the AS clause. A table name qualified by a
+// database name refers to a table of an attached database or, if the database
+// name is "main", of the DB itself. Such a record set is named by the
+// unqualified table name unless renamed.
 //
 // The result can be filtered using a WhereClause and orderd by the OrderBy
 // clause.
 //
+// The FOR UPDATE clause declares the selected rows are going to be updated by
+// the enclosing transaction. Executing a statement having the clause outside
+// of a transaction is an error. The rows are not locked individually, the
+// locking granularity is the whole DB: A transaction holds the exclusive lock
+// of the DB from BEGIN TRANSACTION to COMMIT or ROLLBACK, so no other
+// transaction, nor any statement outside of it, can read or update the DB in
+// the mean time. The FOR UPDATE clause of a nested SELECT statement has no
+// effect.
+//
 //  SelectStmt = "SELECT" [ "DISTINCT" ] ( "*" | FieldList ) "FROM" RecordSetList
-//  	[ WhereClause ] [ GroupByClause ] [ OrderBy ] [ Limit ] [ Offset ].
+//  	[ WhereClause ] [ GroupByClause ] [ OrderBy ] [ Limit ] [ Offset ]
+//  	[ "FOR" "UPDATE" ] .
 //
-//  RecordSet = ( TableName | "(" SelectStmt [ ";" ] ")" ) [ "AS" identifier ] .
+//  RecordSet = ( [ DatabaseName "." ] TableName [ TableSample ]
+//  	| "(" SelectStmt [ ";" ] ")" ) [ "AS" identifier ] .
 //  RecordSetList = RecordSet { "," RecordSet } [ "," ] .
+//  TableSample = "TABLESAMPLE" "(" Expression "PERCENT" ")"
+//  	[ "REPEATABLE" "(" Expression ")" ] .
 //
 // For example
 //
@@ -1477,6 +2172,26 @@
 // 		) AS c
 //	WHERE a.e > c.e;
 //
+// The TABLESAMPLE clause makes the statement consider a random sample of the
+// rows of a table instead of all of them. The first expression, evaluated
+// once, is the percentage of the rows to sample, a number in [0, 100]. Every
+// row is sampled independently with that probability, so the sample size
+// varies around the percentage of the number of rows. The scan skips the rows
+// not sampled before evaluating any expression for them and, if the table has
+// an index on id() or is a table WITHOUT ROWID, without reading them from the
+// DB. A sampled table is always scanned; its indices are not used to evaluate
+// the WHERE clause.
+//
+// The rows are sampled using the random number generator of the DB, see
+// "Random numbers", or, if the REPEATABLE clause is present, using a
+// generator seeded by its integer expression. The same seed samples the same
+// rows of the same table as long as the table is not updated.
+//
+// For example
+//
+//	SELECT count()*100 FROM events TABLESAMPLE (1 PERCENT) REPEATABLE (42)
+//	WHERE Kind == "error";
+//
 // Fields naming rules
 //
 // A field is an named expression. Identifiers, not used as a type in
@@ -1534,9 +2249,11 @@
 //  OrderBy = "ORDER" "BY" ExpressionList [ "ASC" | "DESC" ] .
 //
 // All of the expression values must yield an ordered type or NULL. Ordered
-// types are defined in "Comparison operators". Collating of elements having a
-// NULL value is different compared to what the comparison operators yield in
-// expression evaluation (NULL result instead of a boolean value).
+// types are defined in "Comparison operators". Strings collate by their
+// bytes unless the expression has the form x COLLATE name, see "Collations".
+// Collating of elements having a NULL value is different compared to what the
+// comparison operators yield in expression evaluation (NULL result instead of
+// a boolean value).
 //
 // Below, T denotes a non NULL value of any QL type.
 //
@@ -1548,6 +2265,12 @@
 //
 // Two NULLs have no collating order (are considered equal).
 //
+// Floating point values collate like they compare, except for NaN. NaN
+// collates after any other floating point value, including +Inf, and two
+// NaNs have no collating order. The same applies to the order of indices,
+// to GROUP BY and DISTINCT, which put all NaNs in one group, and to the
+// aggregate functions min and max. A UNIQUE index accepts at most one NaN.
+//
 // Recordset filtering
 //
 // The WHERE clause restricts records considered by some statements, like
@@ -1563,6 +2286,40 @@
 //
 //  WhereClause = "WHERE" Expression .
 //
+// Constant subexpressions are evaluated once when the statement is compiled.
+// An operand of && or || in a WHERE clause which is then a constant bool is
+// removed together with the other operand if that cannot change the truth of
+// the result, for example "i > 1 && 1 == 0" becomes false and "1 == 1 && i >
+// 1" becomes "i > 1". A removed operand is not evaluated, so the errors it
+// would report are not reported. A WHERE clause which becomes false or NULL
+// selects no rows without reading the table.
+//
+// If the only source of a SELECT statement is a subquery, the WHERE clause is
+// evaluated by the subquery instead, joined to its own WHERE clause by &&, so
+// that it can use an index of the table of the subquery. This is done only if
+// the subquery does not use DISTINCT, GROUP BY, aggregate functions, LIMIT or
+// OFFSET and if the WHERE clause refers only to fields of the subquery which
+// are columns selected under their own names, for example
+//
+//	SELECT * FROM (SELECT * FROM t WHERE a > 0) WHERE b == $1;
+//
+// is executed as
+//
+//	SELECT * FROM (SELECT * FROM t WHERE a > 0 && b == $1);
+//
+// If a SELECT statement from a single table refers, in its field list, WHERE
+// clause and GROUP BY clause, to only one column and the rows are found using
+// an index of that column, the values of the column are taken from the index
+// and the rows of the table are not read, for example
+//
+//	SELECT count() FROM t WHERE a > 10;
+//	SELECT a FROM t WHERE a IN (1, 2, 3);
+//
+// Such a query cannot use id() or SELECT *. An index has a single column, so a
+// query referring to any other column reads the rows. There is no EXPLAIN
+// statement, the rows taken from an index are not counted by MetricRowsRead,
+// see Options.Metrics.
+//
 // Recordset grouping
 //
 // The GROUP BY clause is used to project rows having common values into a
@@ -1580,6 +2337,15 @@
 // in certain cases equal to using the DISTINCT modifier. The last two examples
 // above produce the same resultsets.
 //
+// All rows having NULL in a grouping column, and equal values in the other
+// grouping columns, form a single group.
+//
+// The groups are usually collected in a temporary table before any of them
+// is produced. If the SELECT statement has a single table, no WHERE clause
+// and a single grouping column having an index, the rows are read in the
+// order of the index instead and every group is produced as soon as its last
+// row is read.
+//
 //  GroupByClause = "GROUP BY" ColumnNameList .
 //
 // Skipping records
@@ -1625,6 +2391,12 @@
 //
 // After returning record #8, no more result rows/records are computed.
 //
+// The expressions of the LIMIT and OFFSET clauses can be parameters, which are
+// checked to be of an integer type when the statement is executed. For
+// example
+//
+//	SELECT * FROM t ORDER BY name LIMIT $1 OFFSET $2;
+//
 // Select statement evaluation order
 //
 // 1. The FROM clause is evaluated, producing a Cartesian product of its source
@@ -1671,7 +2443,8 @@
 //
 // Update statements change values of fields in rows of a table.
 //
-//  UpdateStmt = "UPDATE" TableName [ "SET" ] AssignmentList [ WhereClause ] .
+//  UpdateStmt = "UPDATE" TableName [ "SET" ] AssignmentList [ WhereClause ]
+//  	[ Returning ] .
 //
 //  AssignmentList = Assignment { "," Assignment } [ "," ] .
 //  Assignment = ColumnName "=" Expression .
@@ -1687,6 +2460,13 @@
 //
 // Note: The SET clause is optional.
 //
+// The RETURNING clause, see DELETE FROM, makes the statement produce a record
+// set of the updated rows as they are after the update.
+//
+//	BEGIN TRANSACTION;
+//		UPDATE Counters SET Value = Value+1 WHERE Name == "hits" RETURNING Value;
+//	COMMIT;
+//
 // System Tables
 //
 // To allow to query for DB meta data, there exist specially named virtual
@@ -1736,6 +2516,18 @@
 //
 //	SELECT salesperson, avg(sales) FROM salesforce GROUP BY salesperson;
 //
+// Coalesce
+//
+// The built-in function coalesce takes at least one argument and returns the
+// first of its arguments which is not NULL. If all arguments are NULL, this
+// function returns NULL.
+//
+//	func coalesce(x, y ...) typeof(x, y, ...)
+//
+// For example
+//
+//	SELECT coalesce(nickname, name, "anonymous") FROM users;
+//
 // Contains
 //
 // The built-in function contains returns true if substr is within s.
@@ -1794,6 +2586,34 @@
 //
 // If any argument to date is NULL the result is NULL.
 //
+// Date bin
+//
+// The built-in function date_bin returns the start of the interval long bin
+// containing t. It is intended for grouping times into fixed intervals, for
+// example hourly.
+//
+// 	func date_bin(interval duration, t time) time
+// 	func date_bin(interval duration, t time, loc string) time
+//
+// The interval may also be a string accepted by time.ParseDuration, for
+// example "15m" or "1h", and it must be positive. The bins are aligned to
+// midnight of January 1, year 1, so intervals dividing a day start at
+// midnight and 168h bins start on Mondays. Without loc the bins are computed
+// in UTC and the result is in UTC. Given loc, with the same meaning as in
+// date, the bins follow the wall clock of loc and the result is in loc; a
+// bin containing a daylight saving time transition is then shorter or longer
+// than interval.
+//
+// If any argument to date_bin is NULL the result is NULL.
+//
+// The GROUP BY clause accepts only column names, a query grouping by bins
+// selects them in a subquery, for example
+//
+//	SELECT hour, count(), avg(value)
+//	FROM (SELECT date_bin("1h", ts) AS hour, value FROM measurements)
+//	GROUP BY hour
+//	ORDER BY hour;
+//
 // Day
 //
 // The built-in function day returns the day of the month specified by t.
@@ -1802,6 +2622,31 @@
 //
 // If the argument to day is NULL the result is NULL.
 //
+// Format float
+//
+// The built-in function formatFloat returns a textual representation of the
+// floating point value f in decimal notation, without an exponent, the way
+// strconv.FormatFloat of the Go standard library does with the format 'f'.
+// The second form uses exactly prec digits after the decimal point, rounding
+// f as necessary. Otherwise, or if prec is -1, the result has the smallest
+// number of digits necessary to represent f exactly, so it does not depend
+// on the default formatting of floats and can be parsed back by parseFloat.
+//
+// 	func formatFloat(f float) string
+// 	func formatFloat(f float, prec int) string
+//
+// For example
+//
+//	formatFloat(1/3.0, 2)
+//
+// returns
+//
+//	0.33
+//
+// The precision can be of any integer type. A precision less than -1 or
+// greater than 1e6, the limit of package fmt, is an error. If any argument to
+// formatFloat is NULL the result is NULL.
+//
 // Format time
 //
 // The built-in function formatTime returns a textual representation of the
@@ -1815,6 +2660,17 @@
 // value.
 //
 // 	func formatTime(t time, layout string) string
+// 	func formatTime(t time, layout string, loc string) string
+//
+// The second form formats t in the location loc, for discussion of the loc
+// argument please see date(). It is equivalent to formatTime(timeIn(t, loc),
+// layout), for example
+//
+//	formatTime(date(2006, 1, 2, 15, 4, 5, 0, "UTC"), "2006-01-02 15:04 MST", "America/New_York")
+//
+// returns
+//
+//	2006-01-02 10:04 EST
 //
 // If any argument to formatTime is NULL the result is NULL.
 //
@@ -1837,6 +2693,16 @@
 // on a machine in the ACDT zone. The time value is in both cases the same so
 // its ordering and comparing is correct. Only the display value can differ.
 //
+// From base64
+//
+// The built-in function fromBase64 returns the blob encoded by s in the
+// standard base64 encoding with padding, as defined in RFC 4648. It is an
+// error if s is not a valid encoding.
+//
+// 	func fromBase64(s string) blob
+//
+// If the argument to fromBase64 is NULL the result is NULL.
+//
 // HasPrefix
 //
 // The built-in function hasPrefix tests whether the string s begins with prefix.
@@ -1853,6 +2719,15 @@
 //
 // If any argument to hasSuffix is NULL the result is NULL.
 //
+// Hex
+//
+// The built-in function hex returns the hexadecimal representation of b, two
+// upper case digits per byte.
+//
+// 	func hex(b blob) string
+//
+// If the argument to hex is NULL the result is NULL.
+//
 // Hour
 //
 // The built-in function hour returns the hour within the day specified by t,
@@ -1876,7 +2751,9 @@
 // The built-in function id takes zero or one arguments. If no argument is
 // provided, id() returns a table-unique automatically assigned numeric
 // identifier of type int. Ids of deleted records are not reused unless the DB
-// becomes completely empty (has no tables).
+// becomes completely empty (has no tables). A DB opened with the IDPolicy
+// option, see Options, can instead never reuse the ids or reuse the lowest
+// ids not used by the rows of the table.
 //
 // 	func id() int
 //
@@ -1913,12 +2790,21 @@
 // 	WHERE bar.fooID == id(foo)
 // 	ORDER BY id(foo);
 //
+// Ifnull
+//
+// The built-in function ifnull returns x if it is not NULL, otherwise it
+// returns y. It is equivalent to coalesce(x, y).
+//
+//	func ifnull(x, y T) T
+//
 // Length
 //
 // The built-in function len takes a string argument and returns the lentgh of
-// the string in bytes.
+// the string in bytes. Given an array argument, len returns the number of its
+// elements.
 //
 // 	func len(s string) int
+// 	func len(a array) int
 //
 // The expression len(s) is constant if s is a string constant.
 //
@@ -1932,7 +2818,9 @@
 //
 // 	func max(e expression) typeof(e) // The largest value of the expression.
 //
-// The expression values must be of an ordered type.
+// The expression values must be of an ordered type. The values are compared
+// in the same order as used by ORDER BY, so, for example, max of a string
+// column is the value ORDER BY would place last.
 //
 // For example
 //
@@ -2003,6 +2891,30 @@
 //
 // 	func now() time
 //
+// Parse float
+//
+// The built-in function parseFloat parses a string as a floating point number
+// the way strconv.ParseFloat of the Go standard library does and returns the
+// nearest float64 value. An error reporting the string occurs if the string
+// is not well formed or its value is out of the range of float64.
+//
+// 	func parseFloat(s string) float64
+//
+// If the argument to parseFloat is NULL the result is NULL.
+//
+// Parse int
+//
+// The built-in function parseInt parses a string as an integer in the base of
+// 2 to 36, or 10 if the base is omitted, the way strconv.ParseInt of the Go
+// standard library does. If the base is zero, the base is implied by the
+// prefix of the string like in a Go integer literal, for example "0x" selects
+// base 16. An error reporting the string occurs if the string is not well
+// formed or its value is out of the range of int64.
+//
+// 	func parseInt(s string[, base int]) int64
+//
+// If any argument to parseInt is NULL the result is NULL.
+//
 // Parse time
 //
 // The built-in function parseTime parses a formatted string and returns the
@@ -2041,6 +2953,68 @@
 //
 // If any argument to parseTime is NULL the result is NULL.
 //
+// Nullif
+//
+// The built-in function nullif returns NULL if x == y, otherwise it returns x.
+// The types of x and y must be comparable as defined in "Comparison
+// operators". If x is NULL the result is NULL, if only y is NULL the result is
+// x.
+//
+//	func nullif(x, y T) T
+//
+// Percentile
+//
+// The built-in aggregate function percentile_cont returns the p-th
+// percentile, 0 <= p <= 1, of the values of an expression, interpolating
+// linearly between the two values closest to it. For example, p = 0.5 is the
+// median. Percentile_cont ignores NULL values, but returns NULL if all values
+// are NULL or if it is applied to an empty record set.
+//
+// 	func percentile_cont(e numeric, p float) float64
+//
+// The column values must be of a real numeric type. The value of p is taken
+// from the first row having a non NULL value of e. For example
+//
+//	SELECT endpoint, percentile_cont(latency, 0.95) FROM requests GROUP BY endpoint;
+//
+// Percentile_cont keeps all the values of a group in memory. The built-in
+// aggregate function approx_percentile returns an approximate p-th percentile
+// in a single pass instead, keeping a Greenwald-Khanna summary of the values.
+// It returns a value of the expression whose rank differs from the rank of the
+// exact percentile by at most 0.1% of the number of values, using memory
+// proportional to the logarithm of the number of values. For example, the
+// approximate median of a million values is one of the values between the
+// 499000th and 501000th smallest.
+//
+// 	func approx_percentile(e numeric, p float) float64
+//
+// Random numbers
+//
+// The built-in function rand returns a pseudo random number in [0, 1) and the
+// built-in function randInt returns a pseudo random integer in [lo, hi). If
+// any argument to randInt is NULL the result is NULL.
+//
+// 	func rand() float64
+// 	func randInt(lo, hi int64) int64
+//
+// Every DB has its own generator of the numbers, seeded by the time of its
+// first use. The built-in function random_seed seeds the generator of the DB
+// with n, so that the following calls of rand and randInt return the same
+// sequence of numbers every time. Random_seed returns NULL, it does nothing if
+// n is NULL.
+//
+// 	func random_seed(n int64)
+//
+// For example, a reproducible sample of about 1% of the rows of a table
+//
+//	SELECT random_seed(42) FROM one;
+//	SELECT * FROM t WHERE rand() < 0.01;
+//
+// The sequence is reproducible only if the numbers are drawn in the same
+// order. Without ORDER BY, the order of rows of a table is specified only
+// with Options.StableOrder. The statements of other clients of the DB
+// executed meanwhile draw from the same generator.
+//
 // Second
 //
 // The built-in function second returns the second offset within the minute
@@ -2090,6 +3064,25 @@
 //
 // If any argument to timeIn is NULL the result is NULL.
 //
+// To base64
+//
+// The built-in function toBase64 returns the standard base64 encoding with
+// padding of b, as defined in RFC 4648.
+//
+// 	func toBase64(b blob) string
+//
+// If the argument to toBase64 is NULL the result is NULL.
+//
+// Unhex
+//
+// The built-in function unhex returns the blob represented by the hexadecimal
+// digits of s, which may be of either case. It is an error if s contains a
+// character other than a hexadecimal digit or an odd number of them.
+//
+// 	func unhex(s string) blob
+//
+// If the argument to unhex is NULL the result is NULL.
+//
 // Weekday
 //
 // The built-in function weekday returns the day of the week specified by t.
@@ -2198,6 +3191,8 @@
 // 	- WHERE c relOp parameter   // For indexed column c
 // 	- WHERE parameter relOp c   // For indexed column c
 // 	- WHERE constExpr relOp c   // For indexed column c
+// 	- WHERE c BETWEEN lo AND hi // For indexed column c
+// 	- WHERE c IN (list)         // For indexed column c
 //
 // The relOp is one of the relation operators <, <=, ==, >=, >. For the
 // equality operator both operands must be of comparable types. For all other
@@ -2205,6 +3200,14 @@
 // a compile time constant expression. Some constant folding is still a TODO.
 // Parameter is a QL parameter ($1 etc.).
 //
+// The BETWEEN bounds, lo and hi, and the items of the IN list must be constant
+// expressions or parameters. BETWEEN is evaluated by a scan of the index
+// limited to the range of lo and hi. IN is evaluated by looking up every
+// distinct list item in the index, in ascending order, so the resulting rows
+// are ordered by c. The column c may also be id() when id() is
+// indexed. There is no EXPLAIN statement, the use of an index shows as fewer
+// rows counted by MetricRowsRead, see Options.Metrics.
+//
 // Query rewriting
 //
 // Consider tables t and u, both with an indexed field f. The WHERE expression
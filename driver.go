@@ -8,6 +8,7 @@ package ql
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"errors"
@@ -25,6 +26,7 @@ var (
 	_ driver.Conn    = (*driverConn)(nil)
 	_ driver.Driver  = (*sqlDriver)(nil)
 	_ driver.Execer  = (*driverConn)(nil)
+	_ driver.Pinger  = (*driverConn)(nil)
 	_ driver.Queryer = (*driverConn)(nil)
 	_ driver.Result  = (*driverResult)(nil)
 	_ driver.Rows    = (*driverRows)(nil)
@@ -208,7 +210,7 @@ func newDriverConn(d *sqlDriver, ddb *driverDB) driver.Conn {
 
 // Prepare returns a prepared statement, bound to this connection.
 func (c *driverConn) Prepare(query string) (driver.Stmt, error) {
-	list, err := Compile(query)
+	list, err := c.db.db.compile(query)
 	if err != nil {
 		return nil, err
 	}
@@ -240,6 +242,18 @@ func (c *driverConn) Close() error {
 	return err.error()
 }
 
+// Ping verifies the DB of the connection is open and usable, see
+// driver.Pinger. It reads from the storage of the DB while the DB is locked
+// for reading, so it reports a failing DB file, or a lock which cannot be
+// acquired within Options.LockTimeout, as an error.
+func (c *driverConn) Ping(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return c.db.db.ping(c.ctx)
+}
+
 // Begin starts and returns a new transaction.
 func (c *driverConn) Begin() (driver.Tx, error) {
 	if c.ctx == nil {
@@ -293,7 +307,7 @@ func (c *driverConn) Rollback() error {
 //
 // Exec may return driver.ErrSkip.
 func (c *driverConn) Exec(query string, args []driver.Value) (driver.Result, error) {
-	list, err := Compile(query)
+	list, err := c.db.db.compile(query)
 	if err != nil {
 		return nil, err
 	}
@@ -328,7 +342,7 @@ func driverExec(db *driverDB, ctx *TCtx, list List, args []driver.Value) (driver
 //
 // Query may return driver.ErrSkip.
 func (c *driverConn) Query(query string, args []driver.Value) (driver.Rows, error) {
-	list, err := Compile(query)
+	list, err := c.db.db.compile(query)
 	if err != nil {
 		return nil, err
 	}
@@ -444,7 +458,7 @@ func (r *driverRows) Next(dest []driver.Value) error {
 				switch v := xi.(type) {
 				case nil, int64, float64, bool, []byte, time.Time:
 					dest[i] = v
-				case complex64, complex128, *big.Int, *big.Rat:
+				case complex64, complex128, *big.Int, *big.Rat, []interface{}:
 					var buf bytes.Buffer
 					fmt.Fprintf(&buf, "%v", v)
 					dest[i] = buf.Bytes()
@@ -0,0 +1,655 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/cznic/exp/lldb"
+	"github.com/cznic/mathutil"
+)
+
+// Layout of an encrypted file:
+//
+//	header: magic [8]byte, salt [16]byte, MAC [32]byte
+//	sector 0: nonce [16]byte, ciphertext [1..encSector]byte, MAC [32]byte
+//	sector 1: ...
+//
+// Every sector but the last one holds exactly encSector bytes of payload. A
+// sector is encrypted with a fresh random nonce each time it is written. The
+// MAC of a sector covers its index, nonce and ciphertext.
+//
+// Layout of the journal of an encrypted file, see EncryptedOSFile:
+//
+//	magic [8]byte, size int64, n int64
+//	n times: index int64, length int32, sector [length]byte
+//	MAC [32]byte
+//
+// The sectors are stored encrypted as in the file. The size is the plaintext
+// size of the file. The MAC covers everything before it.
+const (
+	encJournal  = "\x00qljrn01"
+	encMagic    = "\x00qlenc01"
+	encSalt     = 16
+	encHdr      = len(encMagic) + encSalt + sha256.Size
+	encSector   = 4096
+	encOverhead = aes.BlockSize + sha256.Size
+)
+
+var _ lldb.OSFile = (*encFile)(nil)
+
+type encFile struct {
+	block   cipher.Block
+	dirty   map[int64][]byte // Encrypted sectors not yet synced, by index.
+	disk    int64            // Plaintext size of the synced file.
+	f       *os.File
+	journal *os.File // Nil for temporary files.
+	macKey  []byte
+	mu      sync.Mutex
+	pos     int64
+	size    int64 // Plaintext size.
+}
+
+// EncryptedOSFile returns a lldb.OSFile which transparently encrypts the
+// content of the file named path using AES in CTR mode. The length of key
+// must be 16, 24 or 32 bytes, selecting AES-128, AES-192 or AES-256. The file
+// is created if it does not exist.
+//
+// The result is intended to be used as Options.OSFile:
+//
+//	f, err := ql.EncryptedOSFile("example.db", key)
+//	if err != nil {
+//		...
+//	}
+//
+//	db, err := ql.OpenFile("", &ql.Options{
+//		OSFile:   f,
+//		TempFile: ql.EncryptedTempFile(key),
+//	})
+//
+// The file content is split into sectors of 4 kB, each encrypted with a
+// random nonce and authenticated with HMAC-SHA256. Opening the file using a
+// wrong key or reading a modified sector fails with an error. Removing whole
+// sectors from the end of the file or replacing the file with an older copy
+// of itself is not detected.
+//
+// The sectors written are kept in memory until Sync, which writes them to the
+// journal file named path+"-journal" before it writes them to the file. The
+// journal is encrypted and authenticated like the file. A Sync interrupted by
+// a crash is completed when the file is opened again, if the journal was
+// fully written, otherwise the file keeps its content before the Sync.
+// OpenFile does not use a write ahead log with an encrypted file, its commits
+// are made atomic by the journal.
+func EncryptedOSFile(path string, key []byte) (lldb.OSFile, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := os.OpenFile(path+"-journal", os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	r, err := newEncFile(f, j, key)
+	if err != nil {
+		f.Close()
+		j.Close()
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// EncryptedTempFile returns a function suitable for Options.TempFile, which
+// creates temporary files encrypted using key. See EncryptedOSFile for
+// details. Temporary data smaller than Options.TempSpillThreshold are kept in
+// memory and do not use the temporary files.
+func EncryptedTempFile(key []byte) func(dir, prefix string) (lldb.OSFile, error) {
+	return func(dir, prefix string) (lldb.OSFile, error) {
+		f, err := ioutil.TempFile(dir, prefix)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := newEncFile(f, nil, key)
+		if err != nil {
+			nm := f.Name()
+			f.Close()
+			os.Remove(nm)
+			return nil, err
+		}
+
+		return r, nil
+	}
+}
+
+// newEncFile returns the encrypted file f. A nil journal makes the writes go
+// directly to f.
+func newEncFile(f, journal *os.File, key []byte) (*encFile, error) {
+	switch len(key) {
+	case 16, 24, 32:
+		// ok
+	default:
+		return nil, fmt.Errorf("invalid encryption key size %d", len(key))
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	hdr := make([]byte, encHdr)
+	psz := fi.Size()
+	switch {
+	case psz == 0:
+		copy(hdr, encMagic)
+		if _, err = io.ReadFull(rand.Reader, hdr[len(encMagic):len(encMagic)+encSalt]); err != nil {
+			return nil, err
+		}
+	case psz < int64(encHdr):
+		return nil, fmt.Errorf("%s: not an encrypted DB file", f.Name())
+	default:
+		if _, err = f.ReadAt(hdr, 0); err != nil {
+			return nil, err
+		}
+
+		if string(hdr[:len(encMagic)]) != encMagic {
+			return nil, fmt.Errorf("%s: not an encrypted DB file", f.Name())
+		}
+	}
+
+	salt := hdr[len(encMagic) : len(encMagic)+encSalt]
+	block, err := aes.NewCipher(deriveKey(key, "enc", salt)[:len(key)])
+	if err != nil {
+		return nil, err
+	}
+
+	r := &encFile{block: block, f: f, journal: journal, macKey: deriveKey(key, "mac", salt)}
+	mac := hmac.New(sha256.New, r.macKey)
+	mac.Write(hdr[:len(encMagic)+encSalt])
+	sum := mac.Sum(nil)
+	switch {
+	case psz == 0:
+		copy(hdr[len(encMagic)+encSalt:], sum)
+		if _, err = f.WriteAt(hdr, 0); err != nil {
+			return nil, err
+		}
+
+		if err = f.Sync(); err != nil {
+			return nil, err
+		}
+	case !hmac.Equal(sum, hdr[len(encMagic)+encSalt:]):
+		return nil, fmt.Errorf("%s: wrong encryption key or corrupted file", f.Name())
+	}
+
+	if err = r.recover(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// recover brings f to its state after the last Sync. A journal left by an
+// interrupted Sync is applied to the file if it is complete, otherwise it is
+// discarded. The sectors not yet synced are discarded.
+func (f *encFile) recover() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.dirty = nil
+	if f.journal != nil {
+		fi, err := f.journal.Stat()
+		if err != nil {
+			return err
+		}
+
+		if n := fi.Size(); n != 0 {
+			b := make([]byte, n)
+			if _, err = f.journal.ReadAt(b, 0); err != nil {
+				return err
+			}
+
+			if size, sectors, ok := f.parseJournal(b); ok {
+				if err = f.apply(size, sectors); err != nil {
+					return err
+				}
+			} else if err = f.emptyJournal(); err != nil {
+				return err
+			}
+		}
+	}
+
+	fi, err := f.f.Stat()
+	if err != nil {
+		return err
+	}
+
+	q := fi.Size() - int64(encHdr)
+	f.size = q / (encSector + encOverhead) * encSector
+	if n := q % (encSector + encOverhead); n != 0 {
+		if n <= encOverhead {
+			return fmt.Errorf("%s: corrupted encrypted file", f.f.Name())
+		}
+
+		f.size += n - encOverhead
+	}
+	f.disk = f.size
+	return nil
+}
+
+// parseJournal returns the plaintext size and the sectors of the journal b
+// and whether b is complete.
+func (f *encFile) parseJournal(b []byte) (size int64, sectors map[int64][]byte, ok bool) {
+	if len(b) < len(encJournal)+16+sha256.Size || string(b[:len(encJournal)]) != encJournal {
+		return
+	}
+
+	mac := hmac.New(sha256.New, f.macKey)
+	mac.Write(b[:len(b)-sha256.Size])
+	if !hmac.Equal(mac.Sum(nil), b[len(b)-sha256.Size:]) {
+		return
+	}
+
+	b = b[len(encJournal) : len(b)-sha256.Size]
+	size, n := int64(binary.BigEndian.Uint64(b)), int64(binary.BigEndian.Uint64(b[8:]))
+	b = b[16:]
+	sectors = map[int64][]byte{}
+	for ; n != 0; n-- {
+		if len(b) < 12 {
+			return 0, nil, false
+		}
+
+		i, ln := int64(binary.BigEndian.Uint64(b)), int(binary.BigEndian.Uint32(b[8:]))
+		if b = b[12:]; i < 0 || ln <= encOverhead || ln > encSector+encOverhead || len(b) < ln {
+			return 0, nil, false
+		}
+
+		sectors[i], b = b[:ln], b[ln:]
+	}
+	return size, sectors, size >= 0 && len(b) == 0
+}
+
+// apply writes the sectors to the file, truncates it to hold size bytes of
+// plaintext and empties the journal.
+func (f *encFile) apply(size int64, sectors map[int64][]byte) error {
+	for _, i := range sectorIndexes(sectors) {
+		if _, err := f.f.WriteAt(sectors[i], int64(encHdr)+i*(encSector+encOverhead)); err != nil {
+			return err
+		}
+	}
+
+	if err := f.f.Truncate(physSize(size)); err != nil {
+		return err
+	}
+
+	if err := f.f.Sync(); err != nil {
+		return err
+	}
+
+	return f.emptyJournal()
+}
+
+func (f *encFile) emptyJournal() error {
+	if err := f.journal.Truncate(0); err != nil {
+		return err
+	}
+
+	return f.journal.Sync()
+}
+
+// sync writes the sectors not yet synced to the journal and then to the
+// file.
+func (f *encFile) sync() error {
+	if f.journal == nil {
+		return f.f.Sync()
+	}
+
+	if len(f.dirty) == 0 && f.size == f.disk {
+		return nil
+	}
+
+	if err := f.writeJournal(); err != nil {
+		return err
+	}
+
+	if err := f.apply(f.size, f.dirty); err != nil {
+		return err
+	}
+
+	f.dirty, f.disk = nil, f.size
+	return nil
+}
+
+// writeJournal writes and syncs the journal of the sectors not yet synced.
+func (f *encFile) writeJournal() error {
+	b := []byte(encJournal)
+	var b12 [12]byte
+	binary.BigEndian.PutUint64(b12[:], uint64(f.size))
+	b = append(b, b12[:8]...)
+	binary.BigEndian.PutUint64(b12[:], uint64(len(f.dirty)))
+	b = append(b, b12[:8]...)
+	for _, i := range sectorIndexes(f.dirty) {
+		binary.BigEndian.PutUint64(b12[:], uint64(i))
+		binary.BigEndian.PutUint32(b12[8:], uint32(len(f.dirty[i])))
+		b = append(append(b, b12[:]...), f.dirty[i]...)
+	}
+	mac := hmac.New(sha256.New, f.macKey)
+	mac.Write(b)
+	b = mac.Sum(b)
+	if err := f.journal.Truncate(0); err != nil {
+		return err
+	}
+
+	if _, err := f.journal.WriteAt(b, 0); err != nil {
+		return err
+	}
+
+	return f.journal.Sync()
+}
+
+func sectorIndexes(m map[int64][]byte) []int64 {
+	r := make([]int64, 0, len(m))
+	for i := range m {
+		r = append(r, i)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
+
+func deriveKey(key []byte, purpose string, salt []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(purpose))
+	mac.Write(salt)
+	return mac.Sum(nil)
+}
+
+// physSize returns the size of the file holding n bytes of plaintext.
+func physSize(n int64) int64 {
+	r := int64(encHdr) + n/encSector*(encSector+encOverhead)
+	if m := n % encSector; m != 0 {
+		r += m + encOverhead
+	}
+	return r
+}
+
+func (f *encFile) sectorMAC(i int64, nonce, ct []byte) []byte {
+	mac := hmac.New(sha256.New, f.macKey)
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(i))
+	mac.Write(b[:])
+	mac.Write(nonce)
+	mac.Write(ct)
+	return mac.Sum(nil)
+}
+
+// readSector returns the plaintext of sector i.
+func (f *encFile) readSector(i int64) ([]byte, error) {
+	n := f.size - i*encSector
+	if n <= 0 {
+		return nil, nil
+	}
+
+	if n > encSector {
+		n = encSector
+	}
+
+	buf, ok := f.dirty[i]
+	if !ok {
+		buf = make([]byte, n+encOverhead)
+		if _, err := f.f.ReadAt(buf, int64(encHdr)+i*(encSector+encOverhead)); err != nil {
+			return nil, err
+		}
+	}
+
+	nonce, ct, sum := buf[:aes.BlockSize], buf[aes.BlockSize:aes.BlockSize+n], buf[aes.BlockSize+n:]
+	if !hmac.Equal(sum, f.sectorMAC(i, nonce, ct)) {
+		return nil, fmt.Errorf("%s: corrupted encrypted file, sector %d", f.f.Name(), i)
+	}
+
+	pt := make([]byte, n)
+	cipher.NewCTR(f.block, nonce).XORKeyStream(pt, ct)
+	return pt, nil
+}
+
+// writeSector encrypts pt and writes it as sector i. With a journal, the
+// sector is kept in memory until Sync.
+func (f *encFile) writeSector(i int64, pt []byte) error {
+	buf := make([]byte, len(pt)+encOverhead)
+	nonce, ct := buf[:aes.BlockSize], buf[aes.BlockSize:aes.BlockSize+len(pt)]
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+
+	cipher.NewCTR(f.block, nonce).XORKeyStream(ct, pt)
+	copy(buf[aes.BlockSize+len(pt):], f.sectorMAC(i, nonce, ct))
+	if f.journal != nil {
+		if f.dirty == nil {
+			f.dirty = map[int64][]byte{}
+		}
+		f.dirty[i] = buf
+		return nil
+	}
+
+	_, err := f.f.WriteAt(buf, int64(encHdr)+i*(encSector+encOverhead))
+	return err
+}
+
+// write writes b at off. Any gap between the current size and off is zero
+// filled.
+func (f *encFile) write(b []byte, off int64) error {
+	end := off + int64(len(b))
+	if end <= f.size && len(b) == 0 {
+		return nil
+	}
+
+	lo, newSize := mathutil.MinInt64(off, f.size), mathutil.MaxInt64(f.size, end)
+	for i := lo / encSector; i*encSector < end; i++ {
+		start := i * encSector
+		n := mathutil.MinInt64(newSize-start, encSector)
+		old, err := f.readSector(i)
+		if err != nil {
+			return err
+		}
+
+		pt := make([]byte, n)
+		copy(pt, old)
+		if a, z := mathutil.MaxInt64(off, start), mathutil.MinInt64(end, start+n); a < z {
+			copy(pt[a-start:z-start], b[a-off:z-off])
+		}
+		if err = f.writeSector(i, pt); err != nil {
+			return err
+		}
+	}
+	f.size = newSize
+	return nil
+}
+
+// Close implements lldb.OSFile. The sectors not yet synced are synced first.
+func (f *encFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	err := f.sync()
+	if f.journal != nil {
+		if e := f.journal.Close(); err == nil {
+			err = e
+		}
+	}
+	if e := f.f.Close(); err == nil {
+		err = e
+	}
+	return err
+}
+
+// Name implements lldb.OSFile.
+func (f *encFile) Name() string { return f.f.Name() }
+
+// Sync implements lldb.OSFile.
+func (f *encFile) Sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.sync()
+}
+
+// Stat implements lldb.OSFile. The size reported is the size of the
+// plaintext.
+func (f *encFile) Stat() (os.FileInfo, error) {
+	fi, err := f.f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return encFileInfo{fi, f.size}, nil
+}
+
+type encFileInfo struct {
+	os.FileInfo
+	size int64
+}
+
+func (fi encFileInfo) Size() int64 { return fi.size }
+
+// Truncate implements lldb.OSFile.
+func (f *encFile) Truncate(size int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if size < 0 {
+		return fmt.Errorf("%s: invalid truncate size %d", f.f.Name(), size)
+	}
+
+	if size >= f.size {
+		return f.write(nil, size)
+	}
+
+	if n := size % encSector; n != 0 {
+		pt, err := f.readSector(size / encSector)
+		if err != nil {
+			return err
+		}
+
+		if err = f.writeSector(size/encSector, pt[:n]); err != nil {
+			return err
+		}
+	}
+
+	if f.journal == nil {
+		if err := f.f.Truncate(physSize(size)); err != nil {
+			return err
+		}
+	}
+
+	for i := range f.dirty {
+		if i*encSector >= size {
+			delete(f.dirty, i)
+		}
+	}
+	f.size = size
+	return nil
+}
+
+// ReadAt implements lldb.OSFile.
+func (f *encFile) ReadAt(b []byte, off int64) (n int, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.readAt(b, off)
+}
+
+func (f *encFile) readAt(b []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, fmt.Errorf("%s: negative offset %d", f.f.Name(), off)
+	}
+
+	for len(b) != 0 {
+		if off >= f.size {
+			return n, io.EOF
+		}
+
+		pt, err := f.readSector(off / encSector)
+		if err != nil {
+			return n, err
+		}
+
+		m := copy(b, pt[off%encSector:])
+		n += m
+		b = b[m:]
+		off += int64(m)
+	}
+	return n, nil
+}
+
+// WriteAt implements lldb.OSFile.
+func (f *encFile) WriteAt(b []byte, off int64) (n int, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if off < 0 {
+		return 0, fmt.Errorf("%s: negative offset %d", f.f.Name(), off)
+	}
+
+	if err = f.write(b, off); err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+// Read implements lldb.OSFile.
+func (f *encFile) Read(b []byte) (n int, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n, err = f.readAt(b, f.pos)
+	f.pos += int64(n)
+	if n != 0 && err == io.EOF {
+		err = nil
+	}
+	return n, err
+}
+
+// Write implements lldb.OSFile.
+func (f *encFile) Write(b []byte) (n int, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err = f.write(b, f.pos); err != nil {
+		return 0, err
+	}
+
+	f.pos += int64(len(b))
+	return len(b), nil
+}
+
+// Seek implements lldb.OSFile.
+func (f *encFile) Seek(offset int64, whence int) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch whence {
+	case 0:
+		// nop
+	case 1:
+		offset += f.pos
+	case 2:
+		offset += f.size
+	default:
+		return f.pos, fmt.Errorf("%s: invalid whence %d", f.f.Name(), whence)
+	}
+
+	if offset < 0 {
+		return f.pos, fmt.Errorf("%s: negative position %d", f.f.Name(), offset)
+	}
+
+	f.pos = offset
+	return offset, nil
+}
@@ -0,0 +1,263 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEncryptedOSFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	key := []byte("0123456789abcdef")
+	nm := filepath.Join(dir, "enc")
+	f, err := EncryptedOSFile(nm, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rng := rand.New(rand.NewSource(42))
+	var e []byte
+	for i := 0; i < 500; i++ {
+		switch rng.Intn(4) {
+		case 0:
+			sz := rng.Int63n(5 * encSector)
+			if err = f.Truncate(sz); err != nil {
+				t.Fatal(i, err)
+			}
+
+			if n := int(sz); n < len(e) {
+				e = e[:n]
+			} else {
+				e = append(e, make([]byte, n-len(e))...)
+			}
+		default:
+			off := rng.Intn(4 * encSector)
+			b := make([]byte, rng.Intn(2*encSector))
+			rng.Read(b)
+			if _, err = f.WriteAt(b, int64(off)); err != nil {
+				t.Fatal(i, err)
+			}
+
+			if n := off + len(b); n > len(e) {
+				e = append(e, make([]byte, n-len(e))...)
+			}
+			copy(e[off:], b)
+		}
+	}
+
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if f, err = EncryptedOSFile(nm, key); err != nil {
+		t.Fatal(err)
+	}
+
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fi.Size(), int64(len(e)); g != e {
+		t.Fatalf("size %d, expected %d", g, e)
+	}
+
+	g := make([]byte, len(e)+1)
+	n, err := f.ReadAt(g, 0)
+	if n != len(e) || err != io.EOF {
+		t.Fatalf("ReadAt: %d, %v", n, err)
+	}
+
+	if !bytes.Equal(g[:n], e) {
+		t.Fatal("content mismatch")
+	}
+
+	if _, err = EncryptedOSFile(nm, []byte("fedcba9876543210")); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestEncryptedDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	key := []byte("0123456789abcdef0123456789abcdef")
+	nm := filepath.Join(dir, "ql.db")
+	open := func() *DB {
+		f, err := EncryptedOSFile(nm, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The temporary data are small, a negative TempSpillThreshold
+		// makes them go to the encrypted temp files.
+		db, err := OpenFile("", &Options{OSFile: f, TempFile: EncryptedTempFile(key), TempSpillThreshold: -1})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return db
+	}
+
+	db := open()
+	if _, _, err = db.Run(NewRWCtx(), `
+	BEGIN TRANSACTION;
+		CREATE TABLE t (s string);
+		INSERT INTO t VALUES ("secret one"), ("secret two");
+	COMMIT;`,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = os.Stat(walName(nm)); !os.IsNotExist(err) {
+		t.Fatalf("expected no WAL, got %v", err)
+	}
+
+	if err = db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ioutil.ReadFile(nm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Contains(b, []byte("secret")) {
+		t.Fatal("plaintext found in encrypted DB file")
+	}
+
+	db = open()
+	defer db.Close()
+
+	rs, _, err := db.Run(nil, "SELECT s FROM t ORDER BY s DESC;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := rs[0].Rows(-1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, e := fmt.Sprint(rows), "[[secret two] [secret one]]"; g != e {
+		t.Fatalf("got %s, expected %s", g, e)
+	}
+}
+
+func TestEncryptedJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ql-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	key := []byte("0123456789abcdef")
+	nm := filepath.Join(dir, "enc")
+	open := func() *encFile {
+		f, err := EncryptedOSFile(nm, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return f.(*encFile)
+	}
+
+	// crash leaves the journal written and the file not.
+	crash := func(f *encFile, torn bool) {
+		if err := f.writeJournal(); err != nil {
+			t.Fatal(err)
+		}
+
+		if torn {
+			fi, err := f.journal.Stat()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err = f.journal.Truncate(fi.Size() - 1); err != nil {
+				t.Fatal(err)
+			}
+		}
+		f.journal.Close()
+		f.f.Close()
+	}
+
+	check := func(f *encFile, e string) {
+		fi, err := f.Stat()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b := make([]byte, fi.Size())
+		if _, err = f.ReadAt(b, 0); err != nil && err != io.EOF {
+			t.Fatal(err)
+		}
+
+		if g := string(b); g != e {
+			t.Fatalf("got %q, expected %q", g, e)
+		}
+	}
+
+	f := open()
+	if _, err = f.WriteAt([]byte("old content"), 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = f.Sync(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = f.WriteAt([]byte("new"), 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = f.Truncate(9); err != nil {
+		t.Fatal(err)
+	}
+
+	check(f, "new conte")
+	crash(f, false)
+	f = open()
+	check(f, "new conte")
+	if _, err = f.WriteAt(bytes.Repeat([]byte("x"), 2*encSector), 0); err != nil {
+		t.Fatal(err)
+	}
+
+	crash(f, true)
+	f = open()
+	check(f, "new conte")
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fi, err := os.Stat(nm + "-journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := fi.Size(); n != 0 {
+		t.Fatalf("journal size %d, expected 0", n)
+	}
+}
@@ -6,13 +6,106 @@ package ql
 
 import (
 	"errors"
+	"fmt"
+	"time"
 )
 
 var (
 	errBeginTransNoCtx          = errors.New("BEGIN TRANSACTION: Must use R/W context, have nil")
 	errCommitNotInTransaction   = errors.New("COMMIT: Not in transaction")
+	errDBClosed                 = errors.New("DB is closed")
 	errDivByZero                = errors.New("division by zero")
 	errIncompatibleDBFormat     = errors.New("incompatible DB format")
 	errNoDataForHandle          = errors.New("read: no data for handle")
 	errRollbackNotInTransaction = errors.New("ROLLBACK: Not in transaction")
+	errTxDone                   = errors.New("transaction has already been committed or rolled back")
 )
+
+// Errors returned by OpenFile. They are wrapped with the details of the
+// failure, test for them using errors.Is.
+var (
+	// ErrCorruptID reports an invalid id() counter in a DB file.
+	ErrCorruptID = errors.New("corrupted DB: id")
+
+	// ErrLocked reports a DB file whose lock file cannot be acquired,
+	// typically because the DB is open in another process.
+	ErrLocked = errors.New("cannot lock DB file")
+
+	// ErrStaleWAL reports a non empty write ahead log of a DB file. It is
+	// left behind by a process which crashed during a commit, possibly one
+	// of another version using a different WAL format. The contents of the
+	// WAL are never replayed when a DB file is opened, so a WAL of another
+	// version cannot be misinterpreted. A WAL holding only the headroom of
+	// AllocatorOptions.MinWAL is not stale.
+	ErrStaleWAL = errors.New("non empty WAL file")
+
+	// ErrUnknownFormat reports a file which is not a DB file, or a DB
+	// file written in a record format newer than this package supports.
+	ErrUnknownFormat = errors.New("unknown file format")
+)
+
+// ErrOpenTransaction is returned by DB.Close while a transaction is open,
+// unless the DB was opened with Options.RollbackOnClose.
+var ErrOpenTransaction = errors.New("cannot close DB while open transaction exist: commit or roll back the transaction first")
+
+// TimeoutError is returned by DB.ExecuteTimeout, and by iterating the
+// Recordsets it returned, when the execution exceeds the timeout.
+type TimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("statement execution timeout %v exceeded", e.Timeout)
+}
+
+// TempLimitError is returned when a statement would exceed
+// Options.MaxTempFiles or Options.MaxTempBytes.
+type TempLimitError struct {
+	Files bool  // The limit is Options.MaxTempFiles, not Options.MaxTempBytes.
+	Limit int64 // The value of the limit.
+}
+
+func (e *TempLimitError) Error() string {
+	if e.Files {
+		return fmt.Sprintf("temp file limit of %d files exceeded", e.Limit)
+	}
+
+	return fmt.Sprintf("temp file limit of %d bytes exceeded", e.Limit)
+}
+
+// LockTimeoutError is returned when the DB was opened with a positive
+// Options.LockTimeout and a statement, a Recordset or a transaction cannot
+// acquire the lock guarding the DB against concurrent updates within that
+// time. Locks describes the holders and waiters of the lock at the moment of
+// the timeout.
+type LockTimeoutError struct {
+	Timeout time.Duration
+	Write   bool // The write lock was requested.
+	Locks   LockInfo
+}
+
+func (e *LockTimeoutError) Error() string {
+	kind := "read"
+	if e.Write {
+		kind = "write"
+	}
+	return fmt.Sprintf("%s lock acquisition timeout %v exceeded (writer: %v, readers: %d, waiting writers: %d, waiting readers: %d)", kind, e.Timeout, e.Locks.Writer, e.Locks.Readers, e.Locks.WaitingWriters, e.Locks.WaitingReaders)
+}
+
+// ConversionError is returned when a value read back from a temporary result
+// set cannot be converted to the type of its column.
+type ConversionError struct {
+	Column string      // Column name, empty if the column is unnamed.
+	Index  int         // Column index.
+	Value  interface{} // The value which cannot be converted.
+	Type   Type        // The column type.
+	Err    error       // The conversion error.
+}
+
+func (e *ConversionError) Error() string {
+	nm := e.Column
+	if nm == "" {
+		nm = fmt.Sprintf("#%d", e.Index)
+	}
+	return fmt.Sprintf("column %s: %v", nm, e.Err)
+}
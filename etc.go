@@ -32,6 +32,7 @@ const (
 	qUint32     = 0x77 // 'w'
 	qUint64     = 0x78 // 'x', alias uint
 
+	qArray    = 0x41 // 'A'
 	qBigInt   = 0x49 // 'I'
 	qBigRat   = 0x52 // 'R'
 	qBlob     = 0x42 // 'B'
@@ -41,6 +42,7 @@ const (
 
 var (
 	type2Str = map[int]string{
+		qArray:      "array",
 		qBigInt:     "bigint",
 		qBigRat:     "bigrat",
 		qBlob:       "blob",
@@ -67,6 +69,80 @@ func typeStr(typ int) (r string) {
 	return type2Str[typ]
 }
 
+// elemType returns the type of v if it can be an element of an array, ie. if
+// v is a non NULL value of a QL type other than array. Otherwise elemType
+// returns zero.
+func elemType(v interface{}) int {
+	switch v.(type) {
+	case bool:
+		return qBool
+	case complex64:
+		return qComplex64
+	case complex128:
+		return qComplex128
+	case float32:
+		return qFloat32
+	case float64:
+		return qFloat64
+	case int8:
+		return qInt8
+	case int16:
+		return qInt16
+	case int32:
+		return qInt32
+	case int64:
+		return qInt64
+	case string:
+		return qString
+	case uint8:
+		return qUint8
+	case uint16:
+		return qUint16
+	case uint32:
+		return qUint32
+	case uint64:
+		return qUint64
+	case []byte:
+		return qBlob
+	case *big.Int:
+		return qBigInt
+	case *big.Rat:
+		return qBigRat
+	case time.Time:
+		return qTime
+	case time.Duration:
+		return qDuration
+	}
+	return 0
+}
+
+// isArray reports whether all elements of a are valid array elements of the
+// same type.
+func isArray(a []interface{}) bool {
+	for _, v := range a {
+		if t := elemType(v); t == 0 || t != elemType(a[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// collateArray collates arrays lexicographically. Arrays of different element
+// types are ordered by the element type.
+func collateArray(x, y []interface{}) int {
+	if len(x) != 0 && len(y) != 0 {
+		if tx, ty := elemType(x[0]), elemType(y[0]); tx != ty {
+			if tx < ty {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	return collate(x, y)
+}
+
 func noEOF(err error) error {
 	if err == io.EOF {
 		err = nil
@@ -516,6 +592,32 @@ func truncConv(val interface{}) (interface{}, error) {
 	return nil, fmt.Errorf("constant %v truncated to integer", val)
 }
 
+// checkLossless returns an error if v, the result of converting the numeric
+// value val to the integer type typ, has a different value than val.
+func checkLossless(val, v interface{}, typ int) error {
+	if d, ok := val.(time.Duration); ok {
+		val = int64(d)
+	}
+	x, err := convert(val, qBigRat)
+	if err != nil {
+		return nil
+	}
+
+	y, err := convert(v, qBigRat)
+	if err != nil {
+		return err
+	}
+
+	switch r := x.(*big.Rat); {
+	case r != nil && r.Cmp(y.(*big.Rat)) == 0:
+		return nil
+	case r != nil && !r.IsInt():
+		return fmt.Errorf("conversion of %v (type %T) to %s discards the fraction", val, val, typeStr(typ))
+	default:
+		return fmt.Errorf("conversion of %v (type %T) to %s overflows", val, val, typeStr(typ))
+	}
+}
+
 func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 	if val == nil {
 		return nil, nil
@@ -1136,6 +1238,13 @@ func convert(val interface{}, typ int) (v interface{}, err error) { //NTYPE
 		default:
 			return invConv(val, typ)
 		}
+	case qArray:
+		switch x := val.(type) {
+		case []interface{}:
+			return x, nil
+		default:
+			return invConv(val, typ)
+		}
 	case qBigInt:
 		switch x := val.(type) {
 		// case blob
@@ -1353,7 +1462,7 @@ func typeCheck(rec []interface{}, cols []*col) (err error) {
 			case idealComplex:
 				y := complex128(v.(idealComplex))
 				switch c.typ {
-				case qBool:
+				case qArray, qBool:
 				case qComplex64:
 					rec[i] = complex64(y)
 					continue
@@ -1369,7 +1478,7 @@ func typeCheck(rec []interface{}, cols []*col) (err error) {
 			case idealFloat:
 				y := float64(v.(idealFloat))
 				switch c.typ {
-				case qBool:
+				case qArray, qBool:
 				case qComplex64:
 					rec[i] = complex(float32(y), 0)
 					continue
@@ -1491,7 +1600,7 @@ func typeCheck(rec []interface{}, cols []*col) (err error) {
 			case idealInt:
 				y := int64(v.(idealInt))
 				switch c.typ {
-				case qBool:
+				case qArray, qBool:
 				case qComplex64:
 					rec[i] = complex(float32(y), 0)
 					continue
@@ -1573,7 +1682,7 @@ func typeCheck(rec []interface{}, cols []*col) (err error) {
 			case idealRune:
 				y := int64(v.(idealRune))
 				switch c.typ {
-				case qBool:
+				case qArray, qBool:
 				case qComplex64:
 					rec[i] = complex(float32(y), 0)
 					continue
@@ -1655,7 +1764,7 @@ func typeCheck(rec []interface{}, cols []*col) (err error) {
 			case idealUint:
 				y := uint64(v.(idealUint))
 				switch c.typ {
-				case qBool:
+				case qArray, qBool:
 				case qComplex64:
 					rec[i] = complex(float32(y), 0)
 					continue
@@ -1742,6 +1851,40 @@ func typeCheck(rec []interface{}, cols []*col) (err error) {
 }
 
 //TODO collate1 should return errors instead of panicing
+// collateFloat collates the floating point values x and y. NaN collates after
+// all other values, including +Inf, and NaNs collate equal, unlike in the
+// comparison operators, see "Comparison operators".
+func collateFloat(x, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	case x == y:
+		return 0
+	}
+
+	switch xn, yn := math.IsNaN(x), math.IsNaN(y); {
+	case xn && yn:
+		return 0
+	case xn:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// isNaN reports whether v is a floating point NaN.
+func isNaN(v interface{}) bool {
+	switch x := v.(type) {
+	case float32:
+		return math.IsNaN(float64(x))
+	case float64:
+		return math.IsNaN(x)
+	}
+	return false
+}
+
 func collate1(a, b interface{}) int {
 	switch x := a.(type) {
 	case nil:
@@ -2087,41 +2230,11 @@ func collate1(a, b interface{}) int {
 		case nil:
 			return 1
 		case idealFloat:
-			if x < y {
-				return -1
-			}
-
-			if x == y {
-				return 0
-			}
-
-			return 1
+			return collateFloat(float64(x), float64(y))
 		case float32:
-			{
-				x, y := float64(x), float64(y)
-				if x < y {
-					return -1
-				}
-
-				if x == y {
-					return 0
-				}
-
-				return 1
-			}
+			return collateFloat(float64(x), float64(y))
 		case float64:
-			{
-				x, y := float64(x), float64(y)
-				if x < y {
-					return -1
-				}
-
-				if x == y {
-					return 0
-				}
-
-				return 1
-			}
+			return collateFloat(float64(x), y)
 		default:
 			panic("internal error 016")
 		}
@@ -2222,28 +2335,9 @@ func collate1(a, b interface{}) int {
 		case nil:
 			return 1
 		case float32:
-			if x < y {
-				return -1
-			}
-
-			if x == y {
-				return 0
-			}
-
-			return 1
+			return collateFloat(float64(x), float64(y))
 		case idealFloat:
-			{
-				x, y := float32(x), float32(y)
-				if x < y {
-					return -1
-				}
-
-				if x == y {
-					return 0
-				}
-
-				return 1
-			}
+			return collateFloat(float64(x), float64(float32(y)))
 		default:
 			panic("internal error 019")
 		}
@@ -2252,28 +2346,9 @@ func collate1(a, b interface{}) int {
 		case nil:
 			return 1
 		case float64:
-			if x < y {
-				return -1
-			}
-
-			if x == y {
-				return 0
-			}
-
-			return 1
+			return collateFloat(x, y)
 		case idealFloat:
-			{
-				x, y := float64(x), float64(y)
-				if x < y {
-					return -1
-				}
-
-				if x == y {
-					return 0
-				}
-
-				return 1
-			}
+			return collateFloat(x, float64(y))
 		default:
 			panic("internal error 020")
 		}
@@ -2596,6 +2671,15 @@ func collate1(a, b interface{}) int {
 		default:
 			panic("internal error 030")
 		}
+	case []interface{}:
+		switch y := b.(type) {
+		case nil:
+			return 1
+		case []interface{}:
+			return collateArray(x, y)
+		default:
+			panic("internal error 073")
+		}
 	case *big.Int:
 		switch y := b.(type) {
 		case nil:
@@ -2746,7 +2830,7 @@ func isOrderedType(v interface{}) (y interface{}, r bool, err error) {
 		uint8, uint16, uint32, uint64,
 		string:
 		return v, true, nil
-	case *big.Int, *big.Rat, time.Time, time.Duration:
+	case *big.Int, *big.Rat, time.Time, time.Duration, []interface{}:
 		return x, true, nil
 	case chunk:
 		if y, err = x.expand(); err != nil {
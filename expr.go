@@ -8,23 +8,32 @@ import (
 	"fmt"
 	"log"
 	"math/big"
+	"reflect"
 	"regexp"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 var (
+	_ expression = (*arrayExpr)(nil)
 	_ expression = (*binaryOperation)(nil)
 	_ expression = (*call)(nil)
+	_ expression = (*cast)(nil)
+	_ expression = (*collateExpr)(nil)
 	_ expression = (*conversion)(nil)
 	_ expression = (*ident)(nil)
 	_ expression = (*indexOp)(nil)
+	_ expression = (*isDistinct)(nil)
 	_ expression = (*isNull)(nil)
 	_ expression = (*pIn)(nil)
 	_ expression = (*pLike)(nil)
+	_ expression = (*pMatch)(nil)
+	_ expression = (*pExists)(nil)
 	_ expression = (*parameter)(nil)
 	_ expression = (*pexpr)(nil)
 	_ expression = (*slice)(nil)
+	_ expression = (*subquery)(nil)
 	_ expression = (*unaryOperation)(nil)
 	_ expression = value{}
 )
@@ -108,6 +117,87 @@ func isPossiblyRewriteableCrossJoinWhereExpression(expr expression) (bool, []exp
 	}
 }
 
+// simplifyWhere returns e, the expression of a WHERE clause, without the
+// operands of && and || which are constant bools, or made constant by
+// simplifyWhere, as they do not change the truth of the result:
+//
+//	false && x, x && false	false
+//	true || x, x || true	true
+//	true && x, x && true	x
+//	false || x, x || false	x
+//
+// A WHERE clause selects only the rows for which it is true, so a WHERE
+// clause simplified to false or NULL selects no rows without evaluating it
+// for any row, see selectsNone. The removed operands are never evaluated, so
+// the errors they would report are not reported either.
+func simplifyWhere(e expression) expression {
+	if x, ok := simplify(e); ok {
+		return x
+	}
+
+	return e
+}
+
+// simplify returns the simplified e, see simplifyWhere, and whether it
+// differs from e.
+func simplify(e expression) (expression, bool) {
+	switch x := e.(type) {
+	case *pexpr:
+		y, ok := simplify(x.expr)
+		switch {
+		case !ok:
+			return e, false
+		case isValue(y):
+			return y, true
+		default:
+			return &pexpr{y}, true
+		}
+	case *binaryOperation:
+		if x.op != andand && x.op != oror {
+			return e, false
+		}
+
+		l, lch := simplify(x.l)
+		r, rch := simplify(x.r)
+		lv, lok := constBool(l)
+		rv, rok := constBool(r)
+		switch {
+		case x.op == andand && (lok && !lv || rok && !rv):
+			return value{false}, true
+		case x.op == oror && (lok && lv || rok && rv):
+			return value{true}, true
+		case lok:
+			return r, true
+		case rok:
+			return l, true
+		case lch || rch:
+			return &binaryOperation{x.op, l, r}, true
+		}
+	}
+	return e, false
+}
+
+// constBool returns the value of e if e is a constant bool.
+func constBool(e expression) (v, ok bool) {
+	if x, isValue := e.(value); isValue {
+		v, ok = x.val.(bool)
+	}
+	return v, ok
+}
+
+// isValue reports whether e is a constant.
+func isValue(e expression) bool {
+	_, ok := e.(value)
+	return ok
+}
+
+// selectsNone reports whether e, the expression of a WHERE clause, is false
+// or NULL, selecting no rows.
+func selectsNone(e expression) bool {
+	x, ok := e.(value)
+	return ok && (x.val == nil || x.val == false)
+}
+
 type pexpr struct {
 	expr expression
 }
@@ -180,14 +270,29 @@ func newBetween(expr, lo, hi interface{}, not bool) (expression, error) {
 }
 
 type pLike struct {
+	ci      bool       // ILIKE
+	escape  expression // nil: no ESCAPE clause
 	expr    expression
 	pattern expression
 	re      *regexp.Regexp
 	sexpr   *string
 }
 
-func (p *pLike) isStatic() bool { return p.expr.isStatic() && p.pattern.isStatic() }
-func (p *pLike) String() string { return fmt.Sprintf("%q LIKE %q", p.expr, p.pattern) }
+func (p *pLike) isStatic() bool {
+	return p.expr.isStatic() && p.pattern.isStatic() && (p.escape == nil || p.escape.isStatic())
+}
+
+func (p *pLike) String() string {
+	op := "LIKE"
+	if p.ci {
+		op = "ILIKE"
+	}
+	if p.escape == nil {
+		return fmt.Sprintf("%s %s %s", p.expr, op, p.pattern)
+	}
+
+	return fmt.Sprintf("%s %s %s ESCAPE %s", p.expr, op, p.pattern, p.escape)
+}
 
 func (p *pLike) eval(ctx map[interface{}]interface{}, arg []interface{}) (v interface{}, err error) {
 	var sexpr string
@@ -232,11 +337,34 @@ func (p *pLike) eval(ctx map[interface{}]interface{}, arg []interface{}) (v inte
 			return nil, fmt.Errorf("non-string pattern in LIKE: %v (value of type %T)", pattern, pattern)
 		}
 
+		if p.escape != nil {
+			esc, err := expand1(p.escape.eval(ctx, arg))
+			if err != nil {
+				return nil, err
+			}
+
+			if esc == nil {
+				return nil, nil
+			}
+
+			sesc, ok := esc.(string)
+			if !ok {
+				return nil, fmt.Errorf("non-string escape in LIKE: %v (value of type %T)", esc, esc)
+			}
+
+			if spattern, err = likeEscape(spattern, sesc); err != nil {
+				return nil, err
+			}
+		}
+
+		if p.ci {
+			spattern = "(?i)" + spattern
+		}
 		if re, err = regexp.Compile(spattern); err != nil {
 			return nil, err
 		}
 
-		if p.pattern.isStatic() {
+		if p.pattern.isStatic() && (p.escape == nil || p.escape.isStatic()) {
 			p.re = re
 		}
 	}
@@ -244,6 +372,76 @@ func (p *pLike) eval(ctx map[interface{}]interface{}, arg []interface{}) (v inte
 	return re.MatchString(sexpr), nil
 }
 
+// likeEscape returns the regular expression pattern with every character
+// preceded by the escape character esc, which must be a single character,
+// quoted such that it matches literally.
+func likeEscape(pattern, esc string) (string, error) {
+	e, n := utf8.DecodeRuneInString(esc)
+	if n == 0 || n != len(esc) || e == utf8.RuneError && n == 1 {
+		return "", fmt.Errorf("LIKE ESCAPE must be a single character: %q", esc)
+	}
+
+	var b []byte
+	for i := 0; i < len(pattern); {
+		c, n := utf8.DecodeRuneInString(pattern[i:])
+		if c != e {
+			b = append(b, pattern[i:i+n]...)
+			i += n
+			continue
+		}
+
+		i += n
+		if i == len(pattern) {
+			return "", fmt.Errorf("LIKE pattern ends with the escape character: %q", pattern)
+		}
+
+		_, n = utf8.DecodeRuneInString(pattern[i:])
+		b = append(b, regexp.QuoteMeta(pattern[i:i+n])...)
+		i += n
+	}
+	return string(b), nil
+}
+
+type pMatch struct {
+	expr  expression
+	query expression
+}
+
+func (p *pMatch) isStatic() bool { return p.expr.isStatic() && p.query.isStatic() }
+func (p *pMatch) String() string { return fmt.Sprintf("%s MATCH %s", p.expr, p.query) }
+
+func (p *pMatch) eval(ctx map[interface{}]interface{}, arg []interface{}) (v interface{}, err error) {
+	expr, err := expand1(p.expr.eval(ctx, arg))
+	if err != nil {
+		return
+	}
+
+	if expr == nil {
+		return
+	}
+
+	sexpr, ok := expr.(string)
+	if !ok {
+		return nil, fmt.Errorf("non-string expression in MATCH: %v (value of type %T)", expr, expr)
+	}
+
+	query, err := expand1(p.query.eval(ctx, arg))
+	if err != nil {
+		return
+	}
+
+	if query == nil {
+		return
+	}
+
+	squery, ok := query.(string)
+	if !ok {
+		return nil, fmt.Errorf("non-string query in MATCH: %v (value of type %T)", query, query)
+	}
+
+	return matchWords(sexpr, squery), nil
+}
+
 type binaryOperation struct {
 	op   int
 	l, r expression
@@ -370,6 +568,12 @@ func (o *binaryOperation) eval(ctx map[interface{}]interface{}, arg []interface{
 		}
 	}()
 
+	if o.isRelOp() {
+		if c := collation(o.l, o.r); c != nil {
+			return o.evalCollated(c, ctx, arg)
+		}
+	}
+
 	switch op := o.op; op {
 	case andand:
 		a, err := expand1(o.l.eval(ctx, arg))
@@ -2877,7 +3081,7 @@ func (i *ident) isQualified() bool { return strings.Contains(i.s, ".") }
 
 func (i *ident) isStatic() bool { return false }
 
-func (i *ident) String() string { return i.s }
+func (i *ident) String() string { return quoteIdent(i.s) }
 
 func (i *ident) eval(ctx map[interface{}]interface{}, _ []interface{}) (v interface{}, err error) {
 	if _, ok := ctx["$agg0"]; ok {
@@ -2887,6 +3091,12 @@ func (i *ident) eval(ctx map[interface{}]interface{}, _ []interface{}) (v interf
 	//defer func() { dbg("ident %q -> %v %v", i.s, v, err) }()
 	v, ok := ctx[i.s]
 	if !ok {
+		if x, _ := ctx["$ctx"].(*execCtx); x != nil {
+			if v, ok = x.outerField(i.s); ok {
+				return
+			}
+		}
+
 		err = fmt.Errorf("unknown field %s", i.s)
 	}
 	return
@@ -2896,10 +3106,12 @@ type pIn struct {
 	expr expression
 	not  bool
 	list []expression
+	sel  *selectStmt
+	arr  expression
 }
 
 func (n *pIn) isStatic() bool {
-	if !n.expr.isStatic() {
+	if n.sel != nil || n.arr != nil || !n.expr.isStatic() {
 		return false
 	}
 
@@ -2914,6 +3126,22 @@ func (n *pIn) isStatic() bool {
 //LATER newIn
 
 func (n *pIn) String() string {
+	if n.sel != nil {
+		if n.not {
+			return fmt.Sprintf("%s NOT IN (%s)", n.expr, n.sel)
+		}
+
+		return fmt.Sprintf("%s IN (%s)", n.expr, n.sel)
+	}
+
+	if n.arr != nil {
+		if n.not {
+			return fmt.Sprintf("%s NOT IN %s", n.expr, n.arr)
+		}
+
+		return fmt.Sprintf("%s IN %s", n.expr, n.arr)
+	}
+
 	a := []string{}
 	for _, v := range n.list {
 		a = append(a, v.String())
@@ -2931,7 +3159,33 @@ func (n *pIn) eval(ctx map[interface{}]interface{}, arg []interface{}) (v interf
 		return
 	}
 
-	for _, v := range n.list {
+	if n.sel != nil {
+		return n.evalSelect(lhs, ctx)
+	}
+
+	list := n.list
+	if n.arr != nil {
+		a0, err := expand1(n.arr.eval(ctx, arg))
+		if err != nil {
+			return nil, err
+		}
+
+		a, ok := a0.([]interface{})
+		if !ok && a0 != nil {
+			return nil, fmt.Errorf("invalid operation: %s (%s of type %T is not an array)", n, n.arr, a0)
+		}
+
+		// Arrays of different element types may be stored in the same
+		// column, an element of another type than lhs is not equal to it.
+		list = make([]expression, 0, len(a))
+		for _, v := range a {
+			if x, y := coerce(lhs, v); lhs == nil || reflect.TypeOf(x) == reflect.TypeOf(y) {
+				list = append(list, value{v})
+			}
+		}
+	}
+
+	for _, v := range list {
 		b, err := newBinaryOperation(eq, value{lhs}, v)
 		if err != nil {
 			return nil, err
@@ -2949,6 +3203,203 @@ func (n *pIn) eval(ctx map[interface{}]interface{}, arg []interface{}) (v interf
 	return n.not, nil
 }
 
+// pInSet is the materialized result of the subquery of pIn.
+type pInSet struct {
+	t   temp
+	col *col // Result column, typ == 0 if the result has no non NULL values.
+}
+
+// evalSelect evaluates lhs [NOT] IN (SELECT ...). The subquery is
+// materialized in a temp BTree once per execution of the enclosing query,
+// unless it is correlated, and the BTree is then probed for lhs.
+func (n *pIn) evalSelect(lhs interface{}, ctx map[interface{}]interface{}) (v interface{}, err error) {
+	set, ok := ctx[n].(*pInSet)
+	if !ok {
+		x, _ := ctx["$ctx"].(*execCtx)
+		if x == nil {
+			return nil, fmt.Errorf("subquery not supported in this context: %s", n)
+		}
+
+		sub := x.sub(ctx)
+		if set, err = n.materialize(sub); err != nil {
+			return
+		}
+
+		switch {
+		case sub.corr:
+			defer func() {
+				if e := set.t.Drop(); e != nil && err == nil {
+					err = e
+				}
+			}()
+		default:
+			*x.temps = append(*x.temps, set.t)
+			ctx[n] = set
+		}
+	}
+
+	if lhs == nil || set.col.typ == 0 {
+		return n.not, nil
+	}
+
+	k := []interface{}{lhs}
+	if err = typeCheck(k, []*col{set.col}); err != nil {
+		return
+	}
+
+	found, err := set.t.Get(k)
+	if err != nil {
+		return
+	}
+
+	return (len(found) != 0) != n.not, nil
+}
+
+func (n *pIn) materialize(ctx *execCtx) (set *pInSet, err error) {
+	t, err := ctx.db.store.CreateTemp(true)
+	if err != nil {
+		return
+	}
+
+	var cols []*col
+	var name string
+	ok := false
+	if err = n.sel.do(ctx, false, func(_ interface{}, data []interface{}) (more bool, err error) {
+		if ok {
+			if err = expand(data); err != nil {
+				return
+			}
+
+			if data[0] == nil {
+				return true, nil
+			}
+
+			if err = infer(data, &cols); err != nil {
+				return
+			}
+
+			return true, t.Set(data, []interface{}{true})
+		}
+
+		ok = true
+		flds := data[0].([]*fld)
+		if g := len(flds); g != 1 {
+			return false, fmt.Errorf("subquery in IN predicate must have exactly one column, have %d", g)
+		}
+
+		name = flds[0].name
+		return true, nil
+	}); err != nil {
+		t.Drop()
+		return
+	}
+
+	c := &col{name: name}
+	if len(cols) != 0 {
+		c.typ = cols[0].typ
+	}
+	return &pInSet{t, c}, nil
+}
+
+type pExists struct {
+	not bool
+	sel *selectStmt
+}
+
+func (n *pExists) isStatic() bool { return false }
+
+func (n *pExists) String() string {
+	if n.not {
+		return fmt.Sprintf("NOT EXISTS (%s)", n.sel)
+	}
+
+	return fmt.Sprintf("EXISTS (%s)", n.sel)
+}
+
+// eval reports whether the subquery produces at least one row. Uncorrelated
+// subqueries are evaluated once per execution of the enclosing query.
+func (n *pExists) eval(ctx map[interface{}]interface{}, arg []interface{}) (v interface{}, err error) {
+	if v, ok := ctx[n]; ok {
+		return v, nil
+	}
+
+	x, _ := ctx["$ctx"].(*execCtx)
+	if x == nil {
+		return nil, fmt.Errorf("subquery not supported in this context: %s", n)
+	}
+
+	sub := x.sub(ctx)
+	found, ok := false, false
+	if err = n.sel.do(sub, false, func(_ interface{}, data []interface{}) (more bool, err error) {
+		if ok {
+			found = true
+			return false, nil
+		}
+
+		ok = true
+		return true, nil
+	}); err != nil {
+		return
+	}
+
+	v = found != n.not
+	if !sub.corr {
+		ctx[n] = v
+	}
+	return
+}
+
+// subquery is a scalar subquery, ie. (SELECT ...) used as an operand.
+type subquery struct {
+	sel *selectStmt
+}
+
+func (n *subquery) isStatic() bool { return false }
+
+func (n *subquery) String() string { return fmt.Sprintf("(%s)", n.sel) }
+
+// eval returns the only field of the only row produced by the subquery, or
+// NULL if it produces no rows. Uncorrelated subqueries are evaluated once per
+// execution of the enclosing query.
+func (n *subquery) eval(ctx map[interface{}]interface{}, arg []interface{}) (v interface{}, err error) {
+	if v, ok := ctx[n]; ok {
+		return v, nil
+	}
+
+	x, _ := ctx["$ctx"].(*execCtx)
+	if x == nil {
+		return nil, fmt.Errorf("subquery not supported in this context: %s", n)
+	}
+
+	sub := x.sub(ctx)
+	ok, found := false, false
+	if err = n.sel.do(sub, false, func(_ interface{}, data []interface{}) (more bool, err error) {
+		if !ok {
+			if g := len(data[0].([]*fld)); g != 1 {
+				return false, fmt.Errorf("scalar subquery must have exactly one column, have %d", g)
+			}
+
+			ok = true
+			return true, nil
+		}
+
+		if found {
+			return false, fmt.Errorf("scalar subquery produces more than one row: %s", n)
+		}
+
+		found = true
+		v, err = expand1(data[0], nil)
+		return err == nil, err
+	}); err != nil {
+		return nil, err
+	}
+
+	if !sub.corr {
+		ctx[n] = v
+	}
+	return
+}
+
 type value struct {
 	val interface{}
 }
@@ -2961,6 +3412,8 @@ func (l value) String() string {
 		return "NULL"
 	case string:
 		return fmt.Sprintf("%q", x)
+	case []byte:
+		return fmt.Sprintf("X'%X'", x)
 	default:
 		return fmt.Sprintf("%v", l.val)
 	}
@@ -2991,7 +3444,71 @@ func (c *conversion) eval(ctx map[interface{}]interface{}, arg []interface{}) (v
 		return
 	}
 
-	return convert(val, c.typ)
+	if v, err = convert(val, c.typ); err != nil {
+		return
+	}
+
+	switch c.typ {
+	case qInt8, qInt16, qInt32, qInt64, qUint8, qUint16, qUint32, qUint64:
+		if x, _ := ctx["$ctx"].(*execCtx); x != nil && x.strict {
+			err = checkLossless(val, v, c.typ)
+		}
+	}
+	return
+}
+
+// arrayExpr is array(x, y, ...).
+type arrayExpr struct {
+	list []expression
+}
+
+func (a *arrayExpr) isStatic() bool {
+	for _, v := range a.list {
+		if !v.isStatic() {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *arrayExpr) String() string {
+	s := []string{}
+	for _, v := range a.list {
+		s = append(s, v.String())
+	}
+	return fmt.Sprintf("array(%s)", strings.Join(s, ", "))
+}
+
+func (a *arrayExpr) eval(ctx map[interface{}]interface{}, arg []interface{}) (v interface{}, err error) {
+	r := make([]interface{}, len(a.list))
+	for i, e := range a.list {
+		x, err := expand1(e.eval(ctx, arg))
+		if err != nil {
+			return nil, err
+		}
+
+		switch y := x.(type) {
+		case nil:
+			return nil, nil
+		case []interface{}:
+			if len(a.list) == 1 { // Conversion.
+				return y, nil
+			}
+
+			return nil, fmt.Errorf("invalid array element %s (arrays cannot be nested)", e)
+		}
+
+		if x = ideal(x); elemType(x) == 0 {
+			return nil, fmt.Errorf("invalid array element %s (type %T)", e, x)
+		}
+
+		if i != 0 && elemType(x) != elemType(r[0]) {
+			return nil, fmt.Errorf("invalid array element %s (mismatched types %T and %T)", e, r[0], x)
+		}
+
+		r[i] = x
+	}
+	return r, nil
 }
 
 type unaryOperation struct {
@@ -3247,7 +3764,7 @@ func newCall(f string, arg []expression) (v expression, isAgg bool, err error) {
 	}
 
 	isAgg = x.isAggregate
-	if g, min, max := len(arg), x.minArgs, x.maxArgs; g < min || g > max {
+	if g, min, max := len(arg), x.minArgs, x.maxArgs; g < min || max >= 0 && g > max {
 		a := []interface{}{}
 		for _, v := range arg {
 			a = append(a, v)
@@ -3370,6 +3887,51 @@ func (is *isNull) eval(ctx map[interface{}]interface{}, arg []interface{}) (v in
 	return val == nil != is.not, nil
 }
 
+type isDistinct struct {
+	l, r expression
+	not  bool
+}
+
+func (is *isDistinct) isStatic() bool { return is.l.isStatic() && is.r.isStatic() }
+
+func (is *isDistinct) String() string {
+	if is.not {
+		return fmt.Sprintf("%s IS NOT DISTINCT FROM %s", is.l, is.r)
+	}
+
+	return fmt.Sprintf("%s IS DISTINCT FROM %s", is.l, is.r)
+}
+
+func (is *isDistinct) eval(ctx map[interface{}]interface{}, arg []interface{}) (v interface{}, err error) {
+	l, err := expand1(is.l.eval(ctx, arg))
+	if err != nil {
+		return
+	}
+
+	r, err := expand1(is.r.eval(ctx, arg))
+	if err != nil {
+		return
+	}
+
+	switch {
+	case l == nil && r == nil:
+		return is.not, nil
+	case l == nil || r == nil:
+		return !is.not, nil
+	}
+
+	b, err := newBinaryOperation(neq, value{l}, value{r})
+	if err != nil {
+		return
+	}
+
+	if v, err = b.eval(ctx, arg); err != nil {
+		return
+	}
+
+	return v.(bool) != is.not, nil
+}
+
 type indexOp struct {
 	expr, x expression
 }
@@ -0,0 +1,157 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+// FieldInfo describes a field of a Recordset.
+//
+// The type of a field is known when the field is a column of a table, a
+// conversion or a literal, or when it is computed by count, min or max of
+// such fields. The Type of other fields, like ones computed by arithmetic
+// expressions, is zero. Such fields, as well as the fields of the system
+// tables, are reported as Nullable.
+type FieldInfo struct {
+	Name     string // Field name, empty for fields having no name.
+	Type     Type   // Field type (BigInt, BigRat, ...) or zero if not known.
+	Nullable bool   // Field values can be NULL.
+}
+
+func (r recordset) FieldInfo() (fields []FieldInfo, err error) {
+	err = r.ctx.db.locked(r, func(ctx *execCtx) (err error) {
+		fields, err = fieldInfo(ctx, r.rset)
+		return
+	})
+	return
+}
+
+// fieldInfo returns the descriptions of the fields of r.
+func fieldInfo(ctx *execCtx, r rset) ([]FieldInfo, error) {
+	switch x := r.(type) {
+	case *crossJoinRset:
+		return x.fieldInfo(ctx)
+	case *distinctRset:
+		return fieldInfo(ctx, x.src)
+	case *groupByRset:
+		return fieldInfo(ctx, x.src)
+	case *limitRset:
+		return fieldInfo(ctx, x.src)
+	case *offsetRset:
+		return fieldInfo(ctx, x.src)
+	case *orderByRset:
+		return fieldInfo(ctx, x.src)
+	case *whereRset:
+		return fieldInfo(ctx, x.src)
+	case *selectRset:
+		src, err := fieldInfo(ctx, x.src)
+		if err != nil || len(x.flds) == 0 {
+			return src, err
+		}
+
+		fi := make([]FieldInfo, len(x.flds))
+		for i, v := range x.flds {
+			fi[i].Name = v.name
+			fi[i].Type, fi[i].Nullable = exprInfo(v.expr, src)
+		}
+		return fi, nil
+	case tableRset:
+		db, nm, err := ctx.db.resolve(string(x))
+		if err != nil {
+			return nil, err
+		}
+
+		if t := db.root.tables[nm]; t != nil {
+			fi := make([]FieldInfo, len(t.cols))
+			for i, c := range t.cols {
+				fi[i] = FieldInfo{c.name, Type(c.typ), !c.notNull}
+			}
+			return fi, nil
+		}
+	}
+
+	var fi []FieldInfo
+	err := r.do(ctx, true, func(_ interface{}, data []interface{}) (bool, error) {
+		for _, v := range data[0].([]*fld) {
+			fi = append(fi, FieldInfo{Name: v.name, Nullable: true})
+		}
+		return false, nil
+	})
+	return fi, err
+}
+
+// fieldInfo returns the descriptions of the fields of r, named like by
+// crossJoinRset.do.
+func (r *crossJoinRset) fieldInfo(ctx *execCtx) (fi []FieldInfo, err error) {
+	for _, pair0 := range r.sources {
+		pair := pair0.([]interface{})
+		altName := pair[1].(string)
+		var a []FieldInfo
+		switch x := pair[0].(type) {
+		case string: // table name
+			a, err = fieldInfo(ctx, tableRset(x))
+			if altName == "" {
+				altName = unqualified(x)
+			}
+		case *tableSample:
+			a, err = fieldInfo(ctx, tableRset(x.table))
+			if altName == "" {
+				altName = unqualified(x.table)
+			}
+		case *selectStmt:
+			a, err = fieldInfo(ctx, x.exec0())
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(r.sources) > 1 {
+			for i := range a {
+				switch {
+				case altName == "":
+					a[i].Name = ""
+				case a[i].Name != "":
+					a[i].Name = altName + "." + a[i].Name
+				}
+			}
+		}
+		fi = append(fi, a...)
+	}
+	return
+}
+
+// exprInfo returns the type of the values of the field expression e and
+// whether they can be NULL. The fields of the source of e are described by
+// src.
+func exprInfo(e expression, src []FieldInfo) (Type, bool) {
+	switch x := e.(type) {
+	case *call:
+		switch {
+		case x.f == "count":
+			return Int64, false
+		case (x.f == "min" || x.f == "max") && len(x.arg) == 1:
+			typ, _ := exprInfo(x.arg[0], src)
+			return typ, true
+		}
+	case *cast:
+		_, nullable := exprInfo(x.val, src)
+		return Type(x.typ), nullable
+	case *collateExpr:
+		return exprInfo(x.expr, src)
+	case *conversion:
+		_, nullable := exprInfo(x.val, src)
+		return Type(x.typ), nullable
+	case *ident:
+		for _, v := range src {
+			if v.Name == x.s {
+				return v.Type, v.Nullable
+			}
+		}
+	case *pexpr:
+		return exprInfo(x.expr, src)
+	case value:
+		if x.val != nil {
+			return Type(elemType(ideal(x.val))), false
+		}
+	}
+	return 0, true
+}
@@ -9,14 +9,16 @@
 package ql
 
 import (
+	"context"
 	"crypto/sha1"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"math/big"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,10 +27,29 @@ import (
 	"github.com/cznic/mathutil"
 )
 
+// The 16 byte header of a DB file is the magic, followed by the name of its
+// Codec, zero padded to hdrAppID, the big endian application id and the big
+// endian user version.
 const (
-	magic = "\x60\xdbql"
+	magic          = "\x60\xdbql"
+	hdrAppID       = 8  // Offset of the application id in the header.
+	hdrUserVersion = 12 // Offset of the user version in the header.
 )
 
+// recordFormat is the version of the format of the records written to a DB
+// file. Version 1 records are lldb.EncodeScalars of the record fields.
+//
+// The header of a DB file has no room for the version and the records cannot
+// be tagged with it, any first byte is a valid encoding of the first field of
+// an existing record. The version is therefore recorded as the second field
+// of the root record, the record of handle 1, which holds the head of the
+// table list. Version 1 files have no second field, unless the root record
+// holds also the sequence number of the last commit, see DB.Changes, as its
+// third field. Opening a file of a version newer than recordFormat fails. The
+// record format of an open file selects the decoder of its records, see
+// file.decode.
+const recordFormat = 1
+
 var (
 	_ btreeIndex    = (*fileIndex)(nil)
 	_ btreeIterator = (*fileBTreeIterator)(nil)
@@ -70,7 +91,72 @@ func expand(data []interface{}) (err error) {
 
 // OpenFile returns a DB backed by a named file. The back end limits the size
 // of a record to about 64 kB.
+//
+// Opening a DB file which the process already has open returns the DB opened
+// first, instead of failing to acquire the lock of the DB file. The files are
+// the same if their absolute paths, with symbolic links resolved, are equal.
+// The DB keeps the options of the first OpenFile, the options of the later
+// ones are only validated. The DB is closed by the Close matching the last of
+// the OpenFiles returning it, the other Closes do nothing. DB files opened
+// using Options.OSFile or by ATTACH are not shared.
 func OpenFile(name string, opt *Options) (db *DB, err error) {
+	return OpenFileContext(context.Background(), name, opt)
+}
+
+// OpenFileContext is like OpenFile but it stops waiting for the lock of the
+// DB file, see Options.LockWait, when ctx is done.
+func OpenFileContext(ctx context.Context, name string, opt *Options) (db *DB, err error) {
+	return openFile(ctx, name, opt, opt.OSFile == nil)
+}
+
+// openFile implements OpenFileContext. If share is true, the DB is shared with
+// the other opens of the same DB file, see OpenFile.
+func openFile(ctx context.Context, name string, opt *Options, share bool) (db *DB, err error) {
+	if err = opt.Allocator.check(); err != nil {
+		return nil, err
+	}
+
+	if err = checkCodec(opt.Codec); err != nil {
+		return nil, err
+	}
+
+	if err = checkIdentQuote(opt.IdentifierQuote); err != nil {
+		return nil, err
+	}
+
+	if opt.ReadAhead < 0 {
+		return nil, fmt.Errorf("(file-027) invalid option ReadAhead: %d", opt.ReadAhead)
+	}
+
+	if opt.LockWait < 0 {
+		return nil, fmt.Errorf("(file-029) invalid option LockWait: %v", opt.LockWait)
+	}
+
+	if opt.IDPolicy < IDDefault || opt.IDPolicy > IDReuseLowest {
+		return nil, fmt.Errorf("(file-033) invalid option IDPolicy: %d", opt.IDPolicy)
+	}
+
+	if opt.IDBase < 0 {
+		return nil, fmt.Errorf("(file-034) invalid option IDBase: %d", opt.IDBase)
+	}
+
+	if opt.MaxRetries < 0 {
+		return nil, fmt.Errorf("(file-036) invalid option MaxRetries: %d", opt.MaxRetries)
+	}
+
+	var path string
+	if share {
+		if path, err = canonicalPath(name); err != nil {
+			return nil, err
+		}
+
+		if db, err = shared(ctx, path); db != nil || err != nil {
+			return db, err
+		}
+
+		defer opened(path)
+	}
+
 	var f lldb.OSFile
 	if f = opt.OSFile; f == nil {
 		f, err = os.OpenFile(name, os.O_RDWR, 0666)
@@ -90,7 +176,7 @@ func OpenFile(name string, opt *Options) (db *DB, err error) {
 		}
 	}
 
-	fi, err := newFileFromOSFile(f) // always ACID
+	fi, err := newFileFromOSFile(ctx, f, opt) // always ACID
 	if err != nil {
 		return
 	}
@@ -102,7 +188,38 @@ func OpenFile(name string, opt *Options) (db *DB, err error) {
 		}
 	}
 
-	return newDB(fi)
+	fi.tempPool, fi.tempSpill = opt.TempFilePoolSize, opt.TempSpillThreshold
+	fi.tempsInUse = map[lldb.OSFile]struct{}{}
+	fi.maxTemps, fi.maxTempSize = opt.MaxTempFiles, opt.MaxTempBytes
+	fi.metrics = opt.Metrics
+	if err = fi.setIDPolicy(opt.IDPolicy, opt.IDBase); err != nil {
+		fi.Close()
+		return nil, err
+	}
+
+	if db, err = newDB(fi); err != nil {
+		return nil, err
+	}
+
+	db.metrics = metricsOrNop(opt.Metrics)
+	db.slowQuery, db.onSlowQuery = opt.SlowQueryThreshold, opt.OnSlowQuery
+	db.lockTimeout = opt.LockTimeout
+	db.closeRollback = opt.RollbackOnClose
+	db.identQuote = opt.IdentifierQuote
+	db.normalize = opt.Normalize
+	db.onChange, db.changeLogSize = opt.OnChange, opt.ChangeLog
+	db.cdc = db.onChange != nil || db.changeLogSize > 0
+
+	db.settings = settings{
+		stableOrder:       opt.StableOrder,
+		strictConversions: opt.StrictConversions,
+		strictFloats:      opt.StrictFloats,
+		timeout:           opt.DefaultQueryTimeout,
+	}
+	if share {
+		db.share(path)
+	}
+	return db, nil
 }
 
 // Options amend the behavior of OpenFile.
@@ -116,7 +233,9 @@ func OpenFile(name string, opt *Options) (db *DB, err error) {
 //
 // OSFile allows to pass an os.File like back end providing, for example,
 // encrypted storage. If this field is nil then OpenFile uses the file named by
-// the 'name' parameter instead.
+// the 'name' parameter instead. EncryptedOSFile provides such a back end.
+// The DB file of EncryptedOSFile has no write ahead log, MinWAL does not
+// apply to it.
 //
 // TempFile
 //
@@ -124,13 +243,378 @@ func OpenFile(name string, opt *Options) (db *DB, err error) {
 // BY, ... clauses. The hook is intended to be used by encrypted DB back ends
 // to avoid leaks of unecrypted data to such temp files by providing temp files
 // which are encrypted as well. Note that *os.File satisfies the lldb.OSFile
-// interface.
+// interface. EncryptedTempFile provides temp files matching EncryptedOSFile.
+// TempFile is not used for the temporary data kept in memory, see
+// TempSpillThreshold. A negative TempSpillThreshold makes all temporary data
+// go to the temp files.
 //
 // If TempFile is nil it defaults to ioutil.TempFile.
+//
+// TempSpillThreshold
+//
+// TempSpillThreshold is the size, in bytes, up to which the temporary data of
+// evaluating a GROUP BY, ORDER BY, ... clause are kept in memory. Once the data
+// grow larger they are moved to a temp file provided by TempFile. Zero selects
+// the default of 1 MiB. A negative value keeps no temporary data in memory.
+// ORDER BY sorts larger than memory by an external merge sort, every
+// TempSpillThreshold bytes of the result set become a sorted run in a temp
+// file of their own and the runs are merged when the sorted rows are read.
+//
+// TempFilePoolSize
+//
+// TempFilePoolSize is the maximum number of temp files kept for reuse by later
+// queries. Instead of being removed once a query is done with it, a temp file
+// is truncated and returned to the pool, unless the pool is full or the temp
+// file grew beyond 64 MiB. The pooled temp files are removed after the pool
+// is not used for a minute and when the DB is closed. Closing the DB also
+// removes the temp files not yet returned, for example by a query which
+// failed. Zero disables the pool.
+//
+// MaxTempFiles, MaxTempBytes
+//
+// MaxTempFiles limits the number of temp files in use by all the statements
+// executing concurrently on the DB, not counting the pooled ones. MaxTempBytes
+// limits the total size of those temp files. A statement which would exceed a
+// limit fails with a *TempLimitError and its temporary data are dropped. Every
+// sorted run of an external ORDER BY sort is a temp file, see
+// TempSpillThreshold. A value not greater than zero sets no limit. The limits
+// protect the volume of the temp files, shared with other processes, from a
+// runaway query.
+//
+// Allocator
+//
+// Allocator tunes the storage space allocator of the DB file. The zero value
+// selects the defaults. See AllocatorOptions for details.
+//
+// DefaultQueryTimeout
+//
+// DefaultQueryTimeout, if positive, limits the time DB.Execute and DB.Run
+// spend executing a statement list. See DB.ExecuteTimeout for details. The
+// value can be changed later using PRAGMA query_timeout.
+//
+// StableOrder
+//
+// StableOrder makes scanning a table, as done by a SELECT not using an index,
+// produce the rows in the order of their id(). Without StableOrder the order
+// of rows not specified by ORDER BY is an implementation detail which may
+// change. If id() of the table is indexed, for example by
+//
+//	CREATE INDEX tID ON t (id());
+//
+// the scan walks the index and StableOrder costs little more than the index
+// lookups. Otherwise enabling StableOrder costs time and memory proportional
+// to the number of rows in the table on every scan, which is fine for tests
+// comparing query results to golden data. The value can be changed later
+// using PRAGMA stable_order.
+//
+// Metrics
+//
+// Metrics, if not nil, receives the counts of executed statements, rows read
+// and written, committed and rolled back transactions, created temp files, of
+// bytes allocated and freed in the DB file and of rows added to new indices,
+// as well as the execution time of every statement. See Metric for details.
+//
+// Codec
+//
+// Codec, if not nil, serializes the values of the bigint, bigrat, time and
+// duration types stored in the DB file instead of the default encoding/gob
+// based one. The name of the codec is recorded in the header of a new DB file
+// and opening the DB file using a different codec fails. Values of the other
+// types are not affected by Codec.
+//
+// SlowQueryThreshold, OnSlowQuery
+//
+// If SlowQueryThreshold is positive and OnSlowQuery is not nil, OnSlowQuery is
+// called with the text of every statement whose execution takes longer than
+// SlowQueryThreshold and with its duration. The time spent iterating the
+// Recordset returned by a statement is not included. OnSlowQuery is called
+// once DB.Execute, DB.Run, ... has executed the statement list and released
+// the locks it acquired for executing the statements. Locks of a transaction
+// left open by the statement list are still held, using the DB in OnSlowQuery
+// may deadlock in such case.
+//
+// LockTimeout
+//
+// LockTimeout, if positive, limits the time spent waiting for the lock
+// guarding the DB against concurrent updates, for example by a statement
+// executed while another goroutine has a transaction open. A blocked
+// acquisition then fails with a *LockTimeoutError describing the holders and
+// waiters of the lock instead of waiting forever. See also DB.Locks.
+//
+// ApplicationID
+//
+// ApplicationID identifies the application owning the DB file. It is recorded
+// in the header of a new DB file. If it is not zero, opening an existing DB
+// file having a different application id fails, which prevents an application
+// from accidentally using an unrelated DB file. See also DB.ApplicationID.
+//
+// IdentifierQuote
+//
+// IdentifierQuote, if not zero, is the character quoting identifiers in the
+// statements compiled by DB.Run, DB.Query and the database/sql driver. It must
+// be either '"' or '`'. See CompileQuoted for details.
+//
+// StrictConversions
+//
+// StrictConversions makes a conversion of a number to an integer type, like
+// int8(x), fail if the result does not have the same value as x because x
+// overflows the integer type or has a fractional part. Without
+// StrictConversions such conversion truncates x. The value can be changed
+// later using PRAGMA strict_conversions.
+//
+// StrictFloats
+//
+// StrictFloats makes the statements inserting or updating rows fail if a
+// column would be set to a NaN or an infinite floating point value. Without
+// StrictFloats such values are stored, see "Comparison operators" for how
+// they compare and collate. The value can be changed later using PRAGMA
+// strict_floats.
+//
+// Normalize
+//
+// Normalize, if not nil, returns the normalized form of a string, for example
+// norm.NFC.String of the golang.org/x/text/unicode/norm package. Normalize is
+// then applied to the string values written to the DB by INSERT INTO and
+// UPDATE statements, to the string arguments of the executed statements and
+// to the text of the statements compiled by DB.Run, DB.Query and the
+// database/sql driver. Canonically equivalent strings, like a composed and a
+// decomposed "é", then compare equal in WHERE clauses and in indices. Strings
+// computed by other expressions, for example in WHERE clauses, the string
+// literals of statement lists compiled otherwise and the strings written to
+// the DB before Normalize was set are not normalized. Normalize costs time on
+// every string written and it must not change between opens of a DB.
+//
+// OnChange
+//
+// OnChange, if not nil, is called with every row inserted, updated or deleted
+// by a transaction when the outermost transaction commits, in the order of the
+// changes. The changes of a transaction rolled back, or of a nested
+// transaction rolled back, are not reported. INSERT OR REPLACE reports the
+// replaced rows as deleted and the new row as inserted, TRUNCATE TABLE reports
+// every row as deleted. Changes of the schema, for example by DROP TABLE or
+// ALTER TABLE, are not reported. OnChange is called while the DB is still
+// locked by the committing transaction, so the transactions are reported in
+// the order of their commits, but using the DB in OnChange deadlocks. The
+// changes are buffered in memory until the commit.
+//
+// ChangeLog
+//
+// ChangeLog, if positive, enables DB.Changes and sets the number of the most
+// recently committed change events kept in memory for the streams resuming
+// from an earlier commit. See DB.Changes for details.
+//
+// ReadAhead
+//
+// ReadAhead, if positive, makes every read of the DB file not satisfied by
+// the previous read fetch ReadAhead bytes starting at the requested offset.
+// The records of a table written in sequence are mostly adjacent in the DB
+// file, so scanning the table is then served by fewer and larger reads. Reads
+// larger than ReadAhead and reads of the WAL and temporary files are not
+// affected. Values of 32 to 256 kB are reasonable, random access benefits
+// less or even suffers from read ahead. ReadAhead must not be negative.
+//
+// LockWait
+//
+// LockWait is the time OpenFile waits for the lock of the DB file while it is
+// held by another process, or by another DB of the same process, having the
+// DB file open. OpenFile retries with an exponential backoff and fails with
+// ErrLocked once LockWait elapses, or once the context passed to
+// OpenFileContext is done. Zero fails at once, LockWait must not be negative.
+// The WAL is checked only after the lock is acquired, a non empty WAL is left
+// by a crash, not by a contending process, so it fails regardless of
+// LockWait. Unlike LockTimeout, LockWait applies only to opening the DB.
+//
+// RollbackOnClose
+//
+// RollbackOnClose makes DB.Close roll back a transaction which is still open
+// instead of failing with ErrOpenTransaction. A transaction is never
+// committed by Close. Either way no changes of the transaction reach the DB
+// file or its WAL, so the DB file can be opened again without recovery.
+//
+// IDPolicy, IDBase
+//
+// IDPolicy selects how the ids of the rows, see the built-in function id, are
+// assigned, see IDPolicy. IDBase is the smallest id assigned, the default is
+// 1. IDBase must not be negative. Raising IDBase between opens of a DB makes
+// the ids continue from it, lowering it has no effect on the ids assigned by
+// IDMonotonic and IDNeverReuse, which never go back, except when IDMonotonic
+// restarts them.
+//
+// The policy and the base are kept in the DB file. Opening it with IDPolicy
+// IDDefault or with a zero IDBase keeps the policy or the base of the DB
+// file, otherwise the DB file is updated to use the ones of the options. A
+// DB file using a policy other than IDMonotonic or a base other than 1
+// cannot be opened by versions of this package not supporting the options,
+// they fail with ErrCorruptID.
+//
+// The last id assigned by IDMonotonic and IDNeverReuse is kept in the DB file
+// and it is updated in the transaction assigning the id, so neither a
+// rollback nor reopening the DB makes them assign an id again, except when
+// IDMonotonic restarts the ids.
+//
+// MaxRetries
+//
+// MaxRetries is the number of times a read or a write of the DB file failing
+// with a transient error is retried, zero disables retrying. The attempts are
+// delayed by an exponential backoff starting at a millisecond and capped at 100
+// milliseconds. The transient errors are the errors of the OSFile having, or
+// wrapping an error having, a Temporary method reporting true, like a
+// syscall.Errno such as EAGAIN or ETIMEDOUT, and only they are retried. An
+// *os.File of a local file does not report such errors, so retrying is useful
+// only for an OSFile of a remote storage. The reads and writes of the DB file
+// are at fixed offsets, so repeating them is idempotent. No other operation is
+// retried, in particular neither a statement, a transaction or its commit, so a
+// committed transaction is never applied twice, nor the errors of the
+// allocator, such as a corrupted DB, errors of constraints and other logical
+// errors, errors of the WAL, of the temporary files and of syncing the DB file.
+// An operation still failing after MaxRetries retries fails as if it was not
+// retried. MaxRetries must not be negative.
 type Options struct {
-	CanCreate bool
-	OSFile    lldb.OSFile
-	TempFile  func(dir, prefix string) (f lldb.OSFile, err error)
+	CanCreate           bool
+	OSFile              lldb.OSFile
+	TempFile            func(dir, prefix string) (f lldb.OSFile, err error)
+	TempSpillThreshold  int64
+	TempFilePoolSize    int
+	MaxTempFiles        int
+	MaxTempBytes        int64
+	Allocator           AllocatorOptions
+	DefaultQueryTimeout time.Duration
+	StableOrder         bool
+	Metrics             Metrics
+	Codec               Codec
+	SlowQueryThreshold  time.Duration
+	OnSlowQuery         func(sql string, d time.Duration)
+	LockTimeout         time.Duration
+	ApplicationID       int32
+	IdentifierQuote     rune
+	StrictConversions   bool
+	StrictFloats        bool
+	Normalize           func(string) string
+	OnChange            func(ChangeEvent)
+	ChangeLog           int
+	ReadAhead           int
+	LockWait            time.Duration
+	RollbackOnClose     bool
+	IDPolicy            IDPolicy
+	IDBase              int64
+	MaxRetries          int
+}
+
+// IDPolicy selects how the ids of the rows of the tables of a DB are
+// assigned, see Options.IDPolicy.
+type IDPolicy int
+
+// Values of IDPolicy.
+const (
+	// IDDefault keeps the policy of the DB file, IDMonotonic for a new
+	// one.
+	IDDefault IDPolicy = iota
+
+	// IDMonotonic assigns ids from a counter shared by all tables, so the
+	// ids of the rows deleted are not reused. The counter restarts at
+	// Options.IDBase when the last table of the DB is dropped.
+	IDMonotonic
+
+	// IDNeverReuse is like IDMonotonic but the counter never restarts, so
+	// no id is ever assigned twice in a DB.
+	IDNeverReuse
+
+	// IDReuseLowest assigns to a new row the lowest id, not less than
+	// Options.IDBase, of no row of its table. Finding it walks the index on
+	// id() of the table, so only tables having such an index reuse the
+	// ids, see CREATE INDEX. The rows of other tables and of partitioned
+	// tables, see CREATE TABLE, get their ids as by IDNeverReuse.
+	IDReuseLowest
+)
+
+func (p IDPolicy) String() string {
+	switch p {
+	case IDDefault:
+		return "IDDefault"
+	case IDMonotonic:
+		return "IDMonotonic"
+	case IDNeverReuse:
+		return "IDNeverReuse"
+	case IDReuseLowest:
+		return "IDReuseLowest"
+	default:
+		return fmt.Sprintf("IDPolicy(%d)", int(p))
+	}
+}
+
+// AllocatorOptions amend the behavior of the storage space allocator used by
+// OpenFile. The options are validated when the DB is opened.
+//
+// The atom and page sizes of the allocator are fixed by the file format and
+// cannot be changed. The allocator does not support pre-allocating file
+// space, the file grows as needed.
+//
+// DisableCompression
+//
+// DisableCompression turns off the compression of newly written blocks. It is
+// safe to change this option between opens of a DB, the allocator reads both
+// compressed and uncompressed blocks regardless of the setting.
+//
+// MinCompress
+//
+// MinCompress sets the size, in bytes, of the smallest record the allocator
+// attempts to compress. Compressing small records costs more CPU time than the
+// little space it can save. Zero selects the default of 64 bytes. Every block
+// is tagged as compressed or not, so it is safe to change this option between
+// opens of a DB. MinCompress must not be negative.
+//
+// MinWAL
+//
+// MinWAL sets the minimum size, in bytes, of the write ahead log file. The
+// value is rounded up to a multiple of 16. The extra file space serves as a
+// headroom; commits fitting into it should not fail due to the volume being
+// full. MinWAL must not be negative. The on-disk format of the DB file is not
+// affected and the WAL is truncated when the DB is closed, so it is safe to
+// change this option between opens of a DB. A WAL holding only its headroom,
+// left behind by a process which exited without closing the DB, is discarded
+// when the DB is opened.
+//
+// The WAL holds only the outermost transaction being committed. Once the
+// transaction is written to the DB file, the WAL is emptied down to MinWAL
+// bytes, so the WAL does not grow over a sequence of committed transactions
+// and there is no separate checkpoint to configure or trigger. The WAL grows
+// only as much as the largest single transaction requires; a writer
+// ingesting a stream of data can bound it by committing in batches.
+type AllocatorOptions struct {
+	DisableCompression bool
+	MinCompress        int
+	MinWAL             int64
+}
+
+// defaultMinCompress is the value of AllocatorOptions.MinCompress when it is
+// zero.
+const defaultMinCompress = 64
+
+func (o *AllocatorOptions) check() error {
+	if o.MinCompress < 0 {
+		return fmt.Errorf("(file-032) invalid allocator option MinCompress: %d", o.MinCompress)
+	}
+
+	if o.MinWAL < 0 {
+		return fmt.Errorf("(file-019) invalid allocator option MinWAL: %d", o.MinWAL)
+	}
+
+	return nil
+}
+
+func (o *AllocatorOptions) minCompress() int {
+	if o.MinCompress == 0 {
+		return defaultMinCompress
+	}
+
+	return o.MinCompress
+}
+
+func (o *AllocatorOptions) walOptions() (r []lldb.WALOption) {
+	if o.MinWAL != 0 {
+		r = append(r, lldb.MinWAL(o.MinWAL))
+	}
+	return
 }
 
 type fileBTreeIterator struct {
@@ -139,6 +623,10 @@ type fileBTreeIterator struct {
 }
 
 func (it *fileBTreeIterator) Next() (k, v []interface{}, err error) {
+	if err = it.t.collateErr(); err != nil {
+		return
+	}
+
 	bk, bv, err := it.en.Next()
 	if err != nil {
 		return
@@ -184,17 +672,22 @@ func (it *fileBTreeIterator) Next() (k, v []interface{}, err error) {
 	return
 }
 
-func enforce(val []interface{}, cols []*col) (err error) {
+// enforce converts the values in val to the types of their columns in cols.
+// The error, if any, is a *ConversionError.
+func enforce(val []interface{}, cols []*col) error {
 	for i, v := range val {
-		if val[i], err = convert(v, cols[i].typ); err != nil {
-			return
+		x, err := convert(v, cols[i].typ)
+		if err != nil {
+			return &ConversionError{Column: cols[i].name, Index: i, Value: v, Type: Type(cols[i].typ), Err: err}
 		}
+
+		val[i] = x
 	}
-	return
+	return nil
 }
 
 //NTYPE
-func infer(from []interface{}, to *[]*col) {
+func infer(from []interface{}, to *[]*col) error {
 	if len(*to) == 0 {
 		*to = make([]*col, len(from))
 		for i := range *to {
@@ -258,35 +751,108 @@ func infer(from []interface{}, to *[]*col) {
 				c.typ = qTime
 			case time.Duration:
 				c.typ = qDuration
+			case []interface{}:
+				c.typ = qArray
 			case chunk:
 				vals, err := lldb.DecodeScalars([]byte(x.b))
 				if err != nil {
-					log.Panic("err")
+					return err
 				}
 
 				if len(vals) == 0 {
-					log.Panic("internal error 040")
+					return fmt.Errorf("internal error 040")
 				}
 
 				i, ok := vals[0].(int64)
 				if !ok {
-					log.Panic("internal error 041")
+					return fmt.Errorf("internal error 041")
 				}
 
 				c.typ = int(i)
 			case map[string]interface{}: // map of ids of a cross join
 			default:
-				log.Panic("internal error 042")
+				return fmt.Errorf("internal error 042: %T", x)
 			}
 		}
 	}
+	return nil
 }
 
 type fileTemp struct {
 	*file
-	colsK []*col
-	colsV []*col
-	t     *lldb.BTree
+	colsK   []*col
+	colsV   []*col
+	collate func(a, b []byte) int
+	err     error          // First failure of the B+Tree collating function, if any.
+	mf      *lldb.MemFiler // Non nil while t is kept in memory.
+	src     *file          // The DB providing the temp file.
+	t       *lldb.BTree
+}
+
+// spill moves t from memory to a temp file.
+func (t *fileTemp) spill() (err error) {
+	f, err := t.src.getTempFile()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			t.src.putTempFile(f)
+		}
+	}()
+
+	sz, err := t.mf.Size()
+	if err != nil {
+		return
+	}
+
+	if _, err = io.Copy(f, io.NewSectionReader(t.mf, 0, sz)); err != nil {
+		return
+	}
+
+	a, err := lldb.NewAllocator(lldb.NewOSFiler(f), &lldb.Options{})
+	if err != nil {
+		return
+	}
+
+	bt, err := lldb.OpenBTree(a, t.collate, t.t.Handle())
+	if err != nil {
+		return
+	}
+
+	t.file = &file{a: a, codec: t.codec, f0: f, format: recordFormat}
+	t.t, t.mf = bt, nil
+	return
+}
+
+// checkSpill moves t to a temp file if it outgrew the spill threshold of the
+// DB.
+func (t *fileTemp) checkSpill() error {
+	if t.mf == nil {
+		return nil
+	}
+
+	sz, err := t.mf.Size()
+	if err != nil {
+		return err
+	}
+
+	if sz <= t.src.spillThreshold() {
+		return nil
+	}
+
+	return t.spill()
+}
+
+// collateErr returns the first failure of the collating function of the B+Tree
+// of t, if any.
+func (t *fileTemp) collateErr() error {
+	if t.err == nil {
+		return nil
+	}
+
+	return collateFailed(t.err)
 }
 
 func (t *fileTemp) BeginTransaction() error {
@@ -312,27 +878,161 @@ func (t *fileTemp) Get(k []interface{}) (v []interface{}, err error) {
 		return
 	}
 
+	if err = t.collateErr(); err != nil {
+		return
+	}
+
 	return lldb.DecodeScalars(bv)
 }
 
 func (t *fileTemp) Drop() (err error) {
-	if t.f0 == nil {
+	if t.f0 == nil { // Kept in memory.
+		t.mf = nil
 		return
 	}
 
-	fn := t.f0.Name()
-	if err = t.f0.Close(); err != nil {
-		return
+	return t.src.putTempFile(t.f0)
+}
+
+// tempPoolIdle is how long the pooled temp files of a DB file are kept
+// unused before they are removed.
+var tempPoolIdle = time.Minute
+
+// maxPooledTemp is the largest size of a temp file returned to the pool. A
+// larger one is removed instead.
+const maxPooledTemp = 1 << 26
+
+// getTempFile returns a temp file from the pool of s or a new one if the pool
+// is empty.
+func (s *file) getTempFile() (lldb.OSFile, error) {
+	s.tmu.Lock()
+	if n := s.maxTemps; n > 0 && s.tempFiles >= n {
+		s.tmu.Unlock()
+		return nil, &TempLimitError{Files: true, Limit: int64(n)}
+	}
+
+	s.tempFiles++
+	if n := len(s.temps); n != 0 {
+		f := s.temps[n-1]
+		s.temps = s.temps[:n-1]
+		s.tempsInUse[f] = struct{}{}
+		s.tmu.Unlock()
+		return f, nil
+	}
+
+	s.tmu.Unlock()
+	f0, err := s.tempFile("", "ql-tmp-")
+	if err != nil {
+		s.tmu.Lock()
+		s.tempFiles--
+		s.tmu.Unlock()
+		return nil, err
+	}
+
+	s.inc(MetricTempFiles, 1)
+	f := &limitedTempFile{OSFile: f0, src: s}
+	s.tmu.Lock()
+	s.tempsInUse[f] = struct{}{}
+	s.tmu.Unlock()
+	return f, nil
+}
+
+// putTempFile truncates f and returns it to the pool of s. If the pool is
+// full or f is larger than maxPooledTemp, f is closed and removed instead.
+// The pooled temp files are removed once the pool is not used for
+// tempPoolIdle.
+func (s *file) putTempFile(f lldb.OSFile) error {
+	s.tmu.Lock()
+	if _, ok := s.tempsInUse[f]; !ok { // Removed by Close.
+		s.tmu.Unlock()
+		return nil
+	}
+
+	delete(s.tempsInUse, f)
+	s.tempFiles--
+	s.tmu.Unlock()
+	if s.tempPool > 0 && pooledTemp(f) {
+		s.tmu.Lock()
+		if len(s.temps) < s.tempPool {
+			s.temps = append(s.temps, f)
+			if s.tempTimer == nil {
+				s.tempTimer = time.AfterFunc(tempPoolIdle, s.expireTemps)
+			} else {
+				s.tempTimer.Reset(tempPoolIdle)
+			}
+			s.tmu.Unlock()
+			return nil
+		}
+
+		s.tmu.Unlock()
+	}
+
+	return removeTempFile(f)
+}
+
+// pooledTemp truncates f for reuse. It reports false if f is too large to be
+// pooled or if it cannot be truncated.
+func pooledTemp(f lldb.OSFile) bool {
+	fi, err := f.Stat()
+	if err != nil || fi.Size() > maxPooledTemp || f.Truncate(0) != nil {
+		return false
+	}
+
+	_, err = f.Seek(0, 0)
+	return err == nil
+}
+
+// expireTemps removes the pooled temp files of s.
+func (s *file) expireTemps() {
+	s.tmu.Lock()
+	temps := s.temps
+	s.temps = nil
+	s.tmu.Unlock()
+	for _, f := range temps {
+		removeTempFile(f)
+	}
+}
+
+// flushTemps closes and removes the pooled temp files of s and the ones still
+// in use, for example by a query which failed before dropping its temporary
+// data.
+func (s *file) flushTemps() (err error) {
+	s.tmu.Lock()
+	if s.tempTimer != nil {
+		s.tempTimer.Stop()
+	}
+	temps := s.temps
+	for f := range s.tempsInUse {
+		temps = append(temps, f)
+	}
+	s.temps, s.tempsInUse, s.tempFiles = nil, map[lldb.OSFile]struct{}{}, 0
+	s.tmu.Unlock()
+
+	// Closing a temp file takes tmu, see limitedTempFile.
+	for _, f := range temps {
+		errSet(&err, removeTempFile(f))
+	}
+	return
+}
+
+func removeTempFile(f lldb.OSFile) error {
+	fn := f.Name()
+	if err := f.Close(); err != nil {
+		return err
 	}
 
 	if fn == "" {
-		return
+		return nil
 	}
 
 	return os.Remove(fn)
 }
 
 func (t *fileTemp) SeekFirst() (it btreeIterator, err error) {
+	if err = t.collateErr(); err != nil {
+		return
+	}
+
 	en, err := t.t.SeekFirst()
 	if err != nil {
 		return
@@ -350,8 +1050,13 @@ func (t *fileTemp) Set(k, v []interface{}) (err error) {
 		return
 	}
 
-	infer(k, &t.colsK)
-	infer(v, &t.colsV)
+	if err = infer(k, &t.colsK); err != nil {
+		return
+	}
+
+	if err = infer(v, &t.colsV); err != nil {
+		return
+	}
 
 	if err = t.flatten(k); err != nil {
 		return
@@ -371,45 +1076,174 @@ func (t *fileTemp) Set(k, v []interface{}) (err error) {
 		return
 	}
 
-	return t.t.Set(bk, bv)
+	if err = t.t.Set(bk, bv); err != nil {
+		return
+	}
+
+	if err = t.collateErr(); err != nil {
+		return
+	}
+
+	return t.checkSpill()
+}
+
+func (t *fileTemp) Create(data ...interface{}) (h int64, err error) {
+	if h, err = t.file.Create(data...); err != nil {
+		return
+	}
+
+	return h, t.checkSpill()
 }
 
 type file struct {
-	a        *lldb.Allocator
-	codec    *gobCoder
-	f        lldb.Filer
-	f0       lldb.OSFile
-	id       int64
-	lck      io.Closer
-	mu       sync.Mutex
-	name     string
-	tempFile func(dir, prefix string) (f lldb.OSFile, err error)
-	wal      *os.File
-}
-
-func newFileFromOSFile(f lldb.OSFile) (fi *file, err error) {
-	nm := lockName(f.Name())
-	lck, err := lock.Lock(nm)
-	if err != nil {
-		if lck != nil {
-			lck.Close()
-		}
-		return nil, err
+	a           *lldb.Allocator
+	broken      error // Of the transaction at level brokenTnl, see file.poison.
+	brokenTnl   int
+	codec       *valueCoder
+	dbf         *dbFiler // The DB file under f.
+	f           lldb.Filer
+	f0          lldb.OSFile
+	format      int      // Version of the record format, see recordFormat.
+	hdr         [16]byte // Guarded by mu.
+	id          int64
+	idBase      int64    // See Options.IDBase.
+	idPolicy    IDPolicy // See Options.IDPolicy.
+	lck         io.Closer
+	maxRetries  int     // See Options.MaxRetries.
+	maxTempSize int64   // See Options.MaxTempBytes.
+	maxTemps    int     // See Options.MaxTempFiles.
+	metrics     Metrics // Nil if not used.
+	minCompress int     // See AllocatorOptions.MinCompress.
+	mu          sync.Mutex
+	name        string
+	readAhead   int // See Options.ReadAhead.
+	tempFile    func(dir, prefix string) (f lldb.OSFile, err error)
+	tempPool    int                      // See Options.TempFilePoolSize.
+	tempSpill   int64                    // See Options.TempSpillThreshold.
+	tempBytes   int64                    // Size of the temp files. Guarded by tmu.
+	tempFiles   int                      // Temp files in use. Guarded by tmu.
+	tempTimer   *time.Timer              // Removes the idle pooled temp files. Guarded by tmu.
+	temps       []lldb.OSFile            // Pooled temp files. Guarded by tmu.
+	tempsInUse  map[lldb.OSFile]struct{} // Guarded by tmu.
+	tmu         sync.Mutex
+	tnl         int  // Transaction nesting level.
+	truncWAL    bool // WAL has a headroom, truncate it on Close.
+	wal         *os.File
+	walOpts     []lldb.WALOption
+}
+
+// inc adds n to the counter m of s.metrics, if any.
+func (s *file) inc(m Metric, n int64) {
+	if s.metrics != nil {
+		s.metrics.Inc(m, n)
 	}
+}
 
-	close := true
-	defer func() {
-		if close && lck != nil {
-			lck.Close()
-		}
-	}()
+// size returns the length of the content of the block h if s.metrics are
+// used, zero otherwise. It is called before freeing the block to report the
+// freed bytes.
+func (s *file) size(h int64) (int64, error) {
+	if s.metrics == nil {
+		return 0, nil
+	}
 
-	var w *os.File
-	closew := false
-	wn := walName(f.Name())
-	w, err = os.OpenFile(wn, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
-	closew = true
-	defer func() {
+	b, err := s.a.Get(nil, h)
+	return int64(len(b)), err
+}
+
+// compressFor makes s.a compress the block being written only if its content
+// of n bytes is not shorter than s.minCompress. The returned function restores
+// the setting of s.a, which applies to the pages of the B+Trees as well. s.mu
+// must be held.
+func (s *file) compressFor(n int) (restore func()) {
+	c := s.a.Compress
+	s.a.Compress = c && n >= s.minCompress
+	return func() { s.a.Compress = c }
+}
+
+// alloc allocates a block of b, see compressFor. s.mu must be held.
+func (s *file) alloc(b []byte) (int64, error) {
+	defer s.compressFor(len(b))()
+	return s.a.Alloc(b)
+}
+
+// realloc changes the content of the block h to b, see compressFor. s.mu must
+// be held.
+func (s *file) realloc(h int64, b []byte) error {
+	defer s.compressFor(len(b))()
+	return s.a.Realloc(h, b)
+}
+
+// defaultTempSpill is the size of the temporary data kept in memory when
+// Options.TempSpillThreshold is zero.
+const defaultTempSpill = 1 << 20
+
+func (s *file) spillThreshold() int64 {
+	if s.tempSpill == 0 {
+		return defaultTempSpill
+	}
+
+	return s.tempSpill
+}
+
+// maxLockBackoff is the longest delay between the attempts of lockFile.
+const maxLockBackoff = 100 * time.Millisecond
+
+// lockFile acquires the lock file nm. While the lock is held by someone else,
+// lockFile retries with an exponential backoff until wait elapses or ctx is
+// done.
+func lockFile(ctx context.Context, nm string, wait time.Duration) (io.Closer, error) {
+	deadline := time.Now().Add(wait)
+	for delay := time.Millisecond; ; delay *= 2 {
+		lck, err := lock.Lock(nm)
+		if err == nil {
+			return lck, nil
+		}
+
+		if lck != nil {
+			lck.Close()
+		}
+
+		d := deadline.Sub(time.Now())
+		if d <= 0 {
+			return nil, err
+		}
+
+		if delay > maxLockBackoff {
+			delay = maxLockBackoff
+		}
+		if d > delay {
+			d = delay
+		}
+		t := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+func newFileFromOSFile(ctx context.Context, f lldb.OSFile, opt *Options) (fi *file, err error) {
+	lck, err := lockFile(ctx, lockName(f.Name()), opt.LockWait)
+	if err != nil {
+		return nil, fmt.Errorf("(file-028) %w %s: %v", ErrLocked, f.Name(), err)
+	}
+
+	close := true
+	defer func() {
+		if close && lck != nil {
+			lck.Close()
+		}
+	}()
+
+	var w *os.File
+	closew := false
+	wn := walName(f.Name())
+	w, err = os.OpenFile(wn, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	closew = true
+	defer func() {
 		if closew {
 			nm := w.Name()
 			w.Close()
@@ -435,11 +1269,44 @@ func newFileFromOSFile(f lldb.OSFile) (fi *file, err error) {
 			return nil, err
 		}
 
-		if st.Size() != 0 {
-			return nil, fmt.Errorf("(file-001) non empty WAL file %s exists", wn)
+		if n := st.Size(); n != 0 {
+			empty, err := headroomWAL(w, n)
+			if err != nil {
+				return nil, err
+			}
+
+			if !empty {
+				return nil, fmt.Errorf(
+					"(file-001) %w %s exists: %d bytes; it may be left behind by a process which crashed during a commit or be written by another version, its contents are not used",
+					ErrStaleWAL, wn, n,
+				)
+			}
+
+			// Left behind by a process which did not close the DB after
+			// its last commit. The first commit allocates the headroom
+			// again.
+			if err = w.Truncate(0); err != nil {
+				return nil, err
+			}
 		}
 	}
 
+	if journaled(f) {
+		// The commits go through the journal of the encrypted DB file,
+		// a WAL would hold their data in plaintext.
+		nm := w.Name()
+		closew = false
+		if err = w.Close(); err != nil {
+			return nil, err
+		}
+
+		if err = os.Remove(nm); err != nil {
+			return nil, err
+		}
+
+		w = nil
+	}
+
 	info, err := f.Stat()
 	if err != nil {
 		return nil, err
@@ -449,13 +1316,17 @@ func newFileFromOSFile(f lldb.OSFile) (fi *file, err error) {
 	case sz == 0:
 		b := make([]byte, 16)
 		copy(b, []byte(magic))
+		if opt.Codec != nil {
+			copy(b[len(magic):], opt.Codec.Name())
+		}
+		binary.BigEndian.PutUint32(b[hdrAppID:], uint32(opt.ApplicationID))
 		if _, err := f.Write(b); err != nil {
 			return nil, err
 		}
 
-		filer := lldb.Filer(lldb.NewOSFiler(f))
-		filer = lldb.NewInnerFiler(filer, 16)
-		if filer, err = lldb.NewACIDFiler(filer, w); err != nil {
+		dbf := &dbFiler{Filer: newOSFiler(f, opt.ReadAhead, opt.MaxRetries)}
+		filer, err := newACIDFiler(lldb.NewInnerFiler(dbf, 16), w, opt.Allocator.walOptions())
+		if err != nil {
 			return nil, err
 		}
 
@@ -464,16 +1335,23 @@ func newFileFromOSFile(f lldb.OSFile) (fi *file, err error) {
 			return nil, err
 		}
 
-		a.Compress = true
+		a.Compress = !opt.Allocator.DisableCompression
 		s := &file{
-			a:     a,
-			codec: newGobCoder(),
-			f0:    f,
-			f:     filer,
-			lck:   lck,
-			name:  f.Name(),
-			wal:   w,
-		}
+			a:           a,
+			codec:       newValueCoder(opt.Codec),
+			dbf:         dbf,
+			f0:          f,
+			f:           filer,
+			format:      recordFormat,
+			idPolicy:    IDMonotonic,
+			lck:         lck,
+			minCompress: opt.Allocator.minCompress(),
+			name:        f.Name(),
+			wal:         w,
+		}
+		copy(s.hdr[:], b)
+		s.truncWAL, s.walOpts = opt.Allocator.MinWAL != 0, opt.Allocator.walOptions()
+		s.readAhead, s.maxRetries = opt.ReadAhead, opt.MaxRetries
 		if err = s.BeginTransaction(); err != nil {
 			return nil, err
 		}
@@ -484,7 +1362,7 @@ func newFileFromOSFile(f lldb.OSFile) (fi *file, err error) {
 		}
 
 		if h != 1 { // root
-			log.Panic("internal error 043")
+			return nil, fmt.Errorf("internal error 043")
 		}
 
 		if h, err = s.a.Alloc(make([]byte, 8)); err != nil {
@@ -492,218 +1370,717 @@ func newFileFromOSFile(f lldb.OSFile) (fi *file, err error) {
 		}
 
 		if h != 2 { // id
-			log.Panic("internal error 044")
+			return nil, fmt.Errorf("internal error 044")
 		}
 
 		close, closew = false, false
 		return s, s.Commit()
 	default:
 		b := make([]byte, 16)
-		if _, err := f.Read(b); err != nil {
+		n, err := io.ReadFull(f, b)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return nil, err
+		}
+
+		if n < len(b) || string(b[:len(magic)]) != magic {
+			return nil, fmt.Errorf(
+				"(file-002) %w: %s: read %d bytes |% x|, expected a %d byte header starting with |% x|; the file may not be a DB file, its header may be truncated by an interrupted write, or it may be written by an incompatible version",
+				ErrUnknownFormat, f.Name(), n, b[:n], len(b), magic,
+			)
+		}
+
+		g, e := strings.TrimRight(string(b[len(magic):hdrAppID]), "\x00"), gobCodec
+		if g == "" {
+			g = gobCodec
+		}
+		if opt.Codec != nil {
+			e = opt.Codec.Name()
+		}
+		if g != e {
+			return nil, fmt.Errorf("(file-025) DB file %s uses codec %q, not %q", f.Name(), g, e)
+		}
+
+		if g := int32(binary.BigEndian.Uint32(b[hdrAppID:])); opt.ApplicationID != 0 && g != opt.ApplicationID {
+			return nil, fmt.Errorf("(file-026) DB file %s has application id %d, not %d", f.Name(), g, opt.ApplicationID)
+		}
+
+		dbf := &dbFiler{Filer: newOSFiler(f, opt.ReadAhead, opt.MaxRetries)}
+		filer, err := newACIDFiler(lldb.NewInnerFiler(dbf, 16), w, opt.Allocator.walOptions())
+		if err != nil {
+			return nil, err
+		}
+
+		a, err := lldb.NewAllocator(filer, &lldb.Options{})
+		if err != nil {
+			return nil, err
+		}
+
+		id, policy, base, err := readID(a, f.Name())
+		if err != nil {
 			return nil, err
 		}
 
-		if string(b[:len(magic)]) != magic {
-			return nil, fmt.Errorf("(file-002) unknown file format")
+		format, err := readRecordFormat(a)
+		if err != nil {
+			return nil, err
+		}
+
+		a.Compress = !opt.Allocator.DisableCompression
+		s := &file{
+			a:           a,
+			codec:       newValueCoder(opt.Codec),
+			dbf:         dbf,
+			f0:          f,
+			f:           filer,
+			format:      format,
+			id:          id,
+			idBase:      base,
+			idPolicy:    policy,
+			lck:         lck,
+			minCompress: opt.Allocator.minCompress(),
+			name:        f.Name(),
+			wal:         w,
+		}
+		copy(s.hdr[:], b)
+		s.truncWAL, s.walOpts = opt.Allocator.MinWAL != 0, opt.Allocator.walOptions()
+		s.readAhead, s.maxRetries = opt.ReadAhead, opt.MaxRetries
+
+		close, closew = false, false
+		return s, nil
+	}
+}
+
+// readID returns the last id assigned by the DB file name of a, its id policy
+// and its id base, kept in the record of handle 2, see file.idRecord.
+func readID(a *lldb.Allocator, name string) (id int64, policy IDPolicy, base int64, err error) {
+	const h = 2
+	bid, err := a.Get(nil, h) // id
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("(file-035) %s: reading the id at handle %d: %w", name, h, err)
+	}
+
+	policy = IDMonotonic
+	if len(bid) == 17 {
+		policy, base = IDPolicy(bid[8]), int64(binary.BigEndian.Uint64(bid[9:]))
+	}
+	if len(bid) != 8 && len(bid) != 17 || policy < IDMonotonic || policy > IDReuseLowest || base < 0 {
+		return 0, 0, 0, fmt.Errorf(
+			"(file-003) %w: %s: handle %d: read %d bytes |% x|, expected 8 or 17 bytes; the file may be damaged, truncated by an interrupted write, or written by an incompatible version",
+			ErrCorruptID, name, h, len(bid), bid,
+		)
+	}
+
+	return int64(binary.BigEndian.Uint64(bid)), policy, base, nil
+}
+
+// idRecord returns the record of handle 2 of s. It is the last id assigned,
+// 8 bytes, followed by the id policy, 1 byte, and the id base, 8 bytes, if
+// they are not the defaults.
+func (s *file) idRecord() []byte {
+	if s.idPolicy == IDMonotonic && s.idBase == 0 {
+		b := make([]byte, 8)
+		binary.BigEndian.PutUint64(b, uint64(s.id))
+		return b
+	}
+
+	b := make([]byte, 17)
+	binary.BigEndian.PutUint64(b, uint64(s.id))
+	b[8] = byte(s.idPolicy)
+	binary.BigEndian.PutUint64(b[9:], uint64(s.idBase))
+	return b
+}
+
+// setIDPolicy makes s use the id policy and the id base, if they are not
+// IDDefault and zero, and records them in the DB file, see Options.IDPolicy.
+func (s *file) setIDPolicy(policy IDPolicy, base int64) (err error) {
+	if (policy == IDDefault || policy == s.idPolicy) && (base == 0 || base == s.idBase) {
+		return nil
+	}
+
+	if policy != IDDefault {
+		s.idPolicy = policy
+	}
+	if base != 0 {
+		s.idBase = base
+	}
+	if err = s.BeginTransaction(); err != nil {
+		return err
+	}
+
+	if err = s.a.Realloc(2, s.idRecord()); err != nil {
+		s.Rollback()
+		return err
+	}
+
+	return s.Commit()
+}
+
+// readRecordFormat returns the record format version of the DB file of a,
+// see recordFormat.
+func readRecordFormat(a *lldb.Allocator) (int, error) {
+	b, err := a.Get(nil, 1) // root
+	if err != nil {
+		return 0, err
+	}
+
+	root, err := lldb.DecodeScalars(b)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(root) < 2 {
+		return 1, nil
+	}
+
+	v, ok := root[1].(int64)
+	if !ok {
+		return 0, fmt.Errorf("(file-030) corrupted DB: root record %v", root)
+	}
+
+	if v < 1 || v > recordFormat {
+		return 0, fmt.Errorf("(file-031) %w: record format version %d, supported versions are 1 to %d", ErrUnknownFormat, v, recordFormat)
+	}
+
+	return int(v), nil
+}
+
+// decode decodes the record b according to the record format of s.
+func (s *file) decode(b []byte) ([]interface{}, error) {
+	switch s.format {
+	case 1:
+		return lldb.DecodeScalars(b)
+	default:
+		return nil, fmt.Errorf("internal error 075: record format %d", s.format)
+	}
+}
+
+func (s *file) OpenIndex(unique bool, handle int64) (btreeIndex, error) {
+	t, err := lldb.OpenBTree(s.a, s.collate, handle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileIndex{s, handle, t, unique}, nil
+}
+
+func (s *file) CreateIndex(unique bool) ( /* handle */ int64, btreeIndex, error) {
+	t, h, err := lldb.CreateBTree(s.a, s.collate)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	return h, &fileIndex{s, h, t, unique}, nil
+}
+
+func (s *file) Acid() bool { return s.wal != nil || journaled(s.f0) }
+
+func errSet(p *error, errs ...error) (err error) {
+	err = *p
+	for _, e := range errs {
+		if err != nil {
+			return
+		}
+		*p, err = e, e
+	}
+	return
+}
+
+func (s *file) lock() func() {
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+func (s *file) Close() (err error) {
+	defer s.lock()()
+
+	es := s.f0.Sync()
+	ef := s.f0.Close()
+	var et, ew error
+	if s.wal != nil {
+		if s.truncWAL {
+			et = s.wal.Truncate(0)
+		}
+		ew = s.wal.Close()
+	}
+	el := s.lck.Close()
+	ep := s.flushTemps()
+	return errSet(&err, es, ef, et, ew, el, ep)
+}
+
+func (s *file) Name() string { return s.name }
+
+func (s *file) Sync() (err error) {
+	defer s.lock()()
+	if err = s.f0.Sync(); err != nil || s.wal == nil {
+		return err
+	}
+
+	return s.wal.Sync()
+}
+
+func (s *file) Size() (int64, error) {
+	fi, err := s.f0.Stat()
+	if err != nil {
+		return -1, err
+	}
+
+	return fi.Size(), nil
+}
+
+func (s *file) Verify() (allocs int64, err error) {
+	defer s.lock()()
+	var stat lldb.AllocStats
+	if err = s.a.Verify(lldb.NewMemFiler(), nil, &stat); err != nil {
+		return
+	}
+
+	allocs = stat.AllocAtoms
+	return
+}
+
+func (s *file) Header(off int) int32 {
+	defer s.lock()()
+	return int32(binary.BigEndian.Uint32(s.hdr[off:]))
+}
+
+func (s *file) SetHeader(off int, v int32) error {
+	defer s.lock()()
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(v))
+	if _, err := s.f0.WriteAt(b[:], int64(off)); err != nil {
+		return err
+	}
+
+	if err := s.f0.Sync(); err != nil {
+		return err
+	}
+
+	copy(s.hdr[off:], b[:])
+	return nil
+}
+
+func (s *file) FreeSpace() (*SpaceInfo, error) {
+	defer s.lock()()
+	var stat lldb.AllocStats
+	if err := s.a.Verify(lldb.NewMemFiler(), nil, &stat); err != nil {
+		return nil, err
+	}
+
+	r := &SpaceInfo{TotalAtoms: stat.TotalAtoms, FreeAtoms: stat.FreeAtoms}
+	for atoms, n := range stat.FreeMap {
+		if atoms > r.LargestFreeAtoms {
+			r.LargestFreeAtoms = atoms
+		}
+		r.FreeBlocks += n
+	}
+	if r.FreeAtoms != 0 {
+		r.Fragmentation = 1 - float64(r.LargestFreeAtoms)/float64(r.FreeAtoms)
+	}
+	return r, nil
+}
+
+func (s *file) expandBytes(d []interface{}) (err error) {
+	for i, v := range d {
+		b, ok := v.([]byte)
+		if !ok {
+			continue
+		}
+
+		d[i], err = s.loadChunks(b)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// collateError is the panic value of file.collate when it fails to decode a
+// B+Tree key. The collating function of a lldb B+Tree cannot return an error,
+// so the B+Tree operations of the file back end recover the panic and return
+// the error instead.
+type collateError struct {
+	err error
+}
+
+// recoverCollate converts a collateError panic to an error returned in *err.
+// It must be deferred directly.
+func recoverCollate(err *error) {
+	switch x := recover().(type) {
+	case nil:
+		// nop
+	case collateError:
+		*err = collateFailed(x.err)
+	default:
+		panic(x)
+	}
+}
+
+// recoverUpdate is recoverCollate of the B+Tree operations updating the DB
+// file. The panic may leave the B+Tree half updated, so the current
+// transaction is poisoned. It must be deferred directly.
+func (s *file) recoverUpdate(err *error) {
+	switch x := recover().(type) {
+	case nil:
+		// nop
+	case collateError:
+		*err = collateFailed(x.err)
+		s.poison(*err)
+	default:
+		panic(x)
+	}
+}
+
+// poison makes the updates and the commit of the current transaction fail
+// with err, it can only be rolled back. Committing it rolls it back instead.
+func (s *file) poison(err error) {
+	defer s.lock()()
+	if s.broken == nil {
+		s.broken, s.brokenTnl = fmt.Errorf("(file-038) transaction must be rolled back: %v", err), s.tnl
+	}
+}
+
+// poisoned returns the error of the poisoned transaction, if any.
+func (s *file) poisoned() error {
+	defer s.lock()()
+	return s.broken
+}
+
+func collateFailed(err error) error {
+	return fmt.Errorf("(file-021) corrupted DB: cannot collate B+Tree keys: %v", err)
+}
+
+func (s *file) collate(a, b []byte) int {
+	r, err := s.collateKeys(a, b)
+	if err != nil {
+		panic(collateError{err})
+	}
+
+	return r
+}
+
+// collateKeys compares the encoded B+Tree keys a and b.
+func (s *file) collateKeys(a, b []byte) (int, error) {
+	da, err := lldb.DecodeScalars(a)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = s.expandBytes(da); err != nil {
+		return 0, err
+	}
+
+	db, err := lldb.DecodeScalars(b)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = s.expandBytes(db); err != nil {
+		return 0, err
+	}
+
+	return collate(da, db), nil
+}
+
+func (s *file) CreateTemp(asc bool) (bt temp, err error) {
+	mf := lldb.NewMemFiler()
+	a, err := lldb.NewAllocator(mf, &lldb.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	k := 1
+	if !asc {
+		k = -1
+	}
+
+	x := &fileTemp{
+		file: &file{
+			a:      a,
+			codec:  s.codec,
+			format: recordFormat,
+		},
+		mf:  mf,
+		src: s,
+	}
+	x.collate = func(a, b []byte) int {
+		r, err := s.collateKeys(a, b)
+		if err != nil && x.err == nil {
+			x.err = err
+		}
+		return k * r
+	}
+	if x.t, _, err = lldb.CreateBTree(a, x.collate); err != nil {
+		return nil, err
+	}
+
+	if s.tempSpill < 0 {
+		if err = x.spill(); err != nil {
+			return nil, err
 		}
+	}
+	return x, nil
+}
+
+func (s *file) BeginTransaction() (err error) {
+	defer s.lock()()
+	if s.broken != nil {
+		return s.broken
+	}
+
+	if err = s.f.BeginUpdate(); err == nil {
+		s.tnl++
+	}
+	return
+}
+
+func (s *file) Rollback() (err error) {
+	defer s.lock()()
+	s.tnl--
+	if err = s.f.Rollback(); err != nil {
+		return
+	}
+
+	if s.tnl < s.brokenTnl {
+		s.broken, s.brokenTnl = nil, 0
+	}
+	s.inc(MetricRollbacks, 1)
+	if s.tnl != 0 {
+		return
+	}
 
-		filer := lldb.Filer(lldb.NewOSFiler(f))
-		filer = lldb.NewInnerFiler(filer, 16)
-		if filer, err = lldb.NewACIDFiler(filer, w); err != nil {
-			return nil, err
-		}
+	// Ids assigned by the transaction are not assigned again, but
+	// restarting the ids is undone.
+	id, _, _, err := readID(s.a, s.name)
+	if err == nil && id > s.id {
+		s.id = id
+	}
+	return
+}
 
-		a, err := lldb.NewAllocator(filer, &lldb.Options{})
-		if err != nil {
-			return nil, err
+// Commit commits the current transaction. If committing the outermost
+// transaction fails, for example because the volume is full, the transaction
+// is rolled back and the DB remains usable. A poisoned transaction is rolled
+// back, see file.poison.
+func (s *file) Commit() (err error) {
+	defer s.lock()()
+	if err = s.broken; err != nil {
+		s.tnl--
+		if e := s.f.Rollback(); e != nil {
+			return fmt.Errorf("%v; (file-022) rollback: %v", err, e)
 		}
 
-		bid, err := a.Get(nil, 2) // id
-		if err != nil {
-			return nil, err
+		if s.tnl < s.brokenTnl {
+			s.broken, s.brokenTnl = nil, 0
 		}
+		s.inc(MetricRollbacks, 1)
+		return
+	}
 
-		if len(bid) != 8 {
-			return nil, fmt.Errorf("(file-003) corrupted DB: id |% x|", bid)
-		}
+	var sz0 int64
+	if s.tnl == 1 {
+		if sz0, err = s.reserve(); err != nil {
+			s.tnl--
+			if e := s.f.Rollback(); e != nil {
+				return fmt.Errorf("%v; (file-022) rollback: %v", err, e)
+			}
 
-		id := int64(0)
-		for _, v := range bid {
-			id = (id << 8) | int64(v)
+			s.inc(MetricRollbacks, 1)
+			return
 		}
+	}
 
-		a.Compress = true
-		s := &file{
-			a:     a,
-			codec: newGobCoder(),
-			f0:    f,
-			f:     filer,
-			id:    id,
-			lck:   lck,
-			name:  f.Name(),
-			wal:   w,
+	s.tnl--
+	s.dbf.written = false
+	if err = s.f.EndUpdate(); err != nil {
+		if s.tnl == 0 {
+			if e := s.reset(sz0); e != nil {
+				return fmt.Errorf("%v; (file-023) cannot recover from a failed commit: %v", err, e)
+			}
 		}
-
-		close, closew = false, false
-		return s, nil
+		return
 	}
+
+	s.inc(MetricCommits, 1)
+	return
 }
 
-func (s *file) OpenIndex(unique bool, handle int64) (btreeIndex, error) {
-	t, err := lldb.OpenBTree(s.a, s.collate, handle)
+// reserve grows the DB file to the size it will have once the current
+// transaction is committed and returns its previous size. Running out of disk
+// space is then detected before the commit starts modifying the DB file,
+// while the transaction can still be rolled back.
+func (s *file) reserve() (sz0 int64, err error) {
+	sz, err := s.f.Size()
 	if err != nil {
-		return nil, err
+		return
 	}
 
-	return &fileIndex{s, handle, t, unique}, nil
-}
-
-func (s *file) CreateIndex(unique bool) ( /* handle */ int64, btreeIndex, error) {
-	t, h, err := lldb.CreateBTree(s.a, s.collate)
+	fi, err := s.f0.Stat()
 	if err != nil {
-		return -1, nil, err
+		return
 	}
 
-	return h, &fileIndex{s, h, t, unique}, nil
-}
-
-func (s *file) Acid() bool { return s.wal != nil }
+	sz += 16 // See newFileFromOSFile.
+	if sz0 = fi.Size(); sz <= sz0 {
+		return
+	}
 
-func errSet(p *error, errs ...error) (err error) {
-	err = *p
-	for _, e := range errs {
-		if err != nil {
+	b := make([]byte, mathutil.MinInt64(sz-sz0, 1<<16))
+	for off := sz0; off < sz; {
+		n := int(mathutil.MinInt64(int64(len(b)), sz-off))
+		if _, err = s.f0.WriteAt(b[:n], off); err != nil {
+			s.f0.Truncate(sz0)
 			return
 		}
-		*p, err = e, e
+
+		off += int64(n)
 	}
 	return
 }
 
-func (s *file) lock() func() {
-	s.mu.Lock()
-	return s.mu.Unlock
-}
-
-func (s *file) Close() (err error) {
-	defer s.lock()()
-
-	es := s.f0.Sync()
-	ef := s.f0.Close()
-	var ew error
-	if s.wal != nil {
-		ew = s.wal.Close()
+// reset replaces the filer and the allocator of s after a failed commit of the
+// outermost transaction. If the WAL holds the complete transaction, it is
+// applied to the DB file. Otherwise the WAL is discarded. The DB file is
+// truncated to sz0, its size before reserve grew it, if the commit failed
+// before writing it, applying the WAL grows it again.
+func (s *file) reset(sz0 int64) (err error) {
+	if s.wal == nil {
+		return s.resetJournaled()
 	}
-	el := s.lck.Close()
-	return errSet(&err, es, ef, ew, el)
-}
 
-func (s *file) Name() string { return s.name }
+	if !s.dbf.written {
+		if err = s.f0.Truncate(sz0); err != nil {
+			return
+		}
+	}
 
-func (s *file) Verify() (allocs int64, err error) {
-	defer s.lock()()
-	var stat lldb.AllocStats
-	if err = s.a.Verify(lldb.NewMemFiler(), nil, &stat); err != nil {
+	if _, err = s.wal.Seek(0, 0); err != nil {
 		return
 	}
 
-	allocs = stat.AllocAtoms
-	return
-}
-
-func (s *file) expandBytes(d []interface{}) (err error) {
-	for i, v := range d {
-		b, ok := v.([]byte)
-		if !ok {
-			continue
-		}
-
-		d[i], err = s.loadChunks(b)
-		if err != nil {
+	f, err := s.newFiler()
+	if _, ok := err.(*lldb.ErrILSEQ); ok || err == io.EOF || err == io.ErrUnexpectedEOF {
+		// The WAL does not hold the complete transaction.
+		if err = s.discardWAL(); err != nil {
 			return
 		}
+
+		f, err = s.newFiler()
+	}
+	if err != nil {
+		return
 	}
-	return
-}
 
-func (s *file) collate(a, b []byte) int { //TODO w/ error return
-	da, err := lldb.DecodeScalars(a)
+	a, err := lldb.NewAllocator(f, &lldb.Options{})
 	if err != nil {
-		log.Panic(err)
+		return
 	}
 
-	if err = s.expandBytes(da); err != nil {
-		log.Panic(err)
+	a.Compress = s.a.Compress
+	s.a, s.f = a, f
+	return
+}
+
+// resetJournaled is reset of an encrypted DB file having no WAL. Its journal
+// holds the complete transaction or nothing, see EncryptedOSFile.
+func (s *file) resetJournaled() (err error) {
+	if err = s.f0.(*encFile).recover(); err != nil {
+		return
 	}
 
-	db, err := lldb.DecodeScalars(b)
+	f, err := s.newFiler()
 	if err != nil {
-		log.Panic(err)
+		return
 	}
 
-	if err = s.expandBytes(db); err != nil {
-		log.Panic(err)
+	a, err := lldb.NewAllocator(f, &lldb.Options{})
+	if err != nil {
+		return
 	}
 
-	return collate(da, db)
+	a.Compress = s.a.Compress
+	s.a, s.f = a, f
+	return
 }
 
-func (s *file) CreateTemp(asc bool) (bt temp, err error) {
-	f, err := s.tempFile("", "ql-tmp-")
+// newFiler returns a new ACID filer of the DB file. See newFileFromOSFile.
+func (s *file) newFiler() (lldb.Filer, error) {
+	dbf := &dbFiler{Filer: newOSFiler(s.f0, s.readAhead, s.maxRetries)}
+	f, err := newACIDFiler(lldb.NewInnerFiler(dbf, 16), s.wal, s.walOpts)
 	if err != nil {
 		return nil, err
 	}
 
-	fn := f.Name()
-	filer := lldb.NewOSFiler(f)
-	a, err := lldb.NewAllocator(filer, &lldb.Options{})
-	if err != nil {
-		f.Close()
-		os.Remove(fn)
-		return nil, err
+	// Reading a WAL holding only its headroom does not rewind it, but the
+	// next commit must overwrite the packet at its start, see headroomWAL.
+	if s.wal != nil {
+		if _, err = s.wal.Seek(0, 0); err != nil {
+			return nil, err
+		}
 	}
 
-	k := 1
-	if !asc {
-		k = -1
-	}
+	s.dbf = dbf
+	return f, nil
+}
 
-	t, _, err := lldb.CreateBTree(a, func(a, b []byte) int { //TODO w/ error return
-		return k * s.collate(a, b)
-	})
-	if err != nil {
-		f.Close()
-		if fn != "" {
-			os.Remove(fn)
-		}
-		return nil, err
+// newACIDFiler returns the ACID filer of db. The commits go through the WAL w
+// or, if w is nil, through the journal of the encrypted DB file below db,
+// which a Sync of db writes, see EncryptedOSFile.
+func newACIDFiler(db lldb.Filer, w *os.File, opts []lldb.WALOption) (lldb.Filer, error) {
+	if w != nil {
+		return lldb.NewACIDFiler(db, w, opts...)
 	}
 
-	x := &fileTemp{file: &file{
-		a:     a,
-		codec: newGobCoder(),
-		f0:    f,
-	},
-		t: t}
-	return x, nil
+	return lldb.NewRollbackFiler(
+		db,
+		func(sz int64) error {
+			if err := db.Truncate(sz); err != nil {
+				return err
+			}
+
+			return db.Sync()
+		},
+		db,
+	)
 }
 
-func (s *file) BeginTransaction() (err error) {
-	defer s.lock()()
-	return s.f.BeginUpdate()
+// journaled reports whether f is an encrypted DB file having a journal.
+func journaled(f lldb.OSFile) bool {
+	ef, ok := f.(*encFile)
+	return ok && ef.journal != nil
 }
 
-func (s *file) Rollback() (err error) {
-	defer s.lock()()
-	return s.f.Rollback()
+// dbFiler is the Filer of the DB file under the ACID filer of a file. It
+// records whether the DB file was written, see file.reset.
+type dbFiler struct {
+	lldb.Filer
+	written bool
 }
 
-func (s *file) Commit() (err error) {
-	defer s.lock()()
-	return s.f.EndUpdate()
+func (f *dbFiler) WriteAt(b []byte, off int64) (int, error) {
+	f.written = true
+	return f.Filer.WriteAt(b, off)
+}
+
+func (f *dbFiler) Truncate(size int64) error {
+	f.written = true
+	return f.Filer.Truncate(size)
+}
+
+// discardWAL truncates an incomplete WAL.
+func (s *file) discardWAL() (err error) {
+	if err = s.wal.Truncate(0); err != nil {
+		return
+	}
+
+	_, err = s.wal.Seek(0, 0)
+	return
 }
 
 func (s *file) Create(data ...interface{}) (h int64, err error) {
+	if err = s.poisoned(); err != nil {
+		return
+	}
+
 	if err = expand(data); err != nil {
 		return
 	}
@@ -718,36 +2095,46 @@ func (s *file) Create(data ...interface{}) (h int64, err error) {
 	}
 
 	defer s.lock()()
-	return s.a.Alloc(b)
+	if h, err = s.alloc(b); err == nil {
+		s.inc(MetricBytesAllocated, int64(len(b)))
+	}
+	return
 }
 
 func (s *file) Delete(h int64, blobCols ...*col) (err error) {
+	if err = s.poisoned(); err != nil {
+		return
+	}
+
 	switch len(blobCols) {
 	case 0:
 		defer s.lock()()
-		return s.a.Free(h)
+		return s.freeBlock(h)
 	default:
 		return s.free(h, blobCols)
 	}
 }
 
 func (s *file) ResetID() (err error) {
+	if s.idPolicy != IDMonotonic {
+		return nil
+	}
+
+	defer s.lock()()
 	s.id = 0
-	return
+	return s.a.Realloc(2, s.idRecord())
 }
 
+func (s *file) IDPolicy() (IDPolicy, int64) { return s.idPolicy, s.idBase }
+
 func (s *file) ID() (int64, error) {
 	defer s.lock()()
 
-	s.id++
-	b := make([]byte, 8)
-	id := s.id
-	for i := 7; i >= 0; i-- {
-		b[i] = byte(id)
-		id >>= 8
+	if s.id < s.idBase-1 {
+		s.id = s.idBase - 1
 	}
-
-	return s.id, s.a.Realloc(2, b)
+	s.id++
+	return s.id, s.a.Realloc(2, s.idRecord())
 }
 
 func (s *file) free(h int64, blobCols []*col) (err error) {
@@ -758,14 +2145,14 @@ func (s *file) free(h int64, blobCols []*col) (err error) {
 		return
 	}
 
-	rec, err := lldb.DecodeScalars(b)
+	rec, err := s.decode(b)
 	if err != nil {
 		return
 	}
 
 	for _, col := range blobCols {
-		if col.index >= len(rec) {
-			return fmt.Errorf("(file-004) file.free: corrupted DB (record len)")
+		if col.index+2 >= len(rec) { // Column added after the record was written.
+			continue
 		}
 
 		switch x := rec[col.index+2].(type) {
@@ -778,59 +2165,156 @@ func (s *file) free(h int64, blobCols []*col) (err error) {
 		}
 	}
 	defer s.lock()()
-	return s.a.Free(h)
+	if err = s.a.Free(h); err == nil {
+		s.inc(MetricBytesFreed, int64(len(b)))
+	}
+	return
+}
+
+// freeBlock frees the block h. s.mu must be held.
+func (s *file) freeBlock(h int64) (err error) {
+	n, err := s.size(h)
+	if err != nil {
+		return
+	}
+
+	if err = s.a.Free(h); err == nil {
+		s.inc(MetricBytesFreed, n)
+	}
+	return
+}
+
+func (s *file) Read(dst []interface{}, h int64, cols ...*col) (data []interface{}, err error) {
+	if data, err = s.read(dst, h, cols...); err == nil && len(cols) != 0 {
+		s.inc(MetricRowsRead, 1)
+	}
+	return
+}
+
+// dictValue returns the value of the DICTIONARY column c of the record
+// having the handle h, which stores the code v of the value, see dictionary.
+func (s *file) dictValue(h int64, c *col, v interface{}) (interface{}, error) {
+	code, ok := v.(int64)
+	if !ok {
+		return v, nil
+	}
+
+	rec, err := s.read(nil, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rec) != 2 {
+		return nil, fmt.Errorf("(file-037) corrupted DB: handle %d, column %s: invalid dictionary value of handle %d", h, c.name, code)
+	}
+
+	return rec[0], nil
 }
 
-func (s *file) Read(dst []interface{}, h int64, cols ...*col) (data []interface{}, err error) { //NTYPE
+// readBufs pools the buffers receiving the blocks read by file.read. The
+// decoded values do not refer to the buffer, so it is reusable once the block
+// is decoded.
+var readBufs = sync.Pool{New: func() interface{} {
+	b := make([]byte, 0, 256)
+	return &b
+}}
+
+func (s *file) read(dst []interface{}, h int64, cols ...*col) (data []interface{}, err error) { //NTYPE
+	pb := readBufs.Get().(*[]byte)
 	s.mu.Lock()
-	b, err := s.a.Get(nil, h) //LATER +bufs
+	b, err := s.a.Get(*pb, h)
 	s.mu.Unlock()
 	if err != nil {
+		readBufs.Put(pb)
 		return
 	}
 
-	rec, err := lldb.DecodeScalars(b)
+	rec, err := s.decode(b)
+	if cap(b) > cap(*pb) { // Get allocated a larger buffer, keep it.
+		*pb = b[:0]
+	}
+	readBufs.Put(pb)
 	if err != nil {
 		return
 	}
 
 	for _, col := range cols {
 		i := col.index + 2
+		if i >= len(rec) || rec[i] == nil { // NULL or column added after the record was written
+			continue
+		}
+
+		if col.dict {
+			if rec[i], err = s.dictValue(h, col, rec[i]); err != nil {
+				return nil, err
+			}
+		}
+
+		v, ok := rec[i], true
 		switch col.typ {
 		case 0:
 		case qBool:
+			_, ok = v.(bool)
 		case qComplex64:
-			rec[i] = complex64(rec[i].(complex128))
+			var x complex128
+			if x, ok = v.(complex128); ok {
+				rec[i] = complex64(x)
+			}
 		case qComplex128:
+			_, ok = v.(complex128)
 		case qFloat32:
-			rec[i] = float32(rec[i].(float64))
+			var x float64
+			if x, ok = v.(float64); ok {
+				rec[i] = float32(x)
+			}
 		case qFloat64:
+			_, ok = v.(float64)
 		case qInt8:
-			rec[i] = int8(rec[i].(int64))
+			var x int64
+			if x, ok = v.(int64); ok {
+				rec[i] = int8(x)
+			}
 		case qInt16:
-			rec[i] = int16(rec[i].(int64))
+			var x int64
+			if x, ok = v.(int64); ok {
+				rec[i] = int16(x)
+			}
 		case qInt32:
-			rec[i] = int32(rec[i].(int64))
+			var x int64
+			if x, ok = v.(int64); ok {
+				rec[i] = int32(x)
+			}
 		case qInt64:
+			_, ok = v.(int64)
 		case qString:
+			_, ok = v.(string)
 		case qUint8:
-			rec[i] = uint8(rec[i].(uint64))
+			var x uint64
+			if x, ok = v.(uint64); ok {
+				rec[i] = uint8(x)
+			}
 		case qUint16:
-			rec[i] = uint16(rec[i].(uint64))
+			var x uint64
+			if x, ok = v.(uint64); ok {
+				rec[i] = uint16(x)
+			}
 		case qUint32:
-			rec[i] = uint32(rec[i].(uint64))
+			var x uint64
+			if x, ok = v.(uint64); ok {
+				rec[i] = uint32(x)
+			}
 		case qUint64:
-		case qBlob, qBigInt, qBigRat, qTime, qDuration:
-			switch x := rec[i].(type) {
-			case nil:
-				rec[i] = nil
-			case []byte:
+			_, ok = v.(uint64)
+		case qBlob, qBigInt, qBigRat, qTime, qDuration, qArray:
+			var x []byte
+			if x, ok = v.([]byte); ok {
 				rec[i] = chunk{f: s, b: x}
-			default:
-				return nil, fmt.Errorf("(file-006) corrupted DB: non nil chunk type is not []byte")
 			}
 		default:
-			log.Panic("internal error 045")
+			return nil, fmt.Errorf("(file-020) corrupted DB: handle %d, column %s: unknown type tag %d", h, col.name, col.typ)
+		}
+		if !ok {
+			return nil, fmt.Errorf("(file-006) corrupted DB: handle %d, column %s: value of type %T is not %s", h, col.name, v, typeStr(col.typ))
 		}
 	}
 
@@ -894,6 +2378,7 @@ func (s *file) freeChunks(enc []byte) (err error) {
 		}
 
 		s.mu.Unlock()
+		s.inc(MetricBytesFreed, int64(len(b)))
 		next = h
 	}
 	return
@@ -964,13 +2449,26 @@ func (s *file) loadChunks(enc []byte) (v interface{}, err error) {
 }
 
 func (s *file) Update(h int64, data ...interface{}) (err error) {
+	if err = s.poisoned(); err != nil {
+		return
+	}
+
 	b, err := lldb.EncodeScalars(data...)
 	if err != nil {
 		return
 	}
 
 	defer s.lock()()
-	return s.a.Realloc(h, b)
+	n, err := s.size(h)
+	if err != nil {
+		return
+	}
+
+	if err = s.realloc(h, b); err == nil {
+		s.inc(MetricBytesFreed, n)
+		s.inc(MetricBytesAllocated, int64(len(b)))
+	}
+	return
 }
 
 func (s *file) UpdateRow(h int64, blobCols []*col, data ...interface{}) (err error) {
@@ -978,17 +2476,26 @@ func (s *file) UpdateRow(h int64, blobCols []*col, data ...interface{}) (err err
 		return s.Update(h, data...)
 	}
 
+	if err = s.poisoned(); err != nil {
+		return
+	}
+
 	if err = expand(data); err != nil {
 		return
 	}
 
-	data0, err := s.Read(nil, h, blobCols...)
+	data0, err := s.read(nil, h, blobCols...)
 	if err != nil {
 		return
 	}
 
 	for _, c := range blobCols {
-		if err = s.freeChunks(data0[c.index+2].(chunk).b); err != nil {
+		x, ok := data0[c.index+2].(chunk)
+		if !ok { // NULL
+			continue
+		}
+
+		if err = s.freeChunks(x.b); err != nil {
 			return
 		}
 	}
@@ -1004,31 +2511,16 @@ func (s *file) UpdateRow(h int64, blobCols []*col, data ...interface{}) (err err
 // + long blobs are (pre)written to a chain of chunks.
 func (s *file) flatten(data []interface{}) (err error) {
 	for i, v := range data {
-		tag := 0
+		var tag int
 		var b []byte
-		switch x := v.(type) {
-		case []byte:
-			tag = qBlob
-			b = x
-		case *big.Int:
-			tag = qBigInt
-			b, err = s.codec.encode(x)
-		case *big.Rat:
-			tag = qBigRat
-			b, err = s.codec.encode(x)
-		case time.Time:
-			tag = qTime
-			b, err = s.codec.encode(x)
-		case time.Duration:
-			tag = qDuration
-			b, err = s.codec.encode(x)
-		default:
-			continue
-		}
-		if err != nil {
+		if tag, b, err = s.tag(v); err != nil {
 			return
 		}
 
+		if tag == 0 {
+			continue
+		}
+
 		const chunk = 1 << 16
 		chunks := 0
 		var next int64
@@ -1049,12 +2541,14 @@ func (s *file) flatten(data []interface{}) (err error) {
 			}
 
 			s.mu.Lock()
-			h, err := s.a.Alloc(buf)
+			h, err := s.alloc(buf)
 			s.mu.Unlock()
 			if err != nil {
 				return err
 			}
 
+			s.inc(MetricBytesAllocated, int64(len(buf)))
+
 			next = h
 			chunks++
 		}
@@ -1074,6 +2568,42 @@ func (s *file) flatten(data []interface{}) (err error) {
 	return
 }
 
+// tag returns the type tag and the encoding of v if v is not a lldb scalar
+// type, see flatten. Otherwise tag is zero.
+func (s *file) tag(v interface{}) (tag int, b []byte, err error) {
+	switch x := v.(type) {
+	case []byte:
+		return qBlob, x, nil
+	case *big.Int:
+		tag = qBigInt
+	case *big.Rat:
+		tag = qBigRat
+	case time.Time:
+		tag = qTime
+	case time.Duration:
+		tag = qDuration
+	case []interface{}:
+		tag = qArray
+	default:
+		return 0, nil, nil
+	}
+
+	b, err = s.codec.encode(v)
+	return tag, b, err
+}
+
+// flattenKey returns v like flatten does, but without writing any chunks,
+// for seeking an index. Collation expands the chunks of the keys of the index,
+// so a long value compares equal to its chunked copy.
+func (s *file) flattenKey(v interface{}) (interface{}, error) {
+	tag, b, err := s.tag(v)
+	if tag == 0 || err != nil {
+		return v, err
+	}
+
+	return lldb.EncodeScalars(tag, b)
+}
+
 func lockName(dbname string) string {
 	base := filepath.Base(filepath.Clean(dbname)) + "lockfile"
 	h := sha1.New()
@@ -1081,6 +2611,33 @@ func lockName(dbname string) string {
 	return filepath.Join(filepath.Dir(dbname), fmt.Sprintf(".%x", h.Sum(nil)))
 }
 
+// walEmpty is the tag of the WAL packet written by lldb at the start of an
+// emptied WAL having a headroom, see AllocatorOptions.MinWAL.
+const walEmpty = 3
+
+// headroomWAL reports whether the n bytes of the WAL w start with the packet
+// tagged walEmpty, written when the WAL is emptied down to its headroom after
+// a commit. The rest of the headroom is not used. A commit overwrites the
+// packet before it writes the DB file, so the DB file is consistent.
+func headroomWAL(w *os.File, n int64) (bool, error) {
+	if n%16 != 0 {
+		return false, nil
+	}
+
+	var b [16]byte
+	if _, err := w.ReadAt(b[:], 0); err != nil {
+		return false, err
+	}
+
+	ln := binary.BigEndian.Uint32(b[:])
+	if ln == 0 || ln > 12 { // The packet is padded to 16 bytes.
+		return false, nil
+	}
+
+	items, err := lldb.DecodeScalars(b[4 : 4+ln])
+	return err == nil && len(items) == 1 && items[0] == int64(walEmpty), nil
+}
+
 func walName(dbname string) (r string) {
 	base := filepath.Base(filepath.Clean(dbname))
 	h := sha1.New()
@@ -1110,7 +2667,13 @@ func init() {
 
 // The []byte version of the key in the BTree shares chunks, if any, with
 // the value stored in the record.
-func (x *fileIndex) Create(indexedValue interface{}, h int64) error {
+func (x *fileIndex) Create(indexedValue interface{}, h int64) (err error) {
+	if err = x.f.poisoned(); err != nil {
+		return
+	}
+
+	defer x.f.recoverUpdate(&err)
+
 	t := x.t
 	switch {
 	case !x.unique:
@@ -1149,7 +2712,13 @@ func (x *fileIndex) Create(indexedValue interface{}, h int64) error {
 	}
 }
 
-func (x *fileIndex) Delete(indexedValue interface{}, h int64) error {
+func (x *fileIndex) Delete(indexedValue interface{}, h int64) (err error) {
+	if err = x.f.poisoned(); err != nil {
+		return
+	}
+
+	defer x.f.recoverUpdate(&err)
+
 	chunk, ok := indexedValue.(chunk)
 	if ok {
 		indexedValue = chunk.b
@@ -1157,7 +2726,6 @@ func (x *fileIndex) Delete(indexedValue interface{}, h int64) error {
 
 	t := x.t
 	var k []byte
-	var err error
 	switch {
 	case !x.unique:
 		k, err = lldb.EncodeScalars(indexedValue, h)
@@ -1181,7 +2749,13 @@ func (x *fileIndex) Drop() error {
 	return x.f.a.Free(x.h)
 }
 
-func (x *fileIndex) Seek(indexedValue interface{}) (indexIterator, bool, error) { //TODO(indices) blobs: +test
+func (x *fileIndex) Seek(indexedValue interface{}) (_ indexIterator, _ bool, err error) { //TODO(indices) blobs: +test
+	defer recoverCollate(&err)
+
+	if indexedValue, err = x.f.flattenKey(indexedValue); err != nil {
+		return nil, false, err
+	}
+
 	k, err := lldb.EncodeScalars(indexedValue, 0)
 	if err != nil {
 		return nil, false, err
@@ -0,0 +1,37 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+// ForEach calls f with the id() and the values of the columns of every row of
+// table, in the order of a table scan, until f returns an error. ForEach then
+// returns that error. The id() of the rows of a table WITHOUT ROWID is zero.
+//
+// The rows are read from the storage one at a time while the DB is locked as
+// by Recordset.Do, so the memory used does not grow with the number of rows.
+// The DB should not be used by f, otherwise it may deadlock. The row passed
+// to f may be reused once f returns.
+func (db *DB) ForEach(table string, f func(id int64, row []interface{}) error) error {
+	r := recordset{ctx: newExecCtx(db, nil, nil), rset: tableRset(table)}
+	return db.locked(r, func(ctx *execCtx) error {
+		ok := false
+		return r.do(ctx, false, func(id interface{}, data []interface{}) (more bool, err error) {
+			if !ok { // The field names.
+				ok = true
+				return true, nil
+			}
+
+			if err = expand(data); err != nil {
+				return false, err
+			}
+
+			n, _ := id.(int64)
+			if err = f(n, data); err != nil {
+				return false, err
+			}
+
+			return true, nil
+		})
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"strings"
+	"unicode"
+)
+
+var _ btreeIndex = fulltextIndex{}
+
+// fulltextIndex is an inverted index of the words of string values. The keys
+// of the underlying non unique index are the distinct words of the indexed
+// values.
+type fulltextIndex struct {
+	btreeIndex
+}
+
+func (x fulltextIndex) Create(indexedValue interface{}, h int64) error {
+	s, _ := indexedValue.(string)
+	for _, w := range words(s) {
+		if err := x.btreeIndex.Create(w, h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x fulltextIndex) Delete(indexedValue interface{}, h int64) error {
+	s, _ := indexedValue.(string)
+	for _, w := range words(s) {
+		if err := x.btreeIndex.Delete(w, h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// words returns the distinct words of s, in the order of their first
+// occurrence. Words are the lower cased maximal sequences of letters and
+// digits.
+func words(s string) (r []string) {
+	m := map[string]bool{}
+	for _, w := range strings.FieldsFunc(strings.ToLower(s), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	}) {
+		if !m[w] {
+			m[w] = true
+			r = append(r, w)
+		}
+	}
+	return
+}
+
+// matchWords reports whether all words of query are words of s. A query
+// having no words matches nothing.
+func matchWords(s, query string) bool {
+	q := words(query)
+	if len(q) == 0 {
+		return false
+	}
+
+	m := map[string]bool{}
+	for _, w := range words(s) {
+		m[w] = true
+	}
+	for _, w := range q {
+		if !m[w] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,405 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// notNullString returns the NOT NULL clause of c, if any.
+func (c *col) notNullString() string {
+	if !c.notNull {
+		return ""
+	}
+
+	return " NOT NULL"
+}
+
+// checkNotNull verifies the record row of t has no NULL value in a NOT NULL
+// column.
+func (t *table) checkNotNull(row []interface{}) error {
+	for _, c := range t.cols {
+		if c.notNull && row[c.index] == nil {
+			return fmt.Errorf("column %s cannot be NULL", c.name)
+		}
+	}
+	return nil
+}
+
+// genString returns the generated column clause of c, if any.
+func (c *col) genString() string {
+	if c.gen == nil {
+		return ""
+	}
+
+	kind := "VIRTUAL"
+	if c.stored {
+		kind = "STORED"
+	}
+	return fmt.Sprintf(" AS (%s) %s", c.gen, kind)
+}
+
+// genRefs returns the names of the columns referred to by e, the generating
+// expression of a generated column. Subqueries, aggregate functions, id() and
+// qualified names cannot be used in e, nor parameters unless params is true,
+// see selectStmt.pushWhere and selectStmt.onlyColumn.
+func genRefs(e expression, params bool) (r []string, err error) {
+	var walk func(expression) error
+	walk = func(e expression) error {
+		switch x := e.(type) {
+		case *arrayExpr:
+			for _, v := range x.list {
+				if err := walk(v); err != nil {
+					return err
+				}
+			}
+		case *binaryOperation:
+			if err := walk(x.l); err != nil {
+				return err
+			}
+
+			return walk(x.r)
+		case *call:
+			if x.f == "id" || builtin[x.f].isAggregate {
+				return fmt.Errorf("cannot use %s in a generated column", x)
+			}
+
+			for _, v := range x.arg {
+				if err := walk(v); err != nil {
+					return err
+				}
+			}
+		case *cast:
+			return walk(x.val)
+		case *collateExpr:
+			return walk(x.expr)
+		case *conversion:
+			return walk(x.val)
+		case *ident:
+			if x.isQualified() {
+				return fmt.Errorf("cannot use qualified name %s in a generated column", x)
+			}
+
+			r = append(r, x.s)
+		case *indexOp:
+			if err := walk(x.expr); err != nil {
+				return err
+			}
+
+			return walk(x.x)
+		case *isDistinct:
+			if err := walk(x.l); err != nil {
+				return err
+			}
+
+			return walk(x.r)
+		case *isNull:
+			return walk(x.expr)
+		case *pIn:
+			if x.sel != nil {
+				return fmt.Errorf("cannot use a subquery in a generated column")
+			}
+
+			if err := walk(x.expr); err != nil {
+				return err
+			}
+
+			for _, v := range x.list {
+				if err := walk(v); err != nil {
+					return err
+				}
+			}
+			if x.arr != nil {
+				return walk(x.arr)
+			}
+		case *pLike:
+			if err := walk(x.expr); err != nil {
+				return err
+			}
+
+			if err := walk(x.pattern); err != nil {
+				return err
+			}
+
+			if x.escape != nil {
+				return walk(x.escape)
+			}
+		case *pMatch:
+			if err := walk(x.expr); err != nil {
+				return err
+			}
+
+			return walk(x.query)
+		case *pExists, *subquery:
+			return fmt.Errorf("cannot use a subquery in a generated column")
+		case parameter:
+			if !params {
+				return fmt.Errorf("cannot use parameter %s in a generated column", x)
+			}
+		case *pexpr:
+			return walk(x.expr)
+		case *slice:
+			if err := walk(x.expr); err != nil {
+				return err
+			}
+
+			if x.lo != nil {
+				if err := walk(*x.lo); err != nil {
+					return err
+				}
+			}
+			if x.hi != nil {
+				return walk(*x.hi)
+			}
+		case *unaryOperation:
+			return walk(x.v)
+		case value:
+			// nop
+		default:
+			return fmt.Errorf("cannot use %s in a generated column", e)
+		}
+		return nil
+	}
+
+	err = walk(e)
+	return
+}
+
+// checkGen verifies the generating expression of c may refer only to the
+// ordinary columns in cols and that c is not a virtual NOT NULL column.
+func checkGen(c *col, cols []*col) error {
+	if c.notNull && !c.stored {
+		return fmt.Errorf("column %s: a virtual generated column cannot be NOT NULL", c.name)
+	}
+
+	refs, err := genRefs(c.gen, false)
+	if err != nil {
+		return fmt.Errorf("column %s: %v", c.name, err)
+	}
+
+	for _, nm := range refs {
+		d := findCol(cols, nm)
+		switch {
+		case d == nil:
+			return fmt.Errorf("column %s: unknown column %s", c.name, nm)
+		case d.gen != nil:
+			return fmt.Errorf("column %s: cannot refer to generated column %s", c.name, nm)
+		}
+	}
+	return nil
+}
+
+// hasGen reports whether t has any generated columns, virtual ones if
+// stored is false, stored ones otherwise. The primary key column counts as a
+// stored generated column.
+func (t *table) hasGen(stored bool) bool {
+	for _, c := range t.cols {
+		if c.gen != nil && c.stored == stored {
+			return true
+		}
+	}
+	return stored && t.pkCol() != nil
+}
+
+// genRow computes the generated columns of t, virtual ones if stored is false,
+// stored ones and the primary key column otherwise, of the record row. The
+// length of row must be at least len(t.cols0).
+func (t *table) genRow(row []interface{}, stored bool) error {
+	var m map[interface{}]interface{}
+	for _, c := range t.cols {
+		if c.gen == nil || c.stored != stored {
+			continue
+		}
+
+		if m == nil {
+			m = map[interface{}]interface{}{}
+			for _, d := range t.cols {
+				if d.gen == nil {
+					m[d.name] = row[d.index]
+				}
+			}
+		}
+
+		v, err := expand1(c.gen.eval(m, nil))
+		if err != nil {
+			return fmt.Errorf("generated column %s: %v", c.name, err)
+		}
+
+		rec := []interface{}{v}
+		cc := *c
+		cc.index = 0
+		if err = typeCheck(rec, []*col{&cc}); err != nil {
+			return fmt.Errorf("generated column %s: %v", c.name, err)
+		}
+
+		row[c.index] = rec[0]
+	}
+	if !stored {
+		return nil
+	}
+
+	if c := t.pkCol(); c != nil {
+		k, err := t.pkKey(c, row)
+		if err != nil {
+			return err
+		}
+
+		row[c.index] = k
+	}
+	return nil
+}
+
+// clearVirtual sets the virtual generated columns of the record row to nil.
+func (t *table) clearVirtual(row []interface{}) {
+	for _, c := range t.cols {
+		if c.gen != nil && !c.stored {
+			row[c.index] = nil
+		}
+	}
+}
+
+// genMeta returns the storage fields of the generated columns of t, one per
+// physical column, or nil if t has no generated or NOT NULL columns. The field
+// of an ordinary column is empty, otherwise it is the generating expression
+// prefixed by 's' for a stored column or 'v' for a virtual one. The field of
+// the primary key column is 'p', or 'P' in a table WITHOUT ROWID, followed by
+// the comma separated indices of the key columns. The field of a DICTIONARY
+// column is 'd' followed by the root of its dictionary. The field of a NOT
+// NULL column is prefixed by '!'.
+func (t *table) genMeta() (r []interface{}) {
+	for _, c := range t.cols0 {
+		if (c.gen != nil || c.notNull || c.dict) && c.name != "" || c.pk != nil {
+			r = make([]interface{}, len(t.cols0))
+			break
+		}
+	}
+	if r == nil {
+		return
+	}
+
+	for i, c := range t.cols0 {
+		s := ""
+		switch {
+		case c.pk != nil:
+			a := make([]string, len(c.pk))
+			for i, v := range c.pk {
+				a[i] = strconv.Itoa(v)
+			}
+			s = "p"
+			if t.withoutRowID {
+				s = "P"
+			}
+			s += strings.Join(a, ",")
+		case c.gen != nil && c.name != "":
+			s = "v"
+			if c.stored {
+				s = "s"
+			}
+			s += c.gen.String()
+		case c.dict && c.name != "":
+			s = "d" + strconv.FormatInt(t.dicts[i].h, 10)
+		}
+		if c.notNull && c.name != "" {
+			s = "!" + s
+		}
+		r[i] = s
+	}
+	return
+}
+
+// loadGen restores the generated, DICTIONARY and NOT NULL columns of t from
+// their storage fields.
+func (t *table) loadGen(data []interface{}) (err error) {
+	if len(data) == 0 {
+		return
+	}
+
+	if g, e := len(data), len(t.cols0); g != e {
+		return fmt.Errorf("corrupted DB: got %d generated column definitions, expected %d", g, e)
+	}
+
+	for i, v := range data {
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("corrupted DB: generated column definition of type %T", v)
+		}
+
+		c := t.cols0[i]
+		if strings.HasPrefix(s, "!") {
+			c.notNull, s = true, s[1:]
+		}
+		if s == "" {
+			continue
+		}
+
+		switch s[0] {
+		case 'P':
+			t.withoutRowID = true
+			fallthrough
+		case 'p':
+			if c.pk, err = loadPK(s[1:], i); err != nil {
+				return fmt.Errorf("corrupted DB: invalid primary key definition %q: %v", s, err)
+			}
+
+			continue
+		case 'd':
+			h, err := strconv.ParseInt(s[1:], 10, 64)
+			if err != nil {
+				return fmt.Errorf("corrupted DB: invalid dictionary definition %q: %v", s, err)
+			}
+
+			x, err := t.store.OpenIndex(true, h)
+			if err != nil {
+				return err
+			}
+
+			if t.dicts == nil {
+				t.dicts = map[int]*dictionary{}
+			}
+			c.dict, t.dicts[i] = true, &dictionary{h, x}
+			continue
+		case 's':
+			c.stored = true
+		case 'v':
+		default:
+			return fmt.Errorf("corrupted DB: invalid generated column definition %q", s)
+		}
+
+		if c.gen, err = compileExpr(s[1:]); err != nil {
+			return fmt.Errorf("corrupted DB: invalid generated column definition %q: %v", s, err)
+		}
+	}
+	return
+}
+
+// loadPK returns the key column indices of the primary key column having index
+// n from their storage field s.
+func loadPK(s string, n int) (r []int, err error) {
+	for _, v := range strings.Split(s, ",") {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+
+		if i < 0 || i >= n {
+			return nil, fmt.Errorf("key column index %d out of range", i)
+		}
+
+		r = append(r, i)
+	}
+	return
+}
+
+// compileExpr parses src, the string form of an expression, as an expression.
+func compileExpr(src string) (expression, error) {
+	l, err := CompileQuoted(fmt.Sprintf("SELECT %s FROM __Table;", src), '`')
+	if err != nil {
+		return nil, err
+	}
+
+	return l.l[0].(*selectStmt).flds[0].expr, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// kvTable is the table holding the KV store of a DB, see DB.KVSet.
+const kvTable = "__KV"
+
+var (
+	kvDelete = MustCompile("DELETE FROM __KV WHERE k == $1;")
+	kvGet    = MustCompile("SELECT v FROM __KV WHERE k == $1;")
+	kvScan   = MustCompile("SELECT k, v FROM __KV WHERE k >= $1;")
+	kvSet    = MustCompile(`
+		CREATE TABLE IF NOT EXISTS __KV (k string, v blob, PRIMARY KEY (k)) WITHOUT ROWID;
+		INSERT OR REPLACE INTO __KV VALUES ($1, $2);`,
+	)
+)
+
+// KVSet sets the value of key in the key/value store of db. KVSet must be
+// called in a transaction of ctx, which may include SQL statements as well:
+// The key/value pairs are committed or rolled back together with the other
+// changes of the transaction.
+//
+// The key/value store is kept in the table
+//
+//	CREATE TABLE __KV (k string, v blob, PRIMARY KEY (k)) WITHOUT ROWID;
+//
+// created by the first KVSet. The keys are stored as strings having the bytes
+// of key, the table is ordered by them and it can be queried by SQL as well.
+func (db *DB) KVSet(ctx *TCtx, key, value []byte) error {
+	_, _, err := db.Execute(ctx, kvSet, string(key), value)
+	return err
+}
+
+// KVGet returns the value of key in the key/value store of db and whether the
+// key exists. The ctx may be nil outside of a transaction. See also KVSet.
+func (db *DB) KVGet(ctx *TCtx, key []byte) (value []byte, ok bool, err error) {
+	rs, _, err := db.Execute(ctx, kvGet, string(key))
+	if err != nil {
+		return nil, false, db.kvErr(err)
+	}
+
+	row, err := rs[0].FirstRow()
+	if row == nil || err != nil {
+		return nil, false, db.kvErr(err)
+	}
+
+	value, _ = row[0].([]byte)
+	return value, true, nil
+}
+
+// KVDelete removes key from the key/value store of db. KVDelete must be
+// called in a transaction of ctx. Removing a key which does not exist is not
+// an error. See also KVSet.
+func (db *DB) KVDelete(ctx *TCtx, key []byte) error {
+	_, _, err := db.Execute(ctx, kvDelete, string(key))
+	return db.kvErr(err)
+}
+
+// KVScan calls f with the key/value pairs of the key/value store of db whose
+// keys start with prefix, in the order of the keys, until f returns false or
+// an error. The ctx may be nil outside of a transaction. The pairs are read
+// from the index of the keys, starting at prefix. See also KVSet.
+func (db *DB) KVScan(ctx *TCtx, prefix []byte, f func(key, value []byte) (more bool, err error)) error {
+	rs, _, err := db.Execute(ctx, kvScan, string(prefix))
+	if err != nil {
+		return db.kvErr(err)
+	}
+
+	return db.kvErr(rs[0].Do(false, func(data []interface{}) (bool, error) {
+		k, ok := data[0].(string)
+		if !ok {
+			return false, fmt.Errorf("KVScan: invalid key %v (type %T)", data[0], data[0])
+		}
+
+		if !bytes.HasPrefix([]byte(k), prefix) {
+			return false, nil
+		}
+
+		v, _ := data[1].([]byte)
+		return f([]byte(k), v)
+	}))
+}
+
+// kvErr returns nil if err is caused by db having no key/value store yet,
+// which is then empty. Otherwise it returns err.
+func (db *DB) kvErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.root != nil && db.root.tables[kvTable] == nil {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,132 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"sync"
+	"time"
+)
+
+// LockInfo describes the holders and waiters of the lock guarding the DB
+// against concurrent updates. The write lock is held by an open transaction,
+// read locks are held by statements executed outside of a transaction and by
+// Recordsets being iterated.
+type LockInfo struct {
+	Writer         bool      // The write lock is held.
+	WriterSince    time.Time // When the write lock was acquired.
+	Readers        int       // Number of read locks held.
+	WaitingWriters int       // Number of goroutines waiting for the write lock.
+	WaitingReaders int       // Number of goroutines waiting for a read lock.
+}
+
+// rwLock is a readers-writer lock like sync.RWMutex, except that acquiring it
+// can time out and that it reports its holders and waiters. A blocked Lock
+// call excludes new readers. The zero value is an unlocked lock.
+type rwLock struct {
+	ch   chan struct{} // Closed on every change of info, nil if none is waiting.
+	info LockInfo
+	mu   sync.Mutex
+}
+
+// Lock acquires l for writing. If d is positive and the lock is not acquired
+// within d, Lock returns a *LockTimeoutError.
+func (l *rwLock) Lock(d time.Duration) error { return l.acquire(d, true) }
+
+// RLock acquires l for reading. If d is positive and the lock is not acquired
+// within d, RLock returns a *LockTimeoutError.
+func (l *rwLock) RLock(d time.Duration) error { return l.acquire(d, false) }
+
+// Unlock releases the write lock of l.
+func (l *rwLock) Unlock() {
+	l.mu.Lock()
+	if !l.info.Writer {
+		l.mu.Unlock()
+		panic("internal error: Unlock of unlocked rwLock")
+	}
+
+	l.info.Writer, l.info.WriterSince = false, time.Time{}
+	l.notify()
+	l.mu.Unlock()
+}
+
+// RUnlock releases a read lock of l.
+func (l *rwLock) RUnlock() {
+	l.mu.Lock()
+	if l.info.Readers == 0 {
+		l.mu.Unlock()
+		panic("internal error: RUnlock of unlocked rwLock")
+	}
+
+	l.info.Readers--
+	l.notify()
+	l.mu.Unlock()
+}
+
+// Info returns the current holders and waiters of l.
+func (l *rwLock) Info() LockInfo {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.info
+}
+
+func (l *rwLock) acquire(d time.Duration, write bool) error {
+	var timer *time.Timer
+	var expired <-chan time.Time
+	if d > 0 {
+		timer = time.NewTimer(d)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for {
+		switch {
+		case l.info.Writer:
+			// wait
+		case write && l.info.Readers == 0:
+			l.info.Writer, l.info.WriterSince = true, time.Now()
+			return nil
+		case !write && l.info.WaitingWriters == 0:
+			l.info.Readers++
+			return nil
+		}
+
+		waiting := &l.info.WaitingReaders
+		if write {
+			waiting = &l.info.WaitingWriters
+		}
+		if l.ch == nil {
+			l.ch = make(chan struct{})
+		}
+		ch := l.ch
+		*waiting++
+		l.mu.Unlock()
+		timedOut := false
+		select {
+		case <-ch:
+		case <-expired:
+			timedOut = true
+		}
+		l.mu.Lock()
+		*waiting--
+		if timedOut {
+			l.notify() // Readers may proceed if no other writer waits.
+			return &LockTimeoutError{Timeout: d, Write: write, Locks: l.info}
+		}
+	}
+}
+
+// notify wakes up the waiters of l. l.mu must be held.
+func (l *rwLock) notify() {
+	if l.ch != nil {
+		close(l.ch)
+		l.ch = nil
+	}
+}
+
+// Locks returns the current holders and waiters of the lock guarding db
+// against concurrent updates. It is intended for diagnosing stalls.
+func (db *DB) Locks() LockInfo { return db.rwmu.Info() }
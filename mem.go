@@ -8,10 +8,12 @@ package ql
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"log"
 	"math/big"
+	"sync"
 	"time"
 )
 
@@ -243,6 +245,8 @@ type mem struct {
 	recycler []int
 	tnl      int
 	rollback *undos
+	hdr      [16]byte // See file.hdr. Guarded by mu.
+	mu       sync.Mutex
 }
 
 func newMemStorage() (s *mem, err error) {
@@ -284,6 +288,47 @@ func (s *mem) CreateIndex(unique bool) ( /* handle */ int64, btreeIndex, error)
 
 func (s *mem) Name() string { return fmt.Sprintf("/proc/self/mem/%p", s) } // fake, non existing name
 
+// Size returns the logical size of the data in s, ie. the sum of the sizes of
+// all values of all records.
+func (s *mem) Size() (n int64, err error) {
+	for _, rec := range s.data {
+		n += valueSize(rec)
+	}
+	return
+}
+
+// valueSize returns the size of the value v, in bytes, disregarding any
+// overhead of its in memory representation.
+func valueSize(v interface{}) (n int64) {
+	switch x := v.(type) {
+	case bool, int8, uint8:
+		return 1
+	case int16, uint16:
+		return 2
+	case float32, int32, uint32:
+		return 4
+	case complex64, float64, int64, uint64, time.Duration, int, uint:
+		return 8
+	case complex128:
+		return 16
+	case time.Time:
+		return 15 // Length of x.MarshalBinary().
+	case string:
+		return int64(len(x))
+	case []byte:
+		return int64(len(x))
+	case *big.Int:
+		return int64(len(x.Bytes()))
+	case *big.Rat:
+		return int64(len(x.Num().Bytes()) + len(x.Denom().Bytes()))
+	case []interface{}:
+		for _, v := range x {
+			n += valueSize(v)
+		}
+	}
+	return
+}
+
 // OpenMem returns a new, empty DB backed by the process' memory. The back end
 // has no limits on field/record/table/DB size other than memory available to
 // the process.
@@ -301,6 +346,21 @@ func OpenMem() (db *DB, err error) {
 	return db, nil
 }
 
+func (s *mem) FreeSpace() (*SpaceInfo, error) { return &SpaceInfo{}, nil }
+
+func (s *mem) Header(off int) int32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return int32(binary.BigEndian.Uint32(s.hdr[off:]))
+}
+
+func (s *mem) SetHeader(off int, v int32) error {
+	s.mu.Lock()
+	binary.BigEndian.PutUint32(s.hdr[off:], uint32(v))
+	s.mu.Unlock()
+	return nil
+}
+
 func (s *mem) Verify() (allocs int64, err error) {
 	for _, v := range s.recycler {
 		if s.data[v] != nil {
@@ -344,11 +404,19 @@ func (s *mem) CreateTemp(asc bool) (_ temp, err error) {
 	}, nil
 }
 
+func (s *mem) CreateSorter(asc bool) (sorter, error) {
+	return s.CreateTemp(asc)
+}
+
 func (s *mem) ResetID() (err error) {
 	s.id = 0
 	return
 }
 
+func (s *mem) IDPolicy() (IDPolicy, int64) { return IDMonotonic, 0 }
+
+func (s *mem) Sync() error { return nil }
+
 func (s *mem) ID() (id int64, err error) {
 	s.id++
 	return s.id, nil
@@ -413,6 +481,8 @@ func (s *mem) clone(data ...interface{}) []interface{} {
 			r[i] = t
 		case time.Duration:
 			r[i] = x
+		case []interface{}:
+			r[i] = s.clone(x...)
 		case map[string]interface{}: // map of ids of a cross join
 			r[i] = x
 		default:
@@ -455,6 +525,13 @@ func (s *mem) Read(dst []interface{}, h int64, cols ...*col) (data []interface{}
 		for n, dn := len(cols)+2, len(d); dn < n; dn++ {
 			d = append(d, nil)
 		}
+		for _, c := range cols {
+			if i := c.index + 2; c.dict && i < len(d) {
+				if code, ok := d[i].(int64); ok {
+					d[i] = s.data[code][0]
+				}
+			}
+		}
 		return d, nil
 	}
 
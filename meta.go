@@ -0,0 +1,181 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	// metaTable is the table holding the metadata of tables and columns,
+	// see DB.SetMeta.
+	metaTable = "__Meta"
+
+	// commentKey is the metadata key of the COMMENT clause of CREATE TABLE
+	// and of column definitions.
+	commentKey = "comment"
+)
+
+var (
+	metaDelete       = MustCompile("DELETE FROM __Meta WHERE TableName == $1 && ColumnName == $2 && Name == $3;")
+	metaDeleteColumn = MustCompile("DELETE FROM __Meta WHERE TableName == $1 && ColumnName == $2;")
+	metaDeleteTable  = MustCompile("DELETE FROM __Meta WHERE TableName == $1;")
+	metaSet          = MustCompile(`
+		CREATE TABLE IF NOT EXISTS __Meta (
+			TableName  string,
+			ColumnName string,
+			Name       string,
+			Value      string,
+			PRIMARY KEY (TableName, ColumnName, Name)
+		) WITHOUT ROWID;
+		INSERT OR REPLACE INTO __Meta VALUES ($1, $2, $3, $4);`,
+	)
+)
+
+// SetMeta sets the value of key in the metadata of the column column of
+// table, or of table itself if column is "". SetMeta must be called in a
+// transaction of ctx. The metadata are committed or rolled back together with
+// the other changes of the transaction.
+//
+// The metadata are kept in the table
+//
+//	CREATE TABLE __Meta (
+//		TableName  string,
+//		ColumnName string,
+//		Name       string,
+//		Value      string,
+//		PRIMARY KEY (TableName, ColumnName, Name)
+//	) WITHOUT ROWID;
+//
+// created by the first SetMeta or COMMENT clause, see CREATE TABLE. The
+// ColumnName of the metadata of a table is empty. The key "comment" holds the
+// COMMENT of a table or column. The metadata are reported by Info and they
+// are removed with their table or column.
+func (db *DB) SetMeta(ctx *TCtx, table, column, key, value string) error {
+	if err := db.checkMeta(table, column); err != nil {
+		return err
+	}
+
+	_, _, err := db.Execute(ctx, metaSet, table, column, key, value)
+	return err
+}
+
+// DeleteMeta removes key from the metadata of the column column of table, or
+// of table itself if column is "". DeleteMeta must be called in a transaction
+// of ctx. Removing a key which does not exist is not an error. See also
+// SetMeta.
+func (db *DB) DeleteMeta(ctx *TCtx, table, column, key string) error {
+	if err := db.checkMeta(table, column); err != nil {
+		return err
+	}
+
+	db.mu.Lock()
+	ok := db.root != nil && db.root.tables[metaTable] != nil
+	db.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	_, _, err := db.Execute(ctx, metaDelete, table, column, key)
+	return err
+}
+
+// checkMeta returns an error if table or its column column, unless column is
+// "", does not exist.
+func (db *DB) checkMeta(table, column string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.root == nil {
+		return fmt.Errorf("table %s does not exist", table)
+	}
+
+	t := db.root.tables[table]
+	switch {
+	case t == nil:
+		return fmt.Errorf("table %s does not exist", table)
+	case column != "" && findCol(t.cols, column) == nil:
+		return fmt.Errorf("column %s.%s does not exist", table, column)
+	}
+
+	return nil
+}
+
+// metaKey identifies the table, or its column, having metadata.
+type metaKey struct {
+	table, column string
+}
+
+// meta returns the metadata of all tables and columns.
+func (db *DB) meta() (r map[metaKey]map[string]string, err error) {
+	t := db.root.tables[metaTable]
+	if t == nil {
+		return nil, nil
+	}
+
+	r = map[metaKey]map[string]string{}
+	for h := t.head; h > 0; {
+		if h, err = tableRset("").doOne(t, h, func(_ interface{}, data []interface{}) (bool, error) {
+			if err := expand(data); err != nil {
+				return false, err
+			}
+
+			var a [4]string
+			for i := range a {
+				a[i], _ = data[i].(string)
+			}
+			k := metaKey{a[0], a[1]}
+			if r[k] == nil {
+				r[k] = map[string]string{}
+			}
+			r[k][a[2]] = a[3]
+			return true, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// setComments records the COMMENT clauses of s, of the table and of its
+// columns.
+func (s *createTableStmt) setComments(ctx *execCtx) error {
+	if s.comment != "" {
+		if err := ctx.run(metaSet, s.tableName, "", commentKey, s.comment); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range s.cols {
+		if c.comment != "" {
+			if err := ctx.run(metaSet, s.tableName, c.name, commentKey, c.comment); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteMeta removes the metadata of the column colName of the table
+// tableName, or of the table and all its columns if colName is "".
+func deleteMeta(ctx *execCtx, tableName, colName string) error {
+	if ctx.db.root.tables[metaTable] == nil || tableName == metaTable {
+		return nil
+	}
+
+	if colName == "" {
+		return ctx.run(metaDeleteTable, tableName)
+	}
+
+	return ctx.run(metaDeleteColumn, tableName, colName)
+}
+
+func (c *col) commentString() string {
+	if c.comment == "" {
+		return ""
+	}
+
+	return " COMMENT " + strconv.Quote(c.comment)
+}
@@ -0,0 +1,75 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+)
+
+// Metric identifies a quantity reported to Metrics.
+type Metric int
+
+// Values of Metric. MetricQueryDuration is reported using Metrics.Observe,
+// the others using Metrics.Inc.
+const (
+	MetricQueries        Metric = iota // Statements executed, BEGIN, COMMIT and ROLLBACK excluded.
+	MetricRowsRead                     // Table rows read.
+	MetricRowsWritten                  // Table rows inserted, updated or deleted.
+	MetricCommits                      // Transactions committed, nested ones included.
+	MetricRollbacks                    // Transactions rolled back, nested ones included.
+	MetricTempFiles                    // Temp files created.
+	MetricBytesAllocated               // Bytes of records written to the DB file.
+	MetricBytesFreed                   // Bytes of records freed in the DB file.
+	MetricQueryDuration                // Statement execution time in seconds, iterating its Recordset excluded.
+	MetricIndexRows                    // Rows added to the indices built by CREATE INDEX.
+)
+
+var metricNames = [...]string{
+	MetricQueries:        "queries",
+	MetricRowsRead:       "rows_read",
+	MetricRowsWritten:    "rows_written",
+	MetricCommits:        "commits",
+	MetricRollbacks:      "rollbacks",
+	MetricTempFiles:      "temp_files",
+	MetricBytesAllocated: "bytes_allocated",
+	MetricBytesFreed:     "bytes_freed",
+	MetricQueryDuration:  "query_duration_seconds",
+	MetricIndexRows:      "index_rows",
+}
+
+// String implements fmt.Stringer.
+func (m Metric) String() string {
+	if m >= 0 && int(m) < len(metricNames) {
+		return metricNames[m]
+	}
+
+	return fmt.Sprintf("Metric(%d)", int(m))
+}
+
+// Metrics receives measurements of a DB, for example to export them to a
+// monitoring system. The methods are called synchronously while the DB
+// executes a statement, possibly from several goroutines at the same time,
+// so they should be cheap and must be safe for concurrent use.
+type Metrics interface {
+	// Inc adds n to the counter m.
+	Inc(m Metric, n int64)
+	// Observe records the value v of m.
+	Observe(m Metric, v float64)
+}
+
+// nopMetrics is the Metrics used when none is configured.
+type nopMetrics struct{}
+
+func (nopMetrics) Inc(Metric, int64)       {}
+func (nopMetrics) Observe(Metric, float64) {}
+
+// metricsOrNop returns m or, if m is nil, a Metrics doing nothing.
+func metricsOrNop(m Metrics) Metrics {
+	if m == nil {
+		return nopMetrics{}
+	}
+
+	return m
+}
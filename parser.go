@@ -18,6 +18,7 @@ import __yyfmt__ "fmt"
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cznic/mathutil"
 )
@@ -35,311 +36,467 @@ type yyXError struct {
 }
 
 const (
-	yyDefault      = 57429
+	yyDefault      = 57467
 	yyEOFCode      = 57344
 	add            = 57346
 	alter          = 57347
-	and            = 57348
-	andand         = 57349
-	andnot         = 57350
-	as             = 57351
-	asc            = 57352
-	begin          = 57353
-	between        = 57354
-	bigIntType     = 57355
-	bigRatType     = 57356
-	blobType       = 57357
-	boolType       = 57358
-	by             = 57359
-	byteType       = 57360
-	column         = 57361
-	commit         = 57362
-	complex128Type = 57363
-	complex64Type  = 57364
-	create         = 57365
-	deleteKwd      = 57366
-	desc           = 57367
-	distinct       = 57368
-	drop           = 57369
-	durationType   = 57370
-	eq             = 57371
+	analyze        = 57348
+	and            = 57349
+	andand         = 57350
+	andnot         = 57351
+	arrayType      = 57352
+	as             = 57353
+	asc            = 57354
+	attach         = 57355
+	begin          = 57356
+	between        = 57357
+	bigIntType     = 57358
+	bigRatType     = 57359
+	blobLit        = 57360
+	blobType       = 57361
+	boolType       = 57362
+	by             = 57363
+	byteType       = 57364
+	castKwd        = 57365
+	collateKwd     = 57366
+	column         = 57367
+	comment        = 57368
+	commit         = 57369
+	complex128Type = 57370
+	complex64Type  = 57371
+	conflict       = 57372
+	create         = 57373
+	database       = 57374
+	dcolon         = 57375
+	deleteKwd      = 57376
+	desc           = 57377
+	detach         = 57378
+	dictionaryKwd  = 57379
+	distinct       = 57380
+	do             = 57381
+	drop           = 57382
+	durationType   = 57383
+	eq             = 57384
 	yyErrCode      = 57345
-	exists         = 57372
-	falseKwd       = 57373
-	float32Type    = 57375
-	float64Type    = 57376
-	floatLit       = 57377
-	floatType      = 57374
-	from           = 57378
-	ge             = 57379
-	group          = 57380
-	identifier     = 57381
-	ifKwd          = 57382
-	imaginaryLit   = 57383
-	in             = 57384
-	index          = 57385
-	insert         = 57386
-	int16Type      = 57388
-	int32Type      = 57389
-	int64Type      = 57390
-	int8Type       = 57391
-	intLit         = 57393
-	intType        = 57387
-	into           = 57392
-	is             = 57394
-	le             = 57395
-	like           = 57396
-	limit          = 57397
-	lsh            = 57398
-	neq            = 57399
-	not            = 57400
-	null           = 57401
-	offset         = 57402
-	on             = 57403
-	or             = 57404
-	order          = 57405
-	oror           = 57406
-	qlParam        = 57407
-	rollback       = 57408
-	rsh            = 57409
-	runeType       = 57410
-	selectKwd      = 57411
-	set            = 57412
-	stringLit      = 57414
-	stringType     = 57413
-	tableKwd       = 57415
-	timeType       = 57416
-	transaction    = 57417
-	trueKwd        = 57418
-	truncate       = 57419
-	uint16Type     = 57421
-	uint32Type     = 57422
-	uint64Type     = 57423
-	uint8Type      = 57424
-	uintType       = 57420
-	unique         = 57425
-	update         = 57426
-	values         = 57427
-	where          = 57428
+	escape         = 57385
+	exists         = 57386
+	falseKwd       = 57387
+	float32Type    = 57389
+	float64Type    = 57390
+	floatLit       = 57391
+	floatType      = 57388
+	forKwd         = 57392
+	from           = 57393
+	fulltext       = 57394
+	ge             = 57395
+	group          = 57396
+	hash           = 57397
+	identifier     = 57398
+	ifKwd          = 57399
+	ignore         = 57400
+	ilike          = 57401
+	imaginaryLit   = 57402
+	in             = 57403
+	index          = 57404
+	insert         = 57405
+	int16Type      = 57407
+	int32Type      = 57408
+	int64Type      = 57409
+	int8Type       = 57410
+	intLit         = 57412
+	intType        = 57406
+	into           = 57411
+	is             = 57413
+	key            = 57414
+	le             = 57415
+	less           = 57416
+	like           = 57417
+	limit          = 57418
+	lsh            = 57419
+	match          = 57420
+	neq            = 57421
+	not            = 57422
+	null           = 57423
+	offset         = 57424
+	on             = 57425
+	or             = 57426
+	order          = 57427
+	oror           = 57428
+	partitionKwd   = 57429
+	partitionsKwd  = 57430
+	percent        = 57431
+	pragma         = 57432
+	primary        = 57433
+	qlParam        = 57434
+	rangeKwd       = 57435
+	reindex        = 57436
+	repeatable     = 57437
+	replace        = 57438
+	returning      = 57439
+	rollback       = 57440
+	rowid          = 57441
+	rsh            = 57442
+	runeType       = 57443
+	selectKwd      = 57444
+	set            = 57445
+	stored         = 57446
+	stringLit      = 57448
+	stringType     = 57447
+	tableKwd       = 57449
+	tablesample    = 57450
+	than           = 57451
+	timeType       = 57452
+	transaction    = 57453
+	trueKwd        = 57454
+	truncate       = 57455
+	uint16Type     = 57457
+	uint32Type     = 57458
+	uint64Type     = 57459
+	uint8Type      = 57460
+	uintType       = 57456
+	unique         = 57461
+	update         = 57462
+	values         = 57463
+	virtual        = 57464
+	where          = 57465
+	without        = 57466
 
 	yyMaxDepth = 200
-	yyTabOfs   = -204
+	yyTabOfs   = -316
 )
 
 var (
 	yyXLAT = map[int]int{
-		59:    0,   // ';' (179x)
-		57344: 1,   // $end (178x)
-		41:    2,   // ')' (152x)
-		44:    3,   // ',' (120x)
-		40:    4,   // '(' (119x)
-		43:    5,   // '+' (103x)
-		45:    6,   // '-' (103x)
-		94:    7,   // '^' (103x)
-		57402: 8,   // offset (100x)
-		57397: 9,   // limit (97x)
-		57381: 10,  // identifier (87x)
-		57405: 11,  // order (85x)
-		57428: 12,  // where (80x)
-		57380: 13,  // group (75x)
-		57404: 14,  // or (74x)
-		57406: 15,  // oror (74x)
-		57378: 16,  // from (72x)
-		57352: 17,  // asc (68x)
-		57367: 18,  // desc (68x)
-		93:    19,  // ']' (67x)
-		57351: 20,  // as (66x)
-		58:    21,  // ':' (64x)
-		57348: 22,  // and (64x)
-		57349: 23,  // andand (62x)
-		124:   24,  // '|' (55x)
-		57400: 25,  // not (55x)
-		57355: 26,  // bigIntType (54x)
-		57356: 27,  // bigRatType (54x)
-		57357: 28,  // blobType (54x)
-		57358: 29,  // boolType (54x)
-		57360: 30,  // byteType (54x)
-		57363: 31,  // complex128Type (54x)
-		57364: 32,  // complex64Type (54x)
-		57370: 33,  // durationType (54x)
-		57375: 34,  // float32Type (54x)
-		57376: 35,  // float64Type (54x)
-		57374: 36,  // floatType (54x)
-		57388: 37,  // int16Type (54x)
-		57389: 38,  // int32Type (54x)
-		57390: 39,  // int64Type (54x)
-		57391: 40,  // int8Type (54x)
-		57387: 41,  // intType (54x)
-		57401: 42,  // null (54x)
-		57410: 43,  // runeType (54x)
-		57413: 44,  // stringType (54x)
-		57416: 45,  // timeType (54x)
-		57421: 46,  // uint16Type (54x)
-		57422: 47,  // uint32Type (54x)
-		57423: 48,  // uint64Type (54x)
-		57424: 49,  // uint8Type (54x)
-		57420: 50,  // uintType (54x)
-		57354: 51,  // between (53x)
-		57384: 52,  // in (53x)
-		60:    53,  // '<' (52x)
-		62:    54,  // '>' (52x)
-		57371: 55,  // eq (52x)
-		57373: 56,  // falseKwd (52x)
-		57377: 57,  // floatLit (52x)
-		57379: 58,  // ge (52x)
-		57383: 59,  // imaginaryLit (52x)
-		57393: 60,  // intLit (52x)
-		57394: 61,  // is (52x)
-		57395: 62,  // le (52x)
-		57396: 63,  // like (52x)
-		57399: 64,  // neq (52x)
-		57407: 65,  // qlParam (52x)
-		57414: 66,  // stringLit (52x)
-		57418: 67,  // trueKwd (52x)
-		33:    68,  // '!' (48x)
-		57499: 69,  // Type (47x)
-		42:    70,  // '*' (46x)
-		57444: 71,  // Conversion (46x)
-		57471: 72,  // Literal (46x)
-		57472: 73,  // Operand (46x)
-		57475: 74,  // PrimaryExpression (46x)
-		57478: 75,  // QualifiedIdent (46x)
-		37:    76,  // '%' (43x)
-		38:    77,  // '&' (43x)
-		47:    78,  // '/' (43x)
-		57350: 79,  // andnot (43x)
-		57398: 80,  // lsh (43x)
-		57409: 81,  // rsh (43x)
-		57500: 82,  // UnaryExpr (42x)
-		57477: 83,  // PrimaryTerm (35x)
-		57476: 84,  // PrimaryFactor (31x)
-		91:    85,  // '[' (30x)
-		57460: 86,  // Factor (20x)
-		57461: 87,  // Factor1 (20x)
-		57497: 88,  // Term (19x)
-		57456: 89,  // Expression (18x)
-		57505: 90,  // logOr (12x)
-		57439: 91,  // ColumnName (10x)
-		57496: 92,  // TableName (9x)
-		57411: 93,  // selectKwd (7x)
-		57457: 94,  // ExpressionList (6x)
-		57436: 95,  // Call (5x)
-		57466: 96,  // Index (5x)
-		57493: 97,  // Slice (5x)
-		57438: 98,  // ColumnDef (4x)
-		57369: 99,  // drop (4x)
-		57372: 100, // exists (4x)
-		57382: 101, // ifKwd (4x)
-		57385: 102, // index (4x)
-		57485: 103, // SelectStmt (4x)
-		57415: 104, // tableKwd (4x)
-		57427: 105, // values (4x)
-		57503: 106, // WhereClause (4x)
-		61:    107, // '=' (2x)
-		57346: 108, // add (2x)
-		57347: 109, // alter (2x)
-		57430: 110, // AlterTableStmt (2x)
-		57431: 111, // Assignment (2x)
-		57353: 112, // begin (2x)
-		57435: 113, // BeginTransactionStmt (2x)
-		57359: 114, // by (2x)
-		57440: 115, // ColumnNameList (2x)
-		57362: 116, // commit (2x)
-		57443: 117, // CommitStmt (2x)
-		57365: 118, // create (2x)
-		57446: 119, // CreateIndexStmt (2x)
-		57448: 120, // CreateTableStmt (2x)
-		57449: 121, // CreateTableStmt1 (2x)
-		57450: 122, // CreateTableStmt2 (2x)
-		57451: 123, // DeleteFromStmt (2x)
-		57366: 124, // deleteKwd (2x)
-		57453: 125, // DropIndexStmt (2x)
-		57454: 126, // DropTableStmt (2x)
-		57455: 127, // EmptyStmt (2x)
-		57462: 128, // Field (2x)
-		57465: 129, // GroupByClause (2x)
-		57386: 130, // insert (2x)
-		57467: 131, // InsertIntoStmt (2x)
-		57504: 132, // logAnd (2x)
-		57473: 133, // OrderBy (2x)
-		57479: 134, // RecordSet (2x)
-		57480: 135, // RecordSet1 (2x)
-		57408: 136, // rollback (2x)
-		57484: 137, // RollbackStmt (2x)
-		57488: 138, // SelectStmtGroup (2x)
-		57489: 139, // SelectStmtLimit (2x)
-		57490: 140, // SelectStmtOffset (2x)
-		57491: 141, // SelectStmtOrder (2x)
-		57492: 142, // SelectStmtWhere (2x)
-		57412: 143, // set (2x)
-		57494: 144, // Statement (2x)
-		57419: 145, // truncate (2x)
-		57498: 146, // TruncateTableStmt (2x)
-		57426: 147, // update (2x)
-		57501: 148, // UpdateStmt (2x)
-		46:    149, // '.' (1x)
-		57432: 150, // AssignmentList (1x)
-		57433: 151, // AssignmentList1 (1x)
-		57434: 152, // AssignmentList2 (1x)
-		57437: 153, // Call1 (1x)
-		57361: 154, // column (1x)
-		57441: 155, // ColumnNameList1 (1x)
-		57442: 156, // ColumnNameList2 (1x)
-		57445: 157, // CreateIndexIfNotExists (1x)
-		57447: 158, // CreateIndexStmtUnique (1x)
-		57368: 159, // distinct (1x)
-		57452: 160, // DropIndexIfExists (1x)
-		57458: 161, // ExpressionList1 (1x)
-		57459: 162, // ExpressionList2 (1x)
-		57463: 163, // Field1 (1x)
-		57464: 164, // FieldList (1x)
-		57468: 165, // InsertIntoStmt1 (1x)
-		57469: 166, // InsertIntoStmt2 (1x)
-		57470: 167, // InsertIntoStmt3 (1x)
-		57392: 168, // into (1x)
-		57403: 169, // on (1x)
-		57474: 170, // OrderBy1 (1x)
-		57506: 171, // oSet (1x)
-		57481: 172, // RecordSet11 (1x)
-		57482: 173, // RecordSet2 (1x)
-		57483: 174, // RecordSetList (1x)
-		57486: 175, // SelectStmtDistinct (1x)
-		57487: 176, // SelectStmtFieldList (1x)
-		57495: 177, // StatementList (1x)
-		57417: 178, // transaction (1x)
-		57425: 179, // unique (1x)
-		57502: 180, // UpdateStmt1 (1x)
-		57429: 181, // $default (0x)
-		57345: 182, // error (0x)
+		57392: 0,   // forKwd (310x)
+		59:    1,   // ';' (303x)
+		57344: 2,   // $end (297x)
+		57439: 3,   // returning (280x)
+		57431: 4,   // percent (270x)
+		41:    5,   // ')' (255x)
+		57401: 6,   // ilike (249x)
+		57420: 7,   // match (249x)
+		57385: 8,   // escape (238x)
+		57366: 9,   // collateKwd (223x)
+		57368: 10,  // comment (204x)
+		44:    11,  // ',' (202x)
+		57425: 12,  // on (201x)
+		43:    13,  // '+' (194x)
+		45:    14,  // '-' (194x)
+		94:    15,  // '^' (194x)
+		40:    16,  // '(' (191x)
+		57424: 17,  // offset (189x)
+		57418: 18,  // limit (187x)
+		57379: 19,  // dictionaryKwd (186x)
+		57427: 20,  // order (176x)
+		57465: 21,  // where (174x)
+		57422: 22,  // not (171x)
+		57396: 23,  // group (167x)
+		57426: 24,  // or (166x)
+		57428: 25,  // oror (165x)
+		57429: 26,  // partitionKwd (165x)
+		57352: 27,  // arrayType (164x)
+		57348: 28,  // analyze (161x)
+		57353: 29,  // as (161x)
+		57355: 30,  // attach (161x)
+		57374: 31,  // database (161x)
+		57378: 32,  // detach (161x)
+		57432: 33,  // pragma (161x)
+		57436: 34,  // reindex (161x)
+		57450: 35,  // tablesample (161x)
+		57466: 36,  // without (161x)
+		57372: 37,  // conflict (160x)
+		57381: 38,  // do (160x)
+		57394: 39,  // fulltext (160x)
+		57397: 40,  // hash (160x)
+		57400: 41,  // ignore (160x)
+		57414: 42,  // key (160x)
+		57416: 43,  // less (160x)
+		57430: 44,  // partitionsKwd (160x)
+		57435: 45,  // rangeKwd (160x)
+		57437: 46,  // repeatable (160x)
+		57438: 47,  // replace (160x)
+		57441: 48,  // rowid (160x)
+		57446: 49,  // stored (160x)
+		57451: 50,  // than (160x)
+		57464: 51,  // virtual (160x)
+		57365: 52,  // castKwd (159x)
+		57393: 53,  // from (159x)
+		57398: 54,  // identifier (159x)
+		57433: 55,  // primary (159x)
+		57354: 56,  // asc (153x)
+		57377: 57,  // desc (153x)
+		93:    58,  // ']' (152x)
+		58:    59,  // ':' (149x)
+		57349: 60,  // and (149x)
+		57350: 61,  // andand (147x)
+		124:   62,  // '|' (132x)
+		57357: 63,  // between (128x)
+		57403: 64,  // in (128x)
+		60:    65,  // '<' (127x)
+		62:    66,  // '>' (127x)
+		57384: 67,  // eq (127x)
+		57395: 68,  // ge (127x)
+		57413: 69,  // is (127x)
+		57415: 70,  // le (127x)
+		57417: 71,  // like (127x)
+		57421: 72,  // neq (127x)
+		42:    73,  // '*' (118x)
+		37:    74,  // '%' (114x)
+		38:    75,  // '&' (114x)
+		47:    76,  // '/' (114x)
+		57351: 77,  // andnot (114x)
+		57419: 78,  // lsh (114x)
+		57442: 79,  // rsh (114x)
+		57358: 80,  // bigIntType (109x)
+		57359: 81,  // bigRatType (109x)
+		57361: 82,  // blobType (109x)
+		57362: 83,  // boolType (109x)
+		57364: 84,  // byteType (109x)
+		57370: 85,  // complex128Type (109x)
+		57371: 86,  // complex64Type (109x)
+		57383: 87,  // durationType (109x)
+		57389: 88,  // float32Type (109x)
+		57390: 89,  // float64Type (109x)
+		57388: 90,  // floatType (109x)
+		57407: 91,  // int16Type (109x)
+		57408: 92,  // int32Type (109x)
+		57409: 93,  // int64Type (109x)
+		57410: 94,  // int8Type (109x)
+		57406: 95,  // intType (109x)
+		57443: 96,  // runeType (109x)
+		57447: 97,  // stringType (109x)
+		57452: 98,  // timeType (109x)
+		57457: 99,  // uint16Type (109x)
+		57458: 100, // uint32Type (109x)
+		57459: 101, // uint64Type (109x)
+		57460: 102, // uint8Type (109x)
+		57456: 103, // uintType (109x)
+		57516: 104, // Identifier (107x)
+		91:    105, // '[' (101x)
+		57375: 106, // dcolon (101x)
+		57423: 107, // null (69x)
+		57434: 108, // qlParam (68x)
+		57412: 109, // intLit (67x)
+		57448: 110, // stringLit (67x)
+		57360: 111, // blobLit (66x)
+		57387: 112, // falseKwd (66x)
+		57391: 113, // floatLit (66x)
+		57402: 114, // imaginaryLit (66x)
+		57454: 115, // trueKwd (66x)
+		57490: 116, // ConversionType (63x)
+		33:    117, // '!' (62x)
+		57528: 118, // Parameter (62x)
+		57534: 119, // QualifiedIdent (62x)
+		57478: 120, // Cast (60x)
+		57489: 121, // Conversion (60x)
+		57524: 122, // Literal (60x)
+		57525: 123, // Operand (60x)
+		57530: 124, // PrimaryExpression (60x)
+		57563: 125, // UnaryExpr (56x)
+		57533: 126, // PrimaryTerm (49x)
+		57444: 127, // selectKwd (49x)
+		57531: 128, // PrimaryFactor (45x)
+		57463: 129, // values (42x)
+		57382: 130, // drop (41x)
+		46:    131, // '.' (40x)
+		61:    132, // '=' (40x)
+		57445: 133, // set (40x)
+		57346: 134, // add (39x)
+		57386: 135, // exists (39x)
+		57510: 136, // Factor (28x)
+		57511: 137, // Factor1 (28x)
+		57560: 138, // Term (27x)
+		57506: 139, // Expression (26x)
+		57568: 140, // logOr (18x)
+		57484: 141, // ColumnName (15x)
+		57557: 142, // TableName (11x)
+		57545: 143, // SelectStmt (9x)
+		57507: 144, // ExpressionList (7x)
+		57537: 145, // RecordSet11 (6x)
+		57476: 146, // Call (5x)
+		57399: 147, // ifKwd (5x)
+		57517: 148, // Index (5x)
+		57404: 149, // index (5x)
+		57554: 150, // Slice (5x)
+		57479: 151, // ColumnDef (4x)
+		57480: 152, // ColumnDefComment (4x)
+		57485: 153, // ColumnNameList (4x)
+		57411: 154, // into (4x)
+		57449: 155, // tableKwd (4x)
+		57462: 156, // update (4x)
+		57566: 157, // WhereClause (4x)
+		57470: 158, // Assignment (3x)
+		57363: 159, // by (3x)
+		57380: 160, // distinct (3x)
+		57512: 161, // Field (3x)
+		57543: 162, // Returning (3x)
+		57562: 163, // Type (3x)
+		57347: 164, // alter (2x)
+		57468: 165, // AlterTableStmt (2x)
+		57469: 166, // AnalyzeStmt (2x)
+		57471: 167, // AssignmentList (2x)
+		57474: 168, // AttachStmt (2x)
+		57356: 169, // begin (2x)
+		57475: 170, // BeginTransactionStmt (2x)
+		57477: 171, // Call1 (2x)
+		57482: 172, // ColumnDefNotNull (2x)
+		57369: 173, // commit (2x)
+		57488: 174, // CommitStmt (2x)
+		57373: 175, // create (2x)
+		57491: 176, // CreateIndexIfNotExists (2x)
+		57492: 177, // CreateIndexStmt (2x)
+		57494: 178, // CreateTableStmt (2x)
+		57495: 179, // CreateTableStmt1 (2x)
+		57496: 180, // CreateTableStmt2 (2x)
+		57498: 181, // CreateTableStmt4 (2x)
+		57499: 182, // CreateTableStmt5 (2x)
+		57500: 183, // DeleteFromStmt (2x)
+		57376: 184, // deleteKwd (2x)
+		57501: 185, // DetachStmt (2x)
+		57503: 186, // DropIndexStmt (2x)
+		57504: 187, // DropTableStmt (2x)
+		57505: 188, // EmptyStmt (2x)
+		57514: 189, // FieldList (2x)
+		57405: 190, // insert (2x)
+		57518: 191, // InsertIntoStmt (2x)
+		57522: 192, // InsertIntoStmtOn (2x)
+		57567: 193, // logAnd (2x)
+		57569: 194, // oReturning (2x)
+		57570: 195, // oSet (2x)
+		57529: 196, // PragmaStmt (2x)
+		57535: 197, // RecordSet (2x)
+		57536: 198, // RecordSet1 (2x)
+		57538: 199, // RecordSet12 (2x)
+		57542: 200, // ReindexStmt (2x)
+		57440: 201, // rollback (2x)
+		57544: 202, // RollbackStmt (2x)
+		57547: 203, // SelectStmtFieldList (2x)
+		57555: 204, // Statement (2x)
+		57558: 205, // TableSample (2x)
+		57455: 206, // truncate (2x)
+		57561: 207, // TruncateTableStmt (2x)
+		57564: 208, // UpdateStmt (2x)
+		57565: 209, // UpdateStmt1 (2x)
+		57472: 210, // AssignmentList1 (1x)
+		57473: 211, // AssignmentList2 (1x)
+		57367: 212, // column (1x)
+		57481: 213, // ColumnDefDictionary (1x)
+		57483: 214, // ColumnDefStored (1x)
+		57486: 215, // ColumnNameList1 (1x)
+		57487: 216, // ColumnNameList2 (1x)
+		57493: 217, // CreateIndexStmtUnique (1x)
+		57497: 218, // CreateTableStmt3 (1x)
+		57502: 219, // DropIndexIfExists (1x)
+		57508: 220, // ExpressionList1 (1x)
+		57509: 221, // ExpressionList2 (1x)
+		57513: 222, // Field1 (1x)
+		57515: 223, // GroupByClause (1x)
+		57519: 224, // InsertIntoStmt1 (1x)
+		57520: 225, // InsertIntoStmt2 (1x)
+		57521: 226, // InsertIntoStmt3 (1x)
+		57523: 227, // InsertIntoStmtOr (1x)
+		57526: 228, // OrderBy (1x)
+		57527: 229, // OrderBy1 (1x)
+		57532: 230, // PrimaryKey (1x)
+		57539: 231, // RecordSet2 (1x)
+		57540: 232, // RecordSetList (1x)
+		57541: 233, // RecordSetList1 (1x)
+		57546: 234, // SelectStmtDistinct (1x)
+		57548: 235, // SelectStmtForUpdate (1x)
+		57549: 236, // SelectStmtGroup (1x)
+		57550: 237, // SelectStmtLimit (1x)
+		57551: 238, // SelectStmtOffset (1x)
+		57552: 239, // SelectStmtOrder (1x)
+		57553: 240, // SelectStmtWhere (1x)
+		57556: 241, // StatementList (1x)
+		57559: 242, // TableSample1 (1x)
+		57453: 243, // transaction (1x)
+		57461: 244, // unique (1x)
+		57467: 245, // $default (0x)
+		57345: 246, // error (0x)
 	}
 
 	yySymNames = []string{
+		"forKwd",
 		"';'",
 		"$end",
+		"returning",
+		"percent",
 		"')'",
+		"ilike",
+		"match",
+		"escape",
+		"collateKwd",
+		"comment",
 		"','",
-		"'('",
+		"on",
 		"'+'",
 		"'-'",
 		"'^'",
+		"'('",
 		"offset",
 		"limit",
-		"identifier",
+		"dictionaryKwd",
 		"order",
 		"where",
+		"not",
 		"group",
 		"or",
 		"oror",
+		"partitionKwd",
+		"arrayType",
+		"analyze",
+		"as",
+		"attach",
+		"database",
+		"detach",
+		"pragma",
+		"reindex",
+		"tablesample",
+		"without",
+		"conflict",
+		"do",
+		"fulltext",
+		"hash",
+		"ignore",
+		"key",
+		"less",
+		"partitionsKwd",
+		"rangeKwd",
+		"repeatable",
+		"replace",
+		"rowid",
+		"stored",
+		"than",
+		"virtual",
+		"castKwd",
 		"from",
+		"identifier",
+		"primary",
 		"asc",
 		"desc",
 		"']'",
-		"as",
 		"':'",
 		"and",
 		"andand",
 		"'|'",
-		"not",
+		"between",
+		"in",
+		"'<'",
+		"'>'",
+		"eq",
+		"ge",
+		"is",
+		"le",
+		"like",
+		"neq",
+		"'*'",
+		"'%'",
+		"'&'",
+		"'/'",
+		"andnot",
+		"lsh",
+		"rsh",
 		"bigIntType",
 		"bigRatType",
 		"blobType",
@@ -356,7 +513,6 @@ var (
 		"int64Type",
 		"int8Type",
 		"intType",
-		"null",
 		"runeType",
 		"stringType",
 		"timeType",
@@ -365,41 +521,38 @@ var (
 		"uint64Type",
 		"uint8Type",
 		"uintType",
-		"between",
-		"in",
-		"'<'",
-		"'>'",
-		"eq",
+		"Identifier",
+		"'['",
+		"dcolon",
+		"null",
+		"qlParam",
+		"intLit",
+		"stringLit",
+		"blobLit",
 		"falseKwd",
 		"floatLit",
-		"ge",
 		"imaginaryLit",
-		"intLit",
-		"is",
-		"le",
-		"like",
-		"neq",
-		"qlParam",
-		"stringLit",
 		"trueKwd",
+		"ConversionType",
 		"'!'",
-		"Type",
-		"'*'",
+		"Parameter",
+		"QualifiedIdent",
+		"Cast",
 		"Conversion",
 		"Literal",
 		"Operand",
 		"PrimaryExpression",
-		"QualifiedIdent",
-		"'%'",
-		"'&'",
-		"'/'",
-		"andnot",
-		"lsh",
-		"rsh",
 		"UnaryExpr",
 		"PrimaryTerm",
+		"selectKwd",
 		"PrimaryFactor",
-		"'['",
+		"values",
+		"drop",
+		"'.'",
+		"'='",
+		"set",
+		"add",
+		"exists",
 		"Factor",
 		"Factor1",
 		"Term",
@@ -407,706 +560,1085 @@ var (
 		"logOr",
 		"ColumnName",
 		"TableName",
-		"selectKwd",
+		"SelectStmt",
 		"ExpressionList",
+		"RecordSet11",
 		"Call",
+		"ifKwd",
 		"Index",
+		"index",
 		"Slice",
 		"ColumnDef",
-		"drop",
-		"exists",
-		"ifKwd",
-		"index",
-		"SelectStmt",
+		"ColumnDefComment",
+		"ColumnNameList",
+		"into",
 		"tableKwd",
-		"values",
+		"update",
 		"WhereClause",
-		"'='",
-		"add",
+		"Assignment",
+		"by",
+		"distinct",
+		"Field",
+		"Returning",
+		"Type",
 		"alter",
 		"AlterTableStmt",
-		"Assignment",
+		"AnalyzeStmt",
+		"AssignmentList",
+		"AttachStmt",
 		"begin",
 		"BeginTransactionStmt",
-		"by",
-		"ColumnNameList",
+		"Call1",
+		"ColumnDefNotNull",
 		"commit",
 		"CommitStmt",
 		"create",
+		"CreateIndexIfNotExists",
 		"CreateIndexStmt",
 		"CreateTableStmt",
 		"CreateTableStmt1",
 		"CreateTableStmt2",
+		"CreateTableStmt4",
+		"CreateTableStmt5",
 		"DeleteFromStmt",
 		"deleteKwd",
+		"DetachStmt",
 		"DropIndexStmt",
 		"DropTableStmt",
 		"EmptyStmt",
-		"Field",
-		"GroupByClause",
+		"FieldList",
 		"insert",
 		"InsertIntoStmt",
+		"InsertIntoStmtOn",
 		"logAnd",
-		"OrderBy",
+		"oReturning",
+		"oSet",
+		"PragmaStmt",
 		"RecordSet",
 		"RecordSet1",
+		"RecordSet12",
+		"ReindexStmt",
 		"rollback",
 		"RollbackStmt",
-		"SelectStmtGroup",
-		"SelectStmtLimit",
-		"SelectStmtOffset",
-		"SelectStmtOrder",
-		"SelectStmtWhere",
-		"set",
+		"SelectStmtFieldList",
 		"Statement",
+		"TableSample",
 		"truncate",
 		"TruncateTableStmt",
-		"update",
 		"UpdateStmt",
-		"'.'",
-		"AssignmentList",
+		"UpdateStmt1",
 		"AssignmentList1",
 		"AssignmentList2",
-		"Call1",
 		"column",
+		"ColumnDefDictionary",
+		"ColumnDefStored",
 		"ColumnNameList1",
 		"ColumnNameList2",
-		"CreateIndexIfNotExists",
 		"CreateIndexStmtUnique",
-		"distinct",
+		"CreateTableStmt3",
 		"DropIndexIfExists",
 		"ExpressionList1",
 		"ExpressionList2",
 		"Field1",
-		"FieldList",
+		"GroupByClause",
 		"InsertIntoStmt1",
 		"InsertIntoStmt2",
 		"InsertIntoStmt3",
-		"into",
-		"on",
+		"InsertIntoStmtOr",
+		"OrderBy",
 		"OrderBy1",
-		"oSet",
-		"RecordSet11",
+		"PrimaryKey",
 		"RecordSet2",
 		"RecordSetList",
+		"RecordSetList1",
 		"SelectStmtDistinct",
-		"SelectStmtFieldList",
+		"SelectStmtForUpdate",
+		"SelectStmtGroup",
+		"SelectStmtLimit",
+		"SelectStmtOffset",
+		"SelectStmtOrder",
+		"SelectStmtWhere",
 		"StatementList",
+		"TableSample1",
 		"transaction",
 		"unique",
-		"UpdateStmt1",
 		"$default",
 		"error",
 	}
 
 	yyReductions = map[int]struct{ xsym, components int }{
 		0:   {0, 1},
-		1:   {110, 5},
-		2:   {110, 6},
-		3:   {111, 3},
-		4:   {150, 3},
-		5:   {151, 0},
-		6:   {151, 3},
-		7:   {152, 0},
-		8:   {152, 1},
-		9:   {113, 2},
-		10:  {95, 3},
-		11:  {153, 0},
-		12:  {153, 1},
-		13:  {98, 2},
-		14:  {91, 1},
-		15:  {115, 3},
-		16:  {155, 0},
-		17:  {155, 3},
-		18:  {156, 0},
-		19:  {156, 1},
-		20:  {117, 1},
-		21:  {71, 4},
-		22:  {119, 10},
-		23:  {119, 12},
-		24:  {157, 0},
-		25:  {157, 3},
-		26:  {158, 0},
-		27:  {158, 1},
-		28:  {120, 8},
-		29:  {120, 11},
-		30:  {121, 0},
-		31:  {121, 3},
-		32:  {122, 0},
-		33:  {122, 1},
-		34:  {123, 3},
-		35:  {123, 4},
-		36:  {125, 4},
-		37:  {160, 0},
-		38:  {160, 2},
-		39:  {126, 3},
-		40:  {126, 5},
-		41:  {127, 0},
-		42:  {89, 1},
-		43:  {89, 3},
-		44:  {90, 1},
-		45:  {90, 1},
-		46:  {94, 3},
-		47:  {161, 0},
-		48:  {161, 3},
-		49:  {162, 0},
-		50:  {162, 1},
-		51:  {86, 1},
-		52:  {86, 5},
-		53:  {86, 6},
-		54:  {86, 5},
-		55:  {86, 6},
-		56:  {86, 3},
-		57:  {86, 4},
-		58:  {87, 1},
-		59:  {87, 3},
-		60:  {87, 3},
-		61:  {87, 3},
-		62:  {87, 3},
-		63:  {87, 3},
-		64:  {87, 3},
-		65:  {87, 3},
-		66:  {128, 2},
-		67:  {163, 0},
-		68:  {163, 2},
-		69:  {164, 1},
-		70:  {164, 3},
-		71:  {129, 3},
-		72:  {96, 3},
-		73:  {131, 10},
-		74:  {131, 5},
-		75:  {165, 0},
-		76:  {165, 3},
-		77:  {166, 0},
-		78:  {166, 5},
-		79:  {167, 0},
-		80:  {167, 1},
-		81:  {72, 1},
-		82:  {72, 1},
-		83:  {72, 1},
-		84:  {72, 1},
-		85:  {72, 1},
-		86:  {72, 1},
-		87:  {72, 1},
-		88:  {73, 1},
-		89:  {73, 1},
-		90:  {73, 1},
-		91:  {73, 3},
-		92:  {133, 4},
-		93:  {170, 0},
-		94:  {170, 1},
-		95:  {170, 1},
-		96:  {74, 1},
-		97:  {74, 1},
-		98:  {74, 2},
-		99:  {74, 2},
-		100: {74, 2},
-		101: {84, 1},
-		102: {84, 3},
-		103: {84, 3},
-		104: {84, 3},
-		105: {84, 3},
-		106: {83, 1},
-		107: {83, 3},
-		108: {83, 3},
-		109: {83, 3},
-		110: {83, 3},
-		111: {83, 3},
-		112: {83, 3},
-		113: {83, 3},
-		114: {75, 1},
-		115: {75, 3},
-		116: {134, 2},
-		117: {135, 1},
-		118: {135, 4},
-		119: {172, 0},
-		120: {172, 1},
-		121: {173, 0},
-		122: {173, 2},
-		123: {174, 1},
-		124: {174, 3},
-		125: {137, 1},
-		126: {103, 10},
-		127: {103, 11},
-		128: {139, 0},
-		129: {139, 2},
-		130: {140, 0},
-		131: {140, 2},
-		132: {175, 0},
-		133: {175, 1},
-		134: {176, 1},
-		135: {176, 1},
-		136: {176, 2},
-		137: {142, 0},
-		138: {142, 1},
-		139: {138, 0},
-		140: {138, 1},
-		141: {141, 0},
-		142: {141, 1},
-		143: {97, 3},
-		144: {97, 4},
-		145: {97, 4},
-		146: {97, 5},
-		147: {144, 1},
-		148: {144, 1},
-		149: {144, 1},
-		150: {144, 1},
-		151: {144, 1},
-		152: {144, 1},
-		153: {144, 1},
-		154: {144, 1},
-		155: {144, 1},
-		156: {144, 1},
-		157: {144, 1},
-		158: {144, 1},
-		159: {144, 1},
-		160: {144, 1},
-		161: {177, 1},
-		162: {177, 3},
-		163: {92, 1},
-		164: {88, 1},
-		165: {88, 3},
-		166: {132, 1},
-		167: {132, 1},
-		168: {146, 3},
-		169: {69, 1},
-		170: {69, 1},
-		171: {69, 1},
-		172: {69, 1},
-		173: {69, 1},
-		174: {69, 1},
-		175: {69, 1},
-		176: {69, 1},
-		177: {69, 1},
-		178: {69, 1},
-		179: {69, 1},
-		180: {69, 1},
-		181: {69, 1},
-		182: {69, 1},
-		183: {69, 1},
-		184: {69, 1},
-		185: {69, 1},
-		186: {69, 1},
-		187: {69, 1},
-		188: {69, 1},
-		189: {69, 1},
-		190: {69, 1},
-		191: {69, 1},
-		192: {69, 1},
-		193: {148, 5},
-		194: {180, 0},
-		195: {180, 1},
-		196: {82, 1},
-		197: {82, 2},
-		198: {82, 2},
-		199: {82, 2},
-		200: {82, 2},
-		201: {106, 2},
-		202: {171, 0},
-		203: {171, 1},
+		1:   {165, 5},
+		2:   {165, 6},
+		3:   {165, 12},
+		4:   {165, 6},
+		5:   {166, 1},
+		6:   {166, 2},
+		7:   {158, 3},
+		8:   {167, 3},
+		9:   {210, 0},
+		10:  {210, 3},
+		11:  {211, 0},
+		12:  {211, 1},
+		13:  {168, 5},
+		14:  {170, 2},
+		15:  {146, 3},
+		16:  {171, 0},
+		17:  {171, 1},
+		18:  {120, 6},
+		19:  {151, 5},
+		20:  {151, 9},
+		21:  {152, 0},
+		22:  {152, 2},
+		23:  {213, 0},
+		24:  {213, 1},
+		25:  {172, 0},
+		26:  {172, 2},
+		27:  {214, 0},
+		28:  {214, 1},
+		29:  {214, 1},
+		30:  {141, 1},
+		31:  {153, 3},
+		32:  {215, 0},
+		33:  {215, 3},
+		34:  {216, 0},
+		35:  {216, 1},
+		36:  {174, 1},
+		37:  {121, 4},
+		38:  {177, 10},
+		39:  {177, 10},
+		40:  {177, 12},
+		41:  {176, 0},
+		42:  {176, 3},
+		43:  {217, 0},
+		44:  {217, 1},
+		45:  {178, 11},
+		46:  {178, 14},
+		47:  {179, 0},
+		48:  {179, 3},
+		49:  {180, 0},
+		50:  {180, 1},
+		51:  {180, 3},
+		52:  {218, 0},
+		53:  {218, 1},
+		54:  {181, 0},
+		55:  {181, 2},
+		56:  {182, 0},
+		57:  {182, 6},
+		58:  {182, 8},
+		59:  {183, 3},
+		60:  {183, 4},
+		61:  {183, 5},
+		62:  {185, 3},
+		63:  {186, 4},
+		64:  {219, 0},
+		65:  {219, 2},
+		66:  {187, 3},
+		67:  {187, 5},
+		68:  {188, 0},
+		69:  {139, 1},
+		70:  {139, 3},
+		71:  {140, 1},
+		72:  {140, 1},
+		73:  {144, 3},
+		74:  {220, 0},
+		75:  {220, 3},
+		76:  {221, 0},
+		77:  {221, 1},
+		78:  {136, 1},
+		79:  {136, 5},
+		80:  {136, 6},
+		81:  {136, 3},
+		82:  {136, 4},
+		83:  {136, 3},
+		84:  {136, 4},
+		85:  {136, 6},
+		86:  {136, 7},
+		87:  {136, 5},
+		88:  {136, 6},
+		89:  {136, 3},
+		90:  {136, 4},
+		91:  {136, 5},
+		92:  {136, 6},
+		93:  {136, 5},
+		94:  {136, 6},
+		95:  {137, 1},
+		96:  {137, 3},
+		97:  {137, 3},
+		98:  {137, 3},
+		99:  {137, 3},
+		100: {137, 3},
+		101: {137, 3},
+		102: {137, 3},
+		103: {137, 5},
+		104: {137, 3},
+		105: {137, 5},
+		106: {137, 3},
+		107: {161, 2},
+		108: {222, 0},
+		109: {222, 2},
+		110: {189, 1},
+		111: {189, 3},
+		112: {223, 3},
+		113: {104, 1},
+		114: {104, 1},
+		115: {104, 1},
+		116: {104, 1},
+		117: {104, 1},
+		118: {104, 1},
+		119: {104, 1},
+		120: {104, 1},
+		121: {104, 1},
+		122: {104, 1},
+		123: {104, 1},
+		124: {104, 1},
+		125: {104, 1},
+		126: {104, 1},
+		127: {104, 1},
+		128: {104, 1},
+		129: {104, 1},
+		130: {104, 1},
+		131: {104, 1},
+		132: {104, 1},
+		133: {104, 1},
+		134: {104, 1},
+		135: {104, 1},
+		136: {104, 1},
+		137: {104, 1},
+		138: {104, 1},
+		139: {104, 1},
+		140: {104, 1},
+		141: {104, 1},
+		142: {104, 1},
+		143: {104, 1},
+		144: {104, 1},
+		145: {104, 1},
+		146: {104, 1},
+		147: {104, 1},
+		148: {104, 1},
+		149: {104, 1},
+		150: {148, 3},
+		151: {191, 12},
+		152: {191, 7},
+		153: {224, 0},
+		154: {224, 3},
+		155: {225, 0},
+		156: {225, 5},
+		157: {226, 0},
+		158: {226, 1},
+		159: {192, 0},
+		160: {192, 10},
+		161: {227, 0},
+		162: {227, 2},
+		163: {227, 2},
+		164: {122, 1},
+		165: {122, 1},
+		166: {122, 1},
+		167: {122, 1},
+		168: {122, 1},
+		169: {122, 1},
+		170: {122, 1},
+		171: {122, 1},
+		172: {123, 1},
+		173: {123, 1},
+		174: {123, 1},
+		175: {123, 3},
+		176: {123, 4},
+		177: {228, 4},
+		178: {229, 0},
+		179: {229, 1},
+		180: {229, 1},
+		181: {118, 1},
+		182: {196, 2},
+		183: {196, 4},
+		184: {124, 1},
+		185: {124, 1},
+		186: {124, 1},
+		187: {124, 2},
+		188: {124, 2},
+		189: {124, 2},
+		190: {124, 3},
+		191: {124, 3},
+		192: {128, 1},
+		193: {128, 3},
+		194: {128, 3},
+		195: {128, 3},
+		196: {128, 3},
+		197: {230, 5},
+		198: {126, 1},
+		199: {126, 3},
+		200: {126, 3},
+		201: {126, 3},
+		202: {126, 3},
+		203: {126, 3},
+		204: {126, 3},
+		205: {126, 3},
+		206: {119, 1},
+		207: {119, 3},
+		208: {197, 2},
+		209: {198, 2},
+		210: {198, 4},
+		211: {198, 4},
+		212: {145, 0},
+		213: {145, 1},
+		214: {199, 0},
+		215: {199, 1},
+		216: {231, 0},
+		217: {231, 2},
+		218: {232, 1},
+		219: {232, 3},
+		220: {233, 0},
+		221: {233, 1},
+		222: {200, 2},
+		223: {162, 2},
+		224: {202, 1},
+		225: {143, 12},
+		226: {237, 0},
+		227: {237, 2},
+		228: {238, 0},
+		229: {238, 2},
+		230: {235, 0},
+		231: {235, 2},
+		232: {234, 0},
+		233: {234, 1},
+		234: {203, 1},
+		235: {203, 1},
+		236: {203, 2},
+		237: {240, 0},
+		238: {240, 1},
+		239: {236, 0},
+		240: {236, 1},
+		241: {239, 0},
+		242: {239, 1},
+		243: {150, 3},
+		244: {150, 4},
+		245: {150, 4},
+		246: {150, 5},
+		247: {204, 1},
+		248: {204, 1},
+		249: {204, 1},
+		250: {204, 1},
+		251: {204, 1},
+		252: {204, 1},
+		253: {204, 1},
+		254: {204, 1},
+		255: {204, 1},
+		256: {204, 1},
+		257: {204, 1},
+		258: {204, 1},
+		259: {204, 1},
+		260: {204, 1},
+		261: {204, 1},
+		262: {204, 1},
+		263: {204, 1},
+		264: {204, 1},
+		265: {204, 1},
+		266: {241, 1},
+		267: {241, 3},
+		268: {142, 1},
+		269: {205, 6},
+		270: {242, 0},
+		271: {242, 4},
+		272: {138, 1},
+		273: {138, 3},
+		274: {193, 1},
+		275: {193, 1},
+		276: {207, 3},
+		277: {163, 1},
+		278: {163, 1},
+		279: {116, 1},
+		280: {116, 1},
+		281: {116, 1},
+		282: {116, 1},
+		283: {116, 1},
+		284: {116, 1},
+		285: {116, 1},
+		286: {116, 1},
+		287: {116, 1},
+		288: {116, 1},
+		289: {116, 1},
+		290: {116, 1},
+		291: {116, 1},
+		292: {116, 1},
+		293: {116, 1},
+		294: {116, 1},
+		295: {116, 1},
+		296: {116, 1},
+		297: {116, 1},
+		298: {116, 1},
+		299: {116, 1},
+		300: {116, 1},
+		301: {116, 1},
+		302: {116, 1},
+		303: {208, 6},
+		304: {209, 0},
+		305: {209, 1},
+		306: {125, 1},
+		307: {125, 2},
+		308: {125, 2},
+		309: {125, 2},
+		310: {125, 2},
+		311: {157, 2},
+		312: {194, 0},
+		313: {194, 1},
+		314: {195, 0},
+		315: {195, 1},
 	}
 
 	yyXErrors = map[yyXError]string{}
 
-	yyParseTab = [331][]uint16{
+	yyParseTab = [542][]uint16{
 		// 0
-		{163, 163, 93: 214, 99: 211, 103: 226, 109: 206, 216, 112: 207, 217, 116: 208, 218, 209, 219, 220, 123: 221, 210, 222, 223, 215, 130: 212, 224, 136: 213, 225, 144: 229, 230, 227, 231, 228, 177: 205},
-		{533, 204},
-		{104: 526},
-		{178: 525},
-		{184, 184},
+		{1: 248, 248, 28: 319, 30: 320, 32: 325, 328, 329, 127: 331, 130: 326, 143: 348, 156: 353, 164: 318, 333, 334, 168: 335, 321, 336, 173: 322, 337, 323, 177: 338, 339, 183: 340, 324, 341, 342, 343, 332, 190: 327, 344, 196: 345, 200: 346, 330, 347, 204: 351, 206: 352, 349, 350, 241: 317},
+		{1: 856, 316},
+		{155: 839},
+		{367, 311, 311, 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 391, 142: 838},
+		{31: 834},
 		// 5
-		{102: 178, 104: 491, 158: 489, 179: 490},
-		{16: 486},
-		{102: 476, 104: 477},
-		{168: 459},
-		{79, 79},
+		{243: 833},
+		{1: 280, 280},
+		{39: 744, 149: 273, 155: 746, 217: 743, 244: 745},
+		{53: 738},
+		{31: 736},
 		// 10
-		{4: 72, 72, 72, 72, 10: 72, 26: 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 56: 72, 72, 59: 72, 72, 65: 72, 72, 72, 72, 70: 72, 159: 402, 175: 401},
-		{57, 57},
-		{56, 56},
-		{55, 55},
-		{54, 54},
+		{149: 726, 155: 727},
+		{24: 694, 154: 155, 227: 693},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 690},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 391, 142: 689},
+		{1: 92, 92},
 		// 15
-		{53, 53},
-		{52, 52},
-		{51, 51},
-		{50, 50},
-		{49, 49},
+		{84, 3: 84, 84, 6: 84, 84, 84, 84, 84, 13: 84, 84, 84, 84, 19: 84, 22: 84, 26: 84, 84, 84, 30: 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 54: 84, 84, 73: 84, 80: 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 107: 84, 84, 84, 84, 84, 84, 84, 84, 84, 117: 84, 135: 84, 160: 628, 234: 627},
+		{1: 69, 69},
+		{1: 68, 68},
+		{1: 67, 67},
+		{1: 66, 66},
 		// 20
-		{48, 48},
-		{47, 47},
-		{46, 46},
-		{45, 45},
-		{44, 44},
+		{1: 65, 65},
+		{1: 64, 64},
+		{1: 63, 63},
+		{1: 62, 62},
+		{1: 61, 61},
 		// 25
-		{43, 43},
-		{104: 399},
-		{10: 232, 92: 233},
-		{41, 41, 4: 41, 10: 41, 12: 41, 93: 41, 99: 41, 105: 41, 108: 41, 143: 41},
-		{10: 2, 143: 235, 171: 234},
+		{1: 60, 60},
+		{1: 59, 59},
+		{1: 58, 58},
+		{1: 57, 57},
+		{1: 56, 56},
 		// 30
-		{10: 238, 91: 236, 111: 237, 150: 239},
-		{10: 1},
-		{107: 397},
-		{199, 199, 3: 199, 12: 199, 151: 393},
-		{190, 190, 190, 190, 8: 190, 190, 11: 190, 26: 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 43: 190, 190, 190, 190, 190, 190, 190, 190, 107: 190},
+		{1: 55, 55},
+		{1: 54, 54},
+		{1: 53, 53},
+		{1: 52, 52},
+		{1: 51, 51},
 		// 35
-		{10, 10, 12: 242, 106: 241, 180: 240},
-		{11, 11},
-		{9, 9},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 245},
-		{4: 390},
+		{1: 50, 50},
+		{155: 625},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 391, 142: 392},
+		{203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 105: 203, 203, 127: 203, 129: 203, 203, 203, 203, 203, 203},
+		{202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 105: 202, 202, 127: 202, 129: 202, 202, 202, 202, 202, 202},
 		// 40
-		{162, 162, 162, 162, 8: 162, 162, 11: 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 311, 310, 132: 309},
-		{3, 3, 3, 8: 3, 3, 11: 3, 13: 3, 306, 305, 90: 304},
-		{153, 153, 153, 153, 8: 153, 153, 11: 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 25: 356, 51: 357, 355, 362, 360, 364, 58: 359, 61: 358, 361, 365, 363},
-		{146, 146, 146, 146, 5: 350, 349, 347, 146, 146, 11: 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 348, 146, 51: 146, 146, 146, 146, 146, 58: 146, 61: 146, 146, 146, 146},
-		{123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 11: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 51: 123, 123, 123, 123, 123, 58: 123, 61: 123, 123, 123, 123, 70: 123, 76: 123, 123, 123, 123, 123, 123, 85: 123},
+		{201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 105: 201, 201, 127: 201, 129: 201, 201, 201, 201, 201, 201},
+		{200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 105: 200, 200, 127: 200, 129: 200, 200, 200, 200, 200, 200},
+		{199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 105: 199, 199, 127: 199, 129: 199, 199, 199, 199, 199, 199},
+		{198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 105: 198, 198, 127: 198, 129: 198, 198, 198, 198, 198, 198},
+		{197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 105: 197, 197, 127: 197, 129: 197, 197, 197, 197, 197, 197},
 		// 45
-		{122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 11: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 51: 122, 122, 122, 122, 122, 58: 122, 61: 122, 122, 122, 122, 70: 122, 76: 122, 122, 122, 122, 122, 122, 85: 122},
-		{121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 11: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 51: 121, 121, 121, 121, 121, 58: 121, 61: 121, 121, 121, 121, 70: 121, 76: 121, 121, 121, 121, 121, 121, 85: 121},
-		{120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 11: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 51: 120, 120, 120, 120, 120, 58: 120, 61: 120, 120, 120, 120, 70: 120, 76: 120, 120, 120, 120, 120, 120, 85: 120},
-		{119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 11: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 51: 119, 119, 119, 119, 119, 58: 119, 61: 119, 119, 119, 119, 70: 119, 76: 119, 119, 119, 119, 119, 119, 85: 119},
-		{118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 11: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 51: 118, 118, 118, 118, 118, 58: 118, 61: 118, 118, 118, 118, 70: 118, 76: 118, 118, 118, 118, 118, 118, 85: 118},
+		{196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 105: 196, 196, 127: 196, 129: 196, 196, 196, 196, 196, 196},
+		{195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 105: 195, 195, 127: 195, 129: 195, 195, 195, 195, 195, 195},
+		{194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 105: 194, 194, 127: 194, 129: 194, 194, 194, 194, 194, 194},
+		{193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 105: 193, 193, 127: 193, 129: 193, 193, 193, 193, 193, 193},
+		{192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 105: 192, 192, 127: 192, 129: 192, 192, 192, 192, 192, 192},
 		// 50
-		{117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 11: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 51: 117, 117, 117, 117, 117, 58: 117, 61: 117, 117, 117, 117, 70: 117, 76: 117, 117, 117, 117, 117, 117, 85: 117},
-		{116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 11: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 51: 116, 116, 116, 116, 116, 58: 116, 61: 116, 116, 116, 116, 70: 116, 76: 116, 116, 116, 116, 116, 116, 85: 116},
-		{115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 11: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 51: 115, 115, 115, 115, 115, 58: 115, 61: 115, 115, 115, 115, 70: 115, 76: 115, 115, 115, 115, 115, 115, 85: 115},
-		{114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 11: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 51: 114, 114, 114, 114, 114, 58: 114, 61: 114, 114, 114, 114, 70: 114, 76: 114, 114, 114, 114, 114, 114, 85: 114},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 345},
+		{191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 105: 191, 191, 127: 191, 129: 191, 191, 191, 191, 191, 191},
+		{190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 105: 190, 190, 127: 190, 129: 190, 190, 190, 190, 190, 190},
+		{189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 105: 189, 189, 127: 189, 129: 189, 189, 189, 189, 189, 189},
+		{188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 105: 188, 188, 127: 188, 129: 188, 188, 188, 188, 188, 188},
+		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 105: 187, 187, 127: 187, 129: 187, 187, 187, 187, 187, 187},
 		// 55
-		{108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 11: 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 51: 108, 108, 108, 108, 108, 58: 108, 61: 108, 108, 108, 108, 70: 108, 76: 108, 108, 108, 108, 108, 108, 85: 108},
-		{107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 11: 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 51: 107, 107, 107, 107, 107, 58: 107, 61: 107, 107, 107, 107, 70: 107, 76: 107, 107, 107, 107, 107, 107, 85: 107},
-		{8, 8, 8, 8, 295, 8, 8, 8, 8, 8, 11: 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 51: 8, 8, 8, 8, 8, 58: 8, 61: 8, 8, 8, 8, 70: 8, 76: 8, 8, 8, 8, 8, 8, 85: 296, 95: 299, 297, 298},
-		{103, 103, 103, 103, 5: 103, 103, 103, 103, 103, 11: 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 51: 103, 103, 103, 103, 103, 58: 103, 61: 103, 103, 103, 103, 70: 337, 76: 335, 332, 336, 331, 333, 334},
-		{98, 98, 98, 98, 5: 98, 98, 98, 98, 98, 11: 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 51: 98, 98, 98, 98, 98, 58: 98, 61: 98, 98, 98, 98, 70: 98, 76: 98, 98, 98, 98, 98, 98},
+		{186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 105: 186, 186, 127: 186, 129: 186, 186, 186, 186, 186, 186},
+		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 105: 185, 185, 127: 185, 129: 185, 185, 185, 185, 185, 185},
+		{184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 105: 184, 184, 127: 184, 129: 184, 184, 184, 184, 184, 184},
+		{183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 105: 183, 183, 127: 183, 129: 183, 183, 183, 183, 183, 183},
+		{182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 105: 182, 182, 127: 182, 129: 182, 182, 182, 182, 182, 182},
 		// 60
-		{90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 11: 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 51: 90, 90, 90, 90, 90, 58: 90, 61: 90, 90, 90, 90, 70: 90, 76: 90, 90, 90, 90, 90, 90, 85: 90, 149: 329},
-		{40, 40, 40, 40, 8: 40, 40, 11: 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40},
-		{35, 35, 35, 35, 35},
-		{34, 34, 34, 34, 34},
-		{33, 33, 33, 33, 33},
+		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 105: 181, 181, 127: 181, 129: 181, 181, 181, 181, 181, 181},
+		{180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 105: 180, 180, 127: 180, 129: 180, 180, 180, 180, 180, 180},
+		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 105: 179, 179, 127: 179, 129: 179, 179, 179, 179, 179, 179},
+		{178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 105: 178, 178, 127: 178, 129: 178, 178, 178, 178, 178, 178},
+		{177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 105: 177, 177, 127: 177, 129: 177, 177, 177, 177, 177, 177},
 		// 65
-		{32, 32, 32, 32, 32},
-		{31, 31, 31, 31, 31},
-		{30, 30, 30, 30, 30},
-		{29, 29, 29, 29, 29},
-		{28, 28, 28, 28, 28},
+		{176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 105: 176, 176, 127: 176, 129: 176, 176, 176, 176, 176, 176},
+		{175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 105: 175, 175, 127: 175, 129: 175, 175, 175, 175, 175, 175},
+		{174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 105: 174, 174, 127: 174, 129: 174, 174, 174, 174, 174, 174},
+		{173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 105: 173, 173, 127: 173, 129: 173, 173, 173, 173, 173, 173},
+		{172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 105: 172, 172, 127: 172, 129: 172, 172, 172, 172, 172, 172},
 		// 70
-		{27, 27, 27, 27, 27},
-		{26, 26, 26, 26, 26},
-		{25, 25, 25, 25, 25},
-		{24, 24, 24, 24, 24},
-		{23, 23, 23, 23, 23},
+		{171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 105: 171, 171, 127: 171, 129: 171, 171, 171, 171, 171, 171},
+		{170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 105: 170, 170, 127: 170, 129: 170, 170, 170, 170, 170, 170},
+		{169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 105: 169, 169, 127: 169, 129: 169, 169, 169, 169, 169, 169},
+		{168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 105: 168, 168, 127: 168, 129: 168, 168, 168, 168, 168, 168},
+		{167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 105: 167, 167, 127: 167, 129: 167, 167, 167, 167, 167, 167},
 		// 75
-		{22, 22, 22, 22, 22},
-		{21, 21, 21, 21, 21},
-		{20, 20, 20, 20, 20},
-		{19, 19, 19, 19, 19},
-		{18, 18, 18, 18, 18},
+		{48, 48, 48, 48, 48, 6: 48, 48, 48, 48, 48, 16: 48, 19: 48, 21: 48, 26: 48, 48, 48, 30: 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 54: 48, 48, 127: 48, 129: 48, 48, 133: 48, 48},
+		{2, 3: 2, 2, 6: 2, 2, 2, 2, 2, 19: 2, 26: 2, 2, 2, 30: 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 54: 2, 2, 133: 394, 195: 393},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 397, 141: 395, 158: 396, 167: 398},
+		{1, 3: 1, 1, 6: 1, 1, 1, 1, 1, 19: 1, 26: 1, 1, 1, 30: 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 54: 1, 1},
+		{132: 623},
 		// 80
-		{17, 17, 17, 17, 17},
-		{16, 16, 16, 16, 16},
-		{15, 15, 15, 15, 15},
-		{14, 14, 14, 14, 14},
-		{13, 13, 13, 13, 13},
+		{1: 307, 307, 307, 11: 307, 21: 307, 210: 619},
+		{286, 286, 286, 5: 286, 11: 286, 286, 17: 286, 286, 20: 286, 27: 286, 80: 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 132: 286},
+		{1: 12, 12, 12, 21: 401, 157: 400, 209: 399},
+		{1: 4, 4, 606, 162: 608, 194: 607},
+		{1: 11, 11, 11},
 		// 85
-		{12, 12, 12, 12, 12},
-		{4: 258, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 69: 243, 71: 260, 255, 259, 328, 257},
-		{4: 258, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 69: 243, 71: 260, 255, 259, 327, 257},
-		{4: 258, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 69: 243, 71: 260, 255, 259, 326, 257},
-		{4: 258, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 69: 243, 71: 260, 255, 259, 294, 257},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 405},
+		{199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 11: 199, 199, 199, 199, 199, 601, 199, 199, 20: 199, 199, 199, 199, 199, 199, 29: 199, 53: 199, 56: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 105: 199, 199, 131: 199},
+		{16: 598},
+		{247, 247, 247, 247, 247, 247, 11: 247, 247, 17: 247, 247, 20: 247, 247, 23: 247, 247, 247, 29: 247, 53: 247, 56: 247, 247, 247, 247, 482, 481, 193: 480},
+		{5, 5, 5, 5, 5: 5, 12: 5, 17: 5, 5, 20: 5, 23: 5, 477, 476, 140: 475},
 		// 90
-		{4, 4, 4, 4, 295, 4, 4, 4, 4, 4, 11: 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 51: 4, 4, 4, 4, 4, 58: 4, 61: 4, 4, 4, 4, 70: 4, 76: 4, 4, 4, 4, 4, 4, 85: 296, 95: 299, 297, 298},
-		{2: 193, 4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 320, 94: 319, 153: 318},
-		{4: 258, 293, 292, 290, 10: 264, 21: 301, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 300},
-		{106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 11: 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 51: 106, 106, 106, 106, 106, 58: 106, 61: 106, 106, 106, 106, 70: 106, 76: 106, 106, 106, 106, 106, 106, 85: 106},
-		{105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 11: 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 51: 105, 105, 105, 105, 105, 58: 105, 61: 105, 105, 105, 105, 70: 105, 76: 105, 105, 105, 105, 105, 105, 85: 105},
+		{238, 238, 238, 238, 238, 238, 550, 551, 11: 238, 238, 17: 238, 238, 20: 238, 238, 540, 238, 238, 238, 29: 238, 53: 238, 56: 238, 238, 238, 238, 238, 238, 63: 541, 539, 546, 544, 548, 543, 542, 545, 549, 547},
+		{16: 535},
+		{135: 530},
+		{221, 221, 221, 221, 221, 221, 221, 221, 11: 221, 221, 525, 524, 522, 17: 221, 221, 20: 221, 221, 221, 221, 221, 221, 29: 221, 53: 221, 56: 221, 221, 221, 221, 221, 221, 523, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221},
+		{152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 11: 152, 152, 152, 152, 152, 152, 152, 152, 20: 152, 152, 152, 152, 152, 152, 29: 152, 53: 152, 56: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 105: 152, 152},
 		// 95
-		{104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 11: 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 51: 104, 104, 104, 104, 104, 58: 104, 61: 104, 104, 104, 104, 70: 104, 76: 104, 104, 104, 104, 104, 104, 85: 104},
-		{14: 306, 305, 19: 313, 21: 314, 90: 304},
-		{4: 258, 293, 292, 290, 10: 264, 19: 303, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 302},
-		{14: 306, 305, 19: 307, 90: 304},
-		{61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 11: 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 51: 61, 61, 61, 61, 61, 58: 61, 61: 61, 61, 61, 61, 70: 61, 76: 61, 61, 61, 61, 61, 61, 85: 61},
+		{151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 11: 151, 151, 151, 151, 151, 151, 151, 151, 20: 151, 151, 151, 151, 151, 151, 29: 151, 53: 151, 56: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 105: 151, 151},
+		{150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 11: 150, 150, 150, 150, 150, 150, 150, 150, 20: 150, 150, 150, 150, 150, 150, 29: 150, 53: 150, 56: 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 105: 150, 150},
+		{149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 11: 149, 149, 149, 149, 149, 149, 149, 149, 20: 149, 149, 149, 149, 149, 149, 29: 149, 53: 149, 56: 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 105: 149, 149},
+		{148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 11: 148, 148, 148, 148, 148, 148, 148, 148, 20: 148, 148, 148, 148, 148, 148, 29: 148, 53: 148, 56: 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 105: 148, 148},
+		{147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 11: 147, 147, 147, 147, 147, 147, 147, 147, 20: 147, 147, 147, 147, 147, 147, 29: 147, 53: 147, 56: 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 105: 147, 147},
 		// 100
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 308},
-		{4: 160, 160, 160, 160, 10: 160, 26: 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 56: 160, 160, 59: 160, 160, 65: 160, 160, 160, 160},
-		{4: 159, 159, 159, 159, 10: 159, 26: 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 56: 159, 159, 59: 159, 159, 65: 159, 159, 159, 159},
-		{60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 11: 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 51: 60, 60, 60, 60, 60, 58: 60, 61: 60, 60, 60, 60, 70: 60, 76: 60, 60, 60, 60, 60, 60, 85: 60},
-		{161, 161, 161, 161, 8: 161, 161, 11: 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 311, 310, 132: 309},
+		{146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 11: 146, 146, 146, 146, 146, 146, 146, 146, 20: 146, 146, 146, 146, 146, 146, 29: 146, 53: 146, 56: 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 105: 146, 146},
+		{145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 11: 145, 145, 145, 145, 145, 145, 145, 145, 20: 145, 145, 145, 145, 145, 145, 29: 145, 53: 145, 56: 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 105: 145, 145},
+		{144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 11: 144, 144, 144, 144, 144, 144, 144, 144, 20: 144, 144, 144, 144, 144, 144, 29: 144, 53: 144, 56: 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 105: 144, 144},
+		{143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 11: 143, 143, 143, 143, 143, 143, 143, 143, 20: 143, 143, 143, 143, 143, 143, 29: 143, 53: 143, 56: 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 105: 143, 143},
+		{142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 11: 142, 142, 142, 142, 142, 142, 142, 142, 20: 142, 142, 142, 142, 142, 142, 29: 142, 53: 142, 56: 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 105: 142, 142},
 		// 105
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 312, 246},
-		{4: 38, 38, 38, 38, 10: 38, 26: 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 56: 38, 38, 59: 38, 38, 65: 38, 38, 38, 38},
-		{4: 37, 37, 37, 37, 10: 37, 26: 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 56: 37, 37, 59: 37, 37, 65: 37, 37, 37, 37},
-		{39, 39, 39, 39, 8: 39, 39, 11: 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39},
-		{132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 11: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 51: 132, 132, 132, 132, 132, 58: 132, 61: 132, 132, 132, 132, 70: 132, 76: 132, 132, 132, 132, 132, 132, 85: 132},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 331, 409, 135: 407, 430, 406, 404, 516, 143: 517},
+		{135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 11: 135, 135, 135, 135, 135, 135, 135, 135, 20: 135, 135, 135, 135, 135, 135, 29: 135, 53: 135, 56: 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 105: 135, 135},
+		{132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 11: 132, 132, 132, 132, 132, 132, 132, 132, 20: 132, 132, 132, 132, 132, 132, 29: 132, 53: 132, 56: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 105: 132, 132},
+		{131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 11: 131, 131, 131, 131, 131, 131, 131, 131, 20: 131, 131, 131, 131, 131, 131, 29: 131, 53: 131, 56: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 105: 131, 131},
+		{130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 11: 130, 130, 130, 130, 130, 130, 130, 130, 20: 130, 130, 130, 130, 130, 130, 29: 130, 53: 130, 56: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 105: 130, 130},
 		// 110
-		{4: 258, 293, 292, 290, 10: 264, 19: 316, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 315},
-		{14: 306, 305, 19: 317, 90: 304},
-		{59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 11: 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 51: 59, 59, 59, 59, 59, 58: 59, 61: 59, 59, 59, 59, 70: 59, 76: 59, 59, 59, 59, 59, 59, 85: 59},
-		{58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 11: 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 51: 58, 58, 58, 58, 58, 58: 58, 61: 58, 58, 58, 58, 70: 58, 76: 58, 58, 58, 58, 58, 58, 85: 58},
-		{2: 325},
+		{10, 10, 10, 10, 10, 10, 10, 10, 10, 466, 11: 10, 10, 10, 10, 10, 460, 10, 10, 20: 10, 10, 10, 10, 10, 10, 29: 10, 53: 10, 56: 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 105: 461, 465, 146: 464, 148: 462, 150: 463},
+		{124, 124, 124, 124, 124, 124, 124, 124, 124, 11: 124, 124, 124, 124, 124, 17: 124, 124, 20: 124, 124, 124, 124, 124, 124, 29: 124, 53: 124, 56: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 508, 506, 503, 507, 502, 504, 505},
+		{118, 118, 118, 118, 118, 118, 118, 118, 118, 11: 118, 118, 118, 118, 118, 17: 118, 118, 20: 118, 118, 118, 118, 118, 118, 29: 118, 53: 118, 56: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118},
+		{110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 11: 110, 110, 110, 110, 110, 110, 110, 110, 20: 110, 110, 110, 110, 110, 110, 29: 110, 53: 110, 56: 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 105: 110, 110, 131: 500},
+		{44, 44, 44, 44, 44, 44, 11: 44, 44, 17: 44, 44, 20: 44, 44, 23: 44, 44, 44, 29: 44, 53: 44, 56: 44, 44, 44, 44, 44, 44},
 		// 115
-		{2: 192},
-		{157, 157, 157, 157, 8: 157, 157, 14: 306, 305, 17: 157, 157, 90: 304, 161: 321},
-		{155, 155, 155, 323, 8: 155, 155, 17: 155, 155, 162: 322},
-		{158, 158, 158, 8: 158, 158, 17: 158, 158},
-		{154, 154, 154, 4: 258, 293, 292, 290, 154, 154, 264, 17: 154, 154, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 324},
+		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 29: 37, 53: 37, 56: 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 105: 37, 37},
+		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 29: 36, 53: 36, 56: 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 105: 36, 36},
+		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 29: 35, 53: 35, 56: 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 105: 35, 35},
+		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 29: 34, 53: 34, 56: 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 105: 34, 34},
+		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 29: 33, 53: 33, 56: 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 105: 33, 33},
 		// 120
-		{156, 156, 156, 156, 8: 156, 156, 14: 306, 305, 17: 156, 156, 90: 304},
-		{194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 11: 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 51: 194, 194, 194, 194, 194, 58: 194, 61: 194, 194, 194, 194, 70: 194, 76: 194, 194, 194, 194, 194, 194, 85: 194},
-		{5, 5, 5, 5, 295, 5, 5, 5, 5, 5, 11: 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 51: 5, 5, 5, 5, 5, 58: 5, 61: 5, 5, 5, 5, 70: 5, 76: 5, 5, 5, 5, 5, 5, 85: 296, 95: 299, 297, 298},
-		{6, 6, 6, 6, 295, 6, 6, 6, 6, 6, 11: 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 51: 6, 6, 6, 6, 6, 58: 6, 61: 6, 6, 6, 6, 70: 6, 76: 6, 6, 6, 6, 6, 6, 85: 296, 95: 299, 297, 298},
-		{7, 7, 7, 7, 295, 7, 7, 7, 7, 7, 11: 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 51: 7, 7, 7, 7, 7, 58: 7, 61: 7, 7, 7, 7, 70: 7, 76: 7, 7, 7, 7, 7, 7, 85: 296, 95: 299, 297, 298},
+		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 29: 32, 53: 32, 56: 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 105: 32, 32},
+		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 29: 31, 53: 31, 56: 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 105: 31, 31},
+		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 29: 30, 53: 30, 56: 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 105: 30, 30},
+		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29: 29, 53: 29, 56: 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 105: 29, 29},
+		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 29: 28, 53: 28, 56: 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 105: 28, 28},
 		// 125
-		{10: 330},
-		{89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 11: 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 51: 89, 89, 89, 89, 89, 58: 89, 61: 89, 89, 89, 89, 70: 89, 76: 89, 89, 89, 89, 89, 89, 85: 89},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 344},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 343},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 342},
+		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 29: 27, 53: 27, 56: 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 105: 27, 27},
+		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 29: 26, 53: 26, 56: 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 105: 26, 26},
+		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 29: 25, 53: 25, 56: 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 105: 25, 25},
+		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 29: 24, 53: 24, 56: 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 105: 24, 24},
+		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 29: 23, 53: 23, 56: 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 105: 23, 23},
 		// 130
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 341},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 340},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 339},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 338},
-		{91, 91, 91, 91, 5: 91, 91, 91, 91, 91, 11: 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 51: 91, 91, 91, 91, 91, 58: 91, 61: 91, 91, 91, 91, 70: 91, 76: 91, 91, 91, 91, 91, 91},
+		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 29: 22, 53: 22, 56: 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 105: 22, 22},
+		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 29: 21, 53: 21, 56: 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 105: 21, 21},
+		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 29: 20, 53: 20, 56: 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 105: 20, 20},
+		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 29: 19, 53: 19, 56: 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 105: 19, 19},
+		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 29: 18, 53: 18, 56: 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 105: 18, 18},
 		// 135
-		{92, 92, 92, 92, 5: 92, 92, 92, 92, 92, 11: 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 51: 92, 92, 92, 92, 92, 58: 92, 61: 92, 92, 92, 92, 70: 92, 76: 92, 92, 92, 92, 92, 92},
-		{93, 93, 93, 93, 5: 93, 93, 93, 93, 93, 11: 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 51: 93, 93, 93, 93, 93, 58: 93, 61: 93, 93, 93, 93, 70: 93, 76: 93, 93, 93, 93, 93, 93},
-		{94, 94, 94, 94, 5: 94, 94, 94, 94, 94, 11: 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 51: 94, 94, 94, 94, 94, 58: 94, 61: 94, 94, 94, 94, 70: 94, 76: 94, 94, 94, 94, 94, 94},
-		{95, 95, 95, 95, 5: 95, 95, 95, 95, 95, 11: 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 51: 95, 95, 95, 95, 95, 58: 95, 61: 95, 95, 95, 95, 70: 95, 76: 95, 95, 95, 95, 95, 95},
-		{96, 96, 96, 96, 5: 96, 96, 96, 96, 96, 11: 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 51: 96, 96, 96, 96, 96, 58: 96, 61: 96, 96, 96, 96, 70: 96, 76: 96, 96, 96, 96, 96, 96},
+		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 29: 17, 53: 17, 56: 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 105: 17, 17},
+		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 29: 16, 53: 16, 56: 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 105: 16, 16},
+		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 29: 15, 53: 15, 56: 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 105: 15, 15},
+		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 29: 14, 53: 14, 56: 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 105: 14, 14},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 16: 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 118: 419, 420, 425, 424, 418, 423, 499},
 		// 140
-		{97, 97, 97, 97, 5: 97, 97, 97, 97, 97, 11: 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 51: 97, 97, 97, 97, 97, 58: 97, 61: 97, 97, 97, 97, 70: 97, 76: 97, 97, 97, 97, 97, 97},
-		{2: 346, 14: 306, 305, 90: 304},
-		{113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 11: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 51: 113, 113, 113, 113, 113, 58: 113, 61: 113, 113, 113, 113, 70: 113, 76: 113, 113, 113, 113, 113, 113, 85: 113},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 354},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 353},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 16: 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 118: 419, 420, 425, 424, 418, 423, 498},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 16: 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 118: 419, 420, 425, 424, 418, 423, 497},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 16: 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 118: 419, 420, 425, 424, 418, 423, 459},
+		{6, 6, 6, 6, 6, 6, 6, 6, 6, 466, 11: 6, 6, 6, 6, 6, 460, 6, 6, 20: 6, 6, 6, 6, 6, 6, 29: 6, 53: 6, 56: 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 105: 461, 465, 146: 464, 148: 462, 150: 463},
+		{367, 3: 384, 377, 300, 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 491, 144: 490, 171: 489},
 		// 145
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 352},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 351},
-		{99, 99, 99, 99, 5: 99, 99, 99, 99, 99, 11: 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 51: 99, 99, 99, 99, 99, 58: 99, 61: 99, 99, 99, 99, 70: 337, 76: 335, 332, 336, 331, 333, 334},
-		{100, 100, 100, 100, 5: 100, 100, 100, 100, 100, 11: 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 51: 100, 100, 100, 100, 100, 58: 100, 61: 100, 100, 100, 100, 70: 337, 76: 335, 332, 336, 331, 333, 334},
-		{101, 101, 101, 101, 5: 101, 101, 101, 101, 101, 11: 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 51: 101, 101, 101, 101, 101, 58: 101, 61: 101, 101, 101, 101, 70: 337, 76: 335, 332, 336, 331, 333, 334},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 59: 472, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 471},
+		{129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 11: 129, 129, 129, 129, 129, 129, 129, 129, 20: 129, 129, 129, 129, 129, 129, 29: 129, 53: 129, 56: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 105: 129, 129},
+		{128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 11: 128, 128, 128, 128, 128, 128, 128, 128, 20: 128, 128, 128, 128, 128, 128, 29: 128, 53: 128, 56: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 105: 128, 128},
+		{127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 11: 127, 127, 127, 127, 127, 127, 127, 127, 20: 127, 127, 127, 127, 127, 127, 29: 127, 53: 127, 56: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 105: 127, 127},
+		{27: 469, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 116: 470, 163: 468},
 		// 150
-		{102, 102, 102, 102, 5: 102, 102, 102, 102, 102, 11: 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 51: 102, 102, 102, 102, 102, 58: 102, 61: 102, 102, 102, 102, 70: 337, 76: 335, 332, 336, 331, 333, 334},
-		{4: 387},
-		{51: 380, 379},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 376},
-		{25: 374, 42: 373},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 467},
+		{125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 11: 125, 125, 125, 125, 125, 125, 125, 125, 20: 125, 125, 125, 125, 125, 125, 29: 125, 53: 125, 56: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 105: 125, 125},
+		{126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 11: 126, 126, 126, 126, 126, 126, 126, 126, 20: 126, 126, 126, 126, 126, 126, 29: 126, 53: 126, 56: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 105: 126, 126},
+		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 29: 39, 53: 39, 56: 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 105: 39, 39},
+		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 29: 38, 53: 38, 56: 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 105: 38, 38},
 		// 155
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 372},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 371},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 370},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 369},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 368},
+		{24: 477, 476, 58: 484, 485, 140: 475},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 58: 474, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 473},
+		{24: 477, 476, 58: 478, 140: 475},
+		{73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 11: 73, 73, 73, 73, 73, 73, 73, 73, 20: 73, 73, 73, 73, 73, 73, 29: 73, 53: 73, 56: 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 105: 73, 73},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 479},
 		// 160
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 367},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 366},
-		{139, 139, 139, 139, 5: 350, 349, 347, 139, 139, 11: 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 348, 139, 51: 139, 139, 139, 139, 139, 58: 139, 61: 139, 139, 139, 139},
-		{140, 140, 140, 140, 5: 350, 349, 347, 140, 140, 11: 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 348, 140, 51: 140, 140, 140, 140, 140, 58: 140, 61: 140, 140, 140, 140},
-		{141, 141, 141, 141, 5: 350, 349, 347, 141, 141, 11: 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 348, 141, 51: 141, 141, 141, 141, 141, 58: 141, 61: 141, 141, 141, 141},
+		{245, 3: 245, 245, 6: 245, 245, 245, 245, 245, 13: 245, 245, 245, 245, 19: 245, 22: 245, 26: 245, 245, 245, 30: 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 54: 245, 245, 80: 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 107: 245, 245, 245, 245, 245, 245, 245, 245, 245, 117: 245, 135: 245},
+		{244, 3: 244, 244, 6: 244, 244, 244, 244, 244, 13: 244, 244, 244, 244, 19: 244, 22: 244, 26: 244, 244, 244, 30: 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 54: 244, 244, 80: 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 107: 244, 244, 244, 244, 244, 244, 244, 244, 244, 117: 244, 135: 244},
+		{72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 11: 72, 72, 72, 72, 72, 72, 72, 72, 20: 72, 72, 72, 72, 72, 72, 29: 72, 53: 72, 56: 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 105: 72, 72},
+		{246, 246, 246, 246, 246, 246, 11: 246, 246, 17: 246, 246, 20: 246, 246, 23: 246, 246, 246, 29: 246, 53: 246, 56: 246, 246, 246, 246, 482, 481, 193: 480},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 483, 406},
 		// 165
-		{142, 142, 142, 142, 5: 350, 349, 347, 142, 142, 11: 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 348, 142, 51: 142, 142, 142, 142, 142, 58: 142, 61: 142, 142, 142, 142},
-		{143, 143, 143, 143, 5: 350, 349, 347, 143, 143, 11: 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 348, 143, 51: 143, 143, 143, 143, 143, 58: 143, 61: 143, 143, 143, 143},
-		{144, 144, 144, 144, 5: 350, 349, 347, 144, 144, 11: 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 348, 144, 51: 144, 144, 144, 144, 144, 58: 144, 61: 144, 144, 144, 144},
-		{145, 145, 145, 145, 5: 350, 349, 347, 145, 145, 11: 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 348, 145, 51: 145, 145, 145, 145, 145, 58: 145, 61: 145, 145, 145, 145},
-		{148, 148, 148, 148, 8: 148, 148, 11: 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148},
+		{42, 3: 42, 42, 6: 42, 42, 42, 42, 42, 13: 42, 42, 42, 42, 19: 42, 22: 42, 26: 42, 42, 42, 30: 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 54: 42, 42, 80: 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 107: 42, 42, 42, 42, 42, 42, 42, 42, 42, 117: 42, 135: 42},
+		{41, 3: 41, 41, 6: 41, 41, 41, 41, 41, 13: 41, 41, 41, 41, 19: 41, 22: 41, 26: 41, 41, 41, 30: 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 54: 41, 41, 80: 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 107: 41, 41, 41, 41, 41, 41, 41, 41, 41, 117: 41, 135: 41},
+		{43, 43, 43, 43, 43, 43, 11: 43, 43, 17: 43, 43, 20: 43, 43, 23: 43, 43, 43, 29: 43, 53: 43, 56: 43, 43, 43, 43, 43, 43},
+		{166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 11: 166, 166, 166, 166, 166, 166, 166, 166, 20: 166, 166, 166, 166, 166, 166, 29: 166, 53: 166, 56: 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 105: 166, 166},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 58: 487, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 486},
 		// 170
-		{42: 375},
-		{147, 147, 147, 147, 8: 147, 147, 11: 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147},
-		{5: 350, 349, 347, 22: 377, 24: 348},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 378},
-		{150, 150, 150, 150, 5: 350, 349, 347, 150, 150, 11: 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 348},
+		{24: 477, 476, 58: 488, 140: 475},
+		{71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 11: 71, 71, 71, 71, 71, 71, 71, 71, 20: 71, 71, 71, 71, 71, 71, 29: 71, 53: 71, 56: 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 105: 71, 71},
+		{70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 11: 70, 70, 70, 70, 70, 70, 70, 70, 20: 70, 70, 70, 70, 70, 70, 29: 70, 53: 70, 56: 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 105: 70, 70},
+		{5: 496},
+		{5: 299},
 		// 175
-		{4: 384},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 381},
-		{5: 350, 349, 347, 22: 382, 24: 348},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 383},
-		{149, 149, 149, 149, 5: 350, 349, 347, 149, 149, 11: 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 348},
+		{242, 242, 242, 5: 242, 11: 242, 242, 17: 242, 242, 24: 477, 476, 56: 242, 242, 140: 475, 220: 492},
+		{240, 240, 240, 5: 240, 11: 494, 240, 17: 240, 240, 56: 240, 240, 221: 493},
+		{243, 243, 243, 5: 243, 12: 243, 17: 243, 243, 56: 243, 243},
+		{239, 239, 239, 384, 377, 239, 371, 374, 366, 359, 360, 12: 239, 458, 457, 455, 421, 239, 239, 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 239, 239, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 495},
+		{241, 241, 241, 5: 241, 11: 241, 241, 17: 241, 241, 24: 477, 476, 56: 241, 241, 140: 475},
 		// 180
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 320, 94: 385},
-		{2: 386},
-		{151, 151, 151, 151, 8: 151, 151, 11: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 320, 94: 388},
-		{2: 389},
+		{301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 11: 301, 301, 301, 301, 301, 301, 301, 301, 20: 301, 301, 301, 301, 301, 301, 29: 301, 53: 301, 56: 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 105: 301, 301},
+		{7, 7, 7, 7, 7, 7, 7, 7, 7, 466, 11: 7, 7, 7, 7, 7, 460, 7, 7, 20: 7, 7, 7, 7, 7, 7, 29: 7, 53: 7, 56: 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 105: 461, 465, 146: 464, 148: 462, 150: 463},
+		{8, 8, 8, 8, 8, 8, 8, 8, 8, 466, 11: 8, 8, 8, 8, 8, 460, 8, 8, 20: 8, 8, 8, 8, 8, 8, 29: 8, 53: 8, 56: 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 105: 461, 465, 146: 464, 148: 462, 150: 463},
+		{9, 9, 9, 9, 9, 9, 9, 9, 9, 466, 11: 9, 9, 9, 9, 9, 460, 9, 9, 20: 9, 9, 9, 9, 9, 9, 29: 9, 53: 9, 56: 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 105: 461, 465, 146: 464, 148: 462, 150: 463},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 501},
 		// 185
-		{152, 152, 152, 152, 8: 152, 152, 11: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 391},
-		{2: 392, 14: 306, 305, 90: 304},
-		{183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 11: 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 51: 183, 183, 183, 183, 183, 58: 183, 61: 183, 183, 183, 183, 70: 183, 76: 183, 183, 183, 183, 183, 183, 85: 183},
-		{197, 197, 3: 395, 12: 197, 152: 394},
+		{109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 11: 109, 109, 109, 109, 109, 109, 109, 109, 20: 109, 109, 109, 109, 109, 109, 29: 109, 53: 109, 56: 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 105: 109, 109},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 515},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 514},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 513},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 512},
 		// 190
-		{200, 200, 12: 200},
-		{196, 196, 10: 238, 12: 196, 91: 236, 111: 396},
-		{198, 198, 3: 198, 12: 198},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 398},
-		{201, 201, 3: 201, 12: 201, 14: 306, 305, 90: 304},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 511},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 510},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 509},
+		{111, 111, 111, 111, 111, 111, 111, 111, 111, 11: 111, 111, 111, 111, 111, 17: 111, 111, 20: 111, 111, 111, 111, 111, 111, 29: 111, 53: 111, 56: 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111},
+		{112, 112, 112, 112, 112, 112, 112, 112, 112, 11: 112, 112, 112, 112, 112, 17: 112, 112, 20: 112, 112, 112, 112, 112, 112, 29: 112, 53: 112, 56: 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112},
 		// 195
-		{10: 232, 92: 400},
-		{36, 36},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 407, 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 403, 128: 404, 164: 405, 176: 406},
-		{4: 71, 71, 71, 71, 10: 71, 26: 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 56: 71, 71, 59: 71, 71, 65: 71, 71, 71, 71, 70: 71},
-		{3: 137, 14: 306, 305, 137, 20: 457, 90: 304, 163: 456},
+		{113, 113, 113, 113, 113, 113, 113, 113, 113, 11: 113, 113, 113, 113, 113, 17: 113, 113, 20: 113, 113, 113, 113, 113, 113, 29: 113, 53: 113, 56: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113},
+		{114, 114, 114, 114, 114, 114, 114, 114, 114, 11: 114, 114, 114, 114, 114, 17: 114, 114, 20: 114, 114, 114, 114, 114, 114, 29: 114, 53: 114, 56: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114},
+		{115, 115, 115, 115, 115, 115, 115, 115, 115, 11: 115, 115, 115, 115, 115, 17: 115, 115, 20: 115, 115, 115, 115, 115, 115, 29: 115, 53: 115, 56: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115},
+		{116, 116, 116, 116, 116, 116, 116, 116, 116, 11: 116, 116, 116, 116, 116, 17: 116, 116, 20: 116, 116, 116, 116, 116, 116, 29: 116, 53: 116, 56: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116},
+		{117, 117, 117, 117, 117, 117, 117, 117, 117, 11: 117, 117, 117, 117, 117, 17: 117, 117, 20: 117, 117, 117, 117, 117, 117, 29: 117, 53: 117, 56: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117},
 		// 200
-		{3: 135, 16: 135},
-		{3: 454, 16: 69},
-		{16: 408},
-		{16: 70},
-		{4: 411, 10: 410, 134: 412, 409, 174: 413},
+		{5: 521, 24: 477, 476, 140: 475},
+		{1: 519, 5: 104, 145: 518},
+		{5: 520},
+		{5: 103},
+		{140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 11: 140, 140, 140, 140, 140, 140, 140, 140, 20: 140, 140, 140, 140, 140, 140, 29: 140, 53: 140, 56: 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 105: 140, 140},
 		// 205
-		{83, 83, 83, 83, 8: 83, 83, 11: 83, 83, 83, 20: 452, 173: 451},
-		{87, 87, 87, 87, 8: 87, 87, 11: 87, 87, 87, 20: 87},
-		{93: 214, 103: 447},
-		{81, 81, 81, 81, 8: 81, 81, 11: 81, 81, 81},
-		{67, 67, 67, 414, 8: 67, 67, 11: 67, 242, 67, 106: 416, 142: 415},
+		{141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 11: 141, 141, 141, 141, 141, 141, 141, 141, 20: 141, 141, 141, 141, 141, 141, 29: 141, 53: 141, 56: 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 105: 141, 141},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 529},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 528},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 527},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 526},
 		// 210
-		{67, 67, 67, 4: 411, 8: 67, 67, 410, 67, 242, 67, 106: 416, 134: 441, 409, 142: 442},
-		{65, 65, 65, 8: 65, 65, 11: 65, 13: 417, 129: 419, 138: 418},
-		{66, 66, 66, 8: 66, 66, 11: 66, 13: 66},
-		{114: 434},
-		{63, 63, 63, 8: 63, 63, 11: 420, 133: 422, 141: 421},
+		{120, 120, 120, 120, 120, 120, 120, 120, 120, 11: 120, 120, 120, 120, 120, 17: 120, 120, 20: 120, 120, 120, 120, 120, 120, 29: 120, 53: 120, 56: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 508, 506, 503, 507, 502, 504, 505},
+		{121, 121, 121, 121, 121, 121, 121, 121, 121, 11: 121, 121, 121, 121, 121, 17: 121, 121, 20: 121, 121, 121, 121, 121, 121, 29: 121, 53: 121, 56: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 508, 506, 503, 507, 502, 504, 505},
+		{122, 122, 122, 122, 122, 122, 122, 122, 122, 11: 122, 122, 122, 122, 122, 17: 122, 122, 20: 122, 122, 122, 122, 122, 122, 29: 122, 53: 122, 56: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 508, 506, 503, 507, 502, 504, 505},
+		{123, 123, 123, 123, 123, 123, 123, 123, 123, 11: 123, 123, 123, 123, 123, 17: 123, 123, 20: 123, 123, 123, 123, 123, 123, 29: 123, 53: 123, 56: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 508, 506, 503, 507, 502, 504, 505},
+		{16: 531},
 		// 215
-		{64, 64, 64, 8: 64, 64, 11: 64},
-		{114: 429},
-		{76, 76, 76, 8: 76, 424, 139: 423},
-		{62, 62, 62, 8: 62, 62},
-		{74, 74, 74, 8: 427, 140: 426},
+		{127: 331, 143: 532},
+		{1: 519, 5: 104, 145: 533},
+		{5: 534},
+		{222, 222, 222, 222, 222, 222, 11: 222, 222, 17: 222, 222, 20: 222, 222, 23: 222, 222, 222, 29: 222, 53: 222, 56: 222, 222, 222, 222, 222, 222},
+		{127: 331, 143: 536},
 		// 220
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 425},
-		{75, 75, 75, 8: 75, 14: 306, 305, 90: 304},
-		{78, 78, 78},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 428},
-		{73, 73, 73, 14: 306, 305, 90: 304},
+		{1: 519, 5: 104, 145: 537},
+		{5: 538},
+		{223, 223, 223, 223, 223, 223, 11: 223, 223, 17: 223, 223, 20: 223, 223, 23: 223, 223, 223, 29: 223, 53: 223, 56: 223, 223, 223, 223, 223, 223},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 16: 590, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 429, 108: 422, 118: 592, 591},
+		{63: 578, 577},
 		// 225
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 320, 94: 430},
-		{111, 111, 111, 8: 111, 111, 17: 432, 433, 170: 431},
-		{112, 112, 112, 8: 112, 112},
-		{110, 110, 110, 8: 110, 110},
-		{109, 109, 109, 8: 109, 109},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 574},
+		{22: 566, 107: 565, 160: 567},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 564},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 563},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 562},
 		// 230
-		{10: 238, 91: 435, 115: 436},
-		{188, 188, 188, 188, 8: 188, 188, 11: 188, 155: 437},
-		{133, 133, 133, 8: 133, 133, 11: 133},
-		{186, 186, 186, 439, 8: 186, 186, 11: 186, 156: 438},
-		{189, 189, 189, 8: 189, 189, 11: 189},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 561},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 560},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 559},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 556},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 553},
 		// 235
-		{185, 185, 185, 8: 185, 185, 238, 185, 91: 440},
-		{187, 187, 187, 187, 8: 187, 187, 11: 187},
-		{80, 80, 80, 80, 8: 80, 80, 11: 80, 80, 80},
-		{65, 65, 65, 8: 65, 65, 11: 65, 13: 417, 129: 419, 138: 443},
-		{63, 63, 63, 8: 63, 63, 11: 420, 133: 422, 141: 444},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 552},
+		{210, 210, 210, 210, 210, 210, 210, 210, 11: 210, 210, 525, 524, 522, 17: 210, 210, 20: 210, 210, 210, 210, 210, 210, 29: 210, 53: 210, 56: 210, 210, 210, 210, 210, 210, 523, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210},
+		{212, 212, 212, 212, 212, 212, 212, 212, 554, 11: 212, 212, 525, 524, 522, 17: 212, 212, 20: 212, 212, 212, 212, 212, 212, 29: 212, 53: 212, 56: 212, 212, 212, 212, 212, 212, 523, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 555},
+		{211, 211, 211, 211, 211, 211, 211, 211, 11: 211, 211, 525, 524, 522, 17: 211, 211, 20: 211, 211, 211, 211, 211, 211, 29: 211, 53: 211, 56: 211, 211, 211, 211, 211, 211, 523, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211},
 		// 240
-		{76, 76, 76, 8: 76, 424, 139: 445},
-		{74, 74, 74, 8: 427, 140: 446},
-		{77, 77, 77},
-		{449, 2: 85, 172: 448},
-		{2: 450},
+		{214, 214, 214, 214, 214, 214, 214, 214, 557, 11: 214, 214, 525, 524, 522, 17: 214, 214, 20: 214, 214, 214, 214, 214, 214, 29: 214, 53: 214, 56: 214, 214, 214, 214, 214, 214, 523, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 558},
+		{213, 213, 213, 213, 213, 213, 213, 213, 11: 213, 213, 525, 524, 522, 17: 213, 213, 20: 213, 213, 213, 213, 213, 213, 29: 213, 53: 213, 56: 213, 213, 213, 213, 213, 213, 523, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213},
+		{215, 215, 215, 215, 215, 215, 215, 215, 11: 215, 215, 525, 524, 522, 17: 215, 215, 20: 215, 215, 215, 215, 215, 215, 29: 215, 53: 215, 56: 215, 215, 215, 215, 215, 215, 523, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215},
+		{216, 216, 216, 216, 216, 216, 216, 216, 11: 216, 216, 525, 524, 522, 17: 216, 216, 20: 216, 216, 216, 216, 216, 216, 29: 216, 53: 216, 56: 216, 216, 216, 216, 216, 216, 523, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216},
 		// 245
-		{2: 84},
-		{86, 86, 86, 86, 8: 86, 86, 11: 86, 86, 86, 20: 86},
-		{88, 88, 88, 88, 8: 88, 88, 11: 88, 88, 88},
-		{10: 453},
-		{82, 82, 82, 82, 8: 82, 82, 11: 82, 82, 82},
+		{217, 217, 217, 217, 217, 217, 217, 217, 11: 217, 217, 525, 524, 522, 17: 217, 217, 20: 217, 217, 217, 217, 217, 217, 29: 217, 53: 217, 56: 217, 217, 217, 217, 217, 217, 523, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217},
+		{218, 218, 218, 218, 218, 218, 218, 218, 11: 218, 218, 525, 524, 522, 17: 218, 218, 20: 218, 218, 218, 218, 218, 218, 29: 218, 53: 218, 56: 218, 218, 218, 218, 218, 218, 523, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218},
+		{219, 219, 219, 219, 219, 219, 219, 219, 11: 219, 219, 525, 524, 522, 17: 219, 219, 20: 219, 219, 219, 219, 219, 219, 29: 219, 53: 219, 56: 219, 219, 219, 219, 219, 219, 523, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219},
+		{220, 220, 220, 220, 220, 220, 220, 220, 11: 220, 220, 525, 524, 522, 17: 220, 220, 20: 220, 220, 220, 220, 220, 220, 29: 220, 53: 220, 56: 220, 220, 220, 220, 220, 220, 523, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220},
+		{227, 227, 227, 227, 227, 227, 11: 227, 227, 17: 227, 227, 20: 227, 227, 23: 227, 227, 227, 29: 227, 53: 227, 56: 227, 227, 227, 227, 227, 227},
 		// 250
-		{4: 258, 293, 292, 290, 10: 264, 16: 68, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 403, 128: 455},
-		{3: 134, 16: 134},
-		{3: 138, 16: 138},
-		{10: 458},
-		{3: 136, 16: 136},
+		{107: 570, 160: 571},
+		{53: 568},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 569},
+		{225, 225, 225, 225, 225, 225, 11: 225, 225, 525, 524, 522, 17: 225, 225, 20: 225, 225, 23: 225, 225, 225, 29: 225, 53: 225, 56: 225, 225, 225, 225, 225, 225, 523},
+		{226, 226, 226, 226, 226, 226, 11: 226, 226, 17: 226, 226, 20: 226, 226, 23: 226, 226, 226, 29: 226, 53: 226, 56: 226, 226, 226, 226, 226, 226},
 		// 255
-		{10: 232, 92: 460},
-		{4: 462, 93: 129, 105: 129, 165: 461},
-		{93: 214, 103: 466, 105: 465},
-		{10: 238, 91: 435, 115: 463},
-		{2: 464},
+		{53: 572},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 573},
+		{224, 224, 224, 224, 224, 224, 11: 224, 224, 525, 524, 522, 17: 224, 224, 20: 224, 224, 23: 224, 224, 224, 29: 224, 53: 224, 56: 224, 224, 224, 224, 224, 224, 523},
+		{13: 525, 524, 522, 60: 575, 62: 523},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 576},
 		// 260
-		{93: 128, 105: 128},
-		{4: 467},
-		{130, 130},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 320, 94: 468},
-		{2: 469},
+		{229, 229, 229, 229, 229, 229, 11: 229, 229, 525, 524, 522, 17: 229, 229, 20: 229, 229, 23: 229, 229, 229, 29: 229, 53: 229, 56: 229, 229, 229, 229, 229, 229, 523},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 16: 582, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 429, 108: 422, 118: 584, 583},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 579},
+		{13: 525, 524, 522, 60: 580, 62: 523},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 581},
 		// 265
-		{127, 127, 3: 127, 166: 470},
-		{125, 125, 3: 472, 167: 471},
-		{131, 131},
-		{124, 124, 4: 473},
-		{4: 258, 293, 292, 290, 10: 264, 26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 249, 282, 283, 284, 286, 287, 288, 289, 285, 56: 248, 251, 59: 252, 253, 65: 256, 254, 250, 291, 243, 71: 260, 255, 259, 261, 257, 82: 263, 262, 247, 86: 265, 246, 244, 320, 94: 474},
+		{228, 228, 228, 228, 228, 228, 11: 228, 228, 525, 524, 522, 17: 228, 228, 20: 228, 228, 23: 228, 228, 228, 29: 228, 53: 228, 56: 228, 228, 228, 228, 228, 228, 523},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 331, 409, 135: 407, 430, 406, 404, 491, 143: 586, 585},
+		{234, 234, 234, 234, 234, 234, 11: 234, 234, 17: 234, 234, 20: 234, 234, 23: 234, 234, 234, 29: 234, 53: 234, 56: 234, 234, 234, 234, 234, 234},
+		{232, 232, 232, 232, 232, 232, 11: 232, 232, 17: 232, 232, 20: 232, 232, 23: 232, 232, 232, 29: 232, 53: 232, 56: 232, 232, 232, 232, 232, 232},
+		{5: 589},
 		// 270
-		{2: 475},
-		{126, 126, 3: 126},
-		{10: 167, 101: 483, 160: 482},
-		{10: 232, 92: 478, 101: 479},
-		{165, 165},
+		{1: 519, 5: 104, 145: 587},
+		{5: 588},
+		{230, 230, 230, 230, 230, 230, 11: 230, 230, 17: 230, 230, 20: 230, 230, 23: 230, 230, 230, 29: 230, 53: 230, 56: 230, 230, 230, 230, 230, 230},
+		{236, 236, 236, 236, 236, 236, 11: 236, 236, 17: 236, 236, 20: 236, 236, 23: 236, 236, 236, 29: 236, 53: 236, 56: 236, 236, 236, 236, 236, 236},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 331, 409, 135: 407, 430, 406, 404, 491, 143: 594, 593},
 		// 275
-		{100: 480},
-		{10: 232, 92: 481},
-		{164, 164},
-		{10: 485},
-		{100: 484},
+		{235, 235, 235, 235, 235, 235, 11: 235, 235, 17: 235, 235, 20: 235, 235, 23: 235, 235, 235, 29: 235, 53: 235, 56: 235, 235, 235, 235, 235, 235},
+		{233, 233, 233, 233, 233, 233, 11: 233, 233, 17: 233, 233, 20: 233, 233, 23: 233, 233, 233, 29: 233, 53: 233, 56: 233, 233, 233, 233, 233, 233},
+		{5: 597},
+		{1: 519, 5: 104, 145: 595},
+		{5: 596},
 		// 280
-		{10: 166},
-		{168, 168},
-		{10: 232, 92: 487},
-		{170, 170, 12: 242, 106: 488},
-		{169, 169},
+		{231, 231, 231, 231, 231, 231, 11: 231, 231, 17: 231, 231, 20: 231, 231, 23: 231, 231, 231, 29: 231, 53: 231, 56: 231, 231, 231, 231, 231, 231},
+		{237, 237, 237, 237, 237, 237, 11: 237, 237, 17: 237, 237, 20: 237, 237, 23: 237, 237, 237, 29: 237, 53: 237, 56: 237, 237, 237, 237, 237, 237},
+		{367, 3: 384, 377, 300, 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 491, 144: 490, 171: 599},
+		{5: 600},
+		{279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 11: 279, 279, 279, 279, 279, 279, 279, 279, 20: 279, 279, 279, 279, 279, 279, 29: 279, 53: 279, 56: 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 105: 279, 279},
 		// 285
-		{102: 511},
-		{102: 177},
-		{10: 232, 92: 492, 101: 493},
-		{4: 506},
-		{25: 494},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 602},
+		{24: 477, 476, 29: 603, 140: 475},
+		{27: 469, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 116: 470, 163: 604},
+		{5: 605},
+		{298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 11: 298, 298, 298, 298, 298, 298, 298, 298, 20: 298, 298, 298, 298, 298, 298, 29: 298, 53: 298, 56: 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 105: 298, 298},
 		// 290
-		{100: 495},
-		{10: 232, 92: 496},
-		{4: 497},
-		{10: 238, 91: 498, 98: 499},
-		{26: 266, 267, 268, 269, 270, 271, 272, 273, 275, 276, 274, 278, 279, 280, 281, 277, 43: 282, 283, 284, 286, 287, 288, 289, 285, 69: 505},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 73: 613, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 609, 161: 610, 189: 611, 203: 612},
+		{1: 13, 13},
+		{1: 3, 3},
+		{1: 208, 208, 11: 208, 24: 477, 476, 29: 617, 53: 208, 140: 475, 222: 616},
+		{1: 206, 206, 11: 206, 53: 206},
 		// 295
-		{2: 174, 174, 121: 500},
-		{2: 172, 502, 122: 501},
-		{2: 504},
-		{2: 171, 10: 238, 91: 498, 98: 503},
-		{2: 173, 173},
+		{1: 81, 81, 11: 614, 53: 81},
+		{1: 93, 93},
+		{1: 82, 82, 53: 82},
+		{367, 80, 80, 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 80, 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 609, 161: 615},
+		{1: 205, 205, 11: 205, 53: 205},
 		// 300
-		{175, 175},
-		{191, 191, 191, 191},
-		{10: 238, 91: 498, 98: 507},
-		{2: 174, 174, 121: 508},
-		{2: 172, 502, 122: 509},
+		{1: 209, 209, 11: 209, 53: 209},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 618},
+		{1: 207, 207, 11: 207, 53: 207},
+		{1: 305, 305, 305, 11: 621, 21: 305, 211: 620},
+		{1: 308, 308, 308, 21: 308},
 		// 305
-		{2: 510},
-		{176, 176},
-		{10: 180, 101: 513, 157: 512},
-		{10: 516},
-		{25: 514},
+		{367, 304, 304, 304, 377, 6: 371, 374, 366, 359, 360, 19: 364, 21: 304, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 397, 141: 395, 158: 622},
+		{1: 306, 306, 306, 11: 306, 21: 306},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 624},
+		{1: 309, 309, 309, 11: 309, 21: 309, 24: 477, 476, 140: 475},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 391, 142: 626},
 		// 310
-		{100: 515},
-		{10: 179},
-		{169: 517},
-		{10: 518},
-		{4: 519},
+		{1: 40, 40},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 73: 613, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 609, 161: 610, 189: 611, 203: 629},
+		{83, 3: 83, 83, 6: 83, 83, 83, 83, 83, 13: 83, 83, 83, 83, 19: 83, 22: 83, 26: 83, 83, 83, 30: 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 54: 83, 83, 73: 83, 80: 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 107: 83, 83, 83, 83, 83, 83, 83, 83, 83, 117: 83, 135: 83},
+		{53: 630},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 16: 633, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 632, 197: 634, 631, 232: 635},
 		// 315
-		{10: 520},
-		{2: 521, 4: 522},
-		{182, 182},
-		{2: 523},
-		{2: 524},
+		{100, 100, 100, 5: 100, 11: 100, 100, 17: 100, 100, 20: 100, 100, 23: 100, 29: 687, 231: 686},
+		{102, 102, 102, 5: 102, 11: 102, 102, 17: 102, 102, 20: 102, 102, 23: 102, 29: 102, 35: 674, 131: 672, 199: 671, 205: 673},
+		{127: 331, 143: 668},
+		{98, 98, 98, 5: 98, 11: 98, 98, 17: 98, 98, 20: 98, 98, 23: 98},
+		{96, 96, 96, 5: 96, 11: 636, 96, 17: 96, 96, 20: 96, 96, 23: 96, 233: 637},
 		// 320
-		{181, 181},
-		{195, 195},
-		{10: 232, 92: 527},
-		{99: 529, 108: 528},
-		{10: 238, 91: 498, 98: 532},
+		{95, 95, 95, 384, 377, 95, 371, 374, 366, 359, 360, 12: 95, 16: 633, 95, 95, 364, 95, 95, 23: 95, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 632, 197: 667, 631},
+		{79, 79, 79, 5: 79, 12: 79, 17: 79, 79, 20: 79, 401, 23: 79, 157: 639, 240: 638},
+		{77, 77, 77, 5: 77, 12: 77, 17: 77, 77, 20: 77, 23: 640, 223: 642, 236: 641},
+		{78, 78, 78, 5: 78, 12: 78, 17: 78, 78, 20: 78, 23: 78},
+		{159: 660},
 		// 325
-		{154: 530},
-		{10: 238, 91: 531},
-		{202, 202},
-		{203, 203},
-		{163, 163, 93: 214, 99: 211, 103: 226, 109: 206, 216, 112: 207, 217, 116: 208, 218, 209, 219, 220, 123: 221, 210, 222, 223, 215, 130: 212, 224, 136: 213, 225, 144: 534, 230, 227, 231, 228},
+		{75, 75, 75, 5: 75, 12: 75, 17: 75, 75, 20: 643, 228: 645, 239: 644},
+		{76, 76, 76, 5: 76, 12: 76, 17: 76, 76, 20: 76},
+		{159: 655},
+		{90, 90, 90, 5: 90, 12: 90, 17: 90, 647, 237: 646},
+		{74, 74, 74, 5: 74, 12: 74, 17: 74, 74},
 		// 330
-		{42, 42},
+		{88, 88, 88, 5: 88, 12: 88, 17: 650, 238: 649},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 648},
+		{89, 89, 89, 5: 89, 12: 89, 17: 89, 24: 477, 476, 140: 475},
+		{653, 86, 86, 5: 86, 12: 86, 235: 652},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 651},
+		// 335
+		{87, 87, 87, 5: 87, 12: 87, 24: 477, 476, 140: 475},
+		{1: 91, 91, 5: 91, 12: 91},
+		{156: 654},
+		{1: 85, 85, 5: 85, 12: 85},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 491, 144: 656},
+		// 340
+		{138, 138, 138, 5: 138, 12: 138, 17: 138, 138, 56: 658, 659, 229: 657},
+		{139, 139, 139, 5: 139, 12: 139, 17: 139, 139},
+		{137, 137, 137, 5: 137, 12: 137, 17: 137, 137},
+		{136, 136, 136, 5: 136, 12: 136, 17: 136, 136},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 397, 141: 661, 153: 662},
+		// 345
+		{284, 284, 284, 5: 284, 11: 284, 284, 17: 284, 284, 20: 284, 215: 663},
+		{204, 204, 204, 5: 204, 12: 204, 17: 204, 204, 20: 204},
+		{282, 282, 282, 5: 282, 11: 665, 282, 17: 282, 282, 20: 282, 216: 664},
+		{285, 285, 285, 5: 285, 12: 285, 17: 285, 285, 20: 285},
+		{281, 281, 281, 384, 377, 281, 371, 374, 366, 359, 360, 12: 281, 17: 281, 281, 364, 281, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 397, 141: 666},
+		// 350
+		{283, 283, 283, 5: 283, 11: 283, 283, 17: 283, 283, 20: 283},
+		{97, 97, 97, 5: 97, 11: 97, 97, 17: 97, 97, 20: 97, 97, 23: 97},
+		{1: 519, 5: 104, 145: 669},
+		{5: 670},
+		{105, 105, 105, 5: 105, 11: 105, 105, 17: 105, 105, 20: 105, 105, 23: 105, 29: 105},
+		// 355
+		{107, 107, 107, 5: 107, 11: 107, 107, 17: 107, 107, 20: 107, 107, 23: 107, 29: 107},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 684},
+		{101, 101, 101, 5: 101, 11: 101, 101, 17: 101, 101, 20: 101, 101, 23: 101, 29: 101},
+		{16: 675},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 676},
+		// 360
+		{4: 677, 24: 477, 476, 140: 475},
+		{5: 678},
+		{46, 46, 46, 5: 46, 11: 46, 46, 17: 46, 46, 20: 46, 46, 23: 46, 29: 46, 46: 680, 242: 679},
+		{47, 47, 47, 5: 47, 11: 47, 47, 17: 47, 47, 20: 47, 47, 23: 47, 29: 47},
+		{16: 681},
+		// 365
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 682},
+		{5: 683, 24: 477, 476, 140: 475},
+		{45, 45, 45, 5: 45, 11: 45, 45, 17: 45, 45, 20: 45, 45, 23: 45, 29: 45},
+		{102, 102, 102, 5: 102, 11: 102, 102, 17: 102, 102, 20: 102, 102, 23: 102, 29: 102, 35: 674, 199: 685, 205: 673},
+		{106, 106, 106, 5: 106, 11: 106, 106, 17: 106, 106, 20: 106, 106, 23: 106, 29: 106},
+		// 370
+		{108, 108, 108, 5: 108, 11: 108, 108, 17: 108, 108, 20: 108, 108, 23: 108},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 688},
+		{99, 99, 99, 5: 99, 11: 99, 99, 17: 99, 99, 20: 99, 99, 23: 99},
+		{1: 94, 94},
+		{1: 134, 134, 132: 691},
+		// 375
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 692},
+		{1: 133, 133, 24: 477, 476, 140: 475},
+		{154: 697},
+		{41: 695, 47: 696},
+		{154: 154},
+		// 380
+		{154: 153},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 391, 142: 698},
+		{16: 700, 127: 163, 129: 163, 224: 699},
+		{127: 331, 129: 703, 143: 704},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 397, 141: 661, 153: 701},
+		// 385
+		{5: 702},
+		{127: 162, 129: 162},
+		{16: 716},
+		{1: 157, 157, 12: 706, 192: 705},
+		{1: 164, 164},
+		// 390
+		{37: 707},
+		{16: 708},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 397, 141: 661, 153: 709},
+		{5: 710},
+		{38: 711},
+		// 395
+		{156: 712},
+		{2, 3: 2, 2, 6: 2, 2, 2, 2, 2, 19: 2, 26: 2, 2, 2, 30: 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 54: 2, 2, 133: 394, 195: 713},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 397, 141: 395, 158: 396, 167: 714},
+		{1: 12, 12, 21: 401, 157: 400, 209: 715},
+		{1: 156, 156},
+		// 400
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 491, 144: 717},
+		{5: 718},
+		{1: 161, 161, 11: 161, 161, 225: 719},
+		{1: 159, 159, 11: 721, 159, 226: 720},
+		{1: 157, 157, 12: 706, 192: 725},
+		// 405
+		{1: 158, 158, 12: 158, 16: 722},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 491, 144: 723},
+		{5: 724},
+		{1: 160, 160, 11: 160, 160},
+		{1: 165, 165},
+		// 410
+		{252, 3: 252, 252, 6: 252, 252, 252, 252, 252, 19: 252, 26: 252, 252, 252, 30: 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 54: 252, 252, 147: 733, 219: 732},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 391, 142: 728, 147: 729},
+		{1: 250, 250},
+		{135: 730},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 391, 142: 731},
+		// 415
+		{1: 249, 249},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 735},
+		{135: 734},
+		{251, 3: 251, 251, 6: 251, 251, 251, 251, 251, 19: 251, 26: 251, 251, 251, 30: 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 54: 251, 251},
+		{1: 253, 253},
+		// 420
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 737},
+		{1: 254, 254},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 391, 142: 739},
+		{1: 257, 257, 606, 21: 401, 157: 741, 162: 740},
+		{1: 256, 256},
+		// 425
+		{1: 4, 4, 606, 162: 608, 194: 742},
+		{1: 255, 255},
+		{149: 822},
+		{149: 811},
+		{149: 272},
+		// 430
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 391, 142: 747, 147: 748},
+		{16: 803},
+		{22: 749},
+		{135: 750},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 391, 142: 751},
+		// 435
+		{16: 752},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 397, 141: 753, 151: 754},
+		{27: 469, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 116: 470, 163: 787},
+		{5: 269, 11: 269, 179: 755},
+		{5: 267, 11: 757, 180: 756},
+		// 440
+		{5: 767},
+		{367, 3: 384, 377, 266, 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 760, 104: 397, 141: 753, 151: 758, 230: 759},
+		{5: 268, 11: 268},
+		{5: 264, 11: 766, 218: 765},
+		{27: 178, 42: 761, 80: 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178},
+		// 445
+		{16: 762},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 397, 141: 661, 153: 763},
+		{5: 764},
+		{5: 119, 11: 119},
+		{5: 265},
+		// 450
+		{5: 263},
+		{1: 262, 262, 10: 262, 26: 262, 36: 769, 181: 768},
+		{1: 260, 260, 10: 260, 26: 772, 182: 771},
+		{48: 770},
+		{1: 261, 261, 10: 261, 26: 261},
+		// 455
+		{1: 295, 295, 10: 784, 152: 785},
+		{159: 773},
+		{40: 775, 45: 774},
+		{16: 781},
+		{16: 776},
+		// 460
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 397, 141: 777},
+		{5: 778},
+		{44: 779},
+		{109: 780},
+		{1: 258, 258, 10: 258},
+		// 465
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 397, 141: 782},
+		{5: 783},
+		{1: 259, 259, 10: 259},
+		{110: 786},
+		{1: 270, 270},
+		// 470
+		{1: 294, 294, 5: 294, 11: 294},
+		{1: 293, 293, 5: 293, 10: 293, 293, 19: 790, 22: 293, 29: 789, 213: 788},
+		{1: 291, 291, 5: 291, 10: 291, 291, 22: 798, 172: 801},
+		{16: 791},
+		{1: 292, 292, 5: 292, 10: 292, 292, 22: 292},
+		// 475
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 792},
+		{5: 793, 24: 477, 476, 140: 475},
+		{1: 289, 289, 5: 289, 10: 289, 289, 22: 289, 49: 795, 51: 796, 214: 794},
+		{1: 291, 291, 5: 291, 10: 291, 291, 22: 798, 172: 797},
+		{1: 288, 288, 5: 288, 10: 288, 288, 22: 288},
+		// 480
+		{1: 287, 287, 5: 287, 10: 287, 287, 22: 287},
+		{1: 295, 295, 5: 295, 10: 784, 295, 152: 800},
+		{107: 799},
+		{1: 290, 290, 5: 290, 10: 290, 290},
+		{1: 296, 296, 5: 296, 11: 296},
+		// 485
+		{1: 295, 295, 5: 295, 10: 784, 295, 152: 802},
+		{1: 297, 297, 5: 297, 11: 297},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 397, 141: 753, 151: 804},
+		{5: 269, 11: 269, 179: 805},
+		{5: 267, 11: 757, 180: 806},
+		// 490
+		{5: 807},
+		{1: 262, 262, 10: 262, 26: 262, 36: 769, 181: 808},
+		{1: 260, 260, 10: 260, 26: 772, 182: 809},
+		{1: 295, 295, 10: 784, 152: 810},
+		{1: 271, 271},
+		// 495
+		{275, 3: 275, 275, 6: 275, 275, 275, 275, 275, 19: 275, 26: 275, 275, 275, 30: 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 54: 275, 275, 147: 813, 176: 812},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 816},
+		{22: 814},
+		{135: 815},
+		{274, 3: 274, 274, 6: 274, 274, 274, 274, 274, 19: 274, 26: 274, 274, 274, 30: 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 54: 274, 274},
+		// 500
+		{12: 817},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 818},
+		{16: 819},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 820},
+		{5: 821},
+		// 505
+		{1: 277, 277},
+		{275, 3: 275, 275, 6: 275, 275, 275, 275, 275, 19: 275, 26: 275, 275, 275, 30: 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 54: 275, 275, 147: 813, 176: 823},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 824},
+		{12: 825},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 826},
+		// 510
+		{16: 827},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 828},
+		{5: 829, 16: 830},
+		{1: 278, 278},
+		{5: 831},
+		// 515
+		{5: 832},
+		{1: 276, 276},
+		{1: 302, 302},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 835},
+		{24: 477, 476, 29: 836, 140: 475},
+		// 520
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 837},
+		{1: 303, 303},
+		{1: 310, 310},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 391, 142: 840},
+		{130: 842, 134: 841},
+		// 525
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 848, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 397, 141: 753, 151: 847},
+		{26: 844, 212: 843},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 397, 141: 846},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 104: 845},
+		{1: 312, 312},
+		// 530
+		{1: 314, 314},
+		{1: 315, 315},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 19: 364, 26: 375, 182, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 358, 54: 354, 379, 80: 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 849},
+		{129: 850},
+		{43: 851},
+		// 535
+		{50: 852},
+		{16: 853},
+		{367, 3: 384, 377, 6: 371, 374, 366, 359, 360, 13: 458, 457, 455, 421, 19: 364, 22: 408, 26: 375, 356, 355, 30: 357, 362, 363, 378, 381, 387, 390, 361, 365, 368, 369, 370, 372, 373, 376, 380, 382, 383, 385, 386, 388, 389, 402, 54: 354, 379, 80: 431, 432, 433, 434, 435, 436, 437, 438, 440, 441, 439, 443, 444, 445, 446, 442, 447, 448, 449, 451, 452, 453, 454, 450, 429, 107: 411, 422, 416, 417, 413, 410, 414, 415, 412, 403, 456, 419, 420, 425, 424, 418, 423, 426, 428, 427, 128: 409, 135: 407, 430, 406, 404, 854},
+		{5: 855, 24: 477, 476, 140: 475},
+		{1: 313, 313},
+		// 540
+		{1: 248, 248, 28: 319, 30: 320, 32: 325, 328, 329, 127: 331, 130: 326, 143: 348, 156: 353, 164: 318, 333, 334, 168: 335, 321, 336, 173: 322, 337, 323, 177: 338, 339, 183: 340, 324, 341, 342, 343, 332, 190: 327, 344, 196: 345, 200: 346, 330, 347, 204: 857, 206: 352, 349, 350},
+		{1: 49, 49},
 	}
 )
 
@@ -1143,7 +1675,7 @@ func yylex1(yylex yyLexer, lval *yySymType) (n int) {
 }
 
 func yyParse(yylex yyLexer) int {
-	const yyError = 182
+	const yyError = 246
 
 	yyEx, _ := yylex.(yyLexerEx)
 	var yyn int
@@ -1322,57 +1854,131 @@ yynewstate:
 		}
 	case 3:
 		{
-			yyVAL.item = assignment{colName: yyS[yypt-2].item.(string), expr: yyS[yypt-0].item.(expression)}
+			yyVAL.item = &alterTableAddPartitionStmt{tableName: yyS[yypt-9].item.(string), name: yyS[yypt-6].item.(string), bound: yyS[yypt-1].item.(expression)}
 		}
 	case 4:
 		{
-			yyVAL.item = append([]assignment{yyS[yypt-2].item.(assignment)}, yyS[yypt-1].item.([]assignment)...)
+			yyVAL.item = &alterTableDropPartitionStmt{tableName: yyS[yypt-3].item.(string), name: yyS[yypt-0].item.(string)}
 		}
 	case 5:
 		{
-			yyVAL.item = []assignment{}
+			yyVAL.item = &analyzeStmt{}
 		}
 	case 6:
 		{
-			yyVAL.item = append(yyS[yypt-2].item.([]assignment), yyS[yypt-0].item.(assignment))
+			yyVAL.item = &analyzeStmt{tableName: yyS[yypt-0].item.(string)}
+		}
+	case 7:
+		{
+			yyVAL.item = assignment{colName: yyS[yypt-2].item.(string), expr: yyS[yypt-0].item.(expression)}
+		}
+	case 8:
+		{
+			yyVAL.item = append([]assignment{yyS[yypt-2].item.(assignment)}, yyS[yypt-1].item.([]assignment)...)
 		}
 	case 9:
 		{
-			yyVAL.item = beginTransactionStmt{}
+			yyVAL.item = []assignment{}
 		}
 	case 10:
+		{
+			yyVAL.item = append(yyS[yypt-2].item.([]assignment), yyS[yypt-0].item.(assignment))
+		}
+	case 13:
+		{
+			yyVAL.item = &attachStmt{file: yyS[yypt-2].item.(expression), name: yyS[yypt-0].item.(string)}
+		}
+	case 14:
+		{
+			yyVAL.item = beginTransactionStmt{}
+		}
+	case 15:
 		{
 			yyVAL.item = yyS[yypt-1].item
 		}
-	case 11:
+	case 16:
 		{
 			yyVAL.item = []expression{}
 		}
-	case 13:
+	case 18:
 		{
-			yyVAL.item = &col{name: yyS[yypt-1].item.(string), typ: yyS[yypt-0].item.(int)}
+			yyVAL.item = &cast{typ: yyS[yypt-1].item.(int), val: yyS[yypt-3].item.(expression)}
 		}
-	case 15:
+	case 19:
+		{
+			yyVAL.item = &col{name: yyS[yypt-4].item.(string), typ: yyS[yypt-3].item.(int), dict: yyS[yypt-2].item.(bool), notNull: yyS[yypt-1].item.(bool), comment: yyS[yypt-0].item.(string)}
+		}
+	case 20:
+		{
+			yyVAL.item = &col{name: yyS[yypt-8].item.(string), typ: yyS[yypt-7].item.(int), gen: yyS[yypt-4].item.(expression), stored: yyS[yypt-2].item.(bool), notNull: yyS[yypt-1].item.(bool), comment: yyS[yypt-0].item.(string)}
+		}
+	case 21:
+		{
+			yyVAL.item = ""
+		}
+	case 22:
+		{
+			yyVAL.item = yyS[yypt-0].item.(string)
+		}
+	case 23:
+		{
+			yyVAL.item = false
+		}
+	case 24:
+		{
+			yyVAL.item = true
+		}
+	case 25:
+		{
+			yyVAL.item = false
+		}
+	case 26:
+		{
+			yyVAL.item = true
+		}
+	case 27:
+		{
+			yyVAL.item = false
+		}
+	case 28:
+		{
+			yyVAL.item = true
+		}
+	case 29:
+		{
+			yyVAL.item = false
+		}
+	case 31:
 		{
 			yyVAL.item = append([]string{yyS[yypt-2].item.(string)}, yyS[yypt-1].item.([]string)...)
 		}
-	case 16:
+	case 32:
 		{
 			yyVAL.item = []string{}
 		}
-	case 17:
+	case 33:
 		{
 			yyVAL.item = append(yyS[yypt-2].item.([]string), yyS[yypt-0].item.(string))
 		}
-	case 20:
+	case 36:
 		{
 			yyVAL.item = commitStmt{}
 		}
-	case 21:
+	case 37:
 		{
-			yyVAL.item = &conversion{typ: yyS[yypt-3].item.(int), val: yyS[yypt-1].item.(expression)}
+			typ, list := yyS[yypt-3].item.(int), yyS[yypt-1].item.([]expression)
+			switch n := len(list); {
+			case n == 0:
+				yylex.(*lexer).err("missing argument to conversion to %s", typeStr(typ))
+				return 1
+			case n > 1:
+				yylex.(*lexer).err("too many arguments to conversion to %s", typeStr(typ))
+				return 1
+			default:
+				yyVAL.item = &conversion{typ: typ, val: list[0]}
+			}
 		}
-	case 22:
+	case 38:
 		{
 			indexName, tableName, columnName := yyS[yypt-5].item.(string), yyS[yypt-3].item.(string), yyS[yypt-1].item.(string)
 			yyVAL.item = &createIndexStmt{unique: yyS[yypt-8].item.(bool), ifNotExists: yyS[yypt-6].item.(bool), indexName: indexName, tableName: tableName, colName: columnName}
@@ -1386,7 +1992,21 @@ yynewstate:
 				return 1
 			}
 		}
-	case 23:
+	case 39:
+		{
+			indexName, tableName, columnName := yyS[yypt-5].item.(string), yyS[yypt-3].item.(string), yyS[yypt-1].item.(string)
+			yyVAL.item = &createIndexStmt{fulltext: true, ifNotExists: yyS[yypt-6].item.(bool), indexName: indexName, tableName: tableName, colName: columnName}
+			if indexName == tableName || indexName == columnName {
+				yylex.(*lexer).err("index name collision: %s", indexName)
+				return 1
+			}
+
+			if isSystemName[indexName] || isSystemName[tableName] {
+				yylex.(*lexer).err("name is used for system tables: %s", indexName)
+				return 1
+			}
+		}
+	case 40:
 		{
 			indexName, tableName, columnName := yyS[yypt-7].item.(string), yyS[yypt-5].item.(string), yyS[yypt-3].item.(string)
 			yyVAL.item = &createIndexStmt{unique: yyS[yypt-10].item.(bool), ifNotExists: yyS[yypt-8].item.(bool), indexName: indexName, tableName: tableName, colName: "id()"}
@@ -1405,69 +2025,115 @@ yynewstate:
 				return 1
 			}
 		}
-	case 24:
+	case 41:
 		{
 			yyVAL.item = false
 		}
-	case 25:
+	case 42:
 		{
 			yyVAL.item = true
 		}
-	case 26:
+	case 43:
 		{
 			yyVAL.item = false
 		}
-	case 27:
+	case 44:
 		{
 			yyVAL.item = true
 		}
-	case 28:
+	case 45:
 		{
-			nm := yyS[yypt-5].item.(string)
-			yyVAL.item = &createTableStmt{tableName: nm, cols: append([]*col{yyS[yypt-3].item.(*col)}, yyS[yypt-2].item.([]*col)...)}
+			nm := yyS[yypt-8].item.(string)
+			yyVAL.item = &createTableStmt{tableName: nm, cols: append([]*col{yyS[yypt-6].item.(*col)}, yyS[yypt-5].item.([]*col)...), pk: yyS[yypt-4].item.([]string), withoutRowID: yyS[yypt-2].item.(bool), part: yyS[yypt-1].item.(*partitionBy), comment: yyS[yypt-0].item.(string)}
 			if isSystemName[nm] {
 				yylex.(*lexer).err("name is used for system tables: %s", nm)
 				return 1
 			}
 		}
-	case 29:
+	case 46:
 		{
-			nm := yyS[yypt-5].item.(string)
-			yyVAL.item = &createTableStmt{ifNotExists: true, tableName: nm, cols: append([]*col{yyS[yypt-3].item.(*col)}, yyS[yypt-2].item.([]*col)...)}
+			nm := yyS[yypt-8].item.(string)
+			yyVAL.item = &createTableStmt{ifNotExists: true, tableName: nm, cols: append([]*col{yyS[yypt-6].item.(*col)}, yyS[yypt-5].item.([]*col)...), pk: yyS[yypt-4].item.([]string), withoutRowID: yyS[yypt-2].item.(bool), part: yyS[yypt-1].item.(*partitionBy), comment: yyS[yypt-0].item.(string)}
 			if isSystemName[nm] {
 				yylex.(*lexer).err("name is used for system tables: %s", nm)
 				return 1
 			}
 		}
-	case 30:
+	case 47:
 		{
 			yyVAL.item = []*col{}
 		}
-	case 31:
+	case 48:
 		{
 			yyVAL.item = append(yyS[yypt-2].item.([]*col), yyS[yypt-0].item.(*col))
 		}
-	case 34:
+	case 49:
+		{
+			yyVAL.item = []string(nil)
+		}
+	case 50:
+		{
+			yyVAL.item = []string(nil)
+		}
+	case 51:
+		{
+			yyVAL.item = yyS[yypt-1].item
+		}
+	case 54:
+		{
+			yyVAL.item = false
+		}
+	case 55:
+		{
+			yyVAL.item = true
+		}
+	case 56:
+		{
+			yyVAL.item = (*partitionBy)(nil)
+		}
+	case 57:
+		{
+			yyVAL.item = &partitionBy{col: yyS[yypt-1].item.(string)}
+		}
+	case 58:
+		{
+			n, ok := yyS[yypt-0].item.(idealInt)
+			if !ok || n < 1 || n > maxHashPartitions {
+				yylex.(*lexer).err("invalid number of partitions: %v", yyS[yypt-0].item)
+				return 1
+			}
+
+			yyVAL.item = &partitionBy{col: yyS[yypt-3].item.(string), hash: int(n)}
+		}
+	case 59:
 		{
 			yyVAL.item = &truncateTableStmt{yyS[yypt-0].item.(string)}
 		}
-	case 35:
+	case 60:
 		{
-			yyVAL.item = &deleteStmt{tableName: yyS[yypt-1].item.(string), where: yyS[yypt-0].item.(*whereRset).expr}
+			yyVAL.item = &deleteStmt{tableName: yyS[yypt-1].item.(string), returning: yyS[yypt-0].item.([]*fld)}
 		}
-	case 36:
+	case 61:
+		{
+			yyVAL.item = &deleteStmt{tableName: yyS[yypt-2].item.(string), where: yyS[yypt-1].item.(*whereRset).expr, returning: yyS[yypt-0].item.([]*fld)}
+		}
+	case 62:
+		{
+			yyVAL.item = &detachStmt{name: yyS[yypt-0].item.(string)}
+		}
+	case 63:
 		{
 			yyVAL.item = &dropIndexStmt{ifExists: yyS[yypt-1].item.(bool), indexName: yyS[yypt-0].item.(string)}
 		}
-	case 37:
+	case 64:
 		{
 			yyVAL.item = false
 		}
-	case 38:
+	case 65:
 		{
 			yyVAL.item = true
 		}
-	case 39:
+	case 66:
 		{
 			nm := yyS[yypt-0].item.(string)
 			yyVAL.item = &dropTableStmt{tableName: nm}
@@ -1476,7 +2142,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 40:
+	case 67:
 		{
 			nm := yyS[yypt-0].item.(string)
 			yyVAL.item = &dropTableStmt{ifExists: true, tableName: nm}
@@ -1485,11 +2151,11 @@ yynewstate:
 				return 1
 			}
 		}
-	case 41:
+	case 68:
 		{
 			yyVAL.item = nil
 		}
-	case 43:
+	case 70:
 		{
 			var err error
 			if yyVAL.item, err = newBinaryOperation(oror, yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
@@ -1497,27 +2163,51 @@ yynewstate:
 				return 1
 			}
 		}
-	case 46:
+	case 73:
 		{
 			yyVAL.item = append([]expression{yyS[yypt-2].item.(expression)}, yyS[yypt-1].item.([]expression)...)
 		}
-	case 47:
+	case 74:
 		{
 			yyVAL.item = []expression(nil)
 		}
-	case 48:
+	case 75:
 		{
 			yyVAL.item = append(yyS[yypt-2].item.([]expression), yyS[yypt-0].item.(expression))
 		}
-	case 52:
+	case 79:
 		{
 			yyVAL.item = &pIn{expr: yyS[yypt-4].item.(expression), list: yyS[yypt-1].item.([]expression)}
 		}
-	case 53:
+	case 80:
 		{
 			yyVAL.item = &pIn{expr: yyS[yypt-5].item.(expression), not: true, list: yyS[yypt-1].item.([]expression)}
 		}
-	case 54:
+	case 81:
+		{
+			yyVAL.item = &pIn{expr: yyS[yypt-2].item.(expression), arr: &ident{yyS[yypt-0].item.(string)}}
+		}
+	case 82:
+		{
+			yyVAL.item = &pIn{expr: yyS[yypt-3].item.(expression), not: true, arr: &ident{yyS[yypt-0].item.(string)}}
+		}
+	case 83:
+		{
+			yyVAL.item = &pIn{expr: yyS[yypt-2].item.(expression), arr: yyS[yypt-0].item.(expression)}
+		}
+	case 84:
+		{
+			yyVAL.item = &pIn{expr: yyS[yypt-3].item.(expression), not: true, arr: yyS[yypt-0].item.(expression)}
+		}
+	case 85:
+		{
+			yyVAL.item = &pIn{expr: yyS[yypt-5].item.(expression), sel: yyS[yypt-2].item.(*selectStmt)}
+		}
+	case 86:
+		{
+			yyVAL.item = &pIn{expr: yyS[yypt-6].item.(expression), not: true, sel: yyS[yypt-2].item.(*selectStmt)}
+		}
+	case 87:
 		{
 			var err error
 			if yyVAL.item, err = newBetween(yyS[yypt-4].item, yyS[yypt-2].item, yyS[yypt-0].item, false); err != nil {
@@ -1525,7 +2215,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 55:
+	case 88:
 		{
 			var err error
 			if yyVAL.item, err = newBetween(yyS[yypt-5].item, yyS[yypt-2].item, yyS[yypt-0].item, true); err != nil {
@@ -1533,15 +2223,31 @@ yynewstate:
 				return 1
 			}
 		}
-	case 56:
+	case 89:
 		{
 			yyVAL.item = &isNull{expr: yyS[yypt-2].item.(expression)}
 		}
-	case 57:
+	case 90:
 		{
 			yyVAL.item = &isNull{expr: yyS[yypt-3].item.(expression), not: true}
 		}
-	case 59:
+	case 91:
+		{
+			yyVAL.item = &isDistinct{l: yyS[yypt-4].item.(expression), r: yyS[yypt-0].item.(expression)}
+		}
+	case 92:
+		{
+			yyVAL.item = &isDistinct{l: yyS[yypt-5].item.(expression), r: yyS[yypt-0].item.(expression), not: true}
+		}
+	case 93:
+		{
+			yyVAL.item = &pExists{sel: yyS[yypt-2].item.(*selectStmt)}
+		}
+	case 94:
+		{
+			yyVAL.item = &pExists{not: true, sel: yyS[yypt-2].item.(*selectStmt)}
+		}
+	case 96:
 		{
 			var err error
 			if yyVAL.item, err = newBinaryOperation(ge, yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
@@ -1549,7 +2255,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 60:
+	case 97:
 		{
 			var err error
 			if yyVAL.item, err = newBinaryOperation('>', yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
@@ -1557,7 +2263,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 61:
+	case 98:
 		{
 			var err error
 			if yyVAL.item, err = newBinaryOperation(le, yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
@@ -1565,7 +2271,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 62:
+	case 99:
 		{
 			var err error
 			if yyVAL.item, err = newBinaryOperation('<', yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
@@ -1573,7 +2279,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 63:
+	case 100:
 		{
 			var err error
 			if yyVAL.item, err = newBinaryOperation(neq, yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
@@ -1581,7 +2287,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 64:
+	case 101:
 		{
 			var err error
 			if yyVAL.item, err = newBinaryOperation(eq, yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
@@ -1589,11 +2295,27 @@ yynewstate:
 				return 1
 			}
 		}
-	case 65:
+	case 102:
 		{
 			yyVAL.item = &pLike{expr: yyS[yypt-2].item.(expression), pattern: yyS[yypt-0].item.(expression)}
 		}
-	case 66:
+	case 103:
+		{
+			yyVAL.item = &pLike{expr: yyS[yypt-4].item.(expression), pattern: yyS[yypt-2].item.(expression), escape: yyS[yypt-0].item.(expression)}
+		}
+	case 104:
+		{
+			yyVAL.item = &pLike{expr: yyS[yypt-2].item.(expression), pattern: yyS[yypt-0].item.(expression), ci: true}
+		}
+	case 105:
+		{
+			yyVAL.item = &pLike{expr: yyS[yypt-4].item.(expression), pattern: yyS[yypt-2].item.(expression), escape: yyS[yypt-0].item.(expression), ci: true}
+		}
+	case 106:
+		{
+			yyVAL.item = &pMatch{expr: yyS[yypt-2].item.(expression), query: yyS[yypt-0].item.(expression)}
+		}
+	case 107:
 		{
 			expr, name := yyS[yypt-1].item.(expression), yyS[yypt-0].item.(string)
 			if name == "" {
@@ -1604,19 +2326,19 @@ yynewstate:
 			}
 			yyVAL.item = &fld{expr: expr, name: name}
 		}
-	case 67:
+	case 108:
 		{
 			yyVAL.item = ""
 		}
-	case 68:
+	case 109:
 		{
 			yyVAL.item = yyS[yypt-0].item
 		}
-	case 69:
+	case 110:
 		{
 			yyVAL.item = []*fld{yyS[yypt-0].item.(*fld)}
 		}
-	case 70:
+	case 111:
 		{
 			l, f := yyS[yypt-2].item.([]*fld), yyS[yypt-0].item.(*fld)
 			if f.name != "" {
@@ -1628,78 +2350,110 @@ yynewstate:
 
 			yyVAL.item = append(yyS[yypt-2].item.([]*fld), yyS[yypt-0].item.(*fld))
 		}
-	case 71:
+	case 112:
 		{
 			yyVAL.item = &groupByRset{colNames: yyS[yypt-0].item.([]string)}
 		}
-	case 72:
+	case 150:
 		{
 			yyVAL.item = yyS[yypt-1].item
 		}
-	case 73:
+	case 151:
 		{
-			yyVAL.item = &insertIntoStmt{tableName: yyS[yypt-7].item.(string), colNames: yyS[yypt-6].item.([]string), lists: append([][]expression{yyS[yypt-3].item.([]expression)}, yyS[yypt-1].item.([][]expression)...)}
+			yyVAL.item = &insertIntoStmt{tableName: yyS[yypt-8].item.(string), colNames: yyS[yypt-7].item.([]string), conflict: yyS[yypt-10].item.(int), lists: append([][]expression{yyS[yypt-4].item.([]expression)}, yyS[yypt-2].item.([][]expression)...), upsert: yyS[yypt-0].item.(*upsert)}
 		}
-	case 74:
+	case 152:
 		{
-			yyVAL.item = &insertIntoStmt{tableName: yyS[yypt-2].item.(string), colNames: yyS[yypt-1].item.([]string), sel: yyS[yypt-0].item.(*selectStmt)}
+			yyVAL.item = &insertIntoStmt{tableName: yyS[yypt-3].item.(string), colNames: yyS[yypt-2].item.([]string), conflict: yyS[yypt-5].item.(int), sel: yyS[yypt-1].item.(*selectStmt), upsert: yyS[yypt-0].item.(*upsert)}
 		}
-	case 75:
+	case 153:
 		{
 			yyVAL.item = []string{}
 		}
-	case 76:
+	case 154:
 		{
 			yyVAL.item = yyS[yypt-1].item
 		}
-	case 77:
+	case 155:
 		{
 			yyVAL.item = [][]expression{}
 		}
-	case 78:
+	case 156:
 		{
 			yyVAL.item = append(yyS[yypt-4].item.([][]expression), yyS[yypt-1].item.([]expression))
 		}
-	case 88:
+	case 159:
 		{
-			yyVAL.item = value{yyS[yypt-0].item}
+			yyVAL.item = (*upsert)(nil)
 		}
-	case 89:
+	case 160:
 		{
-			n := yyS[yypt-0].item.(int)
-			yyVAL.item = parameter{n}
-			l := yylex.(*lexer)
-			l.params = mathutil.Max(l.params, n)
-			if n == 0 {
-				l.err("parameter number must be non zero")
-				return 1
-			}
+			yyVAL.item = &upsert{colNames: yyS[yypt-6].item.([]string), list: yyS[yypt-1].item.([]assignment), where: yyS[yypt-0].item.(*whereRset).expr}
 		}
-	case 90:
+	case 161:
+		{
+			yyVAL.item = conflictAbort
+		}
+	case 162:
+		{
+			yyVAL.item = conflictIgnore
+		}
+	case 163:
+		{
+			yyVAL.item = conflictReplace
+		}
+	case 172:
+		{
+			yyVAL.item = value{yyS[yypt-0].item}
+		}
+	case 174:
 		{
 			yyVAL.item = &ident{yyS[yypt-0].item.(string)}
 		}
-	case 91:
+	case 175:
 		{
 			yyVAL.item = &pexpr{expr: yyS[yypt-1].item.(expression)}
 		}
-	case 92:
+	case 176:
+		{
+			yyVAL.item = &subquery{sel: yyS[yypt-2].item.(*selectStmt)}
+		}
+	case 177:
 		{
 			yyVAL.item = &orderByRset{by: yyS[yypt-1].item.([]expression), asc: yyS[yypt-0].item.(bool)}
 		}
-	case 93:
+	case 178:
 		{
 			yyVAL.item = true // ASC by default
 		}
-	case 94:
+	case 179:
 		{
 			yyVAL.item = true
 		}
-	case 95:
+	case 180:
 		{
 			yyVAL.item = false
 		}
-	case 98:
+	case 181:
+		{
+			n := yyS[yypt-0].item.(int)
+			yyVAL.item = parameter{n}
+			l := yylex.(*lexer)
+			l.params = mathutil.Max(l.params, n)
+			if n == 0 {
+				l.err("parameter number must be non zero")
+				return 1
+			}
+		}
+	case 182:
+		{
+			yyVAL.item = &pragmaStmt{name: yyS[yypt-0].item.(string)}
+		}
+	case 183:
+		{
+			yyVAL.item = &pragmaStmt{name: yyS[yypt-2].item.(string), expr: yyS[yypt-0].item.(expression)}
+		}
+	case 187:
 		{
 			var err error
 			if yyVAL.item, err = newIndex(yyS[yypt-1].item.(expression), yyS[yypt-0].item.(expression)); err != nil {
@@ -1707,7 +2461,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 99:
+	case 188:
 		{
 			var err error
 			s := yyS[yypt-0].item.([2]*expression)
@@ -1716,7 +2470,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 100:
+	case 189:
 		{
 			x := yylex.(*lexer)
 			f, ok := yyS[yypt-1].item.(*ident)
@@ -1725,6 +2479,11 @@ yynewstate:
 				return 1
 			}
 
+			if strings.EqualFold(f.s, "array") {
+				yyVAL.item = &arrayExpr{yyS[yypt-0].item.([]expression)}
+				break
+			}
+
 			var err error
 			var agg bool
 			if yyVAL.item, agg, err = newCall(f.s, yyS[yypt-0].item.([]expression)); err != nil {
@@ -1735,7 +2494,19 @@ yynewstate:
 				x.agg[n-1] = x.agg[n-1] || agg
 			}
 		}
-	case 102:
+	case 190:
+		{
+			yyVAL.item = &cast{typ: yyS[yypt-0].item.(int), val: yyS[yypt-2].item.(expression)}
+		}
+	case 191:
+		{
+			var err error
+			if yyVAL.item, err = newCollateExpr(yyS[yypt-2].item.(expression), yyS[yypt-0].item.(string)); err != nil {
+				yylex.(*lexer).err("%v", err)
+				return 1
+			}
+		}
+	case 193:
 		{
 			var err error
 			if yyVAL.item, err = newBinaryOperation('^', yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
@@ -1743,7 +2514,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 103:
+	case 194:
 		{
 			var err error
 			if yyVAL.item, err = newBinaryOperation('|', yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
@@ -1751,7 +2522,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 104:
+	case 195:
 		{
 			var err error
 			if yyVAL.item, err = newBinaryOperation('-', yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
@@ -1759,7 +2530,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 105:
+	case 196:
 		{
 			var err error
 			yyVAL.item, err = newBinaryOperation('+', yyS[yypt-2].item, yyS[yypt-0].item)
@@ -1768,7 +2539,11 @@ yynewstate:
 				return 1
 			}
 		}
-	case 107:
+	case 197:
+		{
+			yyVAL.item = yyS[yypt-1].item
+		}
+	case 199:
 		{
 			var err error
 			yyVAL.item, err = newBinaryOperation(andnot, yyS[yypt-2].item, yyS[yypt-0].item)
@@ -1777,7 +2552,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 108:
+	case 200:
 		{
 			var err error
 			yyVAL.item, err = newBinaryOperation('&', yyS[yypt-2].item, yyS[yypt-0].item)
@@ -1786,7 +2561,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 109:
+	case 201:
 		{
 			var err error
 			yyVAL.item, err = newBinaryOperation(lsh, yyS[yypt-2].item, yyS[yypt-0].item)
@@ -1795,7 +2570,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 110:
+	case 202:
 		{
 			var err error
 			yyVAL.item, err = newBinaryOperation(rsh, yyS[yypt-2].item, yyS[yypt-0].item)
@@ -1804,7 +2579,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 111:
+	case 203:
 		{
 			var err error
 			yyVAL.item, err = newBinaryOperation('%', yyS[yypt-2].item, yyS[yypt-0].item)
@@ -1813,7 +2588,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 112:
+	case 204:
 		{
 			var err error
 			yyVAL.item, err = newBinaryOperation('/', yyS[yypt-2].item, yyS[yypt-0].item)
@@ -1822,7 +2597,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 113:
+	case 205:
 		{
 			var err error
 			yyVAL.item, err = newBinaryOperation('*', yyS[yypt-2].item, yyS[yypt-0].item)
@@ -1831,153 +2606,186 @@ yynewstate:
 				return 1
 			}
 		}
-	case 115:
+	case 207:
 		{
 			yyVAL.item = fmt.Sprintf("%s.%s", yyS[yypt-2].item.(string), yyS[yypt-0].item.(string))
 		}
-	case 116:
+	case 208:
 		{
 			yyVAL.item = []interface{}{yyS[yypt-1].item, yyS[yypt-0].item}
 		}
-	case 118:
+	case 209:
+		{
+			yyVAL.item = yyS[yypt-1].item
+			if x := yyS[yypt-0].item.(*tableSample); x != nil {
+				x.table = yyS[yypt-1].item.(string)
+				yyVAL.item = x
+			}
+		}
+	case 210:
+		{
+			yyVAL.item = fmt.Sprintf("%s.%s", yyS[yypt-3].item.(string), yyS[yypt-1].item.(string))
+			if x := yyS[yypt-0].item.(*tableSample); x != nil {
+				x.table = yyVAL.item.(string)
+				yyVAL.item = x
+			}
+		}
+	case 211:
 		{
 			yyVAL.item = yyS[yypt-2].item
 		}
-	case 121:
+	case 214:
+		{
+			yyVAL.item = (*tableSample)(nil)
+		}
+	case 216:
 		{
 			yyVAL.item = ""
 		}
-	case 122:
+	case 217:
 		{
 			yyVAL.item = yyS[yypt-0].item
 		}
-	case 123:
+	case 218:
 		{
 			yyVAL.list = []interface{}{yyS[yypt-0].item}
 		}
-	case 124:
+	case 219:
 		{
 			yyVAL.list = append(yyS[yypt-2].list, yyS[yypt-0].item)
 		}
-	case 125:
+	case 222:
 		{
-			yyVAL.item = rollbackStmt{}
+			yyVAL.item = &reindexStmt{tableName: yyS[yypt-0].item.(string)}
 		}
-	case 126:
+	case 223:
 		{
-			x := yylex.(*lexer)
-			n := len(x.agg)
-			yyVAL.item = &selectStmt{
-				distinct:      yyS[yypt-8].item.(bool),
-				flds:          yyS[yypt-7].item.([]*fld),
-				from:          &crossJoinRset{sources: yyS[yypt-5].list},
-				hasAggregates: x.agg[n-1],
-				where:         yyS[yypt-4].item.(*whereRset),
-				group:         yyS[yypt-3].item.(*groupByRset),
-				order:         yyS[yypt-2].item.(*orderByRset),
-				limit:         yyS[yypt-1].item.(*limitRset),
-				offset:        yyS[yypt-0].item.(*offsetRset),
-			}
-			x.agg = x.agg[:n-1]
+			yyVAL.item = yyS[yypt-0].item
 		}
-	case 127:
+	case 224:
+		{
+			yyVAL.item = rollbackStmt{}
+		}
+	case 225:
 		{
 			x := yylex.(*lexer)
 			n := len(x.agg)
 			yyVAL.item = &selectStmt{
-				distinct:      yyS[yypt-9].item.(bool),
-				flds:          yyS[yypt-8].item.([]*fld),
-				from:          &crossJoinRset{sources: yyS[yypt-6].list},
+				distinct:      yyS[yypt-10].item.(bool),
+				flds:          yyS[yypt-9].item.([]*fld),
+				forUpdate:     yyS[yypt-0].item.(bool),
+				from:          &crossJoinRset{sources: yyS[yypt-7].list},
 				hasAggregates: x.agg[n-1],
-				where:         yyS[yypt-4].item.(*whereRset),
-				group:         yyS[yypt-3].item.(*groupByRset),
-				order:         yyS[yypt-2].item.(*orderByRset),
-				limit:         yyS[yypt-1].item.(*limitRset),
-				offset:        yyS[yypt-0].item.(*offsetRset),
+				where:         yyS[yypt-5].item.(*whereRset),
+				group:         yyS[yypt-4].item.(*groupByRset),
+				order:         yyS[yypt-3].item.(*orderByRset),
+				limit:         yyS[yypt-2].item.(*limitRset),
+				offset:        yyS[yypt-1].item.(*offsetRset),
 			}
 			x.agg = x.agg[:n-1]
 		}
-	case 128:
+	case 226:
 		{
 			yyVAL.item = (*limitRset)(nil)
 		}
-	case 129:
+	case 227:
 		{
 			yyVAL.item = &limitRset{expr: yyS[yypt-0].item.(expression)}
 		}
-	case 130:
+	case 228:
 		{
 			yyVAL.item = (*offsetRset)(nil)
 		}
-	case 131:
+	case 229:
 		{
 			yyVAL.item = &offsetRset{expr: yyS[yypt-0].item.(expression)}
 		}
-	case 132:
+	case 230:
 		{
 			yyVAL.item = false
 		}
-	case 133:
+	case 231:
 		{
 			yyVAL.item = true
 		}
-	case 134:
+	case 232:
+		{
+			yyVAL.item = false
+		}
+	case 233:
+		{
+			yyVAL.item = true
+		}
+	case 234:
 		{
 			yyVAL.item = []*fld{}
 		}
-	case 135:
+	case 235:
 		{
 			yyVAL.item = yyS[yypt-0].item
 		}
-	case 136:
+	case 236:
 		{
 			yyVAL.item = yyS[yypt-1].item
 		}
-	case 137:
+	case 237:
 		{
 			yyVAL.item = (*whereRset)(nil)
 		}
-	case 139:
+	case 239:
 		{
 			yyVAL.item = (*groupByRset)(nil)
 		}
-	case 141:
+	case 241:
 		{
 			yyVAL.item = (*orderByRset)(nil)
 		}
-	case 143:
+	case 243:
 		{
 			yyVAL.item = [2]*expression{nil, nil}
 		}
-	case 144:
+	case 244:
 		{
 			hi := yyS[yypt-1].item.(expression)
 			yyVAL.item = [2]*expression{nil, &hi}
 		}
-	case 145:
+	case 245:
 		{
 			lo := yyS[yypt-2].item.(expression)
 			yyVAL.item = [2]*expression{&lo, nil}
 		}
-	case 146:
+	case 246:
 		{
 			lo := yyS[yypt-3].item.(expression)
 			hi := yyS[yypt-1].item.(expression)
 			yyVAL.item = [2]*expression{&lo, &hi}
 		}
-	case 161:
+	case 266:
 		{
 			if yyS[yypt-0].item != nil {
 				yylex.(*lexer).list = []stmt{yyS[yypt-0].item.(stmt)}
 			}
 		}
-	case 162:
+	case 267:
 		{
 			if yyS[yypt-0].item != nil {
 				yylex.(*lexer).list = append(yylex.(*lexer).list, yyS[yypt-0].item.(stmt))
 			}
 		}
-	case 165:
+	case 269:
+		{
+			seed, _ := yyS[yypt-0].item.(expression)
+			yyVAL.item = &tableSample{percent: yyS[yypt-3].item.(expression), seed: seed}
+		}
+	case 270:
+		{
+			yyVAL.item = nil
+		}
+	case 271:
+		{
+			yyVAL.item = yyS[yypt-1].item
+		}
+	case 273:
 		{
 			var err error
 			if yyVAL.item, err = newBinaryOperation(andand, yyS[yypt-2].item, yyS[yypt-0].item); err != nil {
@@ -1985,19 +2793,23 @@ yynewstate:
 				return 1
 			}
 		}
-	case 168:
+	case 276:
 		{
 			yyVAL.item = &truncateTableStmt{tableName: yyS[yypt-0].item.(string)}
 		}
-	case 193:
+	case 277:
 		{
-			yyVAL.item = &updateStmt{tableName: yyS[yypt-3].item.(string), list: yyS[yypt-1].item.([]assignment), where: yyS[yypt-0].item.(*whereRset).expr}
+			yyVAL.item = qArray
 		}
-	case 194:
+	case 303:
+		{
+			yyVAL.item = &updateStmt{tableName: yyS[yypt-4].item.(string), list: yyS[yypt-2].item.([]assignment), where: yyS[yypt-1].item.(*whereRset).expr, returning: yyS[yypt-0].item.([]*fld)}
+		}
+	case 304:
 		{
 			yyVAL.item = nowhere
 		}
-	case 197:
+	case 307:
 		{
 			var err error
 			yyVAL.item, err = newUnaryOperation('^', yyS[yypt-0].item)
@@ -2006,7 +2818,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 198:
+	case 308:
 		{
 			var err error
 			yyVAL.item, err = newUnaryOperation('!', yyS[yypt-0].item)
@@ -2015,7 +2827,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 199:
+	case 309:
 		{
 			var err error
 			yyVAL.item, err = newUnaryOperation('-', yyS[yypt-0].item)
@@ -2024,7 +2836,7 @@ yynewstate:
 				return 1
 			}
 		}
-	case 200:
+	case 310:
 		{
 			var err error
 			yyVAL.item, err = newUnaryOperation('+', yyS[yypt-0].item)
@@ -2033,9 +2845,13 @@ yynewstate:
 				return 1
 			}
 		}
-	case 201:
+	case 311:
+		{
+			yyVAL.item = &whereRset{expr: simplifyWhere(yyS[yypt-0].item.(expression))}
+		}
+	case 312:
 		{
-			yyVAL.item = &whereRset{expr: yyS[yypt-0].item.(expression)}
+			yyVAL.item = []*fld(nil)
 		}
 
 	}
@@ -18,6 +18,7 @@ package ql
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cznic/mathutil"
 )
@@ -31,31 +32,40 @@ import (
 	list []interface{}
 }
 
-%token	add alter and andand andnot as asc
-	begin between bigIntType bigRatType blobType boolType by byteType
-	column commit complex128Type complex64Type create
-	deleteKwd desc distinct drop durationType
-	eq exists
-	falseKwd floatType float32Type float64Type floatLit from 
-	ge group
-	identifier ifKwd imaginaryLit in index insert intType int16Type
+%token	add alter analyze and andand andnot arrayType as asc attach
+	begin between bigIntType bigRatType blobLit blobType boolType by byteType
+	castKwd collateKwd column comment commit complex128Type complex64Type conflict create
+	database dcolon deleteKwd desc detach dictionaryKwd distinct do drop durationType
+	eq escape exists
+	falseKwd floatType float32Type float64Type floatLit forKwd from fulltext
+	ge group hash
+	identifier ifKwd ignore ilike imaginaryLit in index insert intType int16Type
 	int32Type int64Type int8Type into intLit is
-	le like limit lsh 
+	key
+	le less like limit lsh match
 	neq not null
 	offset on or order oror
-	qlParam
-	rollback rsh runeType
-	selectKwd set stringType stringLit
-	tableKwd timeType transaction trueKwd truncate
+	partitionKwd partitionsKwd percent pragma primary qlParam
+	rangeKwd
+	reindex repeatable replace returning rollback rowid rsh runeType
+	selectKwd set stored stringType stringLit
+	tableKwd tablesample than timeType transaction trueKwd truncate
 	uintType uint16Type uint32Type uint64Type uint8Type unique update
-	values
-	where
+	values virtual
+	where without
+
+%token	<item>
+	blobLit floatLit imaginaryLit intLit stringLit
 
 %token	<item>
-	floatLit imaginaryLit intLit stringLit
+	analyze attach castKwd collateKwd comment conflict database
+	detach dictionaryKwd do escape forKwd fulltext hash ignore ilike
+	key less match partitionKwd partitionsKwd percent pragma primary
+	rangeKwd reindex repeatable replace returning rowid stored
+	tablesample than virtual without
 
 %token	<item>
-	bigIntType bigRatType blobType boolType byteType
+	arrayType bigIntType bigRatType blobType boolType byteType
 	complex64Type complex128Type
 	durationType
 	falseKwd floatType float32Type float64Type
@@ -67,28 +77,34 @@ import (
 	timeType trueKwd
 	uintType uint16Type uint32Type uint64Type uint8Type
 
+%nonassoc	arrayType castKwd forKwd returning
+%nonassoc	'(' ',' partitionKwd
+
 %type	<item>
-	AlterTableStmt Assignment AssignmentList AssignmentList1
+	AlterTableStmt AnalyzeStmt Assignment AssignmentList AssignmentList1 AttachStmt
 	BeginTransactionStmt
-	Call Call1 ColumnDef ColumnName ColumnNameList ColumnNameList1
-	CommitStmt Conversion CreateIndexStmt CreateIndexIfNotExists
-	CreateIndexStmtUnique CreateTableStmt CreateTableStmt1
-	DeleteFromStmt DropIndexStmt DropIndexIfExists DropTableStmt
+	Call Call1 Cast ColumnDef ColumnDefComment ColumnDefDictionary ColumnDefNotNull ColumnDefStored ColumnName ColumnNameList ColumnNameList1
+	CommitStmt Conversion ConversionType CreateIndexStmt CreateIndexIfNotExists
+	CreateIndexStmtUnique CreateTableStmt CreateTableStmt1 CreateTableStmt2
+	CreateTableStmt4 CreateTableStmt5
+	DeleteFromStmt DetachStmt DropIndexStmt DropIndexIfExists DropTableStmt
 	EmptyStmt Expression ExpressionList ExpressionList1
 	Factor Factor1 Field Field1 FieldList
 	GroupByClause
-	Index InsertIntoStmt InsertIntoStmt1 InsertIntoStmt2
+	Identifier Index InsertIntoStmt InsertIntoStmt1 InsertIntoStmt2 InsertIntoStmtOn
+	InsertIntoStmtOr
 	Literal
 	Operand OrderBy OrderBy1
 	QualifiedIdent
-	PrimaryExpression PrimaryFactor PrimaryTerm
-	RecordSet RecordSet1 RecordSet2 RollbackStmt
-	SelectStmt SelectStmtDistinct SelectStmtFieldList SelectStmtLimit
+	Parameter PragmaStmt PrimaryExpression PrimaryFactor PrimaryKey PrimaryTerm
+	RecordSet RecordSet1 RecordSet12 RecordSet2 ReindexStmt Returning RollbackStmt
+	SelectStmt SelectStmtDistinct SelectStmtFieldList SelectStmtForUpdate SelectStmtLimit
 	SelectStmtWhere SelectStmtGroup SelectStmtOffset SelectStmtOrder Slice
 	Statement StatementList
-	TableName Term TruncateTableStmt Type
+	TableName TableSample TableSample1 Term TruncateTableStmt Type
 	UnaryExpr UpdateStmt UpdateStmt1
 	WhereClause
+	oReturning
 
 %type	<list>	RecordSetList
 
@@ -105,6 +121,24 @@ AlterTableStmt:
 	{
 		$$ = &alterTableDropColumnStmt{tableName: $3.(string), colName: $6.(string)}
 	}
+|	alter tableKwd TableName add partitionKwd Identifier values less than '(' Expression ')'
+	{
+		$$ = &alterTableAddPartitionStmt{tableName: $3.(string), name: $6.(string), bound: $11.(expression)}
+	}
+|	alter tableKwd TableName drop partitionKwd Identifier
+	{
+		$$ = &alterTableDropPartitionStmt{tableName: $3.(string), name: $6.(string)}
+	}
+
+AnalyzeStmt:
+	analyze
+	{
+		$$ = &analyzeStmt{}
+	}
+|	analyze TableName
+	{
+		$$ = &analyzeStmt{tableName: $2.(string)}
+	}
 
 Assignment:
 	ColumnName '=' Expression
@@ -132,6 +166,12 @@ AssignmentList2:
 	/* EMPTY */
 |	','
 
+AttachStmt:
+	attach database Expression as Identifier
+	{
+		$$ = &attachStmt{file: $3.(expression), name: $5.(string)}
+	}
+
 BeginTransactionStmt:
 	begin transaction
 	{
@@ -151,14 +191,68 @@ Call1:
 	}
 |	ExpressionList
 
+Cast:
+	castKwd '(' Expression as Type ')'
+	{
+		$$ = &cast{typ: $5.(int), val: $3.(expression)}
+	}
+
 ColumnDef:
-	ColumnName Type
+	ColumnName Type ColumnDefDictionary ColumnDefNotNull ColumnDefComment
+	{
+		$$ = &col{name: $1.(string), typ: $2.(int), dict: $3.(bool), notNull: $4.(bool), comment: $5.(string)}
+	}
+|	ColumnName Type as '(' Expression ')' ColumnDefStored ColumnDefNotNull ColumnDefComment
+	{
+		$$ = &col{name: $1.(string), typ: $2.(int), gen: $5.(expression), stored: $7.(bool), notNull: $8.(bool), comment: $9.(string)}
+	}
+
+ColumnDefComment:
+	/* EMPTY */
+	{
+		$$ = ""
+	}
+|	comment stringLit
+	{
+		$$ = $2.(string)
+	}
+
+ColumnDefDictionary:
+	/* EMPTY */
 	{
-		$$ = &col{name: $1.(string), typ: $2.(int)}
+		$$ = false
+	}
+|	dictionaryKwd
+	{
+		$$ = true
+	}
+
+ColumnDefNotNull:
+	/* EMPTY */
+	{
+		$$ = false
+	}
+|	not null
+	{
+		$$ = true
+	}
+
+ColumnDefStored:
+	/* EMPTY */
+	{
+		$$ = false
+	}
+|	stored
+	{
+		$$ = true
+	}
+|	virtual
+	{
+		$$ = false
 	}
 
 ColumnName:
-	identifier
+	Identifier
 
 ColumnNameList:
 	ColumnName ColumnNameList1 ColumnNameList2
@@ -187,13 +281,23 @@ CommitStmt:
 	}
 
 Conversion:
-	Type '(' Expression ')'
+	ConversionType '(' Call1 ')'
 	{
-		$$ = &conversion{typ: $1.(int), val: $3.(expression)}
+		typ, list := $1.(int), $3.([]expression)
+		switch n := len(list); {
+		case n == 0:
+			yylex.(*lexer).err("missing argument to conversion to %s", typeStr(typ))
+			return 1
+		case n > 1:
+			yylex.(*lexer).err("too many arguments to conversion to %s", typeStr(typ))
+			return 1
+		default:
+			$$ = &conversion{typ: typ, val: list[0]}
+		}
 	}
 
 CreateIndexStmt:
-	create CreateIndexStmtUnique index CreateIndexIfNotExists identifier on identifier '(' identifier ')'
+	create CreateIndexStmtUnique index CreateIndexIfNotExists Identifier on Identifier '(' Identifier ')'
 	{
 		indexName, tableName, columnName := $5.(string), $7.(string), $9.(string)
 		$$ = &createIndexStmt{unique: $2.(bool), ifNotExists: $4.(bool), indexName: indexName, tableName: tableName, colName: columnName}
@@ -207,7 +311,21 @@ CreateIndexStmt:
 			return 1
 		}
 	}
-|	create CreateIndexStmtUnique index CreateIndexIfNotExists identifier on identifier '(' identifier '(' ')' ')'
+|	create fulltext index CreateIndexIfNotExists Identifier on Identifier '(' Identifier ')'
+	{
+		indexName, tableName, columnName := $5.(string), $7.(string), $9.(string)
+		$$ = &createIndexStmt{fulltext: true, ifNotExists: $4.(bool), indexName: indexName, tableName: tableName, colName: columnName}
+		if indexName == tableName || indexName == columnName {
+			yylex.(*lexer).err("index name collision: %s", indexName)
+			return 1
+		}
+
+		if isSystemName[indexName] || isSystemName[tableName] {
+			yylex.(*lexer).err("name is used for system tables: %s", indexName)
+			return 1
+		}
+	}
+|	create CreateIndexStmtUnique index CreateIndexIfNotExists Identifier on Identifier '(' Identifier '(' ')' ')'
 	{
 		indexName, tableName, columnName := $5.(string), $7.(string), $9.(string)
 		$$ = &createIndexStmt{unique: $2.(bool), ifNotExists: $4.(bool), indexName: indexName, tableName: tableName, colName: "id()"}
@@ -246,19 +364,19 @@ CreateIndexStmtUnique:
 	}
 
 CreateTableStmt:
-	create tableKwd TableName '(' ColumnDef CreateTableStmt1 CreateTableStmt2 ')'
+	create tableKwd TableName '(' ColumnDef CreateTableStmt1 CreateTableStmt2 ')' CreateTableStmt4 CreateTableStmt5 ColumnDefComment
 	{
 		nm := $3.(string)
-		$$ = &createTableStmt{tableName: nm, cols: append([]*col{$5.(*col)}, $6.([]*col)...)}
+		$$ = &createTableStmt{tableName: nm, cols: append([]*col{$5.(*col)}, $6.([]*col)...), pk: $7.([]string), withoutRowID: $9.(bool), part: $10.(*partitionBy), comment: $11.(string)}
 		if isSystemName[nm] {
 			yylex.(*lexer).err("name is used for system tables: %s", nm)
 			return 1
 		}
 	}
-|	create tableKwd ifKwd not exists TableName '(' ColumnDef CreateTableStmt1 CreateTableStmt2 ')'
+|	create tableKwd ifKwd not exists TableName '(' ColumnDef CreateTableStmt1 CreateTableStmt2 ')' CreateTableStmt4 CreateTableStmt5 ColumnDefComment
 	{
 		nm := $6.(string)
-		$$ = &createTableStmt{ifNotExists: true, tableName: nm, cols: append([]*col{$8.(*col)}, $9.([]*col)...)}
+		$$ = &createTableStmt{ifNotExists: true, tableName: nm, cols: append([]*col{$8.(*col)}, $9.([]*col)...), pk: $10.([]string), withoutRowID: $12.(bool), part: $13.(*partitionBy), comment: $14.(string)}
 		if isSystemName[nm] {
 			yylex.(*lexer).err("name is used for system tables: %s", nm)
 			return 1
@@ -277,20 +395,74 @@ CreateTableStmt1:
 
 CreateTableStmt2:
 	/* EMPTY */
+	{
+		$$ = []string(nil)
+	}
+|	','
+	{
+		$$ = []string(nil)
+	}
+|	',' PrimaryKey CreateTableStmt3
+	{
+		$$ = $2
+	}
+
+CreateTableStmt3:
+	/* EMPTY */
 |	','
 
+CreateTableStmt4:
+	/* EMPTY */
+	{
+		$$ = false
+	}
+|	without rowid
+	{
+		$$ = true
+	}
+
+CreateTableStmt5:
+	/* EMPTY */
+	{
+		$$ = (*partitionBy)(nil)
+	}
+|	partitionKwd by rangeKwd '(' ColumnName ')'
+	{
+		$$ = &partitionBy{col: $5.(string)}
+	}
+|	partitionKwd by hash '(' ColumnName ')' partitionsKwd intLit
+	{
+		n, ok := $8.(idealInt)
+		if !ok || n < 1 || n > maxHashPartitions {
+			yylex.(*lexer).err("invalid number of partitions: %v", $8)
+			return 1
+		}
+
+		$$ = &partitionBy{col: $5.(string), hash: int(n)}
+	}
+
 DeleteFromStmt:
 	deleteKwd from TableName
 	{
 		$$ = &truncateTableStmt{$3.(string)}
 	}
-|	deleteKwd from TableName WhereClause
+|	deleteKwd from TableName Returning
+	{
+		$$ = &deleteStmt{tableName: $3.(string), returning: $4.([]*fld)}
+	}
+|	deleteKwd from TableName WhereClause oReturning
+	{
+		$$ = &deleteStmt{tableName: $3.(string), where: $4.(*whereRset).expr, returning: $5.([]*fld)}
+	}
+
+DetachStmt:
+	detach database Identifier
 	{
-		$$ = &deleteStmt{tableName: $3.(string), where: $4.(*whereRset).expr}
+		$$ = &detachStmt{name: $3.(string)}
 	}
 
 DropIndexStmt:
-	drop index DropIndexIfExists identifier
+	drop index DropIndexIfExists Identifier
 	{
 		$$ = &dropIndexStmt{ifExists: $3.(bool), indexName: $4.(string)}
 	}
@@ -375,6 +547,30 @@ Factor:
         {
 		$$ = &pIn{expr: $1.(expression), not: true, list: $5.([]expression)}
         }
+|       Factor1 in QualifiedIdent
+        {
+		$$ = &pIn{expr: $1.(expression), arr: &ident{$3.(string)}}
+        }
+|       Factor1 not in QualifiedIdent
+        {
+		$$ = &pIn{expr: $1.(expression), not: true, arr: &ident{$4.(string)}}
+        }
+|       Factor1 in Parameter
+        {
+		$$ = &pIn{expr: $1.(expression), arr: $3.(expression)}
+        }
+|       Factor1 not in Parameter
+        {
+		$$ = &pIn{expr: $1.(expression), not: true, arr: $4.(expression)}
+        }
+|       Factor1 in '(' SelectStmt RecordSet11 ')'
+        {
+		$$ = &pIn{expr: $1.(expression), sel: $4.(*selectStmt)}
+        }
+|       Factor1 not in '(' SelectStmt RecordSet11 ')'
+        {
+		$$ = &pIn{expr: $1.(expression), not: true, sel: $5.(*selectStmt)}
+        }
 |       Factor1 between PrimaryFactor and PrimaryFactor
         {
 		var err error
@@ -399,6 +595,22 @@ Factor:
         {
 		$$ = &isNull{expr: $1.(expression), not: true}
         }
+|       Factor1 is distinct from PrimaryFactor
+        {
+		$$ = &isDistinct{l: $1.(expression), r: $5.(expression)}
+        }
+|       Factor1 is not distinct from PrimaryFactor
+        {
+		$$ = &isDistinct{l: $1.(expression), r: $6.(expression), not: true}
+        }
+|       exists '(' SelectStmt RecordSet11 ')'
+        {
+		$$ = &pExists{sel: $3.(*selectStmt)}
+        }
+|       not exists '(' SelectStmt RecordSet11 ')'
+        {
+		$$ = &pExists{not: true, sel: $4.(*selectStmt)}
+        }
 
 Factor1:
         PrimaryFactor
@@ -454,6 +666,22 @@ Factor1:
 	{
 		$$ = &pLike{expr: $1.(expression), pattern: $3.(expression)}
 	}
+|	Factor1 like PrimaryFactor escape PrimaryFactor
+	{
+		$$ = &pLike{expr: $1.(expression), pattern: $3.(expression), escape: $5.(expression)}
+	}
+|	Factor1 ilike PrimaryFactor
+	{
+		$$ = &pLike{expr: $1.(expression), pattern: $3.(expression), ci: true}
+	}
+|	Factor1 ilike PrimaryFactor escape PrimaryFactor
+	{
+		$$ = &pLike{expr: $1.(expression), pattern: $3.(expression), escape: $5.(expression), ci: true}
+	}
+|	Factor1 match PrimaryFactor
+	{
+		$$ = &pMatch{expr: $1.(expression), query: $3.(expression)}
+	}
 
 Field:
 	Expression Field1
@@ -473,7 +701,7 @@ Field1:
 	{
 		$$ = ""
 	}
-|	as identifier
+|	as Identifier
 	{
 		$$ = $2
 	}
@@ -502,6 +730,53 @@ GroupByClause:
 		$$ = &groupByRset{colNames: $3.([]string)}
 	}
 
+/*
+Identifier accepts the non-reserved keywords as identifiers. Where a
+non-reserved keyword can follow a trailing comma, as in "ORDER BY a, FOR
+UPDATE", the precedence of ',' over the keyword selects the keyword.
+Likewise, the precedence of '(' makes "CAST(" start a CAST expression and
+the precedence of PARTITION makes "ALTER TABLE t ADD partition array" add a
+column.
+*/
+Identifier:
+	identifier
+|	analyze
+|	arrayType
+|	attach
+|	castKwd
+|	collateKwd
+|	comment
+|	conflict
+|	database
+|	detach
+|	dictionaryKwd
+|	do
+|	escape
+|	forKwd
+|	fulltext
+|	hash
+|	ignore
+|	ilike
+|	key
+|	less
+|	match
+|	partitionKwd
+|	partitionsKwd
+|	percent
+|	pragma
+|	primary
+|	rangeKwd
+|	reindex
+|	repeatable
+|	replace
+|	returning
+|	rowid
+|	stored
+|	tablesample
+|	than
+|	virtual
+|	without
+
 Index:
 	'[' Expression ']'
 	{
@@ -509,13 +784,13 @@ Index:
 	}
 
 InsertIntoStmt:
-	insert into TableName InsertIntoStmt1 values '(' ExpressionList ')' InsertIntoStmt2 InsertIntoStmt3
+	insert InsertIntoStmtOr into TableName InsertIntoStmt1 values '(' ExpressionList ')' InsertIntoStmt2 InsertIntoStmt3 InsertIntoStmtOn
 	{
-		$$ = &insertIntoStmt{tableName: $3.(string), colNames: $4.([]string), lists: append([][]expression{$7.([]expression)}, $9.([][]expression)...)}
+		$$ = &insertIntoStmt{tableName: $4.(string), colNames: $5.([]string), conflict: $2.(int), lists: append([][]expression{$8.([]expression)}, $10.([][]expression)...), upsert: $12.(*upsert)}
 	}
-|	insert into TableName InsertIntoStmt1 SelectStmt
+|	insert InsertIntoStmtOr into TableName InsertIntoStmt1 SelectStmt InsertIntoStmtOn
 	{
-		$$ = &insertIntoStmt{tableName: $3.(string), colNames: $4.([]string), sel: $5.(*selectStmt)}
+		$$ = &insertIntoStmt{tableName: $4.(string), colNames: $5.([]string), conflict: $2.(int), sel: $6.(*selectStmt), upsert: $7.(*upsert)}
 	}
 
 InsertIntoStmt1:
@@ -541,11 +816,36 @@ InsertIntoStmt2:
 InsertIntoStmt3:
 |      ','
 
+InsertIntoStmtOn:
+	/* EMPTY */
+	{
+		$$ = (*upsert)(nil)
+	}
+|	on conflict '(' ColumnNameList ')' do update oSet AssignmentList UpdateStmt1
+	{
+		$$ = &upsert{colNames: $4.([]string), list: $9.([]assignment), where: $10.(*whereRset).expr}
+	}
+
+InsertIntoStmtOr:
+	/* EMPTY */
+	{
+		$$ = conflictAbort
+	}
+|	or ignore
+	{
+		$$ = conflictIgnore
+	}
+|	or replace
+	{
+		$$ = conflictReplace
+	}
+
 
 Literal:
 	falseKwd
 |	null
 |	trueKwd
+|	blobLit
 |	floatLit
 |	imaginaryLit
 |	intLit
@@ -556,17 +856,7 @@ Operand:
 	{
 		$$ = value{$1}
 	}
-|	qlParam
-	{
-		n := $1.(int)
-		$$ = parameter{n}
-		l := yylex.(*lexer)
-		l.params = mathutil.Max(l.params, n)
-		if n == 0 {
-			l.err("parameter number must be non zero")
-			return 1
-		}
-	}
+|	Parameter
 |	QualifiedIdent
 	{
 		$$ = &ident{$1.(string)}
@@ -575,6 +865,10 @@ Operand:
 	{
 		$$ = &pexpr{expr: $2.(expression)}
 	}
+|	'(' SelectStmt RecordSet11 ')'
+	{
+		$$ = &subquery{sel: $2.(*selectStmt)}
+	}
 
 OrderBy:
 	order by ExpressionList OrderBy1
@@ -596,9 +890,33 @@ OrderBy1:
 		$$ = false
 	}
 
+Parameter:
+	qlParam
+	{
+		n := $1.(int)
+		$$ = parameter{n}
+		l := yylex.(*lexer)
+		l.params = mathutil.Max(l.params, n)
+		if n == 0 {
+			l.err("parameter number must be non zero")
+			return 1
+		}
+	}
+
+PragmaStmt:
+	pragma Identifier
+	{
+		$$ = &pragmaStmt{name: $2.(string)}
+	}
+|	pragma Identifier '=' Expression
+	{
+		$$ = &pragmaStmt{name: $2.(string), expr: $4.(expression)}
+	}
+
 PrimaryExpression:
 	Operand
 |	Conversion
+|	Cast
 |	PrimaryExpression Index
 	{
 		var err error
@@ -625,6 +943,11 @@ PrimaryExpression:
 			return 1
 		}
 
+		if strings.EqualFold(f.s, "array") {
+			$$ = &arrayExpr{$2.([]expression)}
+			break
+		}
+
 		var err error
 		var agg bool
 		if $$, agg, err = newCall(f.s, $2.([]expression)); err != nil {
@@ -635,6 +958,18 @@ PrimaryExpression:
 			x.agg[n-1] = x.agg[n-1] || agg
 		}
 	}
+|	PrimaryExpression dcolon Type
+	{
+		$$ = &cast{typ: $3.(int), val: $1.(expression)}
+	}
+|	PrimaryExpression collateKwd Identifier
+	{
+		var err error
+		if $$, err = newCollateExpr($1.(expression), $3.(string)); err != nil {
+			yylex.(*lexer).err("%v", err)
+			return 1
+		}
+	}
 
 PrimaryFactor:
 	PrimaryTerm
@@ -672,6 +1007,12 @@ PrimaryFactor:
 		}
 	}
 
+PrimaryKey:
+	primary key '(' ColumnNameList ')'
+	{
+		$$ = $4
+	}
+
 PrimaryTerm:
 	UnaryExpr
 |	PrimaryTerm andnot UnaryExpr
@@ -739,8 +1080,8 @@ PrimaryTerm:
 	}
 
 QualifiedIdent:
-	identifier
-|	identifier '.' identifier
+	Identifier
+|	Identifier '.' Identifier
 	{
 		$$ = fmt.Sprintf("%s.%s", $1.(string), $3.(string))
 	}
@@ -752,7 +1093,22 @@ RecordSet:
 	}
 
 RecordSet1:
-	identifier
+	Identifier RecordSet12
+	{
+		$$ = $1
+		if x := $2.(*tableSample); x != nil {
+			x.table = $1.(string)
+			$$ = x
+		}
+	}
+|	Identifier '.' Identifier RecordSet12
+	{
+		$$ = fmt.Sprintf("%s.%s", $1.(string), $3.(string))
+		if x := $4.(*tableSample); x != nil {
+			x.table = $$.(string)
+			$$ = x
+		}
+	}
 |	'(' SelectStmt RecordSet11 ')'
 	{
 		$$ = $2
@@ -762,12 +1118,19 @@ RecordSet11:
 	/* EMPTY */
 |	';'
 
+RecordSet12:
+	/* EMPTY */
+	{
+		$$ = (*tableSample)(nil)
+	}
+|	TableSample
+
 RecordSet2:
 	/* EMPTY */
 	{
 		$$ = ""
 	}
-|	as identifier
+|	as Identifier
 	{
 		$$ = $2
 	}
@@ -782,6 +1145,22 @@ RecordSetList:
 		$$ = append($1, $3)
 	}
 
+RecordSetList1:
+	/* EMPTY */
+|	','
+
+ReindexStmt:
+	reindex TableName
+	{
+		$$ = &reindexStmt{tableName: $2.(string)}
+	}
+
+Returning:
+	returning SelectStmtFieldList
+	{
+		$$ = $2
+	}
+
 RollbackStmt:
 	rollback
 	{
@@ -789,32 +1168,16 @@ RollbackStmt:
 	}
 
 SelectStmt:
-	selectKwd SelectStmtDistinct SelectStmtFieldList from RecordSetList
-	SelectStmtWhere SelectStmtGroup SelectStmtOrder SelectStmtLimit SelectStmtOffset
-	{
-		x := yylex.(*lexer)
-		n := len(x.agg)
-		$$ = &selectStmt{
-			distinct:      $2.(bool),
-			flds:          $3.([]*fld),
-			from:          &crossJoinRset{sources: $5},
-			hasAggregates: x.agg[n-1],
-			where:         $6.(*whereRset),
-			group:         $7.(*groupByRset),
-			order:         $8.(*orderByRset),
-			limit:         $9.(*limitRset),
-			offset:        $10.(*offsetRset),
-		}
-		x.agg = x.agg[:n-1]
-	}
-|	selectKwd SelectStmtDistinct SelectStmtFieldList from RecordSetList ','
+	selectKwd SelectStmtDistinct SelectStmtFieldList from RecordSetList RecordSetList1
 	SelectStmtWhere SelectStmtGroup SelectStmtOrder SelectStmtLimit SelectStmtOffset
+	SelectStmtForUpdate
 	{
 		x := yylex.(*lexer)
 		n := len(x.agg)
 		$$ = &selectStmt{
 			distinct:      $2.(bool),
 			flds:          $3.([]*fld),
+			forUpdate:     $12.(bool),
 			from:          &crossJoinRset{sources: $5},
 			hasAggregates: x.agg[n-1],
 			where:         $7.(*whereRset),
@@ -844,6 +1207,16 @@ SelectStmtOffset:
 		$$ = &offsetRset{expr: $2.(expression)}
 	}
 
+SelectStmtForUpdate:
+	/* EMPTY */
+	{
+		$$ = false
+	}
+|	forKwd update
+	{
+		$$ = true
+	}
+
 SelectStmtDistinct:
 	/* EMPTY */
 	{
@@ -914,14 +1287,19 @@ Slice:
 Statement:
 	EmptyStmt
 |	AlterTableStmt
+|	AnalyzeStmt
+|	AttachStmt
 |	BeginTransactionStmt
 |	CommitStmt
 |	CreateIndexStmt
 |	CreateTableStmt
 |	DeleteFromStmt
+|	DetachStmt
 |	DropIndexStmt
 |	DropTableStmt
 |	InsertIntoStmt
+|	PragmaStmt
+|	ReindexStmt
 |	RollbackStmt
 |	SelectStmt
 |	TruncateTableStmt
@@ -942,7 +1320,24 @@ StatementList:
 	}
 
 TableName:
-	identifier
+	Identifier
+
+TableSample:
+	tablesample '(' Expression percent ')' TableSample1
+	{
+		seed, _ := $6.(expression)
+		$$ = &tableSample{percent: $3.(expression), seed: seed}
+	}
+
+TableSample1:
+	/* EMPTY */
+	{
+		$$ = nil
+	}
+|	repeatable '(' Expression ')'
+	{
+		$$ = $3
+	}
 
 Term:
 	Factor
@@ -966,6 +1361,13 @@ TruncateTableStmt:
 	}
 
 Type:
+	arrayType
+	{
+		$$ = qArray
+	}
+|	ConversionType
+
+ConversionType:
 	bigIntType
 |	bigRatType
 |	blobType
@@ -992,9 +1394,9 @@ Type:
 |	uint8Type
 
 UpdateStmt:
-	update TableName oSet AssignmentList UpdateStmt1
+	update TableName oSet AssignmentList UpdateStmt1 oReturning
 	{
-		$$ = &updateStmt{tableName: $2.(string), list: $4.([]assignment), where: $5.(*whereRset).expr}
+		$$ = &updateStmt{tableName: $2.(string), list: $4.([]assignment), where: $5.(*whereRset).expr, returning: $6.([]*fld)}
 	}
 
 UpdateStmt1:
@@ -1046,9 +1448,16 @@ UnaryExpr:
 WhereClause:
 	where Expression
 	{
-		$$ = &whereRset{expr: $2.(expression)}
+		$$ = &whereRset{expr: simplifyWhere($2.(expression))}
 	}
 
 
+oReturning:
+	/* EMPTY */
+	{
+		$$ = []*fld(nil)
+	}
+|	Returning
+
 oSet:
 |	set
@@ -0,0 +1,614 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+)
+
+const (
+	// partitionTable is the table describing the partitioned tables.
+	partitionTable = "__Partition"
+
+	// maxHashPartitions is the maximum number of the partitions of a table
+	// partitioned by hash.
+	maxHashPartitions = 1024
+)
+
+var (
+	partitionCreate = MustCompile(`
+		CREATE TABLE IF NOT EXISTS __Partition (
+			TableName  string,
+			Name       string,
+			Method     string,
+			ColumnName string,
+			Bound      array,
+			PRIMARY KEY (TableName, Name)
+		) WITHOUT ROWID;`,
+	)
+	partitionDelete      = MustCompile("DELETE FROM __Partition WHERE TableName == $1 && Name == $2;")
+	partitionDeleteTable = MustCompile("DELETE FROM __Partition WHERE TableName == $1;")
+	partitionInsert      = MustCompile("INSERT INTO __Partition VALUES ($1, $2, $3, $4, $5);")
+)
+
+// partitionBy is the PARTITION BY clause of CREATE TABLE.
+type partitionBy struct {
+	col  string
+	hash int // Number of the partitions of PARTITION BY HASH, zero for RANGE.
+}
+
+func (p *partitionBy) String() string {
+	switch {
+	case p == nil:
+		return ""
+	case p.hash != 0:
+		return fmt.Sprintf(" PARTITION BY HASH (%s) PARTITIONS %d", quoteIdent(p.col), p.hash)
+	default:
+		return fmt.Sprintf(" PARTITION BY RANGE (%s)", quoteIdent(p.col))
+	}
+}
+
+func (p *partitionBy) method() string {
+	if p.hash != 0 {
+		return "hash"
+	}
+
+	return "range"
+}
+
+// partition is a partition of a partitioned table. The rows of a partitioned
+// table are stored in the tables of its partitions.
+type partition struct {
+	name  string
+	bound interface{} // RANGE: the partition holds the keys less than bound, HASH: the number of the partition.
+	t     *table
+}
+
+type partitions []*partition
+
+func (s partitions) Len() int           { return len(s) }
+func (s partitions) Less(i, j int) bool { return collate1(s[i].bound, s[j].bound) < 0 }
+func (s partitions) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// partitioning describes how the rows of a partitioned table are divided
+// among its partitions.
+type partitioning struct {
+	col   *col
+	hash  bool
+	parts []*partition // In the order of their bounds.
+}
+
+// partitionName returns the name of the table of the partition name of the
+// table tableName. The name contains a dot, so it cannot collide with the name
+// of any table created by CREATE TABLE, not even a quoted one.
+func partitionName(tableName, name string) string { return tableName + "." + name }
+
+// isPartitionName reports whether nm is the name of the table of a partition,
+// see partitionName.
+func isPartitionName(nm string) bool { return strings.IndexByte(nm, '.') >= 0 }
+
+// partitionCache holds the partitionings of tables returned by
+// DB.partitioning, see root.partitionsChanged.
+type partitionCache map[*table]*partitioning
+
+// partitionsChanged discards the partitionings cached by DB.partitioning. It
+// is called whenever a table is created or dropped and by the statements
+// changing __Partition, see changePartitions.
+func (r *root) partitionsChanged() { r.parts = nil }
+
+// changePartitions discards the cached partitionings if tableName, the table
+// changed by a statement, is __Partition.
+func changePartitions(ctx *execCtx, tableName string) {
+	if tableName == partitionTable {
+		ctx.db.root.partitionsChanged()
+	}
+}
+
+// partitioning returns the partitioning of t or nil if t is not partitioned.
+// The result is cached until the tables of the DB or __Partition change.
+func (db *DB) partitioning(t *table) (p *partitioning, err error) {
+	pt := db.root.tables[partitionTable]
+	if pt == nil || strings.HasPrefix(t.name, "__") {
+		return nil, nil
+	}
+
+	if p, ok := db.root.parts[t]; ok {
+		return p, nil
+	}
+
+	if p, err = db.loadPartitioning(pt, t); err != nil {
+		return nil, err
+	}
+
+	if db.root.parts == nil {
+		db.root.parts = partitionCache{}
+	}
+	db.root.parts[t] = p
+	return p, nil
+}
+
+// loadPartitioning reads the partitioning of t from pt, the __Partition table.
+func (db *DB) loadPartitioning(pt, t *table) (p *partitioning, err error) {
+	for h := pt.head; h > 0; {
+		if h, err = tableRset("").doOne(pt, h, func(_ interface{}, data []interface{}) (bool, error) {
+			if data[0] != t.name {
+				return true, nil
+			}
+
+			if err := expand(data); err != nil {
+				return false, err
+			}
+
+			if p == nil {
+				p = &partitioning{}
+			}
+			name, _ := data[1].(string)
+			if name == "" {
+				cn, _ := data[3].(string)
+				p.col = findCol(t.cols, cn)
+				p.hash = data[2] == "hash"
+				return true, nil
+			}
+
+			x := db.root.tables[partitionName(t.name, name)]
+			if x == nil {
+				return false, fmt.Errorf("table %s of partition %s does not exist", partitionName(t.name, name), name)
+			}
+
+			var bound interface{}
+			if a, ok := data[4].([]interface{}); ok && len(a) == 1 {
+				bound = a[0]
+			}
+			p.parts = append(p.parts, &partition{name, bound, x})
+			return true, nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if p == nil {
+		return nil, nil
+	}
+
+	if p.col == nil {
+		return nil, fmt.Errorf("invalid partitioning of table %s", t.name)
+	}
+
+	sort.Sort(partitions(p.parts))
+	return p, nil
+}
+
+// partitionOf returns the name of the partitioned table having a partition
+// stored in t, or "" if there is none.
+func (db *DB) partitionOf(t *table) (string, error) {
+	i := strings.LastIndexByte(t.name, '.')
+	if i < 0 {
+		return "", nil
+	}
+
+	pt := db.root.tables[t.name[:i]]
+	if pt == nil {
+		return "", nil
+	}
+
+	p, err := db.partitioning(pt)
+	if p == nil || err != nil {
+		return "", err
+	}
+
+	for _, x := range p.parts {
+		if x.t == t {
+			return pt.name, nil
+		}
+	}
+	return "", nil
+}
+
+// dropPartitions drops the partitions of all partitioned tables, which
+// become tables having no rows. It is used when __Partition is dropped.
+func (db *DB) dropPartitions() error {
+	var a []*table
+	for _, t := range db.root.tables {
+		p, err := db.partitioning(t)
+		if err != nil {
+			return err
+		}
+
+		if p != nil {
+			for _, x := range p.parts {
+				a = append(a, x.t)
+			}
+		}
+	}
+	for _, t := range a {
+		if err := db.root.dropTable(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createPartition creates the table of the partition name of the table t,
+// partitioned by method on the column colName, and records the partition in
+// __Partition.
+func createPartition(ctx *execCtx, t *table, method, colName, name string, bound interface{}) error {
+	nm := partitionName(t.name, name)
+	if ctx.db.root.tables[nm] != nil {
+		return fmt.Errorf("table exists %s", nm)
+	}
+
+	cols := make([]*col, len(t.cols0))
+	for i, c := range t.cols0 {
+		x := *c
+		cols[i] = &x
+	}
+	if _, err := ctx.db.root.createTable(nm, cols); err != nil {
+		return err
+	}
+
+	return ctx.run(partitionInsert, t.name, name, method, colName, []interface{}{bound})
+}
+
+// createPartitions makes t, created by s, a partitioned table.
+func (s *createTableStmt) createPartitions(ctx *execCtx, t *table) error {
+	c := findCol(t.cols, s.part.col)
+	switch {
+	case c == nil:
+		return fmt.Errorf("CREATE TABLE: partitioning column does not exist: %s", s.part.col)
+	case c.gen != nil:
+		return fmt.Errorf("CREATE TABLE: cannot partition by generated column %s", c.name)
+	case c.typ == qArray:
+		return fmt.Errorf("CREATE TABLE: cannot partition by column %s of type array", c.name)
+	}
+
+	if err := ctx.run(partitionCreate); err != nil {
+		return err
+	}
+
+	method := s.part.method()
+	if err := ctx.run(partitionInsert, t.name, "", method, c.name, nil); err != nil {
+		return err
+	}
+
+	for i := 0; i < s.part.hash; i++ {
+		if err := createPartition(ctx, t, method, c.name, fmt.Sprintf("p%d", i), int64(i)); err != nil {
+			return fmt.Errorf("CREATE TABLE: %v", err)
+		}
+	}
+	return nil
+}
+
+// hashIndex returns the index of the hash partition of the key v.
+func (p *partitioning) hashIndex(v interface{}) (int, error) {
+	b, err := pkAppend(nil, v)
+	if err != nil {
+		return -1, err
+	}
+
+	h := fnv.New64a()
+	h.Write(b)
+	return int(h.Sum64() % uint64(len(p.parts))), nil
+}
+
+// route returns the partition of the row data. Rows having a NULL key are
+// stored in the first partition.
+func (p *partitioning) route(data []interface{}) (*partition, error) {
+	if len(p.parts) == 0 {
+		return nil, fmt.Errorf("table has no partitions")
+	}
+
+	v := data[p.col.index]
+	if v == nil {
+		return p.parts[0], nil
+	}
+
+	if p.hash {
+		i, err := p.hashIndex(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return p.parts[i], nil
+	}
+
+	for _, x := range p.parts {
+		if collate1(v, x.bound) < 0 {
+			return x, nil
+		}
+	}
+	return nil, fmt.Errorf("no partition for %s %v", p.col.name, v)
+}
+
+// prune returns the partitions of t which can have rows for which the WHERE
+// expression expr is true. Only the conjuncts of expr comparing the
+// partitioning column to a fixed value are considered.
+func (p *partitioning) prune(ctx *execCtx, t *table, expr expression) (r []*partition) {
+	keep := make([]bool, len(p.parts))
+	for i := range keep {
+		keep[i] = true
+	}
+	var walk func(expression)
+	walk = func(e expression) {
+		switch x := e.(type) {
+		case *pexpr:
+			walk(x.expr)
+		case *binaryOperation:
+			if x.op == andand {
+				walk(x.l)
+				walk(x.r)
+				return
+			}
+
+			p.pruneBy(ctx, t, x, keep)
+		}
+	}
+	if expr != nil {
+		walk(expr)
+	}
+	for i, x := range p.parts {
+		if keep[i] {
+			r = append(r, x)
+		}
+	}
+	return r
+}
+
+// pruneBy clears the items of keep corresponding to the partitions of t
+// which cannot have rows for which ex is true.
+func (p *partitioning) pruneBy(ctx *execCtx, t *table, ex *binaryOperation, keep []bool) {
+	c, op, v, ok, err := (&whereRset{}).indexBound(ctx, t, ex)
+	if !ok || err != nil || c == nil || c.index != p.col.index {
+		return
+	}
+
+	data := []interface{}{v}
+	cc := *c
+	cc.index = 0
+	if typeCheck(data, []*col{&cc}) != nil {
+		return
+	}
+
+	if v = data[0]; v == nil { // Comparing to NULL is never true.
+		for i := range keep {
+			keep[i] = false
+		}
+		return
+	}
+
+	if p.hash {
+		if op != eq {
+			return
+		}
+
+		n, err := p.hashIndex(v)
+		if err != nil {
+			return
+		}
+
+		for i := range keep {
+			keep[i] = keep[i] && i == n
+		}
+		return
+	}
+
+	for i, x := range p.parts {
+		// Partition i holds the keys in [lower, x.bound), the first one
+		// holds also all the keys less than x.bound.
+		var lower interface{}
+		if i > 0 {
+			lower = p.parts[i-1].bound
+		}
+		var in bool
+		switch op {
+		case eq:
+			in = (i == 0 || collate1(v, lower) >= 0) && collate1(v, x.bound) < 0
+		case '>', ge:
+			in = collate1(v, x.bound) < 0
+		case '<':
+			in = i == 0 || collate1(v, lower) > 0
+		case le:
+			in = i == 0 || collate1(v, lower) >= 0
+		}
+		keep[i] = keep[i] && in
+	}
+}
+
+// doPartitions passes to f the rows of the partitioned table t for which the
+// WHERE expression of r is true. It reads only the partitions which can have
+// such rows.
+func (r *whereRset) doPartitions(ctx *execCtx, t *table, p *partitioning, f func(id interface{}, data []interface{}) (more bool, err error)) error {
+	if m, err := f(nil, []interface{}{t.flds()}); !m || err != nil {
+		return err
+	}
+
+	for _, x := range p.prune(ctx, t, r.expr) {
+		hdr, stop := true, false
+		if err := (&whereRset{expr: r.expr, src: partitionRset{x.t}}).do(ctx, false, func(id interface{}, data []interface{}) (bool, error) {
+			if hdr {
+				hdr = false
+				return true, nil
+			}
+
+			m, err := f(id, data)
+			stop = !m
+			return m, err
+		}); err != nil || stop {
+			return err
+		}
+	}
+	return nil
+}
+
+// partitionRset is the rset of the rows of the table of a partition.
+type partitionRset struct {
+	t *table
+}
+
+func (r partitionRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) error {
+	if m, err := f(nil, []interface{}{r.t.flds()}); !m || err != nil || onlyNames {
+		return err
+	}
+
+	return tableRset(r.t.name).doRows(ctx, r.t, nil, f)
+}
+
+// doPartitions passes the rows of the partitions of p to f, see doRows.
+func (r tableRset) doPartitions(ctx *execCtx, p *partitioning, sample func() bool, f func(id interface{}, data []interface{}) (more bool, err error)) error {
+	for _, x := range p.parts {
+		stop := false
+		if err := r.doRows(ctx, x.t, sample, func(id interface{}, data []interface{}) (bool, error) {
+			m, err := f(id, data)
+			stop = !m
+			return m, err
+		}); err != nil || stop {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertPartition inserts the record data, see insert, to the partition of
+// its row.
+func (s *insertIntoStmt) insertPartition(ctx *execCtx, p *partitioning, data []interface{}) error {
+	x, err := p.route(data[2:])
+	if err != nil {
+		return fmt.Errorf("INSERT INTO %s: %v", s.tableName, err)
+	}
+
+	t := x.t
+	h, err := s.insert(ctx, t, t.head, data)
+	if err != nil || h == t.head {
+		return err
+	}
+
+	if err = t.store.Update(t.hhead, h); err != nil {
+		return err
+	}
+
+	t.head = h
+	return nil
+}
+
+type alterTableAddPartitionStmt struct {
+	tableName string
+	name      string
+	bound     expression
+}
+
+func (s *alterTableAddPartitionStmt) String() string {
+	return fmt.Sprintf("ALTER TABLE %s ADD PARTITION %s VALUES LESS THAN (%s);", quoteIdent(s.tableName), quoteIdent(s.name), s.bound)
+}
+
+func (s *alterTableAddPartitionStmt) exec(ctx *execCtx) (_ Recordset, err error) {
+	t, ok := ctx.db.root.tables[s.tableName]
+	if !ok {
+		return nil, fmt.Errorf("ALTER TABLE: table %s does not exist", s.tableName)
+	}
+
+	p, err := ctx.db.partitioning(t)
+	switch {
+	case err != nil:
+		return nil, err
+	case p == nil:
+		return nil, fmt.Errorf("ALTER TABLE %s ADD PARTITION: table is not partitioned", s.tableName)
+	case p.hash:
+		return nil, fmt.Errorf("ALTER TABLE %s ADD PARTITION: table is partitioned by hash", s.tableName)
+	}
+
+	for _, x := range p.parts {
+		if x.name == s.name {
+			return nil, fmt.Errorf("ALTER TABLE %s ADD PARTITION: partition %s exists", s.tableName, s.name)
+		}
+	}
+
+	v, err := expand1(s.bound.eval(map[interface{}]interface{}{"$ctx": ctx}, ctx.arg))
+	if err != nil {
+		return nil, err
+	}
+
+	data := []interface{}{v}
+	c := *p.col
+	c.index = 0
+	ctx.db.normalizeRow(data)
+	if err = typeCheck(data, []*col{&c}); err != nil {
+		return nil, fmt.Errorf("ALTER TABLE %s ADD PARTITION: %v", s.tableName, err)
+	}
+
+	if data[0] == nil {
+		return nil, fmt.Errorf("ALTER TABLE %s ADD PARTITION: bound cannot be NULL", s.tableName)
+	}
+
+	if n := len(p.parts); n != 0 && collate1(data[0], p.parts[n-1].bound) <= 0 {
+		return nil, fmt.Errorf("ALTER TABLE %s ADD PARTITION: bound %v must be greater than the bound of partition %s", s.tableName, data[0], p.parts[n-1].name)
+	}
+
+	if err = createPartition(ctx, t, "range", p.col.name, s.name, data[0]); err != nil {
+		return nil, fmt.Errorf("ALTER TABLE %s ADD PARTITION: %v", s.tableName, err)
+	}
+
+	return nil, nil
+}
+
+func (s *alterTableAddPartitionStmt) isUpdating() bool { return true }
+
+type alterTableDropPartitionStmt struct {
+	tableName string
+	name      string
+}
+
+func (s *alterTableDropPartitionStmt) String() string {
+	return fmt.Sprintf("ALTER TABLE %s DROP PARTITION %s;", quoteIdent(s.tableName), quoteIdent(s.name))
+}
+
+func (s *alterTableDropPartitionStmt) exec(ctx *execCtx) (_ Recordset, err error) {
+	t, ok := ctx.db.root.tables[s.tableName]
+	if !ok {
+		return nil, fmt.Errorf("ALTER TABLE: table %s does not exist", s.tableName)
+	}
+
+	p, err := ctx.db.partitioning(t)
+	switch {
+	case err != nil:
+		return nil, err
+	case p == nil:
+		return nil, fmt.Errorf("ALTER TABLE %s DROP PARTITION: table is not partitioned", s.tableName)
+	case p.hash:
+		return nil, fmt.Errorf("ALTER TABLE %s DROP PARTITION: table is partitioned by hash", s.tableName)
+	}
+
+	for _, x := range p.parts {
+		if x.name != s.name {
+			continue
+		}
+
+		if err = ctx.db.root.dropTable(x.t); err != nil {
+			return nil, err
+		}
+
+		return nil, ctx.run(partitionDelete, s.tableName, s.name)
+	}
+
+	return nil, fmt.Errorf("ALTER TABLE %s DROP PARTITION: partition %s does not exist", s.tableName, s.name)
+}
+
+func (s *alterTableDropPartitionStmt) isUpdating() bool { return true }
+
+// checkNotPartitioned returns an error if t is a partitioned table. The
+// columns and the indices of a partitioned table cannot be changed.
+func checkNotPartitioned(ctx *execCtx, t *table) error {
+	p, err := ctx.db.partitioning(t)
+	if err != nil {
+		return err
+	}
+
+	if p != nil {
+		return fmt.Errorf("table %s is partitioned", t.name)
+	}
+
+	return nil
+}
@@ -0,0 +1,463 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+)
+
+var _ btreeIndex = pkIndex{}
+
+// pkIndex is the unique index of the primary key of a table. Its keys are the
+// values of the hidden primary key column, see pkKey.
+type pkIndex struct {
+	btreeIndex
+}
+
+func (x pkIndex) Create(indexedValue interface{}, h int64) error {
+	_, hit, err := x.btreeIndex.Seek(indexedValue)
+	if err = noEOF(err); err != nil {
+		return err
+	}
+
+	if hit {
+		return fmt.Errorf("duplicate primary key %s", pkString(indexedValue))
+	}
+
+	return x.btreeIndex.Create(indexedValue, h)
+}
+
+// pkString returns the values of the key columns encoded in the primary key
+// k.
+func pkString(k interface{}) string {
+	s, _ := k.(string)
+	a, err := pkDecode(s)
+	if err != nil {
+		return fmt.Sprintf("%q", s)
+	}
+
+	b := make([]string, len(a))
+	for i, v := range a {
+		b[i] = fmt.Sprint(v)
+	}
+	return "(" + strings.Join(b, ", ") + ")"
+}
+
+// pkIndexName returns the name of the primary key index of table.
+func pkIndexName(table string) string { return "__pk_" + table }
+
+// newPK returns the primary key column of the key columns named names. The
+// column is to be appended to cols. It is hidden, ie. it has no name, and it
+// holds the encoded values of the key columns.
+func newPK(cols []*col, names []string) (*col, error) {
+	c := &col{index: len(cols), typ: qString}
+	m := map[string]bool{}
+	for _, nm := range names {
+		d := findCol(cols, nm)
+		switch {
+		case d == nil:
+			return nil, fmt.Errorf("unknown primary key column %s", nm)
+		case m[nm]:
+			return nil, fmt.Errorf("duplicate primary key column %s", nm)
+		case d.typ == qArray:
+			return nil, fmt.Errorf("primary key column %s cannot be of type %s", nm, typeStr(d.typ))
+		case d.gen != nil && !d.stored:
+			return nil, fmt.Errorf("primary key column %s cannot be a virtual generated column", nm)
+		}
+
+		m[nm] = true
+		c.pk = append(c.pk, d.index)
+	}
+	return c, nil
+}
+
+// pkCol returns the primary key column of t or nil if t has no primary key.
+func (t *table) pkCol() *col {
+	for _, c := range t.cols0 {
+		if c.pk != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+// pkNames returns the names of the key columns of t.
+func (t *table) pkNames() (r []string) {
+	if c := t.pkCol(); c != nil {
+		for _, i := range c.pk {
+			r = append(r, t.cols0[i].name)
+		}
+	}
+	return
+}
+
+// pkKey returns the primary key of the record row of t, having the primary
+// key column c. The key columns cannot be NULL.
+func (t *table) pkKey(c *col, row []interface{}) (string, error) {
+	var b []byte
+	for _, j := range c.pk {
+		v, err := expand1(row[j], nil)
+		if err != nil {
+			return "", err
+		}
+
+		if v == nil {
+			return "", fmt.Errorf("primary key column %s cannot be NULL", t.cols0[j].name)
+		}
+
+		if b, err = pkAppend(b, v); err != nil {
+			return "", err
+		}
+	}
+	return string(b), nil
+}
+
+// pkAppend appends the encoding of the key column value v to b. Every value
+// is a type byte followed by its data, encoded such that keys compare as
+// strings in the order of the values of their key columns, except for complex
+// and bigrat ones.
+func pkAppend(b []byte, v interface{}) ([]byte, error) {
+	switch x := v.(type) {
+	case bool:
+		var n byte
+		if x {
+			n = 1
+		}
+		return append(b, qBool, n), nil
+	case complex64:
+		return pkAppendFloat(pkAppendFloat(append(b, qComplex64), float64(real(x))), float64(imag(x))), nil
+	case complex128:
+		return pkAppendFloat(pkAppendFloat(append(b, qComplex128), real(x)), imag(x)), nil
+	case float32:
+		return pkAppendFloat(append(b, qFloat32), float64(x)), nil
+	case float64:
+		return pkAppendFloat(append(b, qFloat64), x), nil
+	case int8:
+		return pkAppendInt(append(b, qInt8), int64(x)), nil
+	case int16:
+		return pkAppendInt(append(b, qInt16), int64(x)), nil
+	case int32:
+		return pkAppendInt(append(b, qInt32), int64(x)), nil
+	case int64:
+		return pkAppendInt(append(b, qInt64), x), nil
+	case string:
+		return pkAppendBytes(append(b, qString), []byte(x)), nil
+	case uint8:
+		return pkAppendUint(append(b, qUint8), uint64(x)), nil
+	case uint16:
+		return pkAppendUint(append(b, qUint16), uint64(x)), nil
+	case uint32:
+		return pkAppendUint(append(b, qUint32), uint64(x)), nil
+	case uint64:
+		return pkAppendUint(append(b, qUint64), x), nil
+	case []byte:
+		return pkAppendBytes(append(b, qBlob), x), nil
+	case *big.Int:
+		m := x.Bytes()
+		switch x.Sign() {
+		case -1:
+			b = pkAppendUint(append(b, qBigInt, 0), ^uint64(len(m)))
+			for _, v := range m {
+				b = append(b, ^v)
+			}
+			return b, nil
+		case 0:
+			return append(b, qBigInt, 1), nil
+		default:
+			return append(pkAppendUint(append(b, qBigInt, 2), uint64(len(m))), m...), nil
+		}
+	case *big.Rat:
+		return pkAppendBytes(append(b, qBigRat), []byte(x.String())), nil
+	case time.Duration:
+		return pkAppendInt(append(b, qDuration), int64(x)), nil
+	case time.Time:
+		return pkAppendUint(pkAppendInt(append(b, qTime), x.Unix()), uint64(x.Nanosecond())), nil
+	default:
+		return nil, fmt.Errorf("primary key column cannot be of type %T", v)
+	}
+}
+
+func pkAppendUint(b []byte, n uint64) []byte {
+	for i := 56; i >= 0; i -= 8 {
+		b = append(b, byte(n>>uint(i)))
+	}
+	return b
+}
+
+func pkAppendInt(b []byte, n int64) []byte { return pkAppendUint(b, uint64(n)^1<<63) }
+
+func pkAppendFloat(b []byte, f float64) []byte {
+	if f == 0 { // -0 == 0
+		f = 0
+	}
+	n := math.Float64bits(f)
+	switch {
+	case n&(1<<63) != 0:
+		n = ^n
+	default:
+		n |= 1 << 63
+	}
+	return pkAppendUint(b, n)
+}
+
+// pkAppendBytes appends s escaping its zero bytes as 0x00 0xff and terminated
+// by 0x00 0x01.
+func pkAppendBytes(b, s []byte) []byte {
+	for _, c := range s {
+		b = append(b, c)
+		if c == 0 {
+			b = append(b, 0xff)
+		}
+	}
+	return append(b, 0, 1)
+}
+
+// pkDecode returns the values of the key columns encoded in the primary key
+// k.
+func pkDecode(k string) (r []interface{}, err error) {
+	b := []byte(k)
+	u := func() uint64 {
+		if len(b) < 8 {
+			err = fmt.Errorf("truncated primary key")
+			return 0
+		}
+
+		var n uint64
+		for _, c := range b[:8] {
+			n = n<<8 | uint64(c)
+		}
+		b = b[8:]
+		return n
+	}
+	i := func() int64 { return int64(u() ^ 1<<63) }
+	f := func() float64 {
+		n := u()
+		switch {
+		case n&(1<<63) != 0:
+			n &^= 1 << 63
+		default:
+			n = ^n
+		}
+		return math.Float64frombits(n)
+	}
+	s := func() (r []byte) {
+		for len(b) >= 2 {
+			c := b[0]
+			if c == 0 {
+				if b[1] == 1 {
+					b = b[2:]
+					return r
+				}
+
+				b = b[1:]
+			}
+			r = append(r, c)
+			b = b[1:]
+		}
+		err = fmt.Errorf("truncated primary key")
+		return nil
+	}
+	for len(b) != 0 && err == nil {
+		tag := b[0]
+		b = b[1:]
+		var v interface{}
+		switch tag {
+		case qBool:
+			if len(b) == 0 {
+				return nil, fmt.Errorf("truncated primary key")
+			}
+
+			v = b[0] != 0
+			b = b[1:]
+		case qComplex64:
+			v = complex64(complex(f(), f()))
+		case qComplex128:
+			v = complex(f(), f())
+		case qFloat32:
+			v = float32(f())
+		case qFloat64:
+			v = f()
+		case qInt8:
+			v = int8(i())
+		case qInt16:
+			v = int16(i())
+		case qInt32:
+			v = int32(i())
+		case qInt64:
+			v = i()
+		case qString:
+			v = string(s())
+		case qUint8:
+			v = uint8(u())
+		case qUint16:
+			v = uint16(u())
+		case qUint32:
+			v = uint32(u())
+		case qUint64:
+			v = u()
+		case qBlob:
+			v = s()
+		case qBigInt:
+			if len(b) == 0 {
+				return nil, fmt.Errorf("truncated primary key")
+			}
+
+			sign := b[0]
+			b = b[1:]
+			x := big.NewInt(0)
+			switch sign {
+			case 0:
+				n := ^u()
+				if n > uint64(len(b)) {
+					return nil, fmt.Errorf("truncated primary key")
+				}
+
+				m := make([]byte, n)
+				for j, c := range b[:n] {
+					m[j] = ^c
+				}
+				x.Neg(x.SetBytes(m))
+				b = b[n:]
+			case 2:
+				n := u()
+				if n > uint64(len(b)) {
+					return nil, fmt.Errorf("truncated primary key")
+				}
+
+				x.SetBytes(b[:n])
+				b = b[n:]
+			}
+			v = x
+		case qBigRat:
+			x, ok := big.NewRat(0, 1).SetString(string(s()))
+			if !ok && err == nil {
+				return nil, fmt.Errorf("invalid bigrat in primary key")
+			}
+
+			v = x
+		case qDuration:
+			v = time.Duration(i())
+		case qTime:
+			v = time.Unix(i(), int64(u())).UTC()
+		default:
+			return nil, fmt.Errorf("invalid primary key type 0x%02x", tag)
+		}
+		r = append(r, v)
+	}
+	return r, err
+}
+
+// tryPK uses the primary key index of t to find the row matching ex, if ex is
+// a conjunction of equalities of all of the key columns and constants or
+// parameters.
+func (r *whereRset) tryPK(ctx *execCtx, t *table, ex *binaryOperation, f func(id interface{}, data []interface{}) (more bool, err error)) (bool, error) {
+	c := t.pkCol()
+	if c == nil {
+		return false, nil
+	}
+
+	xCol := t.indices[c.index+1]
+	if xCol == nil {
+		return false, nil
+	}
+
+	row := make([]interface{}, len(t.cols0))
+	var cols []*col
+	var err error
+	var walk func(expression) bool
+	walk = func(e expression) bool {
+		x, ok := e.(*binaryOperation)
+		if !ok {
+			return false
+		}
+
+		switch x.op {
+		case andand:
+			return walk(x.l) && walk(x.r)
+		case eq:
+			// ok
+		default:
+			return false
+		}
+
+		l, v := x.l, x.r
+		if _, ok := l.(*ident); !ok {
+			l, v = v, l
+		}
+		id, ok := l.(*ident)
+		if !ok {
+			return false
+		}
+
+		d := findCol(t.cols, id.s)
+		if d == nil || findCol(cols, id.s) != nil {
+			return false
+		}
+
+		switch y := v.(type) {
+		case parameter:
+			if row[d.index], err = y.eval(nil, ctx.arg); err != nil {
+				return false
+			}
+		case value:
+			row[d.index] = y.val
+		default:
+			return false
+		}
+
+		cols = append(cols, d)
+		return true
+	}
+	if !walk(ex) || err != nil {
+		return err != nil, err
+	}
+
+	if len(cols) != len(c.pk) {
+		return false, nil
+	}
+
+	for _, i := range c.pk {
+		if findCol(cols, t.cols0[i].name) == nil {
+			return false, nil
+		}
+	}
+
+	if err = typeCheck(row, cols); err != nil {
+		return true, err
+	}
+
+	m, err := f(nil, []interface{}{t.flds()})
+	if !m || err != nil {
+		return true, err
+	}
+
+	for _, d := range cols {
+		if row[d.index] == nil { // Comparing to NULL is never true.
+			return true, nil
+		}
+	}
+
+	k, err := t.pkKey(c, row)
+	if err != nil {
+		return true, err
+	}
+
+	en, hit, err := xCol.x.Seek(k)
+	if err != nil || !hit {
+		return true, noEOF(err)
+	}
+
+	_, h, err := en.Next()
+	if err != nil {
+		return true, noEOF(err)
+	}
+
+	_, err = tableRset("").doOne(t, h, f)
+	return true, err
+}
@@ -16,7 +16,9 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math"
 	"math/big"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -38,6 +40,7 @@ var (
 	_ rset = (*selectRset)(nil)
 	_ rset = (*selectStmt)(nil)
 	_ rset = (*tableRset)(nil)
+	_ rset = (*tableSample)(nil)
 	_ rset = (*whereRset)(nil)
 
 	isTesting bool // enables test hook: select from an index
@@ -149,9 +152,11 @@ func (r recordset) Rows(limit, offset int) (rows [][]interface{}, err error) {
 type groupByRset struct {
 	colNames []string
 	src      rset
+	table    string // The only source of rows, if not filtered by WHERE, see sorted.
 }
 
 func (r *groupByRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	f = ctx.timed(f)
 	t, err := ctx.db.store.CreateTemp(true)
 	if err != nil {
 		return
@@ -170,7 +175,10 @@ func (r *groupByRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, da
 	k := make([]interface{}, len(r.colNames)) //LATER optimize when len(r.cols) == 0
 	if err = r.src.do(ctx, onlyNames, func(rid interface{}, in []interface{}) (more bool, err error) {
 		if ok {
-			infer(in, &cols)
+			if err = infer(in, &cols); err != nil {
+				return
+			}
+
 			for i, c := range gcols {
 				k[i] = in[c.index]
 			}
@@ -255,9 +263,23 @@ func (r *groupByRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, da
 // RowsAffected is updated by INSERT INTO, DELETE FROM and UPDATE statements.
 // The value does not (yet) consider any ROLLBACK statements involved.  QL
 // clients should treat the field as read only.
+//
+// RowsIgnored
+//
+// RowsIgnored is updated by INSERT OR IGNORE INTO statements. It is the number
+// of rows not inserted because of a conflict with existing rows. QL clients
+// should treat the field as read only.
+//
+// RowsReplaced
+//
+// RowsReplaced is updated by INSERT OR REPLACE INTO statements. It is the
+// number of inserted rows which replaced existing rows. RowsAffected includes
+// these rows. QL clients should treat the field as read only.
 type TCtx struct {
 	LastInsertID int64
 	RowsAffected int64
+	RowsIgnored  int64
+	RowsReplaced int64
 }
 
 // NewRWCtx returns a new read/write transaction context.  NewRWCtx is safe for
@@ -307,6 +329,11 @@ func NewRWCtx() *TCtx { return &TCtx{} }
 // actually computing a first row of a query having, say cross joins on n
 // relations (1^n is always 1, n ∈ N).
 //
+// FieldInfo
+//
+// FieldInfo is like Fields, but it describes the fields by their names, types
+// and whether they can be NULL, see the FieldInfo type.
+//
 // FirstRow
 //
 // FirstRow will return the first row of the RecordSet or an error, if any. If
@@ -321,6 +348,7 @@ func NewRWCtx() *TCtx { return &TCtx{} }
 type Recordset interface {
 	Do(names bool, f func(data []interface{}) (more bool, err error)) error
 	Fields() (names []string, err error)
+	FieldInfo() (fields []FieldInfo, err error)
 	FirstRow() (row []interface{}, err error)
 	Rows(limit, offset int) (rows [][]interface{}, err error)
 }
@@ -331,7 +359,7 @@ type assignment struct {
 }
 
 func (a *assignment) String() string {
-	return fmt.Sprintf("%s=%s", a.colName, a.expr)
+	return fmt.Sprintf("%s=%s", quoteIdent(a.colName), a.expr)
 }
 
 type distinctRset struct {
@@ -339,6 +367,7 @@ type distinctRset struct {
 }
 
 func (r *distinctRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	f = ctx.timed(f)
 	t, err := ctx.db.store.CreateTemp(true)
 	if err != nil {
 		return
@@ -408,7 +437,8 @@ func (r *orderByRset) String() string {
 }
 
 func (r *orderByRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
-	t, err := ctx.db.store.CreateTemp(r.asc)
+	f = ctx.timed(f)
+	t, err := ctx.db.store.CreateSorter(r.asc)
 	if err != nil {
 		return
 	}
@@ -419,7 +449,7 @@ func (r *orderByRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, da
 		}
 	}()
 
-	m := map[interface{}]interface{}{}
+	m := map[interface{}]interface{}{"$ctx": ctx}
 	var flds []*fld
 	ok := false
 	k := make([]interface{}, len(r.by)+1)
@@ -439,6 +469,14 @@ func (r *orderByRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, da
 					return false, err
 				}
 
+				if c := collation(expr); c != nil {
+					if val, err = expand1(val, nil); err != nil {
+						return false, err
+					}
+
+					val = c.collated(val)
+				}
+
 				if val != nil {
 					val, ordered, err := isOrderedType(val)
 					if err != nil {
@@ -499,9 +537,32 @@ var nowhere = &whereRset{}
 type whereRset struct {
 	expr expression
 	src  rset
+	only string // The only column used by the query, see selectStmt.onlyColumn.
+}
+
+// doIndexed passes to f the row of t having the handle h, found under the key
+// k of the index of the column c, or of id() if c is nil. If the query uses
+// only the column c, the row is made of k and the record is not read.
+func (r *whereRset) doIndexed(t *table, c *col, k interface{}, h int64, f func(id interface{}, data []interface{}) (more bool, err error)) ( /* next handle */ int64, error) {
+	if c == nil || c.name != r.only {
+		return tableRset("").doOne(t, h, f)
+	}
+
+	data := make([]interface{}, len(t.cols))
+	for i, v := range t.cols {
+		if v.name == c.name {
+			data[i] = k
+		}
+	}
+	m, err := f(nil, data)
+	if !m || err != nil {
+		return -1, err
+	}
+
+	return h, nil
 }
 
-func (r *whereRset) doIndexedBool(t *table, en indexIterator, v bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+func (r *whereRset) doIndexedBool(t *table, c *col, en indexIterator, v bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
 	m, err := f(nil, []interface{}{t.flds()})
 	if !m || err != nil {
 		return
@@ -522,7 +583,7 @@ func (r *whereRset) doIndexedBool(t *table, en indexIterator, v bool, f func(id
 			}
 		}
 
-		if _, err := tableRset("").doOne(t, h, f); err != nil {
+		if _, err := r.doIndexed(t, c, k, h, f); err != nil {
 			return err
 		}
 	}
@@ -535,7 +596,7 @@ func (r *whereRset) tryBinOp(t *table, id *ident, v value, op int, f func(id int
 	}
 
 	xCol := t.indices[c.index+1]
-	if xCol == nil { // no index for this column
+	if xCol == nil || xCol.fulltext { // no index for this column
 		return false, nil
 	}
 
@@ -583,7 +644,7 @@ func (r *whereRset) tryBinOp(t *table, id *ident, v value, op int, f func(id int
 				return true, nil
 			}
 
-			if _, err := tableRset("").doOne(t, h, f); err != nil {
+			if _, err := r.doIndexed(t, c, k, h, f); err != nil {
 				return true, err
 			}
 		}
@@ -608,6 +669,10 @@ func (r *whereRset) tryBinOp(t *table, id *ident, v value, op int, f func(id int
 				return true, noEOF(err)
 			}
 
+			if isNaN(k) { // NaN collates last but it is not greater than v.
+				continue
+			}
+
 			ex.l = value{k}
 			eval, err := ex.eval(nil, nil)
 			if err != nil {
@@ -618,7 +683,7 @@ func (r *whereRset) tryBinOp(t *table, id *ident, v value, op int, f func(id int
 				return true, nil
 			}
 
-			if _, err := tableRset("").doOne(t, h, f); err != nil {
+			if _, err := r.doIndexed(t, c, k, h, f); err != nil {
 				return true, err
 			}
 		}
@@ -719,177 +784,623 @@ func (r *whereRset) tryBinOpID(t *table, v value, op int, f func(id interface{},
 	}
 }
 
-func (r *whereRset) tryUseIndex(ctx *execCtx, f func(id interface{}, data []interface{}) (more bool, err error)) (bool, error) {
-	//TODO(indices) support IS [NOT] NULL
-	c, ok := r.src.(*crossJoinRset)
+// bindOuter replaces an operand of ex referring to a field of the current row
+// of an enclosing query, ie. not to a column of t, by its value. This enables
+// using an index of t for correlated subqueries.
+func (r *whereRset) bindOuter(ctx *execCtx, t *table, ex *binaryOperation) *binaryOperation {
+	if ctx.outer == nil {
+		return ex
+	}
+
+	bind := func(e expression) expression {
+		id, ok := e.(*ident)
+		if !ok || findCol(t.cols0, id.s) != nil {
+			return e
+		}
+
+		if v, ok := ctx.outerField(id.s); ok {
+			return value{v}
+		}
+
+		return e
+	}
+	return &binaryOperation{ex.op, bind(ex.l), bind(ex.r)}
+}
+
+// indexBound decomposes ex of the form column relOp fixedValue or id() relOp
+// fixedValue, or vice versa, such that the fixed value is on the right hand
+// side. The relOp can be also ==. c == nil means id().
+func (r *whereRset) indexBound(ctx *execCtx, t *table, ex *binaryOperation) (c *col, op int, v interface{}, ok bool, err error) {
+	ex = r.bindOuter(ctx, t, ex)
+	var invOp int
+	switch ex.op {
+	case '<':
+		invOp = '>'
+	case le:
+		invOp = ge
+	case '>':
+		invOp = '<'
+	case ge:
+		invOp = le
+	case eq:
+		invOp = eq
+	default:
+		return
+	}
+
+	lhs, rhs, op := ex.l, ex.r, ex.op
+	switch lhs.(type) {
+	case parameter, value:
+		lhs, rhs, op = rhs, lhs, invOp
+	}
+
+	switch rhs.(type) {
+	case parameter, value:
+		if v, err = rhs.eval(nil, ctx.arg); err != nil {
+			return nil, 0, nil, false, err
+		}
+	default:
+		return
+	}
+
+	switch x := lhs.(type) {
+	case *call:
+		if !(x.f == "id" && len(x.arg) == 0) {
+			return
+		}
+
+		return nil, op, v, true, nil
+	case *ident:
+		if c = findCol(t.cols0, x.s); c == nil {
+			return nil, 0, nil, false, fmt.Errorf("undefined column: %s", x.s)
+		}
+
+		return c, op, v, true, nil
+	default:
+		return
+	}
+}
+
+// tryBinOpRange handles WHERE expressions of the form
+//
+//	column {>|>=} lo && column {<|<=} hi
+//
+// in any order of the operands, where column, or id(), is indexed. The index
+// is enumerated starting at lo and the enumeration stops once hi is passed.
+func (r *whereRset) tryBinOpRange(ctx *execCtx, t *table, ex *binaryOperation, f func(id interface{}, data []interface{}) (more bool, err error)) (bool, error) {
+	lx, ok := ex.l.(*binaryOperation)
 	if !ok {
 		return false, nil
 	}
 
-	tabName, ok := c.isSingleTable()
-	if !ok || isSystemName[tabName] {
+	rx, ok := ex.r.(*binaryOperation)
+	if !ok {
 		return false, nil
 	}
 
-	t := ctx.db.root.tables[tabName]
-	if t == nil {
-		return true, fmt.Errorf("table %s does not exist", tabName)
+	lc, loOp, lo, ok, err := r.indexBound(ctx, t, lx)
+	if !ok || err != nil {
+		return false, err
 	}
 
-	if !t.hasIndices() {
+	rc, hiOp, hi, ok, err := r.indexBound(ctx, t, rx)
+	if !ok || err != nil {
+		return false, err
+	}
+
+	if lc != rc {
 		return false, nil
 	}
 
-	//LATER WHERE column1 boolOp column2 ...
-	//LATER WHERE !column (rewritable as: column == false)
-	switch ex := r.expr.(type) {
-	case *unaryOperation: // WHERE !column
-		if ex.op != '!' {
-			return false, nil
+	if loOp == '<' || loOp == le {
+		loOp, lo, hiOp, hi = hiOp, hi, loOp, lo
+	}
+
+	if !(loOp == '>' || loOp == ge) || !(hiOp == '<' || hiOp == le) {
+		return false, nil
+	}
+
+	var xCol *indexedCol
+	var cc col
+	switch {
+	case lc == nil:
+		xCol, cc = t.indices[0], col{typ: qInt64}
+	default:
+		xCol, cc = t.indices[lc.index+1], *lc
+	}
+	if xCol == nil || xCol.fulltext { // no index
+		return false, nil
+	}
+
+	data := []interface{}{lo, hi}
+	cl, ch := cc, cc
+	cl.index, ch.index = 0, 1
+	if err := typeCheck(data, []*col{&cl, &ch}); err != nil {
+		return true, err
+	}
+
+	m, err := f(nil, []interface{}{t.flds()})
+	if !m || err != nil {
+		return true, err
+	}
+
+	lo, hi = data[0], data[1]
+	if lo == nil || hi == nil { // Comparing to NULL is never true.
+		return true, nil
+	}
+
+	en, _, err := xCol.x.Seek(lo)
+	if err != nil {
+		return true, noEOF(err)
+	}
+
+	for {
+		k, h, err := en.Next()
+		if k == nil {
+			return true, nil
 		}
 
-		switch operand := ex.v.(type) {
-		case *ident:
-			c := findCol(t.cols0, operand.s)
-			if c == nil { // no such column
-				return false, fmt.Errorf("unknown column %s", ex)
-			}
+		if err != nil {
+			return true, noEOF(err)
+		}
 
-			if c.typ != qBool { // not a bool column
-				return false, nil
+		if loOp == '>' {
+			eval, err := (&binaryOperation{'>', value{k}, value{lo}}).eval(nil, nil)
+			if err != nil {
+				return true, err
 			}
 
-			xCol := t.indices[c.index+1]
-			if xCol == nil { // column isn't indexed
-				return false, nil
+			if !eval.(bool) {
+				continue
 			}
+		}
 
-			en, _, err := xCol.x.Seek(false)
-			if err != nil {
-				return false, noEOF(err)
-			}
+		eval, err := (&binaryOperation{hiOp, value{k}, value{hi}}).eval(nil, nil)
+		if err != nil {
+			return true, err
+		}
 
-			return true, r.doIndexedBool(t, en, false, f)
-		default:
-			return false, nil
+		if !eval.(bool) {
+			return true, nil
 		}
-	case *ident: // WHERE column
-		c := findCol(t.cols0, ex.s)
-		if c == nil { // no such column
-			return false, fmt.Errorf("unknown column %s", ex)
+
+		if nh, err := r.doIndexed(t, lc, k, h, f); nh < 0 || err != nil {
+			return true, err
 		}
+	}
+}
 
-		if c.typ != qBool { // not a bool column
+// tryIn handles WHERE expressions of the form
+//
+//	column IN (v1, v2, ...)
+//
+// where column, or id(), is indexed and the list items are literals or
+// parameters. The index is looked up for every distinct list item, in
+// collating order.
+func (r *whereRset) tryIn(ctx *execCtx, t *table, ex *pIn, f func(id interface{}, data []interface{}) (more bool, err error)) (bool, error) {
+	if ex.not || ex.sel != nil || ex.arr != nil {
+		return false, nil
+	}
+
+	var xCol *indexedCol
+	var c *col
+	cc := col{typ: qInt64}
+	switch x := ex.expr.(type) {
+	case *ident:
+		if c = findCol(t.cols0, x.s); c == nil {
 			return false, nil
 		}
 
-		xCol := t.indices[c.index+1]
-		if xCol == nil { // column isn't indexed
+		xCol, cc = t.indices[c.index+1], *c
+	case *call:
+		if !(x.f == "id" && len(x.arg) == 0) {
 			return false, nil
 		}
 
-		en, _, err := xCol.x.Seek(true)
-		if err != nil {
-			return false, noEOF(err)
-		}
+		xCol = t.indices[0]
+	default:
+		return false, nil
+	}
+	if xCol == nil || xCol.fulltext { // no index
+		return false, nil
+	}
 
-		return true, r.doIndexedBool(t, en, true, f)
-	case *binaryOperation:
-		//DONE handle id()
-		var invOp int
-		switch ex.op {
-		case '<':
-			invOp = '>'
-		case le:
-			invOp = ge
-		case eq:
-			invOp = eq
-		case '>':
-			invOp = '<'
-		case ge:
-			invOp = le
+	data := make(inValues, len(ex.list))
+	cols := make([]*col, len(ex.list))
+	for i, e := range ex.list {
+		switch x := e.(type) {
+		case parameter:
+			v, err := x.eval(nil, ctx.arg)
+			if err != nil {
+				return true, err
+			}
+
+			data[i] = v
+		case value:
+			data[i] = x.val
 		default:
 			return false, nil
 		}
 
-		switch lhs := ex.l.(type) {
-		case *call:
-			if !(lhs.f == "id" && len(lhs.arg) == 0) {
-				return false, nil
-			}
+		c := cc
+		c.index = i
+		cols[i] = &c
+	}
+	if err := typeCheck(data, cols); err != nil { // leave the error reporting to the filter
+		return false, nil
+	}
 
-			switch rhs := ex.r.(type) {
-			case parameter:
-				v, err := rhs.eval(nil, ctx.arg)
-				if err != nil {
-					return false, err
-				}
+	m, err := f(nil, []interface{}{t.flds()})
+	if !m || err != nil {
+		return true, err
+	}
 
-				return r.tryBinOpID(t, value{v}, ex.op, f)
-			case value:
-				return r.tryBinOpID(t, rhs, ex.op, f)
-			default:
-				return false, nil
-			}
-		case *ident:
-			switch rhs := ex.r.(type) {
-			case parameter:
-				v, err := rhs.eval(nil, ctx.arg)
-				if err != nil {
-					return false, err
-				}
+	sort.Sort(data)
+	var prev interface{}
+	for i, v := range data {
+		if v == nil || i != 0 && collate1(v, prev) == 0 { // NULL is never IN anything.
+			continue
+		}
 
-				return r.tryBinOp(t, lhs, value{v}, ex.op, f)
-			case value:
-				return r.tryBinOp(t, lhs, rhs, ex.op, f)
-			default:
-				return false, nil
-			}
-		case parameter:
-			switch rhs := ex.r.(type) {
-			case *call:
-				if !(rhs.f == "id" && len(rhs.arg) == 0) {
-					return false, nil
-				}
+		prev = v
+		en, _, err := xCol.x.Seek(v)
+		if err != nil {
+			return true, noEOF(err)
+		}
 
-				v, err := lhs.eval(nil, ctx.arg)
-				if err != nil {
-					return false, err
+		for {
+			k, h, err := en.Next()
+			if err != nil {
+				if err = noEOF(err); err != nil {
+					return true, err
 				}
 
-				return r.tryBinOpID(t, value{v}, invOp, f)
-			case *ident:
-				v, err := lhs.eval(nil, ctx.arg)
-				if err != nil {
-					return false, err
-				}
+				break
+			}
 
-				return r.tryBinOp(t, rhs, value{v}, invOp, f)
-			default:
-				return false, nil
+			if collate1(k, v) != 0 {
+				break
 			}
-		case value:
-			switch rhs := ex.r.(type) {
-			case *call:
-				if !(rhs.f == "id" && len(rhs.arg) == 0) {
-					return false, nil
-				}
 
-				return r.tryBinOpID(t, lhs, invOp, f)
-			case *ident:
-				return r.tryBinOp(t, rhs, lhs, invOp, f)
-			default:
-				return false, nil
+			if nh, err := r.doIndexed(t, c, k, h, f); nh < 0 || err != nil {
+				return true, err
 			}
-		default:
-			return false, nil
 		}
-	default:
+	}
+	return true, nil
+}
+
+type inValues []interface{}
+
+func (s inValues) Len() int           { return len(s) }
+func (s inValues) Less(i, j int) bool { return collate1(s[i], s[j]) < 0 }
+func (s inValues) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// tryMatch uses the full text index of column to find the rows having all
+// words of query in column.
+func (r *whereRset) tryMatch(ctx *execCtx, t *table, ex *pMatch, f func(id interface{}, data []interface{}) (more bool, err error)) (bool, error) {
+	id, ok := ex.expr.(*ident)
+	if !ok {
+		return false, nil
+	}
+
+	c := findCol(t.cols0, id.s)
+	if c == nil {
+		return false, nil
+	}
+
+	xCol := t.indices[c.index+1]
+	if xCol == nil || !xCol.fulltext { // no full text index for this column
+		return false, nil
+	}
+
+	var q interface{}
+	switch x := ex.query.(type) {
+	case parameter:
+		var err error
+		if q, err = x.eval(nil, ctx.arg); err != nil {
+			return true, err
+		}
+	case value:
+		q = x.val
+	default:
+		return false, nil
+	}
+
+	var terms []string
+	switch x := q.(type) {
+	case nil:
+		// NULL matches nothing.
+	case string:
+		terms = words(x)
+	default:
+		return false, nil
+	}
+
+	m, err := f(nil, []interface{}{t.flds()})
+	if !m || err != nil || len(terms) == 0 {
+		return true, err
+	}
+
+	var hs []int64
+	for i, w := range terms {
+		en, _, err := xCol.x.Seek(w)
+		if err != nil {
+			return true, noEOF(err)
+		}
+
+		found := map[int64]bool{}
+		for {
+			k, h, err := en.Next()
+			if k == nil || err != nil {
+				if err = noEOF(err); err != nil {
+					return true, err
+				}
+
+				break
+			}
+
+			if k != w {
+				break
+			}
+
+			if i == 0 {
+				hs = append(hs, h)
+				continue
+			}
+
+			found[h] = true
+		}
+		if i == 0 {
+			continue
+		}
+
+		hs0 := hs
+		hs = hs[:0]
+		for _, h := range hs0 {
+			if found[h] {
+				hs = append(hs, h)
+			}
+		}
+		if len(hs) == 0 {
+			break
+		}
+	}
+
+	for _, h := range hs {
+		if h, err := (tableRset("")).doOne(t, h, f); h < 0 || err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+func (r *whereRset) tryUseIndex(ctx *execCtx, f func(id interface{}, data []interface{}) (more bool, err error)) (bool, error) {
+	//TODO(indices) support IS [NOT] NULL
+	c, ok := r.src.(*crossJoinRset)
+	if !ok {
+		return false, nil
+	}
+
+	tabName, ok := c.isSingleTable()
+	if !ok {
+		return false, nil
+	}
+
+	db, tabName, err := ctx.db.resolve(tabName)
+	if err != nil {
+		return true, err
+	}
+
+	if isSystemName[tabName] {
+		return false, nil
+	}
+
+	t := db.root.tables[tabName]
+	if t == nil {
+		return true, fmt.Errorf("table %s does not exist", tabName)
+	}
+
+	p, err := db.partitioning(t)
+	if err != nil {
+		return true, err
+	}
+
+	if p != nil {
+		cc := *ctx
+		cc.db = db
+		return true, r.doPartitions(&cc, t, p, f)
+	}
+
+	if !t.hasIndices() {
+		return false, nil
+	}
+
+	//LATER WHERE column1 boolOp column2 ...
+	//LATER WHERE !column (rewritable as: column == false)
+	switch ex := r.expr.(type) {
+	case *unaryOperation: // WHERE !column
+		if ex.op != '!' {
+			return false, nil
+		}
+
+		switch operand := ex.v.(type) {
+		case *ident:
+			c := findCol(t.cols0, operand.s)
+			if c == nil { // no such column
+				if ctx.outer != nil { // may refer to an enclosing query
+					return false, nil
+				}
+
+				return false, fmt.Errorf("unknown column %s", ex)
+			}
+
+			if c.typ != qBool { // not a bool column
+				return false, nil
+			}
+
+			xCol := t.indices[c.index+1]
+			if xCol == nil || xCol.fulltext { // column isn't indexed
+				return false, nil
+			}
+
+			en, _, err := xCol.x.Seek(false)
+			if err != nil {
+				return false, noEOF(err)
+			}
+
+			return true, r.doIndexedBool(t, c, en, false, f)
+		default:
+			return false, nil
+		}
+	case *ident: // WHERE column
+		c := findCol(t.cols0, ex.s)
+		if c == nil { // no such column
+			if ctx.outer != nil { // may refer to an enclosing query
+				return false, nil
+			}
+
+			return false, fmt.Errorf("unknown column %s", ex)
+		}
+
+		if c.typ != qBool { // not a bool column
+			return false, nil
+		}
+
+		xCol := t.indices[c.index+1]
+		if xCol == nil || xCol.fulltext { // column isn't indexed
+			return false, nil
+		}
+
+		en, _, err := xCol.x.Seek(true)
+		if err != nil {
+			return false, noEOF(err)
+		}
+
+		return true, r.doIndexedBool(t, c, en, true, f)
+	case *pMatch: // WHERE column MATCH query
+		return r.tryMatch(ctx, t, ex, f)
+	case *pIn: // WHERE column IN (list)
+		return r.tryIn(ctx, t, ex, f)
+	case *binaryOperation:
+		//DONE handle id()
+		if ok, err := r.tryPK(ctx, t, ex, f); ok || err != nil {
+			return ok, err
+		}
+
+		if ex.op == andand {
+			if ok, err := r.tryBinOpRange(ctx, t, ex, f); ok || err != nil {
+				return ok, err
+			}
+
+			return r.tryAnd(ctx, db, t, ex, f)
+		}
+
+		ex = r.bindOuter(ctx, t, ex)
+
+		var invOp int
+		switch ex.op {
+		case '<':
+			invOp = '>'
+		case le:
+			invOp = ge
+		case eq:
+			invOp = eq
+		case '>':
+			invOp = '<'
+		case ge:
+			invOp = le
+		default:
+			return false, nil
+		}
+
+		switch lhs := ex.l.(type) {
+		case *call:
+			if !(lhs.f == "id" && len(lhs.arg) == 0) {
+				return false, nil
+			}
+
+			switch rhs := ex.r.(type) {
+			case parameter:
+				v, err := rhs.eval(nil, ctx.arg)
+				if err != nil {
+					return false, err
+				}
+
+				return r.tryBinOpID(t, value{v}, ex.op, f)
+			case value:
+				return r.tryBinOpID(t, rhs, ex.op, f)
+			default:
+				return false, nil
+			}
+		case *ident:
+			switch rhs := ex.r.(type) {
+			case parameter:
+				v, err := rhs.eval(nil, ctx.arg)
+				if err != nil {
+					return false, err
+				}
+
+				return r.tryBinOp(t, lhs, value{v}, ex.op, f)
+			case value:
+				return r.tryBinOp(t, lhs, rhs, ex.op, f)
+			default:
+				return false, nil
+			}
+		case parameter:
+			switch rhs := ex.r.(type) {
+			case *call:
+				if !(rhs.f == "id" && len(rhs.arg) == 0) {
+					return false, nil
+				}
+
+				v, err := lhs.eval(nil, ctx.arg)
+				if err != nil {
+					return false, err
+				}
+
+				return r.tryBinOpID(t, value{v}, invOp, f)
+			case *ident:
+				v, err := lhs.eval(nil, ctx.arg)
+				if err != nil {
+					return false, err
+				}
+
+				return r.tryBinOp(t, rhs, value{v}, invOp, f)
+			default:
+				return false, nil
+			}
+		case value:
+			switch rhs := ex.r.(type) {
+			case *call:
+				if !(rhs.f == "id" && len(rhs.arg) == 0) {
+					return false, nil
+				}
+
+				return r.tryBinOpID(t, lhs, invOp, f)
+			case *ident:
+				return r.tryBinOp(t, rhs, lhs, invOp, f)
+			default:
+				return false, nil
+			}
+		default:
+			return false, nil
+		}
+	default:
 		return false, nil
 	}
 }
 
 func (r *whereRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	f = ctx.timed(f)
+	if selectsNone(r.expr) { // Only the field names.
+		return r.src.do(ctx, true, f)
+	}
+
+	if x, ok := r.expr.(value); ok && x.val == true { // Every row.
+		return r.src.do(ctx, onlyNames, f)
+	}
+
 	//dbg("====")
 	if !onlyNames {
 		if ok, err := r.tryUseIndex(ctx, f); ok || err != nil {
@@ -899,7 +1410,7 @@ func (r *whereRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data
 	}
 
 	//dbg("not using indices")
-	m := map[interface{}]interface{}{}
+	m := map[interface{}]interface{}{"$ctx": ctx}
 	var flds []*fld
 	ok := false
 	return r.src.do(ctx, onlyNames, func(rid interface{}, in []interface{}) (more bool, err error) {
@@ -944,7 +1455,7 @@ type offsetRset struct {
 }
 
 func (r *offsetRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
-	m := map[interface{}]interface{}{}
+	m := map[interface{}]interface{}{"$ctx": ctx}
 	var flds []*fld
 	var ok, eval bool
 	var off uint64
@@ -993,7 +1504,7 @@ type limitRset struct {
 }
 
 func (r *limitRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
-	m := map[interface{}]interface{}{}
+	m := map[interface{}]interface{}{"$ctx": ctx}
 	var flds []*fld
 	var ok, eval bool
 	var lim uint64
@@ -1052,6 +1563,10 @@ func (r *selectRset) doGroup(grp *groupByRset, ctx *execCtx, onlyNames bool, f f
 		return grp.do(ctx, true, f)
 	}
 
+	if src := grp.sorted(ctx); src != nil {
+		return r.doStream(grp, src, ctx, f)
+	}
+
 	var t temp
 	var cols []*col
 	out := make([]interface{}, len(r.flds))
@@ -1060,7 +1575,7 @@ func (r *selectRset) doGroup(grp *groupByRset, ctx *execCtx, onlyNames bool, f f
 	if err = r.src.do(ctx, onlyNames, func(rid interface{}, in []interface{}) (more bool, err error) {
 		if ok {
 			h := in[0].(int64)
-			m := map[interface{}]interface{}{}
+			m := map[interface{}]interface{}{"$ctx": ctx}
 			for h != 0 {
 				in, err = t.Read(nil, h, cols...)
 				if err != nil {
@@ -1118,7 +1633,7 @@ func (r *selectRset) doGroup(grp *groupByRset, ctx *execCtx, onlyNames bool, f f
 
 		fallthrough
 	case 1:
-		m := map[interface{}]interface{}{"$agg0": true} // aggregate empty record set
+		m := map[interface{}]interface{}{"$ctx": ctx, "$agg0": true} // aggregate empty record set
 		for i, fld := range r.flds {
 			if out[i], err = fld.expr.eval(m, ctx.arg); err != nil {
 				return
@@ -1143,13 +1658,13 @@ func (r *selectRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, dat
 		return err
 	}
 
-	var flds []*fld
-	m := map[interface{}]interface{}{}
+	var names []interface{} // Boxed once, not for every row.
+	m := map[interface{}]interface{}{"$ctx": ctx}
 	ok := false
 	return r.src.do(ctx, onlyNames, func(rid interface{}, in []interface{}) (more bool, err error) {
 		if ok {
-			for i, fld := range flds {
-				if nm := fld.name; nm != "" {
+			for i, nm := range names {
+				if nm != nil {
 					m[nm] = in[i]
 				}
 			}
@@ -1166,7 +1681,13 @@ func (r *selectRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, dat
 		}
 
 		ok = true
-		flds = in[0].([]*fld)
+		flds := in[0].([]*fld)
+		names = make([]interface{}, len(flds))
+		for i, fld := range flds {
+			if fld.name != "" {
+				names[i] = fld.name
+			}
+		}
 		m, err := f(nil, []interface{}{r.flds})
 		return m && !onlyNames, err
 	})
@@ -1216,6 +1737,16 @@ func (tableRset) doOne(t *table, h int64, f func(id interface{}, data []interfac
 	}
 
 	h = rec[0].(int64)
+	if t.hasGen(false) {
+		if n := len(t.cols0) + 2 - len(rec); n > 0 {
+			rec = append(rec, make([]interface{}, n)...)
+		}
+
+		if err = t.genRow(rec[2:], false); err != nil {
+			return -1, err
+		}
+	}
+
 	if n := ncols + 2 - len(rec); n > 0 {
 		rec = append(rec, make([]interface{}, n)...)
 	}
@@ -1258,9 +1789,36 @@ func (r tableRset) doSysTable(ctx *execCtx, onlyNames bool, f func(id interface{
 		rec[0] = ti.Name
 		a := []string{}
 		for _, ci := range ti.Columns {
-			a = append(a, fmt.Sprintf("%s %s", ci.Name, ci.Type))
+			s := fmt.Sprintf("%s %s", quoteIdent(ci.Name), ci.Type)
+			if ci.Dict {
+				s += " DICTIONARY"
+			}
+			if ci.Generated != "" {
+				kind := "VIRTUAL"
+				if ci.Stored {
+					kind = "STORED"
+				}
+				s += fmt.Sprintf(" AS (%s) %s", ci.Generated, kind)
+			}
+			if ci.NotNull {
+				s += " NOT NULL"
+			}
+			if ci.Comment != "" {
+				s += " COMMENT " + strconv.Quote(ci.Comment)
+			}
+			a = append(a, s)
+		}
+		if len(ti.PrimaryKey) != 0 {
+			a = append(a, fmt.Sprintf("PRIMARY KEY (%s)", quoteIdentList(ti.PrimaryKey)))
+		}
+		o := ""
+		if ti.WithoutRowID {
+			o = " WITHOUT ROWID"
+		}
+		if ti.Comment != "" {
+			o += " COMMENT " + strconv.Quote(ti.Comment)
 		}
-		rec[1] = fmt.Sprintf("CREATE TABLE %s (%s);", ti.Name, strings.Join(a, ", "))
+		rec[1] = requote(fmt.Sprintf("CREATE TABLE %s (%s)%s;", quoteIdent(ti.Name), strings.Join(a, ", "), o), ctx.db.identQuote)
 		id++
 		m, err := f(id, rec)
 		if !m || err != nil {
@@ -1339,6 +1897,38 @@ func (r tableRset) doSysIndex(ctx *execCtx, onlyNames bool, f func(id interface{
 }
 
 func (r tableRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	return r.doSample(ctx, onlyNames, nil, f)
+}
+
+// doSample is like do but if sample is not nil, it passes to f only the rows
+// for which sample returns true, see tableSample.
+func (r tableRset) doSample(ctx *execCtx, onlyNames bool, sample func() bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	if strings.IndexByte(string(r), '.') >= 0 {
+		db, name, err := ctx.db.resolve(string(r))
+		if err != nil {
+			return err
+		}
+
+		c := *ctx
+		c.db = db
+		return tableRset(name).doSample(&c, onlyNames, sample, f)
+	}
+
+	f = ctx.timed(f)
+	switch r {
+	case "__Table", "__Column", "__Index":
+		if sample != nil {
+			g, ok := f, false
+			f = func(id interface{}, data []interface{}) (bool, error) {
+				if ok && !sample() {
+					return true, nil
+				}
+
+				ok = true
+				return g(id, data)
+			}
+		}
+	}
 	switch r {
 	case "__Table":
 		return r.doSysTable(ctx, onlyNames, f)
@@ -1360,6 +1950,11 @@ func (r tableRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data
 		return fmt.Errorf("table %s does not exist", r)
 	}
 
+	p, err := ctx.db.partitioning(t)
+	if err != nil {
+		return err
+	}
+
 	m, err := f(nil, []interface{}{t.flds()})
 	if onlyNames {
 		return err
@@ -1369,11 +1964,99 @@ func (r tableRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data
 		return
 	}
 
+	if p != nil {
+		return r.doPartitions(ctx, p, sample, f)
+	}
+
+	return r.doRows(ctx, t, sample, f)
+}
+
+// doRows passes the rows of t to f, see doSample.
+func (r tableRset) doRows(ctx *execCtx, t *table, sample func() bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	switch {
+	case sample != nil:
+		return r.doSampled(t, sample, f)
+	case t.withoutRowID:
+		return r.doPK(t, f)
+	case ctx.db.config().stableOrder:
+		return r.doStable(t, f)
+	}
+
 	for h := t.head; h > 0 && err == nil; h, err = r.doOne(t, h, f) {
 	}
 	return
 }
 
+type idHandle struct {
+	id, h int64
+}
+
+type idHandles []idHandle
+
+func (s idHandles) Len() int           { return len(s) }
+func (s idHandles) Less(i, j int) bool { return s[i].id < s[j].id }
+func (s idHandles) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// doStable passes the rows of t to f in the order of their id(). It walks the
+// index on id(), if any, otherwise it sorts the handles of the rows.
+func (r tableRset) doStable(t *table, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	if t.hasIndices() && t.indices[0] != nil {
+		en, err := t.indices[0].x.SeekFirst()
+		if err != nil {
+			return noEOF(err)
+		}
+
+		for {
+			_, h, err := en.Next()
+			if err != nil {
+				return noEOF(err)
+			}
+
+			if h, err = r.doOne(t, h, f); err != nil || h < 0 {
+				return err
+			}
+		}
+	}
+
+	var a idHandles
+	var rec []interface{}
+	for h := t.head; h != 0; h = rec[0].(int64) {
+		if rec, err = t.store.Read(rec, h); err != nil {
+			return
+		}
+
+		a = append(a, idHandle{rec[1].(int64), h})
+	}
+	sort.Sort(a)
+	for _, v := range a {
+		nh, err := r.doOne(t, v.h, f)
+		if err != nil || nh < 0 {
+			return err
+		}
+	}
+	return
+}
+
+// doPK passes the rows of t, a table WITHOUT ROWID, to f in the order of their
+// primary key.
+func (r tableRset) doPK(t *table, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	en, err := t.indices[t.pkCol().index+1].x.SeekFirst()
+	if err != nil {
+		return noEOF(err)
+	}
+
+	for {
+		_, h, err := en.Next()
+		if err != nil {
+			return noEOF(err)
+		}
+
+		if h, err = r.doOne(t, h, f); err != nil || h < 0 {
+			return err
+		}
+	}
+}
+
 type crossJoinRset struct {
 	sources []interface{}
 }
@@ -1394,7 +2077,7 @@ func (r *crossJoinRset) tables() []struct {
 		switch x := pair[0].(type) {
 		case string: // table name
 			if altName == "" {
-				altName = x
+				altName = unqualified(x)
 			}
 			ret = append(ret, struct {
 				i            int
@@ -1414,16 +2097,23 @@ func (r *crossJoinRset) String() string {
 		case string: // table name
 			switch {
 			case altName == "":
-				a[i] = x
+				a[i] = quoteIdent(x)
 			default:
-				a[i] = fmt.Sprintf("%s AS %s", x, altName)
+				a[i] = fmt.Sprintf("%s AS %s", quoteIdent(x), quoteIdent(altName))
+			}
+		case *tableSample:
+			switch {
+			case altName == "":
+				a[i] = x.String()
+			default:
+				a[i] = fmt.Sprintf("%s AS %s", x, quoteIdent(altName))
 			}
 		case *selectStmt:
 			switch {
 			case altName == "":
 				a[i] = fmt.Sprintf("(%s)", x)
 			default:
-				a[i] = fmt.Sprintf("(%s) AS %s", x, altName)
+				a[i] = fmt.Sprintf("(%s) AS %s", x, quoteIdent(altName))
 			}
 		default:
 			log.Panic("internal error 054")
@@ -1444,6 +2134,7 @@ func (r *crossJoinRset) isSingleTable() (string, bool) {
 }
 
 func (r *crossJoinRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	f = ctx.timed(f)
 	rsets := make([]rset, len(r.sources))
 	altNames := make([]string, len(r.sources))
 	//dbg(".... %p", r)
@@ -1455,7 +2146,12 @@ func (r *crossJoinRset) do(ctx *execCtx, onlyNames bool, f func(id interface{},
 		case string: // table name
 			rsets[i] = tableRset(x)
 			if altName == "" {
-				altName = x
+				altName = unqualified(x)
+			}
+		case *tableSample:
+			rsets[i] = x
+			if altName == "" {
+				altName = unqualified(x.table)
 			}
 		case *selectStmt:
 			rsets[i] = x
@@ -1557,9 +2253,15 @@ func findFld(fields []*fld, name string) (f *fld) {
 }
 
 type col struct {
-	index int
-	name  string
-	typ   int
+	index   int
+	name    string
+	typ     int
+	gen     expression // Generating expression of a generated column.
+	stored  bool       // Generated column is stored.
+	notNull bool       // Column cannot be NULL.
+	dict    bool       // DICTIONARY column, see dictionary.
+	pk      []int      // Indices of the key columns of a primary key column.
+	comment string     // COMMENT of a column definition, kept in __Meta, not loaded with the table.
 }
 
 func findCol(cols []*col, name string) (c *col) {
@@ -1573,7 +2275,7 @@ func findCol(cols []*col, name string) (c *col) {
 }
 
 func (f *col) typeCheck(x interface{}) (ok bool) { //NTYPE
-	switch x.(type) {
+	switch x := x.(type) {
 	case nil:
 		return true
 	case bool:
@@ -1614,46 +2316,236 @@ func (f *col) typeCheck(x interface{}) (ok bool) { //NTYPE
 		return f.typ == qTime
 	case time.Duration:
 		return f.typ == qDuration
+	case []interface{}:
+		return f.typ == qArray && isArray(x)
 	case chunk:
 		return true // was checked earlier
 	}
 	return
 }
 
-func cols2meta(f []*col) (s string) {
-	a := []string{}
-	for _, f := range f {
-		a = append(a, string(f.typ)+f.name)
+func cols2meta(f []*col) (s string) {
+	a := []string{}
+	for _, f := range f {
+		a = append(a, string(f.typ)+f.name)
+	}
+	return strings.Join(a, "|")
+}
+
+// DB represent the database capable of executing QL statements.
+type DB struct {
+	attached      map[string]*DB // Attached databases, guarded by rwmu.
+	cc            *TCtx          // Current transaction context
+	cdc           bool           // Record the changes of transactions, see Options.OnChange.
+	changeLog     []ChangeEvent  // Retained for DB.Changes.
+	changeLogSize int            // See Options.ChangeLog.
+	closeRollback bool           // See Options.RollbackOnClose.
+	identQuote    rune           // See Options.IdentifierQuote.
+	isMem         bool
+	metrics       Metrics
+	mu            sync.Mutex
+	normalize     func(string) string               // See Options.Normalize.
+	onChange      func(ChangeEvent)                 // See Options.OnChange.
+	onSlowQuery   func(sql string, d time.Duration) // See Options.OnSlowQuery.
+	path          string                            // Canonical path of a shared DB file, see OpenFile. Guarded by sharedMu.
+	refs          int                               // Number of the opens of a shared DB file. Guarded by sharedMu.
+	root          *root
+	rw            bool          // DB FSM
+	rng           dbRand        // See the built-in function rand.
+	lockTimeout   time.Duration // See Options.LockTimeout.
+	rwmu          rwLock
+	settings      settings      // Guarded by smu.
+	seq           int64         // Sequence number of the last commit having changes.
+	slowQuery     time.Duration // See Options.SlowQueryThreshold.
+	smu           sync.Mutex
+	store         storage
+	streams       map[*ChangeStream]bool // See DB.Changes.
+	tnl           int                    // Transaction nesting level
+}
+
+// settings are the DB properties controlled by the PRAGMA statement.
+type settings struct {
+	stableOrder       bool          // Scan tables in id() order.
+	strictConversions bool          // Reject lossy conversions to integer types.
+	strictFloats      bool          // Reject NaN and infinite values of columns.
+	timeout           time.Duration // Default statement list execution timeout.
+}
+
+func (db *DB) config() settings {
+	db.smu.Lock()
+	defer db.smu.Unlock()
+	return db.settings
+}
+
+// pragma returns the value of the setting name.
+func (db *DB) pragma(name string) (interface{}, error) {
+	c := db.config()
+	switch name {
+	case "query_timeout":
+		return c.timeout, nil
+	case "stable_order":
+		return c.stableOrder, nil
+	case "strict_conversions":
+		return c.strictConversions, nil
+	case "strict_floats":
+		return c.strictFloats, nil
+	case "application_id":
+		return db.store.Header(hdrAppID), nil
+	case "user_version":
+		return db.store.Header(hdrUserVersion), nil
+	default:
+		return nil, fmt.Errorf("PRAGMA: unknown pragma %s", name)
+	}
+}
+
+// setPragma sets the setting name to v.
+func (db *DB) setPragma(name string, v interface{}) error {
+	switch name {
+	case "application_id", "user_version":
+		n, err := pragmaInt32(name, v)
+		if err != nil {
+			return err
+		}
+
+		off := hdrUserVersion
+		if name == "application_id" {
+			off = hdrAppID
+		}
+		return db.store.SetHeader(off, n)
+	}
+
+	db.smu.Lock()
+	defer db.smu.Unlock()
+	switch name {
+	case "query_timeout":
+		x, ok := v.(time.Duration)
+		if !ok {
+			return fmt.Errorf("PRAGMA %s: cannot use %v (type %T) as duration", name, v, v)
+		}
+
+		db.settings.timeout = x
+	case "stable_order":
+		x, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("PRAGMA %s: cannot use %v (type %T) as bool", name, v, v)
+		}
+
+		db.settings.stableOrder = x
+	case "strict_conversions":
+		x, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("PRAGMA %s: cannot use %v (type %T) as bool", name, v, v)
+		}
+
+		db.settings.strictConversions = x
+	case "strict_floats":
+		x, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("PRAGMA %s: cannot use %v (type %T) as bool", name, v, v)
+		}
+
+		db.settings.strictFloats = x
+	default:
+		return fmt.Errorf("PRAGMA: unknown pragma %s", name)
+	}
+	return nil
+}
+
+// pragmaInt32 returns the integer value v of the setting name as an int32.
+func pragmaInt32(name string, v interface{}) (int32, error) {
+	var n int64
+	switch v.(type) {
+	case idealUint, uint8, uint16, uint32, uint64:
+		u, err := convert(v, qUint64)
+		if err != nil {
+			return 0, err
+		}
+
+		if u.(uint64) > math.MaxInt32 {
+			return 0, fmt.Errorf("PRAGMA %s: value %v overflows int32", name, v)
+		}
+
+		n = int64(u.(uint64))
+	case idealInt, idealRune, int8, int16, int32, int64:
+		i, err := convert(v, qInt64)
+		if err != nil {
+			return 0, err
+		}
+
+		n = i.(int64)
+	default:
+		return 0, fmt.Errorf("PRAGMA %s: cannot use %v (type %T) as int32", name, v, v)
+	}
+	if n < math.MinInt32 || n > math.MaxInt32 {
+		return 0, fmt.Errorf("PRAGMA %s: value %v overflows int32", name, v)
 	}
-	return strings.Join(a, "|")
-}
 
-// DB represent the database capable of executing QL statements.
-type DB struct {
-	cc    *TCtx // Current transaction context
-	isMem bool
-	mu    sync.Mutex
-	root  *root
-	rw    bool // DB FSM
-	rwmu  sync.RWMutex
-	store storage
-	tnl   int // Transaction nesting level
+	return int32(n), nil
 }
 
 func newDB(store storage) (db *DB, err error) {
 	db0 := &DB{
-		store: store,
+		metrics: nopMetrics{},
+		store:   store,
 	}
 	if db0.root, err = newRoot(store); err != nil {
 		return
 	}
 
+	db0.seq = db0.root.seq
 	return db0, nil
 }
 
 // Name returns the name of the DB.
 func (db *DB) Name() string { return db.store.Name() }
 
+// UserVersion returns the user version of the DB, an application defined
+// number, for example of the schema revision, set by PRAGMA user_version. It
+// is zero unless set otherwise.
+func (db *DB) UserVersion() int32 { return db.store.Header(hdrUserVersion) }
+
+// ApplicationID returns the application id of the DB, see
+// Options.ApplicationID and PRAGMA application_id.
+func (db *DB) ApplicationID() int32 { return db.store.Header(hdrAppID) }
+
+// FileName returns the name of the DB file or "" for a DB created by OpenMem.
+func (db *DB) FileName() string {
+	if db.isMem {
+		return ""
+	}
+
+	return db.store.Name()
+}
+
+// FileSize returns the current size of the DB file. For a DB created by
+// OpenMem it returns the total size of the values stored in the DB instead.
+func (db *DB) FileSize() (int64, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.store.Size()
+}
+
+// SpaceInfo describes the free space in a DB file. The space is measured in
+// atoms, the 16 byte units of the allocator of the DB file.
+type SpaceInfo struct {
+	TotalAtoms       int64   // Total number of atoms, allocated and free.
+	FreeAtoms        int64   // Number of free atoms.
+	LargestFreeAtoms int64   // Size of the largest contiguous free region.
+	FreeBlocks       int64   // Number of contiguous free regions.
+	Fragmentation    float64 // 1 - LargestFreeAtoms/FreeAtoms, 0 if there are no free atoms.
+}
+
+// FreeSpace reports the free space in the DB file, ie. the space which is
+// reusable by the DB or reclaimable by rewriting it. It verifies the
+// allocator of the DB file to find it, so the cost of FreeSpace is
+// proportional to the size of the DB file. For a DB created by OpenMem,
+// FreeSpace returns a zero SpaceInfo.
+func (db *DB) FreeSpace() (*SpaceInfo, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.store.FreeSpace()
+}
+
 // Run compiles and executes a statement list.  It returns, if applicable, a
 // RecordSet slice and/or an index and error.
 //
@@ -1661,7 +2553,7 @@ func (db *DB) Name() string { return db.store.Name() }
 //
 // Run is safe for concurrent use by multiple goroutines.
 func (db *DB) Run(ctx *TCtx, ql string, arg ...interface{}) (rs []Recordset, index int, err error) {
-	l, err := Compile(ql)
+	l, err := db.compile(ql)
 	if err != nil {
 		return nil, -1, err
 	}
@@ -1673,8 +2565,28 @@ func (db *DB) Run(ctx *TCtx, ql string, arg ...interface{}) (rs []Recordset, ind
 // DB.Execute or an error if any.
 //
 // Compile is safe for concurrent use by multiple goroutines.
-func Compile(src string) (List, error) {
+func Compile(src string) (List, error) { return CompileQuoted(src, 0) }
+
+// CompileQuoted is like Compile, but the string literals of src enclosed in
+// quote, which must be either '"' or '`', are identifiers instead. Such
+// quoted identifiers can be any non empty strings not containing a dot,
+// including keywords, for example
+//
+//	SELECT "order" FROM t WHERE "order" > 10; // quote == '"'
+//
+// The string forms of statements and expressions quote the identifiers which
+// need it by back quotes. The schemas of tables reported by the __Table system
+// table quote them as Options.IdentifierQuote of the DB, by back quotes if it
+// is zero. A zero quote disables quoting of identifiers.
+//
+// CompileQuoted is safe for concurrent use by multiple goroutines.
+func CompileQuoted(src string, quote rune) (List, error) {
+	if err := checkIdentQuote(quote); err != nil {
+		return List{}, err
+	}
+
 	l := newLexer(src)
+	l.quote = byte(quote)
 	if yyParse(l) != 0 {
 		return List{}, l.errs[0]
 	}
@@ -1682,6 +2594,173 @@ func Compile(src string) (List, error) {
 	return List{l.list, l.params}, nil
 }
 
+func checkIdentQuote(quote rune) error {
+	switch quote {
+	case 0, '"', '`':
+		return nil
+	default:
+		return fmt.Errorf("invalid identifier quote %q", quote)
+	}
+}
+
+// compile compiles the statement list src for execution by db, see
+// Options.IdentifierQuote and Options.Normalize.
+func (db *DB) compile(src string) (List, error) {
+	if db.normalize != nil {
+		src = db.normalize(src)
+	}
+
+	return CompileQuoted(src, db.identQuote)
+}
+
+// normalizeRow replaces the string values of the record row by their
+// normalized form, see Options.Normalize.
+func (db *DB) normalizeRow(row []interface{}) {
+	if db.normalize == nil {
+		return
+	}
+
+	for i, v := range row {
+		if s, ok := v.(string); ok {
+			row[i] = db.normalize(s)
+		}
+	}
+}
+
+// checkFloats returns an error if the record row has a NaN or an infinite
+// floating point value and the strict_floats setting is enabled, see
+// Options.StrictFloats.
+func (db *DB) checkFloats(row []interface{}) error {
+	if !db.config().strictFloats {
+		return nil
+	}
+
+	for _, v := range row {
+		var f float64
+		switch x := v.(type) {
+		case float32:
+			f = float64(x)
+		case float64:
+			f = x
+		case idealFloat:
+			f = float64(x)
+		default:
+			continue
+		}
+
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return fmt.Errorf("cannot store %v: strict_floats is enabled", f)
+		}
+	}
+	return nil
+}
+
+// reservedKeywords are the keywords which cannot be used as identifiers, in
+// lower case.
+var reservedKeywords = map[string]bool{
+	"add": true, "alter": true, "and": true, "as": true, "asc": true,
+	"begin": true, "between": true, "bigint": true, "bigrat": true,
+	"blob": true, "bool": true, "by": true, "byte": true, "column": true,
+	"commit": true, "complex128": true, "complex64": true, "create": true,
+	"delete": true, "desc": true, "distinct": true, "drop": true,
+	"duration": true, "exists": true, "false": true, "float": true,
+	"float32": true, "float64": true, "from": true, "group": true, "if": true,
+	"in": true, "index": true, "insert": true, "int": true, "int16": true,
+	"int32": true, "int64": true, "int8": true, "into": true, "is": true,
+	"like": true, "limit": true, "not": true, "null": true, "offset": true,
+	"on": true, "or": true, "order": true, "rollback": true, "rune": true,
+	"select": true, "set": true, "string": true, "table": true, "time": true,
+	"transaction": true, "true": true, "truncate": true, "uint": true,
+	"uint16": true, "uint32": true, "uint64": true, "uint8": true,
+	"unique": true, "update": true, "values": true, "where": true,
+}
+
+// isIdent reports whether s is an identifier which need not be quoted.
+func isIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			// ok
+		case c >= '0' && c <= '9' && i != 0:
+			// ok
+		default:
+			return false
+		}
+	}
+	return !reservedKeywords[strings.ToLower(s)]
+}
+
+// quoteIdent returns the name s quoted by back quotes if it is not a valid
+// identifier, for example if it is a keyword. The parts of a qualified name
+// are quoted separately.
+func quoteIdent(s string) string {
+	a := strings.Split(s, ".")
+	for i, v := range a {
+		if !isIdent(v) {
+			a[i] = "`" + v + "`"
+		}
+	}
+	return strings.Join(a, ".")
+}
+
+// requote returns src, the text of statements produced by QL, which quotes
+// identifiers by back quotes and string literals by double quotes, see
+// quoteIdent, in the form compiled by CompileQuoted(src, quote). For quote
+// '"' the identifiers are double quoted and the string literals back quoted.
+// Back quotes cannot enclose a string containing a back quote or a carriage
+// return, such string literals are left double quoted.
+func requote(src string, quote rune) string {
+	if quote != '"' {
+		return src
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(src); {
+		j := i + 1
+		switch src[i] {
+		case '`':
+			for j < len(src) && src[j] != '`' {
+				j++
+			}
+			j++
+			b.WriteString(strconv.Quote(src[i+1 : j-1]))
+		case '"':
+			for j < len(src) && src[j] != '"' {
+				if src[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			j++
+			s, err := strconv.Unquote(src[i:j])
+			if err != nil || strings.ContainsAny(s, "`\r") {
+				b.WriteString(src[i:j])
+				break
+			}
+
+			b.WriteString("`" + s + "`")
+		default:
+			b.WriteByte(src[i])
+		}
+		i = j
+	}
+	return b.String()
+}
+
+// quoteIdentList returns the names in a quoted by quoteIdent and separated by
+// commas.
+func quoteIdentList(a []string) string {
+	b := make([]string, len(a))
+	for i, v := range a {
+		b[i] = quoteIdent(v)
+	}
+	return strings.Join(b, ", ")
+}
+
 // MustCompile is like Compile but panics if the ql statements in src cannot be
 // compiled. It simplifies safe initialization of global variables holding
 // compiled statement lists for DB.Execute.
@@ -1700,7 +2779,7 @@ func MustCompile(src string) List {
 // arg.
 //
 // The resulting []Recordset corresponds to the SELECT FROM statements in the
-// list.
+// list. ExecuteResults returns also the results of the other statements.
 //
 // If err != nil then index is the zero based index of the failed QL statement.
 // Empty statements do not count.
@@ -1759,12 +2838,21 @@ func MustCompile(src string) List {
 // Execute is safe for concurrent use by multiple goroutines, but one must
 // consider the blocking issues as discussed above.
 //
+// Timeouts
+//
+// If the DB was opened with a non zero Options.DefaultQueryTimeout, or the
+// timeout was set using PRAGMA query_timeout, Execute behaves like
+// ExecuteTimeout called with that timeout.
+//
 // ACID
 //
 // Atomicity: Transactions are atomic. Transactions can be nested. Commit or
 // rollbacks work on the current transaction level. Transactions are made
 // persistent only on the top level commit. Reads made from within an open
-// transaction are dirty reads.
+// transaction are dirty reads. The Recordsets produced within an open
+// transaction see its uncommitted changes and they can be iterated by the
+// goroutine executing the transaction before it ends. Recordsets are
+// evaluated when iterated, so they see the changes made up to that moment.
 //
 // Consistency: Transactions bring the DB from one structurally consistent
 // state to other structurally consistent state.
@@ -1776,49 +2864,168 @@ func MustCompile(src string) List {
 // write ahead log is used. Database is recovered after a crash from the write
 // ahead log automatically on open.
 func (db *DB) Execute(ctx *TCtx, l List, arg ...interface{}) (rs []Recordset, index int, err error) {
+	return db.ExecuteTimeout(db.config().timeout, ctx, l, arg...)
+}
+
+// slowQuery is a statement reported to Options.OnSlowQuery.
+type slowQuery struct {
+	sql string
+	d   time.Duration
+}
+
+// ExecuteTimeout is like Execute but the execution of l is aborted when it
+// does not complete within d. The deadline applies also to iterating the
+// returned Recordsets. The executor checks the deadline before processing
+// every row. On timeout the error returned is a *TimeoutError, any temporary
+// storage used by the statement is released and the DB is rolled back as if
+// the statement failed. A non positive d disables the timeout.
+func (db *DB) ExecuteTimeout(d time.Duration, ctx *TCtx, l List, arg ...interface{}) (rs []Recordset, index int, err error) {
+	index, err = db.execute(d, ctx, l, arg, func(_ int, _ stmt, r Recordset, _ int64) {
+		if r != nil {
+			rs = append(rs, r)
+		}
+	})
+	return rs, index, err
+}
+
+// Result is the outcome of a statement of a list executed by ExecuteResults.
+type Result struct {
+	Index        int       // Zero based index of the statement in the list.
+	Statement    string    // The statement, as by List.String.
+	Recordset    Recordset // The rows of a SELECT statement, nil for other statements.
+	RowsAffected int64     // Rows inserted, updated or deleted by the statement.
+}
+
+// ExecuteResults is like Execute but it returns a Result for every statement
+// of l executed, in the order of the statements, so the Recordsets of the
+// SELECT statements of l can be told apart and the number of rows changed by
+// every statement is known. If err != nil then the Results of the statements
+// executed before the failed statement of index are returned. As with
+// Execute, the Recordsets are evaluated when iterated, not when their
+// statements are executed.
+//
+// RowsAffected is maintained only when ctx is not nil, the statements
+// executed with a nil ctx cannot change the DB.
+func (db *DB) ExecuteResults(ctx *TCtx, l List, arg ...interface{}) (r []Result, index int, err error) {
+	index, err = db.execute(db.config().timeout, ctx, l, arg, func(i int, s stmt, rs Recordset, n int64) {
+		r = append(r, Result{i, s.String(), rs, n})
+	})
+	return r, index, err
+}
+
+// execute executes l as described at ExecuteTimeout. It calls f with the
+// index, the statement, the Recordset, if any, and the number of the rows
+// affected of every statement of l executed successfully.
+func (db *DB) execute(d time.Duration, ctx *TCtx, l List, arg []interface{}, f func(index int, s stmt, r Recordset, rowsAffected int64)) (index int, err error) {
+	var tmo *timeout
+	if d > 0 {
+		tmo = &timeout{d, time.Now().Add(d)}
+	}
+
 	// Sanitize args
 	for i, v := range arg {
 		switch x := v.(type) {
-		case nil, bool, complex64, complex128, float32, float64, string,
+		case nil, bool, complex64, complex128, float32, float64,
 			int8, int16, int32, int64, int,
 			uint8, uint16, uint32, uint64, uint,
 			*big.Int, *big.Rat, []byte, time.Duration, time.Time:
+		case string:
+			if db.normalize != nil {
+				arg[i] = db.normalize(x)
+			}
 		case big.Int:
 			arg[i] = &x
 		case big.Rat:
 			arg[i] = &x
+		case []interface{}:
+			a := make([]interface{}, len(x))
+			for j, v := range x {
+				switch y := v.(type) {
+				case int:
+					a[j] = int64(y)
+				case uint:
+					a[j] = uint64(y)
+				case big.Int:
+					a[j] = &y
+				case big.Rat:
+					a[j] = &y
+				default:
+					if elemType(v) == 0 {
+						return 0, fmt.Errorf("cannot use arg[%d][%d] (type %T):unsupported array element type", i, j, v)
+					}
+
+					a[j] = v
+				}
+			}
+			arg[i] = a
 		default:
-			return nil, 0, fmt.Errorf("cannot use arg[%d] (type %T):unsupported type", i, v)
+			return 0, fmt.Errorf("cannot use arg[%d] (type %T):unsupported type", i, v)
 		}
 	}
 
 	tnl0 := -1
 	if ctx != nil {
 		ctx.LastInsertID, ctx.RowsAffected = 0, 0
+		ctx.RowsIgnored, ctx.RowsReplaced = 0, 0
+	}
+
+	var slow []slowQuery
+	measure := db.slowQuery > 0 && db.onSlowQuery != nil
+	if measure {
+		defer func() {
+			for _, v := range slow {
+				db.onSlowQuery(v.sql, v.d)
+			}
+		}()
 	}
 
 	var s stmt
 	for index, s = range l.l {
-		r, err := db.run1(ctx, &tnl0, s, arg...)
+		var t0 time.Time
+		if measure {
+			t0 = time.Now()
+		}
+		var n0 int64
+		if ctx != nil {
+			n0 = ctx.RowsAffected
+		}
+		r, err := db.run1(ctx, &tnl0, tmo, s, arg...)
+		if measure {
+			if d := time.Since(t0); d > db.slowQuery {
+				slow = append(slow, slowQuery{s.String(), d})
+			}
+		}
 		if err != nil {
 			for tnl0 >= 0 && db.tnl > tnl0 {
-				if _, e2 := db.run1(ctx, &tnl0, rollbackStmt{}); e2 != nil {
+				if _, e2 := db.run1(ctx, &tnl0, nil, rollbackStmt{}); e2 != nil {
 					err = e2
 				}
 			}
-			return rs, index, err
+			return index, err
 		}
 
-		if r != nil {
-			rs = append(rs, r)
+		var n int64
+		if ctx != nil {
+			n = ctx.RowsAffected - n0
 		}
+		f(index, s, r, n)
 	}
 	return
 }
 
-func (db *DB) run1(pc *TCtx, tnl0 *int, s stmt, arg ...interface{}) (rs Recordset, err error) {
+func (db *DB) run1(pc *TCtx, tnl0 *int, tmo *timeout, s stmt, arg ...interface{}) (rs Recordset, err error) {
 	//dbg("%v", s)
 	db.mu.Lock()
+	switch x := s.(type) {
+	case *attachStmt, *detachStmt:
+		return db.runAttach(pc, tmo, s, arg)
+	case *selectStmt:
+		if x.forUpdate && (!db.rw || pc == nil || pc != db.cc) {
+			db.mu.Unlock()
+			return nil, fmt.Errorf("cannot execute SELECT ... FOR UPDATE outside of a transaction")
+		}
+	}
+
 	switch db.rw {
 	case false:
 		switch s.(type) {
@@ -1828,12 +3035,16 @@ func (db *DB) run1(pc *TCtx, tnl0 *int, s stmt, arg ...interface{}) (rs Recordse
 				return nil, errors.New("BEGIN TRANSACTION: cannot start a transaction in nil TransactionCtx")
 			}
 
+			if err = db.rwmu.Lock(db.lockTimeout); err != nil {
+				return
+			}
+
 			if err = db.store.BeginTransaction(); err != nil {
+				db.rwmu.Unlock()
 				return
 			}
 
 			db.beginTransaction()
-			db.rwmu.Lock()
 			db.cc = pc
 			*tnl0 = db.tnl // 0
 			db.tnl++
@@ -1851,10 +3062,14 @@ func (db *DB) run1(pc *TCtx, tnl0 *int, s stmt, arg ...interface{}) (rs Recordse
 				return nil, fmt.Errorf("attempt to update the DB outside of a transaction")
 			}
 
-			db.rwmu.RLock() // can safely grab before Unlock
+			err = db.rwmu.RLock(db.lockTimeout) // can safely grab before Unlock
 			db.mu.Unlock()
+			if err != nil {
+				return
+			}
+
 			defer db.rwmu.RUnlock()
-			return s.exec(&execCtx{db, arg}) // R/O tctx
+			return db.exec(s, arg, tmo) // R/O tctx
 		}
 	default: // case true:
 		switch s.(type) {
@@ -1865,18 +3080,34 @@ func (db *DB) run1(pc *TCtx, tnl0 *int, s stmt, arg ...interface{}) (rs Recordse
 				return nil, errBeginTransNoCtx
 			}
 
+			locked := false
 			if pc != db.cc {
+				var deadline time.Time
+				if db.lockTimeout > 0 {
+					deadline = time.Now().Add(db.lockTimeout)
+				}
 				for db.rw == true {
+					if !deadline.IsZero() && time.Now().After(deadline) {
+						return nil, &LockTimeoutError{Timeout: db.lockTimeout, Write: true, Locks: db.rwmu.Info()}
+					}
+
 					db.mu.Unlock() // Transaction isolation
 					db.mu.Lock()
 				}
 
-				db.rw = true
-				db.rwmu.Lock()
+				if err = db.rwmu.Lock(db.lockTimeout); err != nil {
+					return
+				}
+
+				db.rw, locked = true, true
 				*tnl0 = db.tnl // 0
 			}
 
 			if err = db.store.BeginTransaction(); err != nil {
+				if locked {
+					db.rw = false
+					db.rwmu.Unlock()
+				}
 				return
 			}
 
@@ -1890,13 +3121,26 @@ func (db *DB) run1(pc *TCtx, tnl0 *int, s stmt, arg ...interface{}) (rs Recordse
 				return nil, fmt.Errorf("invalid passed transaction context")
 			}
 
-			db.commit()
-			err = db.store.Commit()
+			switch err = db.commitSeq(); {
+			case err != nil:
+				db.rollback()
+				db.store.Rollback()
+			default:
+				if err = db.store.Commit(); err != nil {
+					db.rollback() // The store rolled back the transaction.
+				} else {
+					db.commit()
+				}
+			}
 			db.tnl--
 			if db.tnl != 0 {
 				return
 			}
 
+			if err == nil && db.cdc {
+				db.changed()
+			}
+
 			db.cc = nil
 			db.rw = false
 			db.rwmu.Unlock()
@@ -1927,9 +3171,12 @@ func (db *DB) run1(pc *TCtx, tnl0 *int, s stmt, arg ...interface{}) (rs Recordse
 				}
 
 				db.mu.Unlock() // must Unlock before RLock
-				db.rwmu.RLock()
+				if err = db.rwmu.RLock(db.lockTimeout); err != nil {
+					return
+				}
+
 				defer db.rwmu.RUnlock()
-				return s.exec(&execCtx{db, arg})
+				return db.exec(s, arg, tmo)
 			}
 
 			defer db.mu.Unlock()
@@ -1939,10 +3186,20 @@ func (db *DB) run1(pc *TCtx, tnl0 *int, s stmt, arg ...interface{}) (rs Recordse
 			}
 
 			if !s.isUpdating() {
-				return s.exec(&execCtx{db, arg})
+				// The recordset sees the uncommitted changes of the
+				// transaction, it can be iterated before the commit.
+				if rs, err = db.exec(s, arg, tmo); err != nil {
+					return
+				}
+
+				if x, ok := rs.(recordset); ok {
+					x.tx = pc
+					rs = x
+				}
+				return
 			}
 
-			if rs, err = s.exec(&execCtx{db, arg}); err != nil {
+			if rs, err = db.exec(s, arg, tmo); err != nil {
 				return
 			}
 
@@ -1951,45 +3208,193 @@ func (db *DB) run1(pc *TCtx, tnl0 *int, s stmt, arg ...interface{}) (rs Recordse
 	}
 }
 
-// Flush ends the transaction collecting window, if applicable. IOW, if the DB
-// is dirty, it schedules a 2PC (WAL + DB file) commit on the next outer most
-// DB.Commit or performs it synchronously if there's currently no open
-// transaction.
-//
-// The collecting window is an implementation detail and future versions of
-// Flush may become a no operation while keeping the operation semantics.
+// exec executes s. Any subqueries materialized by s are released when it
+// returns.
+// measure returns a function reporting to db.metrics the execution of s which
+// started at t0. db.mu must be held if s is updating.
+func (db *DB) measure(s stmt, t0 time.Time) func() {
+	var cc *TCtx
+	var rows int64
+	if s.isUpdating() {
+		cc = db.cc
+		rows = cc.RowsAffected
+	}
+	return func() {
+		db.metrics.Inc(MetricQueries, 1)
+		db.metrics.Observe(MetricQueryDuration, time.Since(t0).Seconds())
+		if cc != nil {
+			db.metrics.Inc(MetricRowsWritten, cc.RowsAffected-rows)
+		}
+	}
+}
+
+func (db *DB) exec(s stmt, arg []interface{}, tmo *timeout) (rs Recordset, err error) {
+	if _, ok := db.metrics.(nopMetrics); !ok {
+		defer db.measure(s, time.Now())()
+	}
+
+	ctx := newExecCtx(db, arg, tmo)
+	defer func() {
+		if e := ctx.drop(); e != nil && err == nil {
+			err = e
+		}
+	}()
+
+	return s.exec(ctx)
+}
+
+// Flush writes the committed state of the DB file and of its WAL to stable
+// storage, as by os.File.Sync, for example before taking a snapshot of the
+// file system. Unlike COMMIT, Flush does not end any transaction. It waits
+// for the open transaction, if any, to end, so it must not be called by the
+// goroutine executing a transaction. Flush is a no operation for a DB created
+// by OpenMem.
 func (db *DB) Flush() (err error) {
-	return nil
+	if err = db.rwmu.Lock(db.lockTimeout); err != nil {
+		return err
+	}
+
+	defer db.rwmu.Unlock()
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.store == nil {
+		return nil
+	}
+
+	return db.store.Sync()
+}
+
+// ping verifies db is open and its storage is usable by reading the root
+// record and the size of the storage while db is locked for reading. If the
+// transaction of ctx is open, db is not locked, as it is already locked by
+// the transaction. ping reports an error if the lock cannot be acquired within
+// Options.LockTimeout or if the storage cannot be read.
+func (db *DB) ping(ctx *TCtx) (err error) {
+	db.mu.Lock()
+	switch {
+	case db.store == nil:
+		db.mu.Unlock()
+		return errDBClosed
+	case db.rw && ctx != nil && ctx == db.cc:
+		defer db.mu.Unlock()
+	default:
+		db.mu.Unlock()
+		if err = db.rwmu.RLock(db.lockTimeout); err != nil {
+			return err
+		}
+
+		defer db.rwmu.RUnlock()
+		db.mu.Lock()
+		defer db.mu.Unlock()
+		if db.store == nil {
+			return errDBClosed
+		}
+	}
+
+	if _, err = db.store.Read(nil, 1); err != nil {
+		return err
+	}
+
+	_, err = db.store.Size()
+	return err
 }
 
-// Close will close the DB. Successful Close is idempotent.
+// Close will close the DB. Successful Close is idempotent, except for a DB
+// returned by more than one OpenFile, see OpenFile.
+//
+// If a transaction is open, Close fails with ErrOpenTransaction and the DB
+// remains open, unless it was opened with Options.RollbackOnClose. Then Close
+// rolls back the transaction, including all its nesting levels, and closes
+// the DB.
 func (db *DB) Close() error {
+	if db.release() {
+		return nil
+	}
+
 	db.mu.Lock()
 	defer db.mu.Unlock()
 	if db.store == nil {
 		return nil
 	}
 
+	var err error
 	if db.tnl != 0 {
-		return fmt.Errorf("cannot close DB while open transaction exist")
+		if !db.closeRollback {
+			if db.path != "" {
+				db.share(db.path) // Undo the release.
+			}
+			return ErrOpenTransaction
+		}
+
+		err = db.abort()
 	}
 
-	err := db.store.Close()
+	errSet(&err, db.store.Close())
+	if e := db.detachAll(); e != nil && err == nil {
+		err = e
+	}
+	for s := range db.streams {
+		close(s.c)
+	}
+	db.streams = nil
 	db.root, db.store = nil, nil
 	return err
 }
 
 func (db *DB) do(r recordset, names int, f func(data []interface{}) (more bool, err error)) (err error) {
+	return db.locked(r, func(ctx *execCtx) error {
+		ok := false
+		return r.do(ctx, names == onlyNames, func(id interface{}, data []interface{}) (more bool, err error) {
+			if ok {
+				if err = expand(data); err != nil {
+					return
+				}
+
+				return f(data)
+			}
+
+			ok = true
+			done := false
+			switch names {
+			case noNames:
+				return true, nil
+			case onlyNames:
+				done = true
+				fallthrough
+			default: // returnNames
+				flds := data[0].([]*fld)
+				a := make([]interface{}, len(flds))
+				for i, v := range flds {
+					a[i] = v.name
+				}
+				more, err := f(a)
+				return more && !done, err
+
+			}
+		})
+	})
+}
+
+// locked calls f with a context for evaluating r while db is locked as
+// required by r.
+func (db *DB) locked(r recordset, f func(ctx *execCtx) error) (err error) {
 	db.mu.Lock()
 	switch db.rw {
 	case false:
-		db.rwmu.RLock() // can safely grab before Unlock
+		err = db.rwmu.RLock(db.lockTimeout) // can safely grab before Unlock
 		db.mu.Unlock()
+		if err != nil {
+			return
+		}
+
 		defer db.rwmu.RUnlock()
 	default: // case true:
 		if r.tx == nil {
 			db.mu.Unlock() // must Unlock before RLock
-			db.rwmu.RLock()
+			if err = db.rwmu.RLock(db.lockTimeout); err != nil {
+				return
+			}
+
 			defer db.rwmu.RUnlock()
 			break
 		}
@@ -2000,35 +3405,14 @@ func (db *DB) do(r recordset, names int, f func(data []interface{}) (more bool,
 		}
 	}
 
-	ok := false
-	return r.do(r.ctx, names == onlyNames, func(id interface{}, data []interface{}) (more bool, err error) {
-		if ok {
-			if err = expand(data); err != nil {
-				return
-			}
-
-			return f(data)
+	ctx := newExecCtx(db, r.ctx.arg, r.ctx.tmo)
+	defer func() {
+		if e := ctx.drop(); e != nil && err == nil {
+			err = e
 		}
+	}()
 
-		ok = true
-		done := false
-		switch names {
-		case noNames:
-			return true, nil
-		case onlyNames:
-			done = true
-			fallthrough
-		default: // returnNames
-			flds := data[0].([]*fld)
-			a := make([]interface{}, len(flds))
-			for i, v := range flds {
-				a[i] = v.name
-			}
-			more, err := f(a)
-			return more && !done, err
-
-		}
-	})
+	return f(ctx)
 }
 
 func (db *DB) beginTransaction() { //TODO Rewrite, must use much smaller undo info!
@@ -2036,20 +3420,22 @@ func (db *DB) beginTransaction() { //TODO Rewrite, must use much smaller undo in
 	newRoot := &root{}
 	*newRoot = *oldRoot
 	newRoot.parent = oldRoot
-	a := make([]*table, 0, len(oldRoot.tables))
+	newRoot.parts = nil
 	newRoot.tables = make(map[string]*table, len(oldRoot.tables))
-	for k, v := range oldRoot.tables {
-		c := v.clone()
-		a = append(a, c)
-		newRoot.tables[k] = c
-	}
-	for i := 0; i < len(a)-1; i++ {
-		l, p := a[i], a[i+1]
-		l.tnext = p
-		p.tprev = l
-	}
-	if len(a) != 0 {
-		newRoot.thead = a[0]
+	newRoot.thead = nil
+	// The clones are linked in the order of the table list in the DB, the
+	// next fields of their records link them so.
+	var prev *table
+	for t := oldRoot.thead; t != nil; t = t.tnext {
+		c := t.clone()
+		c.tprev, c.tnext = prev, nil
+		if prev == nil {
+			newRoot.thead = c
+		} else {
+			prev.tnext = c
+		}
+		newRoot.tables[c.name] = c
+		prev = c
 	}
 	db.root = newRoot
 }
@@ -2058,6 +3444,19 @@ func (db *DB) rollback() {
 	db.root = db.root.parent
 }
 
+// abort rolls back all the nesting levels of the open transaction. db.mu must
+// be held.
+func (db *DB) abort() (err error) {
+	for ; db.tnl != 0; db.tnl-- {
+		db.rollback()
+		errSet(&err, db.store.Rollback())
+	}
+	db.cc = nil
+	db.rw = false
+	db.rwmu.Unlock()
+	return
+}
+
 func (db *DB) commit() {
 	db.root.parent = db.root.parent.parent
 }
@@ -2067,6 +3466,7 @@ type Type int
 
 // Values of ColumnInfo.Type.
 const (
+	Array      Type = qArray
 	BigInt     Type = qBigInt
 	BigRat          = qBigRat
 	Blob            = qBlob
@@ -2095,8 +3495,14 @@ func (t Type) String() string {
 
 // ColumnInfo provides meta data describing a table column.
 type ColumnInfo struct {
-	Name string // Column name.
-	Type Type   // Column type (BigInt, BigRat, ...).
+	Name      string            // Column name.
+	Type      Type              // Column type (BigInt, BigRat, ...).
+	Generated string            // Generating expression of a generated column, if any.
+	Stored    bool              // Generated column is stored.
+	NotNull   bool              // Column is declared NOT NULL.
+	Dict      bool              // Column is declared DICTIONARY.
+	Comment   string            // COMMENT of the column, if any.
+	Meta      map[string]string // Metadata of the column, see DB.SetMeta. Nil if there are none.
 }
 
 // TableInfo provides meta data describing a DB table.
@@ -2107,6 +3513,18 @@ type TableInfo struct {
 	// Table schema. Columns are listed in the order in which they appear
 	// in the schema.
 	Columns []ColumnInfo
+
+	// Names of the primary key columns, if any.
+	PrimaryKey []string
+
+	// Whether the table was created WITHOUT ROWID.
+	WithoutRowID bool
+
+	// COMMENT of the table, if any.
+	Comment string
+
+	// Metadata of the table, see DB.SetMeta. Nil if there are none.
+	Meta map[string]string
 }
 
 // IndexInfo provides meta data describing a DB index.  It corresponds to the
@@ -2128,11 +3546,31 @@ type DbInfo struct {
 }
 
 func (db *DB) info() (r *DbInfo, err error) {
+	meta, err := db.meta()
+	if err != nil {
+		return nil, err
+	}
+
 	r = &DbInfo{Name: db.Name()}
 	for nm, t := range db.root.tables {
-		ti := TableInfo{Name: nm}
+		pt, err := db.partitionOf(t)
+		if err != nil {
+			return nil, err
+		}
+
+		if pt != "" { // Described by __Partition.
+			continue
+		}
+
+		m := meta[metaKey{nm, ""}]
+		ti := TableInfo{Name: nm, PrimaryKey: t.pkNames(), WithoutRowID: t.withoutRowID, Comment: m[commentKey], Meta: m}
 		for _, c := range t.cols {
-			ti.Columns = append(ti.Columns, ColumnInfo{Name: c.name, Type: Type(c.typ)})
+			m := meta[metaKey{nm, c.name}]
+			ci := ColumnInfo{Name: c.name, Type: Type(c.typ), Stored: c.stored, NotNull: c.notNull, Dict: c.dict, Comment: m[commentKey], Meta: m}
+			if c.gen != nil {
+				ci.Generated = c.gen.String()
+			}
+			ti.Columns = append(ti.Columns, ci)
 		}
 		r.Tables = append(r.Tables, ti)
 		for i, x := range t.indices {
@@ -2144,6 +3582,8 @@ func (db *DB) info() (r *DbInfo, err error) {
 			switch {
 			case i == 0:
 				cn = "id()"
+			case t.cols0[i-1].pk != nil:
+				cn = "(" + strings.Join(ti.PrimaryKey, ", ") + ")"
 			default:
 				cn = t.cols0[i-1].name
 			}
@@ -3,7 +3,7 @@
 //TODO Put your favorite license here
 		
 // yacc source generated by ebnf2y[1]
-// at 2015-01-19 16:28:23.939575411 +0100 CET
+// at 2026-10-16 13:44:39.857606000 +0000 UTC
 //
 //  $ ebnf2y -o ql.y -oe ql.ebnf -start StatementList -pkg ql -p _
 //
@@ -31,6 +31,8 @@ import (
 
 %token	_ANDAND
 %token	_ANDNOT
+%token	_BLOB_LIT
+%token	_DCOLON
 %token	_EQ
 %token	_FLOAT_LIT
 %token	_GE
@@ -49,6 +51,8 @@ import (
 %type	<item> 	/*TODO real type(s), if/where applicable */
 	_ANDAND
 	_ANDNOT
+	_BLOB_LIT
+	_DCOLON
 	_EQ
 	_FLOAT_LIT
 	_GE
@@ -66,9 +70,12 @@ import (
 
 %token _ADD
 %token _ALTER
+%token _ANALYZE
 %token _AND
+%token _ARRAY
 %token _AS
 %token _ASC
+%token _ATTACH
 %token _BEGIN
 %token _BETWEEN
 %token _BIGINT
@@ -77,25 +84,39 @@ import (
 %token _BOOL
 %token _BY
 %token _BYTE
+%token _CAST
+%token _COLLATE
 %token _COLUMN
+%token _COMMENT
 %token _COMMIT
 %token _COMPLEX128
 %token _COMPLEX64
+%token _CONFLICT
 %token _CREATE
+%token _DATABASE
 %token _DELETE
 %token _DESC
+%token _DETACH
+%token _DICTIONARY
 %token _DISTINCT
+%token _DO
 %token _DROP
 %token _DURATION
+%token _ESCAPE
 %token _EXISTS
 %token _FALSE
 %token _FLOAT
 %token _FLOAT32
 %token _FLOAT64
+%token _FOR
 %token _FROM
+%token _FULLTEXT
 %token _GROUPBY
+%token _HASH
 %token _ID
 %token _IF
+%token _IGNORE
+%token _ILIKE
 %token _IN
 %token _INDEX
 %token _INSERT
@@ -106,20 +127,37 @@ import (
 %token _INT8
 %token _INTO
 %token _IS
+%token _KEY
+%token _LESS
 %token _LIKE
 %token _LIMIT
+%token _MATCH
 %token _NOT
 %token _NULL
 %token _OFFSET
 %token _ON
 %token _OR
 %token _ORDER
+%token _PARTITION
+%token _PARTITIONS
+%token _PERCENT
+%token _PRAGMA
+%token _PRIMARY
+%token _RANGE
+%token _REINDEX
+%token _REPEATABLE
+%token _REPLACE
+%token _RETURNING
 %token _ROLLBACK
+%token _ROWID
 %token _RUNE
 %token _SELECT
 %token _SET
+%token _STORED
 %token _STRING
 %token _TABLE
+%token _TABLESAMPLE
+%token _THAN
 %token _TIME
 %token _TRANSACTION
 %token _TRUE
@@ -132,35 +170,58 @@ import (
 %token _UNIQUE
 %token _UPDATE
 %token _VALUES
+%token _VIRTUAL
 %token _WHERE
+%token _WITHOUT
 
 %type	<item> 	/*TODO real type(s), if/where applicable */
 	AlterTableStmt
 	AlterTableStmt1
+	AnalyzeStmt
+	AnalyzeStmt1
 	Assignment
 	AssignmentList
 	AssignmentList1
 	AssignmentList2
+	AttachStmt
 	BeginTransactionStmt
 	Call
 	Call1
+	Cast
 	ColumnDef
+	ColumnDef1
+	ColumnDef11
+	ColumnDef111
+	ColumnDef1111
+	ColumnDef2
+	ColumnDef3
 	ColumnName
 	ColumnNameList
 	ColumnNameList1
 	ColumnNameList2
+	Comment
 	CommitStmt
 	Conversion
+	Conversion1
 	CreateIndexStmt
 	CreateIndexStmt1
+	CreateIndexStmt11
 	CreateIndexStmt2
 	CreateIndexStmt3
 	CreateTableStmt
 	CreateTableStmt1
 	CreateTableStmt2
 	CreateTableStmt3
+	CreateTableStmt31
+	CreateTableStmt311
+	CreateTableStmt4
+	CreateTableStmt5
+	CreateTableStmt6
+	DatabaseName
 	DeleteFromStmt
 	DeleteFromStmt1
+	DeleteFromStmt2
+	DetachStmt
 	DropIndexStmt
 	DropIndexStmt1
 	DropTableStmt
@@ -175,7 +236,12 @@ import (
 	Factor
 	Factor1
 	Factor11
+	Factor111
+	Factor112
+	Factor113
 	Factor2
+	Factor3
+	Factor4
 	Field
 	Field1
 	FieldList
@@ -186,23 +252,39 @@ import (
 	IndexName
 	InsertIntoStmt
 	InsertIntoStmt1
+	InsertIntoStmt11
 	InsertIntoStmt2
+	InsertIntoStmt3
+	InsertIntoStmt4
 	Limit
 	Literal
 	Offset
+	OnConflict
+	OnConflict1
+	OnConflict2
 	Operand
+	Operand1
 	OrderBy
 	OrderBy1
 	OrderBy11
+	PartitionBy
+	PartitionBy1
+	PartitionName
+	PragmaStmt
+	PragmaStmt1
 	Predicate
 	Predicate1
 	Predicate11
 	Predicate12
+	Predicate121
+	Predicate122
 	Predicate13
+	Predicate14
 	PrimaryExpression
 	PrimaryFactor
 	PrimaryFactor1
 	PrimaryFactor11
+	PrimaryKey
 	PrimaryTerm
 	PrimaryTerm1
 	PrimaryTerm11
@@ -211,10 +293,15 @@ import (
 	RecordSet
 	RecordSet1
 	RecordSet11
+	RecordSet12
+	RecordSet13
 	RecordSet2
 	RecordSetList
 	RecordSetList1
 	RecordSetList2
+	ReindexStmt
+	Returning
+	Returning1
 	RollbackStmt
 	SelectStmt
 	SelectStmt1
@@ -224,6 +311,7 @@ import (
 	SelectStmt5
 	SelectStmt6
 	SelectStmt7
+	SelectStmt8
 	Slice
 	Slice1
 	Slice2
@@ -232,6 +320,8 @@ import (
 	StatementList
 	StatementList1
 	TableName
+	TableSample
+	TableSample1
 	Term
 	Term1
 	Term11
@@ -243,6 +333,7 @@ import (
 	UpdateStmt
 	UpdateStmt1
 	UpdateStmt2
+	UpdateStmt3
 	Values
 	Values1
 	Values2
@@ -269,1161 +360,1683 @@ AlterTableStmt1:
 	{
 		$$ = []AlterTableStmt1{"DROP", "COLUMN", $3} //TODO 3
 	}
+|	_ADD _PARTITION PartitionName _VALUES _LESS _THAN '(' Expression ')'
+	{
+		$$ = []AlterTableStmt1{"ADD", "PARTITION", $3, "VALUES", "LESS", "THAN", "(", $8, ")"} //TODO 4
+	}
+|	_DROP _PARTITION PartitionName
+	{
+		$$ = []AlterTableStmt1{"DROP", "PARTITION", $3} //TODO 5
+	}
+
+AnalyzeStmt:
+	_ANALYZE AnalyzeStmt1
+	{
+		$$ = []AnalyzeStmt{"ANALYZE", $2} //TODO 6
+	}
+
+AnalyzeStmt1:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 7
+	}
+|	TableName
+	{
+		$$ = $1 //TODO 8
+	}
 
 Assignment:
 	ColumnName '=' Expression
 	{
-		$$ = []Assignment{$1, "=", $3} //TODO 4
+		$$ = []Assignment{$1, "=", $3} //TODO 9
 	}
 
 AssignmentList:
 	Assignment AssignmentList1 AssignmentList2
 	{
-		$$ = []AssignmentList{$1, $2, $3} //TODO 5
+		$$ = []AssignmentList{$1, $2, $3} //TODO 10
 	}
 
 AssignmentList1:
 	/* EMPTY */
 	{
-		$$ = []AssignmentList1(nil) //TODO 6
+		$$ = []AssignmentList1(nil) //TODO 11
 	}
 |	AssignmentList1 ',' Assignment
 	{
-		$$ = append($1.([]AssignmentList1), ",", $3) //TODO 7
+		$$ = append($1.([]AssignmentList1), ",", $3) //TODO 12
 	}
 
 AssignmentList2:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 8
+		$$ = nil //TODO 13
 	}
 |	','
 	{
-		$$ = "," //TODO 9
+		$$ = "," //TODO 14
+	}
+
+AttachStmt:
+	_ATTACH _DATABASE Expression _AS DatabaseName
+	{
+		$$ = []AttachStmt{"ATTACH", "DATABASE", $3, "AS", $5} //TODO 15
 	}
 
 BeginTransactionStmt:
 	_BEGIN _TRANSACTION
 	{
-		$$ = []BeginTransactionStmt{"BEGIN", "TRANSACTION"} //TODO 10
+		$$ = []BeginTransactionStmt{"BEGIN", "TRANSACTION"} //TODO 16
 	}
 
 Call:
 	'(' Call1 ')'
 	{
-		$$ = []Call{"(", $2, ")"} //TODO 11
+		$$ = []Call{"(", $2, ")"} //TODO 17
 	}
 
 Call1:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 12
+		$$ = nil //TODO 18
 	}
 |	ExpressionList
 	{
-		$$ = $1 //TODO 13
+		$$ = $1 //TODO 19
+	}
+
+Cast:
+	_CAST '(' Expression _AS Type ')'
+	{
+		$$ = []Cast{"CAST", "(", $3, "AS", $5, ")"} //TODO 20
 	}
 
 ColumnDef:
-	ColumnName Type
+	ColumnName Type ColumnDef1 ColumnDef2 ColumnDef3
 	{
-		$$ = []ColumnDef{$1, $2} //TODO 14
+		$$ = []ColumnDef{$1, $2, $3, $4, $5} //TODO 21
+	}
+
+ColumnDef1:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 22
+	}
+|	ColumnDef11
+	{
+		$$ = $1 //TODO 23
+	}
+
+ColumnDef11:
+	_DICTIONARY
+	{
+		$$ = "DICTIONARY" //TODO 24
+	}
+|	_AS '(' Expression ')' ColumnDef111
+	{
+		$$ = []ColumnDef11{"AS", "(", $3, ")", $5} //TODO 25
+	}
+
+ColumnDef111:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 26
+	}
+|	ColumnDef1111
+	{
+		$$ = $1 //TODO 27
+	}
+
+ColumnDef1111:
+	_STORED
+	{
+		$$ = "STORED" //TODO 28
+	}
+|	_VIRTUAL
+	{
+		$$ = "VIRTUAL" //TODO 29
+	}
+
+ColumnDef2:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 30
+	}
+|	_NOT _NULL
+	{
+		$$ = []ColumnDef2{"NOT", "NULL"} //TODO 31
+	}
+
+ColumnDef3:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 32
+	}
+|	Comment
+	{
+		$$ = $1 //TODO 33
 	}
 
 ColumnName:
 	_IDENTIFIER
 	{
-		$$ = $1 //TODO 15
+		$$ = $1 //TODO 34
 	}
 
 ColumnNameList:
 	ColumnName ColumnNameList1 ColumnNameList2
 	{
-		$$ = []ColumnNameList{$1, $2, $3} //TODO 16
+		$$ = []ColumnNameList{$1, $2, $3} //TODO 35
 	}
 
 ColumnNameList1:
 	/* EMPTY */
 	{
-		$$ = []ColumnNameList1(nil) //TODO 17
+		$$ = []ColumnNameList1(nil) //TODO 36
 	}
 |	ColumnNameList1 ',' ColumnName
 	{
-		$$ = append($1.([]ColumnNameList1), ",", $3) //TODO 18
+		$$ = append($1.([]ColumnNameList1), ",", $3) //TODO 37
 	}
 
 ColumnNameList2:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 19
+		$$ = nil //TODO 38
 	}
 |	','
 	{
-		$$ = "," //TODO 20
+		$$ = "," //TODO 39
+	}
+
+Comment:
+	_COMMENT _STRING_LIT
+	{
+		$$ = []Comment{"COMMENT", $2} //TODO 40
 	}
 
 CommitStmt:
 	_COMMIT
 	{
-		$$ = "COMMIT" //TODO 21
+		$$ = "COMMIT" //TODO 41
 	}
 
 Conversion:
-	Type '(' Expression ')'
+	Type '(' Conversion1 ')'
+	{
+		$$ = []Conversion{$1, "(", $3, ")"} //TODO 42
+	}
+
+Conversion1:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 43
+	}
+|	ExpressionList
 	{
-		$$ = []Conversion{$1, "(", $3, ")"} //TODO 22
+		$$ = $1 //TODO 44
 	}
 
 CreateIndexStmt:
 	_CREATE CreateIndexStmt1 _INDEX CreateIndexStmt2 IndexName _ON TableName '(' CreateIndexStmt3 ')'
 	{
-		$$ = []CreateIndexStmt{"CREATE", $2, "INDEX", $4, $5, "ON", $7, "(", $9, ")"} //TODO 23
+		$$ = []CreateIndexStmt{"CREATE", $2, "INDEX", $4, $5, "ON", $7, "(", $9, ")"} //TODO 45
 	}
 
 CreateIndexStmt1:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 24
+		$$ = nil //TODO 46
 	}
-|	_UNIQUE
+|	CreateIndexStmt11
 	{
-		$$ = "UNIQUE" //TODO 25
+		$$ = $1 //TODO 47
+	}
+
+CreateIndexStmt11:
+	_UNIQUE
+	{
+		$$ = "UNIQUE" //TODO 48
+	}
+|	_FULLTEXT
+	{
+		$$ = "FULLTEXT" //TODO 49
 	}
 
 CreateIndexStmt2:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 26
+		$$ = nil //TODO 50
 	}
 |	_IF _NOT _EXISTS
 	{
-		$$ = []CreateIndexStmt2{"IF", "NOT", "EXISTS"} //TODO 27
+		$$ = []CreateIndexStmt2{"IF", "NOT", "EXISTS"} //TODO 51
 	}
 
 CreateIndexStmt3:
 	ColumnName
 	{
-		$$ = $1 //TODO 28
+		$$ = $1 //TODO 52
 	}
 |	_ID Call
 	{
-		$$ = []CreateIndexStmt3{"id", $2} //TODO 29
+		$$ = []CreateIndexStmt3{"id", $2} //TODO 53
 	}
 
 CreateTableStmt:
-	_CREATE _TABLE CreateTableStmt1 TableName '(' ColumnDef CreateTableStmt2 CreateTableStmt3 ')'
+	_CREATE _TABLE CreateTableStmt1 TableName '(' ColumnDef CreateTableStmt2 CreateTableStmt3 ')' CreateTableStmt4 CreateTableStmt5 CreateTableStmt6
 	{
-		$$ = []CreateTableStmt{"CREATE", "TABLE", $3, $4, "(", $6, $7, $8, ")"} //TODO 30
+		$$ = []CreateTableStmt{"CREATE", "TABLE", $3, $4, "(", $6, $7, $8, ")", $10, $11, $12} //TODO 54
 	}
 
 CreateTableStmt1:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 31
+		$$ = nil //TODO 55
 	}
 |	_IF _NOT _EXISTS
 	{
-		$$ = []CreateTableStmt1{"IF", "NOT", "EXISTS"} //TODO 32
+		$$ = []CreateTableStmt1{"IF", "NOT", "EXISTS"} //TODO 56
 	}
 
 CreateTableStmt2:
 	/* EMPTY */
 	{
-		$$ = []CreateTableStmt2(nil) //TODO 33
+		$$ = []CreateTableStmt2(nil) //TODO 57
 	}
 |	CreateTableStmt2 ',' ColumnDef
 	{
-		$$ = append($1.([]CreateTableStmt2), ",", $3) //TODO 34
+		$$ = append($1.([]CreateTableStmt2), ",", $3) //TODO 58
 	}
 
 CreateTableStmt3:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 35
+		$$ = nil //TODO 59
+	}
+|	',' CreateTableStmt31
+	{
+		$$ = []CreateTableStmt3{",", $2} //TODO 60
+	}
+
+CreateTableStmt31:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 61
+	}
+|	PrimaryKey CreateTableStmt311
+	{
+		$$ = []CreateTableStmt31{$1, $2} //TODO 62
+	}
+
+CreateTableStmt311:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 63
 	}
 |	','
 	{
-		$$ = "," //TODO 36
+		$$ = "," //TODO 64
+	}
+
+CreateTableStmt4:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 65
+	}
+|	_WITHOUT _ROWID
+	{
+		$$ = []CreateTableStmt4{"WITHOUT", "ROWID"} //TODO 66
+	}
+
+CreateTableStmt5:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 67
+	}
+|	PartitionBy
+	{
+		$$ = $1 //TODO 68
+	}
+
+CreateTableStmt6:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 69
+	}
+|	Comment
+	{
+		$$ = $1 //TODO 70
+	}
+
+DatabaseName:
+	_IDENTIFIER
+	{
+		$$ = $1 //TODO 71
 	}
 
 DeleteFromStmt:
-	_DELETE _FROM TableName DeleteFromStmt1
+	_DELETE _FROM TableName DeleteFromStmt1 DeleteFromStmt2
 	{
-		$$ = []DeleteFromStmt{"DELETE", "FROM", $3, $4} //TODO 37
+		$$ = []DeleteFromStmt{"DELETE", "FROM", $3, $4, $5} //TODO 72
 	}
 
 DeleteFromStmt1:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 38
+		$$ = nil //TODO 73
 	}
 |	WhereClause
 	{
-		$$ = $1 //TODO 39
+		$$ = $1 //TODO 74
+	}
+
+DeleteFromStmt2:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 75
+	}
+|	Returning
+	{
+		$$ = $1 //TODO 76
+	}
+
+DetachStmt:
+	_DETACH _DATABASE DatabaseName
+	{
+		$$ = []DetachStmt{"DETACH", "DATABASE", $3} //TODO 77
 	}
 
 DropIndexStmt:
 	_DROP _INDEX DropIndexStmt1 IndexName
 	{
-		$$ = []DropIndexStmt{"DROP", "INDEX", $3, $4} //TODO 40
+		$$ = []DropIndexStmt{"DROP", "INDEX", $3, $4} //TODO 78
 	}
 
 DropIndexStmt1:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 41
+		$$ = nil //TODO 79
 	}
 |	_IF _EXISTS
 	{
-		$$ = []DropIndexStmt1{"IF", "EXISTS"} //TODO 42
+		$$ = []DropIndexStmt1{"IF", "EXISTS"} //TODO 80
 	}
 
 DropTableStmt:
 	_DROP _TABLE DropTableStmt1 TableName
 	{
-		$$ = []DropTableStmt{"DROP", "TABLE", $3, $4} //TODO 43
+		$$ = []DropTableStmt{"DROP", "TABLE", $3, $4} //TODO 81
 	}
 
 DropTableStmt1:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 44
+		$$ = nil //TODO 82
 	}
 |	_IF _EXISTS
 	{
-		$$ = []DropTableStmt1{"IF", "EXISTS"} //TODO 45
+		$$ = []DropTableStmt1{"IF", "EXISTS"} //TODO 83
 	}
 
 EmptyStmt:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 46
+		$$ = nil //TODO 84
 	}
 
 Expression:
 	Term Expression1
 	{
-		$$ = []Expression{$1, $2} //TODO 47
+		$$ = []Expression{$1, $2} //TODO 85
 	}
 
 Expression1:
 	/* EMPTY */
 	{
-		$$ = []Expression1(nil) //TODO 48
+		$$ = []Expression1(nil) //TODO 86
 	}
 |	Expression1 Expression11 Term
 	{
-		$$ = append($1.([]Expression1), $2, $3) //TODO 49
+		$$ = append($1.([]Expression1), $2, $3) //TODO 87
 	}
 
 Expression11:
 	_OROR
 	{
-		$$ = $1 //TODO 50
+		$$ = $1 //TODO 88
 	}
 |	_OR
 	{
-		$$ = "OR" //TODO 51
+		$$ = "OR" //TODO 89
 	}
 
 ExpressionList:
 	Expression ExpressionList1 ExpressionList2
 	{
-		$$ = []ExpressionList{$1, $2, $3} //TODO 52
+		$$ = []ExpressionList{$1, $2, $3} //TODO 90
 	}
 
 ExpressionList1:
 	/* EMPTY */
 	{
-		$$ = []ExpressionList1(nil) //TODO 53
+		$$ = []ExpressionList1(nil) //TODO 91
 	}
 |	ExpressionList1 ',' Expression
 	{
-		$$ = append($1.([]ExpressionList1), ",", $3) //TODO 54
+		$$ = append($1.([]ExpressionList1), ",", $3) //TODO 92
 	}
 
 ExpressionList2:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 55
+		$$ = nil //TODO 93
 	}
 |	','
 	{
-		$$ = "," //TODO 56
+		$$ = "," //TODO 94
 	}
 
 Factor:
 	PrimaryFactor Factor1 Factor2
 	{
-		$$ = []Factor{$1, $2, $3} //TODO 57
+		$$ = []Factor{$1, $2, $3} //TODO 95
+	}
+|	Factor3 _EXISTS '(' SelectStmt Factor4 ')'
+	{
+		$$ = []Factor{$1, "EXISTS", "(", $4, $5, ")"} //TODO 96
 	}
 
 Factor1:
 	/* EMPTY */
 	{
-		$$ = []Factor1(nil) //TODO 58
+		$$ = []Factor1(nil) //TODO 97
 	}
-|	Factor1 Factor11 PrimaryFactor
+|	Factor1 Factor11
 	{
-		$$ = append($1.([]Factor1), $2, $3) //TODO 59
+		$$ = append($1.([]Factor1), $2) //TODO 98
 	}
 
 Factor11:
+	Factor111 PrimaryFactor
+	{
+		$$ = []Factor11{$1, $2} //TODO 99
+	}
+|	Factor112 PrimaryFactor Factor113
+	{
+		$$ = []Factor11{$1, $2, $3} //TODO 100
+	}
+
+Factor111:
 	_GE
 	{
-		$$ = $1 //TODO 60
+		$$ = $1 //TODO 101
 	}
 |	'>'
 	{
-		$$ = ">" //TODO 61
+		$$ = ">" //TODO 102
 	}
 |	_LE
 	{
-		$$ = $1 //TODO 62
+		$$ = $1 //TODO 103
 	}
 |	'<'
 	{
-		$$ = "<" //TODO 63
+		$$ = "<" //TODO 104
 	}
 |	_NEQ
 	{
-		$$ = $1 //TODO 64
+		$$ = $1 //TODO 105
 	}
 |	_EQ
 	{
-		$$ = $1 //TODO 65
+		$$ = $1 //TODO 106
 	}
-|	_LIKE
+|	_MATCH
 	{
-		$$ = "LIKE" //TODO 66
+		$$ = "MATCH" //TODO 107
+	}
+
+Factor112:
+	_LIKE
+	{
+		$$ = "LIKE" //TODO 108
+	}
+|	_ILIKE
+	{
+		$$ = "ILIKE" //TODO 109
+	}
+
+Factor113:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 110
+	}
+|	_ESCAPE PrimaryFactor
+	{
+		$$ = []Factor113{"ESCAPE", $2} //TODO 111
 	}
 
 Factor2:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 67
+		$$ = nil //TODO 112
 	}
 |	Predicate
 	{
-		$$ = $1 //TODO 68
+		$$ = $1 //TODO 113
+	}
+
+Factor3:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 114
+	}
+|	_NOT
+	{
+		$$ = "NOT" //TODO 115
+	}
+
+Factor4:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 116
+	}
+|	';'
+	{
+		$$ = ";" //TODO 117
 	}
 
 Field:
 	Expression Field1
 	{
-		$$ = []Field{$1, $2} //TODO 69
+		$$ = []Field{$1, $2} //TODO 118
 	}
 
 Field1:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 70
+		$$ = nil //TODO 119
 	}
 |	_AS _IDENTIFIER
 	{
-		$$ = []Field1{"AS", $2} //TODO 71
+		$$ = []Field1{"AS", $2} //TODO 120
 	}
 
 FieldList:
 	Field FieldList1 FieldList2
 	{
-		$$ = []FieldList{$1, $2, $3} //TODO 72
+		$$ = []FieldList{$1, $2, $3} //TODO 121
 	}
 
 FieldList1:
 	/* EMPTY */
 	{
-		$$ = []FieldList1(nil) //TODO 73
+		$$ = []FieldList1(nil) //TODO 122
 	}
 |	FieldList1 ',' Field
 	{
-		$$ = append($1.([]FieldList1), ",", $3) //TODO 74
+		$$ = append($1.([]FieldList1), ",", $3) //TODO 123
 	}
 
 FieldList2:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 75
+		$$ = nil //TODO 124
 	}
 |	','
 	{
-		$$ = "," //TODO 76
+		$$ = "," //TODO 125
 	}
 
 GroupByClause:
 	_GROUPBY ColumnNameList
 	{
-		$$ = []GroupByClause{"GROUP BY", $2} //TODO 77
+		$$ = []GroupByClause{"GROUP BY", $2} //TODO 126
 	}
 
 Index:
 	'[' Expression ']'
 	{
-		$$ = []Index{"[", $2, "]"} //TODO 78
+		$$ = []Index{"[", $2, "]"} //TODO 127
 	}
 
 IndexName:
 	_IDENTIFIER
 	{
-		$$ = $1 //TODO 79
+		$$ = $1 //TODO 128
 	}
 
 InsertIntoStmt:
-	_INSERT _INTO TableName InsertIntoStmt1 InsertIntoStmt2
+	_INSERT InsertIntoStmt1 _INTO TableName InsertIntoStmt2 InsertIntoStmt3 InsertIntoStmt4
 	{
-		$$ = []InsertIntoStmt{"INSERT", "INTO", $3, $4, $5} //TODO 80
+		$$ = []InsertIntoStmt{"INSERT", $2, "INTO", $4, $5, $6, $7} //TODO 129
 	}
 
 InsertIntoStmt1:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 81
+		$$ = nil //TODO 130
 	}
-|	'(' ColumnNameList ')'
+|	_OR InsertIntoStmt11
+	{
+		$$ = []InsertIntoStmt1{"OR", $2} //TODO 131
+	}
+
+InsertIntoStmt11:
+	_IGNORE
 	{
-		$$ = []InsertIntoStmt1{"(", $2, ")"} //TODO 82
+		$$ = "IGNORE" //TODO 132
+	}
+|	_REPLACE
+	{
+		$$ = "REPLACE" //TODO 133
 	}
 
 InsertIntoStmt2:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 134
+	}
+|	'(' ColumnNameList ')'
+	{
+		$$ = []InsertIntoStmt2{"(", $2, ")"} //TODO 135
+	}
+
+InsertIntoStmt3:
 	Values
 	{
-		$$ = $1 //TODO 83
+		$$ = $1 //TODO 136
 	}
 |	SelectStmt
 	{
-		$$ = $1 //TODO 84
+		$$ = $1 //TODO 137
+	}
+
+InsertIntoStmt4:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 138
+	}
+|	OnConflict
+	{
+		$$ = $1 //TODO 139
 	}
 
 Limit:
 	_LIMIT Expression
 	{
-		$$ = []Limit{"Limit", $2} //TODO 85
+		$$ = []Limit{"Limit", $2} //TODO 140
 	}
 
 Literal:
 	_FALSE
 	{
-		$$ = "FALSE" //TODO 86
+		$$ = "FALSE" //TODO 141
 	}
 |	_NULL
 	{
-		$$ = "NULL" //TODO 87
+		$$ = "NULL" //TODO 142
 	}
 |	_TRUE
 	{
-		$$ = "TRUE" //TODO 88
+		$$ = "TRUE" //TODO 143
+	}
+|	_BLOB_LIT
+	{
+		$$ = $1 //TODO 144
 	}
 |	_FLOAT_LIT
 	{
-		$$ = $1 //TODO 89
+		$$ = $1 //TODO 145
 	}
 |	_IMAGINARY_LIT
 	{
-		$$ = $1 //TODO 90
+		$$ = $1 //TODO 146
 	}
 |	_INT_LIT
 	{
-		$$ = $1 //TODO 91
+		$$ = $1 //TODO 147
 	}
 |	_RUNE_LIT
 	{
-		$$ = $1 //TODO 92
+		$$ = $1 //TODO 148
 	}
 |	_STRING_LIT
 	{
-		$$ = $1 //TODO 93
+		$$ = $1 //TODO 149
 	}
 |	_QL_PARAMETER
 	{
-		$$ = $1 //TODO 94
+		$$ = $1 //TODO 150
 	}
 
 Offset:
 	_OFFSET Expression
 	{
-		$$ = []Offset{"OFFSET", $2} //TODO 95
+		$$ = []Offset{"OFFSET", $2} //TODO 151
+	}
+
+OnConflict:
+	_ON _CONFLICT '(' ColumnNameList ')' _DO _UPDATE OnConflict1 AssignmentList OnConflict2
+	{
+		$$ = []OnConflict{"ON", "CONFLICT", "(", $4, ")", "DO", "UPDATE", $8, $9, $10} //TODO 152
+	}
+
+OnConflict1:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 153
+	}
+|	_SET
+	{
+		$$ = "SET" //TODO 154
+	}
+
+OnConflict2:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 155
+	}
+|	WhereClause
+	{
+		$$ = $1 //TODO 156
 	}
 
 Operand:
 	Literal
 	{
-		$$ = $1 //TODO 96
+		$$ = $1 //TODO 157
 	}
 |	QualifiedIdent
 	{
-		$$ = $1 //TODO 97
+		$$ = $1 //TODO 158
 	}
 |	'(' Expression ')'
 	{
-		$$ = []Operand{"(", $2, ")"} //TODO 98
+		$$ = []Operand{"(", $2, ")"} //TODO 159
+	}
+|	'(' SelectStmt Operand1 ')'
+	{
+		$$ = []Operand{"(", $2, $3, ")"} //TODO 160
+	}
+
+Operand1:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 161
+	}
+|	';'
+	{
+		$$ = ";" //TODO 162
 	}
 
 OrderBy:
 	_ORDER _BY ExpressionList OrderBy1
 	{
-		$$ = []OrderBy{"ORDER", "BY", $3, $4} //TODO 99
+		$$ = []OrderBy{"ORDER", "BY", $3, $4} //TODO 163
 	}
 
 OrderBy1:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 100
+		$$ = nil //TODO 164
 	}
 |	OrderBy11
 	{
-		$$ = $1 //TODO 101
+		$$ = $1 //TODO 165
 	}
 
 OrderBy11:
 	_ASC
 	{
-		$$ = "ASC" //TODO 102
+		$$ = "ASC" //TODO 166
 	}
 |	_DESC
 	{
-		$$ = "DESC" //TODO 103
+		$$ = "DESC" //TODO 167
+	}
+
+PartitionBy:
+	_PARTITION _BY PartitionBy1
+	{
+		$$ = []PartitionBy{"PARTITION", "BY", $3} //TODO 168
+	}
+
+PartitionBy1:
+	_RANGE '(' ColumnName ')'
+	{
+		$$ = []PartitionBy1{"RANGE", "(", $3, ")"} //TODO 169
+	}
+|	_HASH '(' ColumnName ')' _PARTITIONS _INT_LIT
+	{
+		$$ = []PartitionBy1{"HASH", "(", $3, ")", "PARTITIONS", $6} //TODO 170
+	}
+
+PartitionName:
+	_IDENTIFIER
+	{
+		$$ = $1 //TODO 171
+	}
+
+PragmaStmt:
+	_PRAGMA _IDENTIFIER PragmaStmt1
+	{
+		$$ = []PragmaStmt{"PRAGMA", $2, $3} //TODO 172
+	}
+
+PragmaStmt1:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 173
+	}
+|	'=' Expression
+	{
+		$$ = []PragmaStmt1{"=", $2} //TODO 174
 	}
 
 Predicate:
 	Predicate1
 	{
-		$$ = $1 //TODO 104
+		$$ = $1 //TODO 175
 	}
 
 Predicate1:
 	Predicate11 Predicate12
 	{
-		$$ = []Predicate1{$1, $2} //TODO 105
+		$$ = []Predicate1{$1, $2} //TODO 176
 	}
 |	_IS Predicate13 _NULL
 	{
-		$$ = []Predicate1{"IS", $2, "NULL"} //TODO 106
+		$$ = []Predicate1{"IS", $2, "NULL"} //TODO 177
+	}
+|	_IS Predicate14 _DISTINCT _FROM PrimaryFactor
+	{
+		$$ = []Predicate1{"IS", $2, "DISTINCT", "FROM", $5} //TODO 178
 	}
 
 Predicate11:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 107
+		$$ = nil //TODO 179
 	}
 |	_NOT
 	{
-		$$ = "NOT" //TODO 108
+		$$ = "NOT" //TODO 180
 	}
 
 Predicate12:
 	_IN '(' ExpressionList ')'
 	{
-		$$ = []Predicate12{"IN", "(", $3, ")"} //TODO 109
+		$$ = []Predicate12{"IN", "(", $3, ")"} //TODO 181
+	}
+|	_IN '(' SelectStmt Predicate121 ')'
+	{
+		$$ = []Predicate12{"IN", "(", $3, $4, ")"} //TODO 182
+	}
+|	_IN Predicate122
+	{
+		$$ = []Predicate12{"IN", $2} //TODO 183
 	}
 |	_BETWEEN PrimaryFactor _AND PrimaryFactor
 	{
-		$$ = []Predicate12{"BETWEEN", $2, "AND", $4} //TODO 110
+		$$ = []Predicate12{"BETWEEN", $2, "AND", $4} //TODO 184
+	}
+
+Predicate121:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 185
+	}
+|	';'
+	{
+		$$ = ";" //TODO 186
+	}
+
+Predicate122:
+	QualifiedIdent
+	{
+		$$ = $1 //TODO 187
+	}
+|	_QL_PARAMETER
+	{
+		$$ = $1 //TODO 188
 	}
 
 Predicate13:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 111
+		$$ = nil //TODO 189
 	}
 |	_NOT
 	{
-		$$ = "NOT" //TODO 112
+		$$ = "NOT" //TODO 190
+	}
+
+Predicate14:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 191
+	}
+|	_NOT
+	{
+		$$ = "NOT" //TODO 192
 	}
 
 PrimaryExpression:
 	Operand
 	{
-		$$ = $1 //TODO 113
+		$$ = $1 //TODO 193
 	}
 |	Conversion
 	{
-		$$ = $1 //TODO 114
+		$$ = $1 //TODO 194
+	}
+|	Cast
+	{
+		$$ = $1 //TODO 195
 	}
 |	PrimaryExpression Index
 	{
-		$$ = []PrimaryExpression{$1, $2} //TODO 115
+		$$ = []PrimaryExpression{$1, $2} //TODO 196
 	}
 |	PrimaryExpression Slice
 	{
-		$$ = []PrimaryExpression{$1, $2} //TODO 116
+		$$ = []PrimaryExpression{$1, $2} //TODO 197
 	}
 |	PrimaryExpression Call
 	{
-		$$ = []PrimaryExpression{$1, $2} //TODO 117
+		$$ = []PrimaryExpression{$1, $2} //TODO 198
+	}
+|	PrimaryExpression _DCOLON Type
+	{
+		$$ = []PrimaryExpression{$1, $2, $3} //TODO 199
+	}
+|	PrimaryExpression _COLLATE _IDENTIFIER
+	{
+		$$ = []PrimaryExpression{$1, "COLLATE", $3} //TODO 200
 	}
 
 PrimaryFactor:
 	PrimaryTerm PrimaryFactor1
 	{
-		$$ = []PrimaryFactor{$1, $2} //TODO 118
+		$$ = []PrimaryFactor{$1, $2} //TODO 201
 	}
 
 PrimaryFactor1:
 	/* EMPTY */
 	{
-		$$ = []PrimaryFactor1(nil) //TODO 119
+		$$ = []PrimaryFactor1(nil) //TODO 202
 	}
 |	PrimaryFactor1 PrimaryFactor11 PrimaryTerm
 	{
-		$$ = append($1.([]PrimaryFactor1), $2, $3) //TODO 120
+		$$ = append($1.([]PrimaryFactor1), $2, $3) //TODO 203
 	}
 
 PrimaryFactor11:
 	'^'
 	{
-		$$ = "^" //TODO 121
+		$$ = "^" //TODO 204
 	}
 |	'|'
 	{
-		$$ = "|" //TODO 122
+		$$ = "|" //TODO 205
 	}
 |	'-'
 	{
-		$$ = "-" //TODO 123
+		$$ = "-" //TODO 206
 	}
 |	'+'
 	{
-		$$ = "+" //TODO 124
+		$$ = "+" //TODO 207
+	}
+
+PrimaryKey:
+	_PRIMARY _KEY '(' ColumnNameList ')'
+	{
+		$$ = []PrimaryKey{"PRIMARY", "KEY", "(", $4, ")"} //TODO 208
 	}
 
 PrimaryTerm:
 	UnaryExpr PrimaryTerm1
 	{
-		$$ = []PrimaryTerm{$1, $2} //TODO 125
+		$$ = []PrimaryTerm{$1, $2} //TODO 209
 	}
 
 PrimaryTerm1:
 	/* EMPTY */
 	{
-		$$ = []PrimaryTerm1(nil) //TODO 126
+		$$ = []PrimaryTerm1(nil) //TODO 210
 	}
 |	PrimaryTerm1 PrimaryTerm11 UnaryExpr
 	{
-		$$ = append($1.([]PrimaryTerm1), $2, $3) //TODO 127
+		$$ = append($1.([]PrimaryTerm1), $2, $3) //TODO 211
 	}
 
 PrimaryTerm11:
 	_ANDNOT
 	{
-		$$ = $1 //TODO 128
+		$$ = $1 //TODO 212
 	}
 |	'&'
 	{
-		$$ = "&" //TODO 129
+		$$ = "&" //TODO 213
 	}
 |	_LSH
 	{
-		$$ = $1 //TODO 130
+		$$ = $1 //TODO 214
 	}
 |	_RSH
 	{
-		$$ = $1 //TODO 131
+		$$ = $1 //TODO 215
 	}
 |	'%'
 	{
-		$$ = "%" //TODO 132
+		$$ = "%" //TODO 216
 	}
 |	'/'
 	{
-		$$ = "/" //TODO 133
+		$$ = "/" //TODO 217
 	}
 |	'*'
 	{
-		$$ = "*" //TODO 134
+		$$ = "*" //TODO 218
 	}
 
 QualifiedIdent:
 	_IDENTIFIER QualifiedIdent1
 	{
-		$$ = []QualifiedIdent{$1, $2} //TODO 135
+		$$ = []QualifiedIdent{$1, $2} //TODO 219
 	}
 
 QualifiedIdent1:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 136
+		$$ = nil //TODO 220
 	}
 |	'.' _IDENTIFIER
 	{
-		$$ = []QualifiedIdent1{".", $2} //TODO 137
+		$$ = []QualifiedIdent1{".", $2} //TODO 221
 	}
 
 RecordSet:
 	RecordSet1 RecordSet2
 	{
-		$$ = []RecordSet{$1, $2} //TODO 138
+		$$ = []RecordSet{$1, $2} //TODO 222
 	}
 
 RecordSet1:
-	TableName
+	RecordSet11 TableName RecordSet12
 	{
-		$$ = $1 //TODO 139
+		$$ = []RecordSet1{$1, $2, $3} //TODO 223
 	}
-|	'(' SelectStmt RecordSet11 ')'
+|	'(' SelectStmt RecordSet13 ')'
 	{
-		$$ = []RecordSet1{"(", $2, $3, ")"} //TODO 140
+		$$ = []RecordSet1{"(", $2, $3, ")"} //TODO 224
 	}
 
 RecordSet11:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 141
+		$$ = nil //TODO 225
+	}
+|	DatabaseName '.'
+	{
+		$$ = []RecordSet11{$1, "."} //TODO 226
+	}
+
+RecordSet12:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 227
+	}
+|	TableSample
+	{
+		$$ = $1 //TODO 228
+	}
+
+RecordSet13:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 229
 	}
 |	';'
 	{
-		$$ = ";" //TODO 142
+		$$ = ";" //TODO 230
 	}
 
 RecordSet2:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 143
+		$$ = nil //TODO 231
 	}
 |	_AS _IDENTIFIER
 	{
-		$$ = []RecordSet2{"AS", $2} //TODO 144
+		$$ = []RecordSet2{"AS", $2} //TODO 232
 	}
 
 RecordSetList:
 	RecordSet RecordSetList1 RecordSetList2
 	{
-		$$ = []RecordSetList{$1, $2, $3} //TODO 145
+		$$ = []RecordSetList{$1, $2, $3} //TODO 233
 	}
 
 RecordSetList1:
 	/* EMPTY */
 	{
-		$$ = []RecordSetList1(nil) //TODO 146
+		$$ = []RecordSetList1(nil) //TODO 234
 	}
 |	RecordSetList1 ',' RecordSet
 	{
-		$$ = append($1.([]RecordSetList1), ",", $3) //TODO 147
+		$$ = append($1.([]RecordSetList1), ",", $3) //TODO 235
 	}
 
 RecordSetList2:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 148
+		$$ = nil //TODO 236
 	}
 |	','
 	{
-		$$ = "," //TODO 149
+		$$ = "," //TODO 237
+	}
+
+ReindexStmt:
+	_REINDEX TableName
+	{
+		$$ = []ReindexStmt{"REINDEX", $2} //TODO 238
+	}
+
+Returning:
+	_RETURNING Returning1
+	{
+		$$ = []Returning{"RETURNING", $2} //TODO 239
+	}
+
+Returning1:
+	'*'
+	{
+		$$ = "*" //TODO 240
+	}
+|	FieldList
+	{
+		$$ = $1 //TODO 241
 	}
 
 RollbackStmt:
 	_ROLLBACK
 	{
-		$$ = "ROLLBACK" //TODO 150
+		$$ = "ROLLBACK" //TODO 242
 	}
 
 SelectStmt:
-	_SELECT SelectStmt1 SelectStmt2 _FROM RecordSetList SelectStmt3 SelectStmt4 SelectStmt5 SelectStmt6 SelectStmt7
+	_SELECT SelectStmt1 SelectStmt2 _FROM RecordSetList SelectStmt3 SelectStmt4 SelectStmt5 SelectStmt6 SelectStmt7 SelectStmt8
 	{
-		$$ = []SelectStmt{"SELECT", $2, $3, "FROM", $5, $6, $7, $8, $9, $10} //TODO 151
+		$$ = []SelectStmt{"SELECT", $2, $3, "FROM", $5, $6, $7, $8, $9, $10, $11} //TODO 243
 	}
 
 SelectStmt1:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 152
+		$$ = nil //TODO 244
 	}
 |	_DISTINCT
 	{
-		$$ = "DISTINCT" //TODO 153
+		$$ = "DISTINCT" //TODO 245
 	}
 
 SelectStmt2:
 	'*'
 	{
-		$$ = "*" //TODO 154
+		$$ = "*" //TODO 246
 	}
 |	FieldList
 	{
-		$$ = $1 //TODO 155
+		$$ = $1 //TODO 247
 	}
 
 SelectStmt3:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 156
+		$$ = nil //TODO 248
 	}
 |	WhereClause
 	{
-		$$ = $1 //TODO 157
+		$$ = $1 //TODO 249
 	}
 
 SelectStmt4:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 158
+		$$ = nil //TODO 250
 	}
 |	GroupByClause
 	{
-		$$ = $1 //TODO 159
+		$$ = $1 //TODO 251
 	}
 
 SelectStmt5:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 160
+		$$ = nil //TODO 252
 	}
 |	OrderBy
 	{
-		$$ = $1 //TODO 161
+		$$ = $1 //TODO 253
 	}
 
 SelectStmt6:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 162
+		$$ = nil //TODO 254
 	}
 |	Limit
 	{
-		$$ = $1 //TODO 163
+		$$ = $1 //TODO 255
 	}
 
 SelectStmt7:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 164
+		$$ = nil //TODO 256
 	}
 |	Offset
 	{
-		$$ = $1 //TODO 165
+		$$ = $1 //TODO 257
+	}
+
+SelectStmt8:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 258
+	}
+|	_FOR _UPDATE
+	{
+		$$ = []SelectStmt8{"FOR", "UPDATE"} //TODO 259
 	}
 
 Slice:
 	'[' Slice1 ':' Slice2 ']'
 	{
-		$$ = []Slice{"[", $2, ":", $4, "]"} //TODO 166
+		$$ = []Slice{"[", $2, ":", $4, "]"} //TODO 260
 	}
 
 Slice1:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 167
+		$$ = nil //TODO 261
 	}
 |	Expression
 	{
-		$$ = $1 //TODO 168
+		$$ = $1 //TODO 262
 	}
 
 Slice2:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 169
+		$$ = nil //TODO 263
 	}
 |	Expression
 	{
-		$$ = $1 //TODO 170
+		$$ = $1 //TODO 264
 	}
 
 Start:
 	StatementList
 	{
-		_parserResult = $1 //TODO 171
+		_parserResult = $1 //TODO 265
 	}
 
 Statement:
 	EmptyStmt
 	{
-		$$ = $1 //TODO 172
+		$$ = $1 //TODO 266
 	}
 |	AlterTableStmt
 	{
-		$$ = $1 //TODO 173
+		$$ = $1 //TODO 267
+	}
+|	AnalyzeStmt
+	{
+		$$ = $1 //TODO 268
+	}
+|	AttachStmt
+	{
+		$$ = $1 //TODO 269
 	}
 |	BeginTransactionStmt
 	{
-		$$ = $1 //TODO 174
+		$$ = $1 //TODO 270
 	}
 |	CommitStmt
 	{
-		$$ = $1 //TODO 175
+		$$ = $1 //TODO 271
 	}
 |	CreateIndexStmt
 	{
-		$$ = $1 //TODO 176
+		$$ = $1 //TODO 272
 	}
 |	CreateTableStmt
 	{
-		$$ = $1 //TODO 177
+		$$ = $1 //TODO 273
 	}
 |	DeleteFromStmt
 	{
-		$$ = $1 //TODO 178
+		$$ = $1 //TODO 274
+	}
+|	DetachStmt
+	{
+		$$ = $1 //TODO 275
 	}
 |	DropIndexStmt
 	{
-		$$ = $1 //TODO 179
+		$$ = $1 //TODO 276
 	}
 |	DropTableStmt
 	{
-		$$ = $1 //TODO 180
+		$$ = $1 //TODO 277
 	}
 |	InsertIntoStmt
 	{
-		$$ = $1 //TODO 181
+		$$ = $1 //TODO 278
+	}
+|	PragmaStmt
+	{
+		$$ = $1 //TODO 279
+	}
+|	ReindexStmt
+	{
+		$$ = $1 //TODO 280
 	}
 |	RollbackStmt
 	{
-		$$ = $1 //TODO 182
+		$$ = $1 //TODO 281
 	}
 |	SelectStmt
 	{
-		$$ = $1 //TODO 183
+		$$ = $1 //TODO 282
 	}
 |	TruncateTableStmt
 	{
-		$$ = $1 //TODO 184
+		$$ = $1 //TODO 283
 	}
 |	UpdateStmt
 	{
-		$$ = $1 //TODO 185
+		$$ = $1 //TODO 284
 	}
 
 StatementList:
 	Statement StatementList1
 	{
-		$$ = []StatementList{$1, $2} //TODO 186
+		$$ = []StatementList{$1, $2} //TODO 285
 	}
 
 StatementList1:
 	/* EMPTY */
 	{
-		$$ = []StatementList1(nil) //TODO 187
+		$$ = []StatementList1(nil) //TODO 286
 	}
 |	StatementList1 ';' Statement
 	{
-		$$ = append($1.([]StatementList1), ";", $3) //TODO 188
+		$$ = append($1.([]StatementList1), ";", $3) //TODO 287
 	}
 
 TableName:
 	_IDENTIFIER
 	{
-		$$ = $1 //TODO 189
+		$$ = $1 //TODO 288
+	}
+
+TableSample:
+	_TABLESAMPLE '(' Expression _PERCENT ')' TableSample1
+	{
+		$$ = []TableSample{"TABLESAMPLE", "(", $3, "PERCENT", ")", $6} //TODO 289
+	}
+
+TableSample1:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 290
+	}
+|	_REPEATABLE '(' Expression ')'
+	{
+		$$ = []TableSample1{"REPEATABLE", "(", $3, ")"} //TODO 291
 	}
 
 Term:
 	Factor Term1
 	{
-		$$ = []Term{$1, $2} //TODO 190
+		$$ = []Term{$1, $2} //TODO 292
 	}
 
 Term1:
 	/* EMPTY */
 	{
-		$$ = []Term1(nil) //TODO 191
+		$$ = []Term1(nil) //TODO 293
 	}
 |	Term1 Term11 Factor
 	{
-		$$ = append($1.([]Term1), $2, $3) //TODO 192
+		$$ = append($1.([]Term1), $2, $3) //TODO 294
 	}
 
 Term11:
 	_ANDAND
 	{
-		$$ = $1 //TODO 193
+		$$ = $1 //TODO 295
 	}
 |	_AND
 	{
-		$$ = "AND" //TODO 194
+		$$ = "AND" //TODO 296
 	}
 
 TruncateTableStmt:
 	_TRUNCATE _TABLE TableName
 	{
-		$$ = []TruncateTableStmt{"TRUNCATE", "TABLE", $3} //TODO 195
+		$$ = []TruncateTableStmt{"TRUNCATE", "TABLE", $3} //TODO 297
 	}
 
 Type:
-	_BIGINT
+	_ARRAY
 	{
-		$$ = "bigint" //TODO 196
+		$$ = "array" //TODO 298
+	}
+|	_BIGINT
+	{
+		$$ = "bigint" //TODO 299
 	}
 |	_BIGRAT
 	{
-		$$ = "bigrat" //TODO 197
+		$$ = "bigrat" //TODO 300
 	}
 |	_BLOB
 	{
-		$$ = "blob" //TODO 198
+		$$ = "blob" //TODO 301
 	}
 |	_BOOL
 	{
-		$$ = "bool" //TODO 199
+		$$ = "bool" //TODO 302
 	}
 |	_BYTE
 	{
-		$$ = "byte" //TODO 200
+		$$ = "byte" //TODO 303
 	}
 |	_COMPLEX128
 	{
-		$$ = "complex128" //TODO 201
+		$$ = "complex128" //TODO 304
 	}
 |	_COMPLEX64
 	{
-		$$ = "complex64" //TODO 202
+		$$ = "complex64" //TODO 305
 	}
 |	_DURATION
 	{
-		$$ = "duration" //TODO 203
+		$$ = "duration" //TODO 306
 	}
 |	_FLOAT
 	{
-		$$ = "float" //TODO 204
+		$$ = "float" //TODO 307
 	}
 |	_FLOAT32
 	{
-		$$ = "float32" //TODO 205
+		$$ = "float32" //TODO 308
 	}
 |	_FLOAT64
 	{
-		$$ = "float64" //TODO 206
+		$$ = "float64" //TODO 309
 	}
 |	_INT
 	{
-		$$ = "int" //TODO 207
+		$$ = "int" //TODO 310
 	}
 |	_INT16
 	{
-		$$ = "int16" //TODO 208
+		$$ = "int16" //TODO 311
 	}
 |	_INT32
 	{
-		$$ = "int32" //TODO 209
+		$$ = "int32" //TODO 312
 	}
 |	_INT64
 	{
-		$$ = "int64" //TODO 210
+		$$ = "int64" //TODO 313
 	}
 |	_INT8
 	{
-		$$ = "int8" //TODO 211
+		$$ = "int8" //TODO 314
 	}
 |	_RUNE
 	{
-		$$ = "rune" //TODO 212
+		$$ = "rune" //TODO 315
 	}
 |	_STRING
 	{
-		$$ = "string" //TODO 213
+		$$ = "string" //TODO 316
 	}
 |	_TIME
 	{
-		$$ = "time" //TODO 214
+		$$ = "time" //TODO 317
 	}
 |	_UINT
 	{
-		$$ = "uint" //TODO 215
+		$$ = "uint" //TODO 318
 	}
 |	_UINT16
 	{
-		$$ = "uint16" //TODO 216
+		$$ = "uint16" //TODO 319
 	}
 |	_UINT32
 	{
-		$$ = "uint32" //TODO 217
+		$$ = "uint32" //TODO 320
 	}
 |	_UINT64
 	{
-		$$ = "uint64" //TODO 218
+		$$ = "uint64" //TODO 321
 	}
 |	_UINT8
 	{
-		$$ = "uint8" //TODO 219
+		$$ = "uint8" //TODO 322
 	}
 
 UnaryExpr:
 	UnaryExpr1 PrimaryExpression
 	{
-		$$ = []UnaryExpr{$1, $2} //TODO 220
+		$$ = []UnaryExpr{$1, $2} //TODO 323
 	}
 
 UnaryExpr1:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 221
+		$$ = nil //TODO 324
 	}
 |	UnaryExpr11
 	{
-		$$ = $1 //TODO 222
+		$$ = $1 //TODO 325
 	}
 
 UnaryExpr11:
 	'^'
 	{
-		$$ = "^" //TODO 223
+		$$ = "^" //TODO 326
 	}
 |	'!'
 	{
-		$$ = "!" //TODO 224
+		$$ = "!" //TODO 327
 	}
 |	'-'
 	{
-		$$ = "-" //TODO 225
+		$$ = "-" //TODO 328
 	}
 |	'+'
 	{
-		$$ = "+" //TODO 226
+		$$ = "+" //TODO 329
 	}
 
 UpdateStmt:
-	_UPDATE TableName UpdateStmt1 AssignmentList UpdateStmt2
+	_UPDATE TableName UpdateStmt1 AssignmentList UpdateStmt2 UpdateStmt3
 	{
-		$$ = []UpdateStmt{"UPDATE", $2, $3, $4, $5} //TODO 227
+		$$ = []UpdateStmt{"UPDATE", $2, $3, $4, $5, $6} //TODO 330
 	}
 
 UpdateStmt1:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 228
+		$$ = nil //TODO 331
 	}
 |	_SET
 	{
-		$$ = "SET" //TODO 229
+		$$ = "SET" //TODO 332
 	}
 
 UpdateStmt2:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 230
+		$$ = nil //TODO 333
 	}
 |	WhereClause
 	{
-		$$ = $1 //TODO 231
+		$$ = $1 //TODO 334
+	}
+
+UpdateStmt3:
+	/* EMPTY */
+	{
+		$$ = nil //TODO 335
+	}
+|	Returning
+	{
+		$$ = $1 //TODO 336
 	}
 
 Values:
 	_VALUES '(' ExpressionList ')' Values1 Values2
 	{
-		$$ = []Values{"VALUES", "(", $3, ")", $5, $6} //TODO 232
+		$$ = []Values{"VALUES", "(", $3, ")", $5, $6} //TODO 337
 	}
 
 Values1:
 	/* EMPTY */
 	{
-		$$ = []Values1(nil) //TODO 233
+		$$ = []Values1(nil) //TODO 338
 	}
 |	Values1 ',' '(' ExpressionList ')'
 	{
-		$$ = append($1.([]Values1), ",", "(", $4, ")") //TODO 234
+		$$ = append($1.([]Values1), ",", "(", $4, ")") //TODO 339
 	}
 
 Values2:
 	/* EMPTY */
 	{
-		$$ = nil //TODO 235
+		$$ = nil //TODO 340
 	}
 |	','
 	{
-		$$ = "," //TODO 236
+		$$ = "," //TODO 341
 	}
 
 WhereClause:
 	_WHERE Expression
 	{
-		$$ = []WhereClause{"WHERE", $2} //TODO 237
+		$$ = []WhereClause{"WHERE", $2} //TODO 342
 	}
 
 %%
@@ -1435,30 +2048,51 @@ var _parserResult interface{}
 type (
 	AlterTableStmt interface{}
 	AlterTableStmt1 interface{}
+	AnalyzeStmt interface{}
+	AnalyzeStmt1 interface{}
 	Assignment interface{}
 	AssignmentList interface{}
 	AssignmentList1 interface{}
 	AssignmentList2 interface{}
+	AttachStmt interface{}
 	BeginTransactionStmt interface{}
 	Call interface{}
 	Call1 interface{}
+	Cast interface{}
 	ColumnDef interface{}
+	ColumnDef1 interface{}
+	ColumnDef11 interface{}
+	ColumnDef111 interface{}
+	ColumnDef1111 interface{}
+	ColumnDef2 interface{}
+	ColumnDef3 interface{}
 	ColumnName interface{}
 	ColumnNameList interface{}
 	ColumnNameList1 interface{}
 	ColumnNameList2 interface{}
+	Comment interface{}
 	CommitStmt interface{}
 	Conversion interface{}
+	Conversion1 interface{}
 	CreateIndexStmt interface{}
 	CreateIndexStmt1 interface{}
+	CreateIndexStmt11 interface{}
 	CreateIndexStmt2 interface{}
 	CreateIndexStmt3 interface{}
 	CreateTableStmt interface{}
 	CreateTableStmt1 interface{}
 	CreateTableStmt2 interface{}
 	CreateTableStmt3 interface{}
+	CreateTableStmt31 interface{}
+	CreateTableStmt311 interface{}
+	CreateTableStmt4 interface{}
+	CreateTableStmt5 interface{}
+	CreateTableStmt6 interface{}
+	DatabaseName interface{}
 	DeleteFromStmt interface{}
 	DeleteFromStmt1 interface{}
+	DeleteFromStmt2 interface{}
+	DetachStmt interface{}
 	DropIndexStmt interface{}
 	DropIndexStmt1 interface{}
 	DropTableStmt interface{}
@@ -1473,7 +2107,12 @@ type (
 	Factor interface{}
 	Factor1 interface{}
 	Factor11 interface{}
+	Factor111 interface{}
+	Factor112 interface{}
+	Factor113 interface{}
 	Factor2 interface{}
+	Factor3 interface{}
+	Factor4 interface{}
 	Field interface{}
 	Field1 interface{}
 	FieldList interface{}
@@ -1484,23 +2123,39 @@ type (
 	IndexName interface{}
 	InsertIntoStmt interface{}
 	InsertIntoStmt1 interface{}
+	InsertIntoStmt11 interface{}
 	InsertIntoStmt2 interface{}
+	InsertIntoStmt3 interface{}
+	InsertIntoStmt4 interface{}
 	Limit interface{}
 	Literal interface{}
 	Offset interface{}
+	OnConflict interface{}
+	OnConflict1 interface{}
+	OnConflict2 interface{}
 	Operand interface{}
+	Operand1 interface{}
 	OrderBy interface{}
 	OrderBy1 interface{}
 	OrderBy11 interface{}
+	PartitionBy interface{}
+	PartitionBy1 interface{}
+	PartitionName interface{}
+	PragmaStmt interface{}
+	PragmaStmt1 interface{}
 	Predicate interface{}
 	Predicate1 interface{}
 	Predicate11 interface{}
 	Predicate12 interface{}
+	Predicate121 interface{}
+	Predicate122 interface{}
 	Predicate13 interface{}
+	Predicate14 interface{}
 	PrimaryExpression interface{}
 	PrimaryFactor interface{}
 	PrimaryFactor1 interface{}
 	PrimaryFactor11 interface{}
+	PrimaryKey interface{}
 	PrimaryTerm interface{}
 	PrimaryTerm1 interface{}
 	PrimaryTerm11 interface{}
@@ -1509,10 +2164,15 @@ type (
 	RecordSet interface{}
 	RecordSet1 interface{}
 	RecordSet11 interface{}
+	RecordSet12 interface{}
+	RecordSet13 interface{}
 	RecordSet2 interface{}
 	RecordSetList interface{}
 	RecordSetList1 interface{}
 	RecordSetList2 interface{}
+	ReindexStmt interface{}
+	Returning interface{}
+	Returning1 interface{}
 	RollbackStmt interface{}
 	SelectStmt interface{}
 	SelectStmt1 interface{}
@@ -1522,6 +2182,7 @@ type (
 	SelectStmt5 interface{}
 	SelectStmt6 interface{}
 	SelectStmt7 interface{}
+	SelectStmt8 interface{}
 	Slice interface{}
 	Slice1 interface{}
 	Slice2 interface{}
@@ -1530,6 +2191,8 @@ type (
 	StatementList interface{}
 	StatementList1 interface{}
 	TableName interface{}
+	TableSample interface{}
+	TableSample1 interface{}
 	Term interface{}
 	Term1 interface{}
 	Term11 interface{}
@@ -1541,6 +2204,7 @@ type (
 	UpdateStmt interface{}
 	UpdateStmt1 interface{}
 	UpdateStmt2 interface{}
+	UpdateStmt3 interface{}
 	Values interface{}
 	Values1 interface{}
 	Values2 interface{}
@@ -122,9 +122,17 @@ func do() (err error) {
 
 			a := []string{}
 			for _, ci := range ti.Columns {
-				a = append(a, fmt.Sprintf("%s %s", ci.Name, ci.Type))
+				s := fmt.Sprintf("%s %s", ci.Name, ci.Type)
+				if ci.Comment != "" {
+					s += fmt.Sprintf(" COMMENT %q", ci.Comment)
+				}
+				a = append(a, s)
 			}
-			r = append(r, fmt.Sprintf("CREATE TABLE %s (%s);", ti.Name, strings.Join(a, ", ")))
+			s := fmt.Sprintf("CREATE TABLE %s (%s)", ti.Name, strings.Join(a, ", "))
+			if ti.Comment != "" {
+				s += fmt.Sprintf(" COMMENT %q", ti.Comment)
+			}
+			r = append(r, s+";")
 		}
 		sort.Strings(r)
 		if len(r) != 0 {
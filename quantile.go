@@ -0,0 +1,126 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// quantileEpsilon is the rank error of approx_percentile relative to the
+// number of values.
+const quantileEpsilon = 0.001
+
+// gkTuple is a value kept by a gkSketch. The rank of v is at least the sum of
+// g of the tuples up to and including it and at most that sum plus delta.
+type gkTuple struct {
+	v     float64
+	g     int64
+	delta int64
+}
+
+// gkSketch is the Greenwald-Khanna summary of a sequence of values. It
+// answers quantile queries with a rank error of at most quantileEpsilon times
+// the number of values using space logarithmic in the number of values.
+type gkSketch struct {
+	n int64
+	s []gkTuple
+}
+
+func (k *gkSketch) insert(v float64) {
+	i := sort.Search(len(k.s), func(i int) bool { return k.s[i].v > v })
+	var delta int64
+	if i != 0 && i != len(k.s) {
+		delta = int64(2 * quantileEpsilon * float64(k.n))
+	}
+	k.s = append(k.s, gkTuple{})
+	copy(k.s[i+1:], k.s[i:])
+	k.s[i] = gkTuple{v, 1, delta}
+	k.n++
+	if k.n%int64(1/(2*quantileEpsilon)) == 0 {
+		k.compress()
+	}
+}
+
+// compress merges the adjacent tuples whose union still satisfies the error
+// bound.
+func (k *gkSketch) compress() {
+	max := int64(2 * quantileEpsilon * float64(k.n))
+	for i := len(k.s) - 2; i > 0; i-- {
+		if t, u := k.s[i], k.s[i+1]; t.g+u.g+u.delta <= max {
+			k.s[i+1].g += t.g
+			k.s = append(k.s[:i], k.s[i+1:]...)
+		}
+	}
+}
+
+// quantile returns a value whose rank is within quantileEpsilon*k.n of p*k.n.
+func (k *gkSketch) quantile(p float64) float64 {
+	r := math.Ceil(p * float64(k.n))
+	e := quantileEpsilon * float64(k.n)
+	var rmin int64
+	for i, t := range k.s {
+		rmin += t.g
+		if float64(rmin+t.delta) > r+e {
+			if i == 0 {
+				return t.v
+			}
+
+			return k.s[i-1].v
+		}
+	}
+	return k.s[len(k.s)-1].v
+}
+
+// percentileArgs returns the arguments of the percentile aggregate fn as
+// float64 values. The value x is NULL if ok is false.
+func percentileArgs(fn string, arg []interface{}) (x, p float64, ok bool, err error) {
+	switch y := arg[1].(type) {
+	case float32:
+		p = float64(y)
+	case float64:
+		p = y
+	case idealFloat:
+		p = float64(y)
+	case idealInt:
+		p = float64(y)
+	case int64:
+		p = float64(y)
+	default:
+		return 0, 0, false, fmt.Errorf("%s: invalid percentile %v (value of type %T)", fn, y, y)
+	}
+	if p < 0 || p > 1 {
+		return 0, 0, false, fmt.Errorf("%s: percentile %v out of range [0, 1]", fn, p)
+	}
+
+	switch y := arg[0].(type) {
+	case nil:
+		return 0, p, false, nil
+	case float32:
+		x = float64(y)
+	case float64:
+		x = y
+	case int8:
+		x = float64(y)
+	case int16:
+		x = float64(y)
+	case int32:
+		x = float64(y)
+	case int64:
+		x = float64(y)
+	case uint8:
+		x = float64(y)
+	case uint16:
+		x = float64(y)
+	case uint32:
+		x = float64(y)
+	case uint64:
+		x = float64(y)
+	default:
+		return 0, 0, false, fmt.Errorf("%s: cannot accept %v (value of type %T)", fn, y, y)
+	}
+	return x, p, true, nil
+}
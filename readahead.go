@@ -0,0 +1,95 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"io"
+	"sync"
+
+	"github.com/cznic/exp/lldb"
+)
+
+// readAheadFiler is a Filer reading ahead in windows of len(buf) bytes. A
+// read not within the current window reads a new window starting at the
+// offset of the read, or ending at its end if the read precedes the current
+// window, so that the following reads of a sequential scan in either
+// direction are served from memory. Writes invalidate the window.
+type readAheadFiler struct {
+	lldb.Filer
+	buf []byte
+	mu  sync.Mutex
+	n   int   // Valid bytes in buf.
+	off int64 // Offset of buf.
+}
+
+// newOSFiler returns a Filer of f, reading ahead readAhead bytes if
+// readAhead is positive and retrying transient errors up to maxRetries times
+// if maxRetries is positive. See Options.ReadAhead and Options.MaxRetries.
+func newOSFiler(f lldb.OSFile, readAhead, maxRetries int) lldb.Filer {
+	var filer lldb.Filer = lldb.NewOSFiler(f)
+	if maxRetries > 0 {
+		filer = &retryFiler{Filer: filer, max: maxRetries}
+	}
+	if readAhead <= 0 {
+		return filer
+	}
+
+	return &readAheadFiler{Filer: filer, buf: make([]byte, readAhead)}
+}
+
+func (f *readAheadFiler) ReadAt(b []byte, off int64) (n int, err error) {
+	if len(b) > len(f.buf) {
+		return f.Filer.ReadAt(b, off)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if off < f.off || off+int64(len(b)) > f.off+int64(f.n) {
+		start := off
+		if off < f.off { // Scanning backwards, like the record list of a table.
+			if start = off + int64(len(b)) - int64(len(f.buf)); start < 0 {
+				start = 0
+			}
+		}
+		f.n, err = f.Filer.ReadAt(f.buf, start)
+		if err != nil && err != io.EOF {
+			f.n = 0
+			return 0, err
+		}
+
+		f.off = start
+	}
+
+	if i := off - f.off; i < int64(f.n) {
+		n = copy(b, f.buf[i:f.n])
+	}
+	if n < len(b) {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
+
+// invalidate discards the window of f.
+func (f *readAheadFiler) invalidate() {
+	f.mu.Lock()
+	f.n = 0
+	f.mu.Unlock()
+}
+
+func (f *readAheadFiler) PunchHole(off, size int64) error {
+	f.invalidate()
+	return f.Filer.PunchHole(off, size)
+}
+
+func (f *readAheadFiler) Truncate(size int64) error {
+	f.invalidate()
+	return f.Filer.Truncate(size)
+}
+
+func (f *readAheadFiler) WriteAt(b []byte, off int64) (int, error) {
+	f.invalidate()
+	return f.Filer.WriteAt(b, off)
+}
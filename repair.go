@@ -0,0 +1,139 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+)
+
+// RepairReport describes the inconsistencies found, and possibly fixed, by
+// DB.Repair.
+type RepairReport struct {
+	// Dangling maps the names of the indices having entries which refer
+	// to no record of their table to the number of such entries. An
+	// index having no name, like the index of a primary key, is named by
+	// its table and column, for example "t(id())".
+	Dangling map[string]int64
+	Fixed    bool // The inconsistencies were repaired.
+}
+
+// Repair verifies the DB and, if fix is true, repairs the inconsistencies it
+// finds. It returns a report of them. Repair runs in its own transaction, so
+// it waits for any open transaction to end and the DB is either fully
+// repaired or left unchanged.
+//
+// Repair first verifies the allocator of the DB. A corrupted allocator cannot
+// be repaired and Repair returns an error in that case. Then it removes the
+// index entries referring to records which do not exist. Repair does not
+// free storage blocks which no table or index refers to, such blocks cannot
+// be enumerated.
+func (db *DB) Repair(fix bool) (r *RepairReport, err error) {
+	ctx := NewRWCtx()
+	if _, _, err = db.Execute(ctx, txBegin); err != nil {
+		return nil, err
+	}
+
+	end := txRollback
+	defer func() {
+		if _, _, e := db.Execute(ctx, end); e != nil && err == nil {
+			r, err = nil, e
+		}
+	}()
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if _, err = db.store.Verify(); err != nil {
+		return nil, fmt.Errorf("Repair: cannot repair a corrupted DB: %v", err)
+	}
+
+	r = &RepairReport{Dangling: map[string]int64{}}
+	for t := db.root.thead; t != nil; t = t.tnext {
+		if err = t.repair(r, fix); err != nil {
+			return nil, err
+		}
+	}
+	if fix {
+		end, r.Fixed = txCommit, true
+	}
+	return r, nil
+}
+
+// repair adds the dangling index entries of t to r and removes them if fix is
+// true.
+func (t *table) repair(r *RepairReport, fix bool) error {
+	if !t.hasIndices() {
+		return nil
+	}
+
+	live := map[int64]bool{}
+	for h := t.head; h != 0; {
+		rec, err := t.store.Read(nil, h)
+		if err != nil {
+			return err
+		}
+
+		live[h] = true
+		h = rec[0].(int64)
+	}
+
+	for i, v := range t.indices {
+		if v == nil {
+			continue
+		}
+
+		x := v.x
+		if f, ok := x.(fulltextIndex); ok {
+			x = f.btreeIndex // The keys are words, not column values.
+		}
+		dangling, err := danglingEntries(x, live)
+		if err != nil {
+			return err
+		}
+
+		if len(dangling) == 0 {
+			continue
+		}
+
+		nm := v.name
+		if nm == "" {
+			cn := "id()"
+			if i != 0 {
+				cn = t.cols0[i-1].name
+			}
+			nm = fmt.Sprintf("%s(%s)", t.name, cn)
+		}
+		r.Dangling[nm] += int64(len(dangling))
+		if !fix {
+			continue
+		}
+
+		for _, k := range dangling {
+			if err = x.Delete(k.value, k.h); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// danglingEntries returns the entries of x referring to a record handle not
+// in live.
+func danglingEntries(x btreeIndex, live map[int64]bool) (r []indexKey, err error) {
+	it, err := x.SeekFirst()
+	if err != nil {
+		return nil, noEOF(err)
+	}
+
+	for {
+		k, h, err := it.Next()
+		if err != nil {
+			return r, noEOF(err)
+		}
+
+		if !live[h] {
+			r = append(r, indexKey{k, h})
+		}
+	}
+}
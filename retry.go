@@ -0,0 +1,59 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cznic/exp/lldb"
+)
+
+// maxRetryBackoff is the longest delay between the attempts of retryFiler.
+const maxRetryBackoff = 100 * time.Millisecond
+
+// retryFiler is a Filer of the DB file retrying the reads and writes failing
+// with a transient error up to max times. See Options.MaxRetries.
+type retryFiler struct {
+	lldb.Filer
+	max int
+}
+
+// isTransient reports whether err, or an error it wraps, has a Temporary
+// method reporting true. An *os.File retries EINTR itself and does not report
+// EAGAIN for regular files, such errors come from an OSFile backed by a
+// network or another remote storage.
+func isTransient(err error) bool {
+	var t interface{ Temporary() bool }
+	return errors.As(err, &t) && t.Temporary()
+}
+
+// retry calls f until it succeeds, fails with an error other than a
+// transient one or f.max retries are exhausted. The delay between the
+// attempts starts at a millisecond and doubles up to maxRetryBackoff.
+func (f *retryFiler) retry(op func() (int, error)) (n int, err error) {
+	delay := time.Millisecond
+	for i := 0; ; i++ {
+		if n, err = op(); err == nil || i == f.max || !isTransient(err) {
+			return n, err
+		}
+
+		time.Sleep(delay)
+		if delay *= 2; delay > maxRetryBackoff {
+			delay = maxRetryBackoff
+		}
+	}
+}
+
+// ReadAt implements Filer. Reading is idempotent.
+func (f *retryFiler) ReadAt(b []byte, off int64) (int, error) {
+	return f.retry(func() (int, error) { return f.Filer.ReadAt(b, off) })
+}
+
+// WriteAt implements Filer. Writing the same bytes at the same offset again is
+// idempotent.
+func (f *retryFiler) WriteAt(b []byte, off int64) (int, error) {
+	return f.retry(func() (int, error) { return f.Filer.WriteAt(b, off) })
+}
@@ -0,0 +1,82 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+// returningRset is the record set of the RETURNING clause of UPDATE or
+// DELETE. It holds the rows of the table as they are after the update or
+// before the delete, the fields of the clause are evaluated by selectRset.
+type returningRset struct {
+	flds []*fld // The RETURNING clause, empty for "*".
+	cols []*fld // The columns of the table.
+	ids  []interface{}
+	rows [][]interface{}
+}
+
+// newReturningRset returns the record set of the RETURNING clause flds, or
+// nil if flds is nil.
+func newReturningRset(flds []*fld) *returningRset {
+	if flds == nil {
+		return nil
+	}
+
+	return &returningRset{flds: flds}
+}
+
+// init sets the columns of r to those of t, unless already set. It does
+// nothing if r is nil.
+func (r *returningRset) init(t *table) {
+	if r != nil && r.cols == nil {
+		r.cols = t.flds()
+	}
+}
+
+// add adds the record data of t, laid out as described at
+// insertIntoStmt.insert, to r. It does nothing if r is nil.
+func (r *returningRset) add(t *table, data []interface{}) error {
+	if r == nil {
+		return nil
+	}
+
+	row, err := t.row(data)
+	if err != nil {
+		return err
+	}
+
+	r.ids = append(r.ids, data[1])
+	r.rows = append(r.rows, row)
+	return nil
+}
+
+// recordset returns the Recordset of r or nil if r is nil.
+func (r *returningRset) recordset(ctx *execCtx) Recordset {
+	if r == nil {
+		return nil
+	}
+
+	return recordset{ctx, &selectRset{flds: r.flds, src: r}, nil}
+}
+
+func (r *returningRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	m, err := f(nil, []interface{}{r.cols})
+	if onlyNames || !m || err != nil {
+		return
+	}
+
+	for i, v := range r.rows {
+		if m, err = f(r.ids[i], v); !m || err != nil {
+			return
+		}
+	}
+	return
+}
+
+// returningString returns the RETURNING clause flds, if not nil.
+func returningString(flds []*fld) string {
+	if flds == nil {
+		return ""
+	}
+
+	return " RETURNING " + fieldsString(flds)
+}
@@ -0,0 +1,194 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Rows is a pull based iterator over the rows of a Recordset. The rows are
+// produced one at a time by the underlying Recordset.Do, so the memory used
+// does not grow with the number of rows, except for what the query itself
+// needs, for example to sort the rows for ORDER BY.
+//
+// While the iteration is in progress the DB is locked as by Recordset.Do.
+// Rows must be closed, unless Next has returned false, and the DB should not
+// be used by the goroutine iterating Rows before that, otherwise it may
+// deadlock.
+//
+//	rows, err := db.Query(nil, "SELECT name, age FROM person;")
+//	if err != nil {
+//		...
+//	}
+//
+//	defer rows.Close()
+//	for rows.Next() {
+//		var name string
+//		var age int64
+//		if err := rows.Scan(&name, &age); err != nil {
+//			...
+//		}
+//		...
+//	}
+//	if err := rows.Err(); err != nil {
+//		...
+//	}
+type Rows struct {
+	ack    chan struct{}
+	closed bool
+	cols   []string
+	err    error // Written by the producer before closing rows.
+	row    []interface{}
+	rows   chan []interface{}
+	stop   chan struct{}
+}
+
+// newRows returns a Rows iterating rs. It waits for rs to produce the names
+// of its fields.
+func newRows(rs Recordset) (*Rows, error) {
+	r := &Rows{
+		ack:  make(chan struct{}),
+		rows: make(chan []interface{}),
+		stop: make(chan struct{}),
+	}
+	go func() {
+		err := rs.Do(true, func(data []interface{}) (more bool, err error) {
+			select {
+			case r.rows <- data:
+			case <-r.stop:
+				return false, nil
+			}
+
+			select {
+			case <-r.ack:
+				return true, nil
+			case <-r.stop:
+				return false, nil
+			}
+		})
+		r.err = err
+		close(r.rows)
+	}()
+
+	names, ok := <-r.rows
+	if !ok {
+		r.closed = true
+		return nil, r.err
+	}
+
+	r.cols = make([]string, len(names))
+	for i, v := range names {
+		r.cols[i], _ = v.(string)
+	}
+	r.ack <- struct{}{}
+	return r, nil
+}
+
+// Query executes the statement list ql like DB.Run and returns a Rows
+// iterating the Recordset of its last statement producing one. It is an error
+// if no statement of ql produces a Recordset.
+func (db *DB) Query(ctx *TCtx, ql string, arg ...interface{}) (*Rows, error) {
+	rs, _, err := db.Run(ctx, ql, arg...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rs) == 0 {
+		return nil, fmt.Errorf("Query: no statement produces a recordset: %s", ql)
+	}
+
+	return newRows(rs[len(rs)-1])
+}
+
+// Columns returns the names of the fields of the rows.
+func (r *Rows) Columns() []string { return r.cols }
+
+// Next advances to the next row, which is then available to Row and Scan. It
+// returns false when there are no more rows or an error occurred, see Err.
+func (r *Rows) Next() bool {
+	if r.closed {
+		return false
+	}
+
+	if r.row != nil {
+		r.row = nil
+		r.ack <- struct{}{}
+	}
+
+	row, ok := <-r.rows
+	if !ok {
+		r.closed = true
+		return false
+	}
+
+	r.row = row
+	return true
+}
+
+// Row returns the current row. The row is valid only until the next call of
+// Next or Close.
+func (r *Rows) Row() []interface{} { return r.row }
+
+// Scan copies the values of the current row to dest, which must have one
+// element per field. Every element of dest must be a pointer to a variable to
+// which the value of the field is assignable or a *interface{}. A NULL value
+// can be scanned only to a *interface{}.
+func (r *Rows) Scan(dest ...interface{}) error {
+	if r.row == nil {
+		return fmt.Errorf("Scan called without a current row")
+	}
+
+	if g, e := len(dest), len(r.row); g != e {
+		return fmt.Errorf("Scan: have %d destination(s), need %d", g, e)
+	}
+
+	for i, v := range r.row {
+		if p, ok := dest[i].(*interface{}); ok {
+			*p = v
+			continue
+		}
+
+		d := reflect.ValueOf(dest[i])
+		if d.Kind() != reflect.Ptr || d.IsNil() {
+			return fmt.Errorf("Scan: destination %d is not a non nil pointer: %T", i, dest[i])
+		}
+
+		if v == nil {
+			return fmt.Errorf("Scan: cannot scan NULL field %s to %T", r.cols[i], dest[i])
+		}
+
+		s := reflect.ValueOf(v)
+		if !s.Type().AssignableTo(d.Elem().Type()) {
+			return fmt.Errorf("Scan: cannot scan field %s of type %T to %T", r.cols[i], v, dest[i])
+		}
+
+		d.Elem().Set(s)
+	}
+	return nil
+}
+
+// Err returns the error, if any, which ended the iteration.
+func (r *Rows) Err() error {
+	if !r.closed {
+		return nil
+	}
+
+	return r.err
+}
+
+// Close ends the iteration and releases the locks of the DB held by r. It is
+// safe to call Close more than once.
+func (r *Rows) Close() error {
+	if r.closed {
+		return r.err
+	}
+
+	r.closed, r.row = true, nil
+	close(r.stop)
+	for range r.rows {
+	}
+	return r.err
+}
@@ -0,0 +1,139 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// tableSample is a table having the TABLESAMPLE clause in the RecordSetList of
+// a SELECT statement.
+type tableSample struct {
+	table   string
+	percent expression
+	seed    expression // Nil if not REPEATABLE.
+}
+
+func (s *tableSample) String() string {
+	r := fmt.Sprintf("%s TABLESAMPLE (%s PERCENT)", quoteIdent(s.table), s.percent)
+	if s.seed != nil {
+		r += fmt.Sprintf(" REPEATABLE (%s)", s.seed)
+	}
+	return r
+}
+
+func (s *tableSample) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) error {
+	var sample func() bool
+	if !onlyNames {
+		var err error
+		if sample, err = s.sampler(ctx); err != nil {
+			return err
+		}
+	}
+
+	return tableRset(s.table).doSample(ctx, onlyNames, sample, f)
+}
+
+// sampler returns a function reporting whether to sample the next row.
+func (s *tableSample) sampler(ctx *execCtx) (func() bool, error) {
+	m := map[interface{}]interface{}{"$ctx": ctx}
+	v, err := expand1(s.percent.eval(m, ctx.arg))
+	if err != nil {
+		return nil, err
+	}
+
+	var p float64
+	switch x := v.(type) {
+	case idealFloat:
+		p = float64(x)
+	case idealInt:
+		p = float64(x)
+	case float64:
+		p = x
+	case int64:
+		p = float64(x)
+	default:
+		return nil, fmt.Errorf("TABLESAMPLE: invalid percentage %v (value of type %T)", x, x)
+	}
+	if p < 0 || p > 100 {
+		return nil, fmt.Errorf("TABLESAMPLE: percentage %v out of range [0, 100]", p)
+	}
+
+	p /= 100
+	if s.seed == nil {
+		r := &ctx.db.rng
+		return func() bool {
+			ok := r.lock().Float64() < p
+			r.mu.Unlock()
+			return ok
+		}, nil
+	}
+
+	if v, err = expand1(s.seed.eval(m, ctx.arg)); err != nil {
+		return nil, err
+	}
+
+	var seed int64
+	switch x := v.(type) {
+	case idealInt:
+		seed = int64(x)
+	case int64:
+		seed = x
+	default:
+		return nil, fmt.Errorf("TABLESAMPLE: invalid seed %v (value of type %T)", x, x)
+	}
+	rng := rand.New(rand.NewSource(seed))
+	return func() bool { return rng.Float64() < p }, nil
+}
+
+// doSampled passes the rows of t for which sample returns true to f. It walks
+// the index on id() or the primary key index, if any, and reads only the
+// sampled rows. Otherwise it walks the record list of t, which links the
+// records by their handles, so every record is read but only the sampled ones
+// are passed to f.
+func (r tableRset) doSampled(t *table, sample func() bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	var x *indexedCol
+	switch {
+	case t.withoutRowID:
+		x = t.indices[t.pkCol().index+1]
+	case t.hasIndices():
+		x = t.indices[0]
+	}
+	if x != nil {
+		en, err := x.x.SeekFirst()
+		if err != nil {
+			return noEOF(err)
+		}
+
+		for {
+			_, h, err := en.Next()
+			if err != nil {
+				return noEOF(err)
+			}
+
+			if !sample() {
+				continue
+			}
+
+			if h, err = r.doOne(t, h, f); err != nil || h < 0 {
+				return err
+			}
+		}
+	}
+
+	var rec []interface{}
+	for h := t.head; h > 0 && err == nil; {
+		if sample() {
+			h, err = r.doOne(t, h, f)
+			continue
+		}
+
+		if rec, err = t.store.Read(rec, h); err == nil {
+			h = rec[0].(int64)
+		}
+	}
+	return
+}
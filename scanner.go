@@ -12,9 +12,11 @@ automatically from 'scanner.l' - DO NOT EDIT in that case!
 package ql
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"unicode"
 )
 
@@ -30,6 +32,7 @@ type lexer struct {
 	ncol   int
 	nline  int
 	params int
+	quote  byte // Quote character of identifiers, if any.
 	sc     int
 	src    string
 	val    []byte
@@ -102,9 +105,9 @@ yystate0:
 	case 0: // start condition: INITIAL
 		goto yystart1
 	case 1: // start condition: S1
-		goto yystart291
+		goto yystart467
 	case 2: // start condition: S2
-		goto yystart296
+		goto yystart472
 	}
 
 	goto yystate0 // silence unused label error
@@ -114,7 +117,7 @@ yystate1:
 yystart1:
 	switch {
 	default:
-		goto yystate3 // c >= '\x01' && c <= '\b' || c == '\v' || c == '\f' || c >= '\x0e' && c <= '\x1f' || c == '#' || c == '%%' || c >= '(' && c <= ',' || c == ':' || c == ';' || c == '@' || c >= '[' && c <= '^' || c == '{' || c >= '}' && c <= 'ÿ'
+		goto yystate3 // c >= '\x01' && c <= '\b' || c == '\v' || c == '\f' || c >= '\x0e' && c <= '\x1f' || c == '#' || c == '%%' || c >= '(' && c <= ',' || c == ';' || c == '@' || c >= '[' && c <= '^' || c == '{' || c >= '}' && c <= 'ÿ'
 	case c == '!':
 		goto yystate6
 	case c == '"':
@@ -131,48 +134,60 @@ yystart1:
 		goto yystate27
 	case c == '0':
 		goto yystate32
-	case c == '<':
+	case c == ':':
 		goto yystate40
+	case c == '<':
+		goto yystate42
 	case c == '=':
-		goto yystate43
-	case c == '>':
 		goto yystate45
+	case c == '>':
+		goto yystate47
 	case c == 'A' || c == 'a':
-		goto yystate48
+		goto yystate50
 	case c == 'B' || c == 'b':
-		goto yystate60
+		goto yystate76
 	case c == 'C' || c == 'c':
-		goto yystate87
+		goto yystate103
 	case c == 'D' || c == 'd':
-		goto yystate111
+		goto yystate143
 	case c == 'E' || c == 'e':
-		goto yystate136
+		goto yystate188
 	case c == 'F' || c == 'f':
-		goto yystate142
+		goto yystate199
 	case c == 'G' || c == 'g':
-		goto yystate158
-	case c == 'H' || c == 'J' || c == 'K' || c == 'M' || c == 'P' || c == 'Q' || c >= 'X' && c <= 'Z' || c == '_' || c == 'h' || c == 'j' || c == 'k' || c == 'm' || c == 'p' || c == 'q' || c >= 'x' && c <= 'z':
-		goto yystate163
+		goto yystate224
+	case c == 'H' || c == 'h':
+		goto yystate229
 	case c == 'I' || c == 'i':
-		goto yystate164
+		goto yystate233
+	case c == 'J' || c == 'Q' || c == 'Y' || c == 'Z' || c == '_' || c == 'j' || c == 'q' || c == 'y' || c == 'z':
+		goto yystate262
+	case c == 'K' || c == 'k':
+		goto yystate263
 	case c == 'L' || c == 'l':
-		goto yystate184
+		goto yystate266
+	case c == 'M' || c == 'm':
+		goto yystate276
 	case c == 'N' || c == 'n':
-		goto yystate191
+		goto yystate281
 	case c == 'O' || c == 'o':
-		goto yystate197
+		goto yystate287
+	case c == 'P' || c == 'p':
+		goto yystate298
 	case c == 'R' || c == 'r':
-		goto yystate208
+		goto yystate324
 	case c == 'S' || c == 's':
-		goto yystate219
+		goto yystate367
 	case c == 'T' || c == 't':
-		goto yystate231
+		goto yystate383
 	case c == 'U' || c == 'u':
-		goto yystate256
+		goto yystate417
 	case c == 'V' || c == 'v':
-		goto yystate277
+		goto yystate438
 	case c == 'W' || c == 'w':
-		goto yystate283
+		goto yystate450
+	case c == 'X' || c == 'x':
+		goto yystate461
 	case c == '\'':
 		goto yystate14
 	case c == '\n':
@@ -182,9 +197,9 @@ yystart1:
 	case c == '\x00':
 		goto yystate2
 	case c == '`':
-		goto yystate288
+		goto yystate464
 	case c == '|':
-		goto yystate289
+		goto yystate465
 	case c >= '1' && c <= '9':
 		goto yystate38
 	}
@@ -195,7 +210,7 @@ yystate2:
 
 yystate3:
 	c = l.next()
-	goto yyrule94
+	goto yyrule132
 
 yystate4:
 	c = l.next()
@@ -219,24 +234,24 @@ yystate6:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule94
+		goto yyrule132
 	case c == '=':
 		goto yystate7
 	}
 
 yystate7:
 	c = l.next()
-	goto yyrule21
+	goto yyrule23
 
 yystate8:
 	c = l.next()
-	goto yyrule10
+	goto yyrule11
 
 yystate9:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule94
+		goto yyrule132
 	case c >= '0' && c <= '9':
 		goto yystate10
 	}
@@ -245,7 +260,7 @@ yystate10:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule93
+		goto yyrule131
 	case c >= '0' && c <= '9':
 		goto yystate10
 	}
@@ -254,7 +269,7 @@ yystate11:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule94
+		goto yyrule132
 	case c == '&':
 		goto yystate12
 	case c == '^':
@@ -263,17 +278,17 @@ yystate11:
 
 yystate12:
 	c = l.next()
-	goto yyrule15
+	goto yyrule16
 
 yystate13:
 	c = l.next()
-	goto yyrule16
+	goto yyrule18
 
 yystate14:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule94
+		goto yyrule132
 	case c == '\'':
 		goto yystate16
 	case c == '\\':
@@ -297,7 +312,7 @@ yystate15:
 
 yystate16:
 	c = l.next()
-	goto yyrule12
+	goto yyrule13
 
 yystate17:
 	c = l.next()
@@ -316,7 +331,7 @@ yystate18:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule12
+		goto yyrule13
 	case c == '\'':
 		goto yystate16
 	case c == '\\':
@@ -329,7 +344,7 @@ yystate19:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule94
+		goto yyrule132
 	case c == '-':
 		goto yystate20
 	}
@@ -347,7 +362,7 @@ yystate21:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule94
+		goto yyrule132
 	case c >= '0' && c <= '9':
 		goto yystate22
 	}
@@ -404,7 +419,7 @@ yystate27:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule94
+		goto yyrule132
 	case c == '*':
 		goto yystate28
 	case c == '/':
@@ -555,11 +570,9 @@ yystate40:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule94
-	case c == '<':
+		goto yyrule132
+	case c == ':':
 		goto yystate41
-	case c == '=':
-		goto yystate42
 	}
 
 yystate41:
@@ -567,221 +580,212 @@ yystate41:
 	goto yyrule17
 
 yystate42:
-	c = l.next()
-	goto yyrule18
-
-yystate43:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule94
+		goto yyrule132
+	case c == '<':
+		goto yystate43
 	case c == '=':
 		goto yystate44
 	}
 
-yystate44:
+yystate43:
 	c = l.next()
 	goto yyrule19
 
+yystate44:
+	c = l.next()
+	goto yyrule20
+
 yystate45:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule94
+		goto yyrule132
 	case c == '=':
 		goto yystate46
-	case c == '>':
-		goto yystate47
 	}
 
 yystate46:
 	c = l.next()
-	goto yyrule20
+	goto yyrule21
 
 yystate47:
-	c = l.next()
-	goto yyrule23
-
-yystate48:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'D' || c == 'd':
-		goto yystate50
-	case c == 'L' || c == 'l':
-		goto yystate52
-	case c == 'N' || c == 'n':
-		goto yystate56
-	case c == 'S' || c == 's':
-		goto yystate58
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'K' || c == 'M' || c >= 'O' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'k' || c == 'm' || c >= 'o' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yyrule132
+	case c == '=':
+		goto yystate48
+	case c == '>':
 		goto yystate49
 	}
 
+yystate48:
+	c = l.next()
+	goto yyrule22
+
 yystate49:
 	c = l.next()
-	switch {
-	default:
-		goto yyrule92
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
-	}
+	goto yyrule25
 
 yystate50:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'D' || c == 'd':
+		goto yystate52
+	case c == 'L' || c == 'l':
+		goto yystate54
+	case c == 'N' || c == 'n':
+		goto yystate58
+	case c == 'R' || c == 'r':
+		goto yystate65
+	case c == 'S' || c == 's':
+		goto yystate69
+	case c == 'T' || c == 't':
+		goto yystate71
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'K' || c == 'M' || c >= 'O' && c <= 'Q' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'k' || c == 'm' || c >= 'o' && c <= 'q' || c >= 'u' && c <= 'z':
 		goto yystate51
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z':
-		goto yystate49
 	}
 
 yystate51:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule24
+		goto yyrule130
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
 yystate52:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
+		goto yyrule130
+	case c == 'D' || c == 'd':
 		goto yystate53
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z':
+		goto yystate51
 	}
 
 yystate53:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
-		goto yystate54
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+		goto yyrule26
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate54:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'R' || c == 'r':
+		goto yyrule130
+	case c == 'T' || c == 't':
 		goto yystate55
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate55:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule25
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate56
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate56:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'D' || c == 'd':
+		goto yyrule130
+	case c == 'R' || c == 'r':
 		goto yystate57
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
 	}
 
 yystate57:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule26
+		goto yyrule27
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
 yystate58:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule28
-	case c == 'C' || c == 'c':
+		goto yyrule130
+	case c == 'A' || c == 'a':
 		goto yystate59
-	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
-		goto yystate49
+	case c == 'D' || c == 'd':
+		goto yystate64
+	case c >= '0' && c <= '9' || c == 'B' || c == 'C' || c >= 'E' && c <= 'Z' || c == '_' || c == 'b' || c == 'c' || c >= 'e' && c <= 'z':
+		goto yystate51
 	}
 
 yystate59:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule27
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'L' || c == 'l':
+		goto yystate60
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
 	}
 
 yystate60:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
-		goto yystate61
-	case c == 'I' || c == 'i':
-		goto yystate70
-	case c == 'L' || c == 'l':
-		goto yystate78
-	case c == 'O' || c == 'o':
-		goto yystate81
+		goto yyrule130
 	case c == 'Y' || c == 'y':
-		goto yystate84
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'H' || c == 'J' || c == 'K' || c == 'M' || c == 'N' || c >= 'P' && c <= 'X' || c == 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'h' || c == 'j' || c == 'k' || c == 'm' || c == 'n' || c >= 'p' && c <= 'x' || c == 'z':
-		goto yystate49
+		goto yystate61
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'X' || c == 'Z' || c == '_' || c >= 'a' && c <= 'x' || c == 'z':
+		goto yystate51
 	}
 
 yystate61:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'G' || c == 'g':
+		goto yyrule130
+	case c == 'Z' || c == 'z':
 		goto yystate62
-	case c == 'T' || c == 't':
-		goto yystate65
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'H' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'f' || c >= 'h' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Y' || c == '_' || c >= 'a' && c <= 'y':
+		goto yystate51
 	}
 
 yystate62:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'I' || c == 'i':
+		goto yyrule130
+	case c == 'E' || c == 'e':
 		goto yystate63
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate63:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'N' || c == 'n':
-		goto yystate64
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
-		goto yystate49
+		goto yyrule28
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate64:
@@ -790,2522 +794,4445 @@ yystate64:
 	default:
 		goto yyrule29
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
 yystate65:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'W' || c == 'w':
+		goto yyrule130
+	case c == 'R' || c == 'r':
 		goto yystate66
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'V' || c >= 'X' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'v' || c >= 'x' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
 	}
 
 yystate66:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
+		goto yyrule130
+	case c == 'A' || c == 'a':
 		goto yystate67
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
 	}
 
 yystate67:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
+		goto yyrule130
+	case c == 'Y' || c == 'y':
 		goto yystate68
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'X' || c == 'Z' || c == '_' || c >= 'a' && c <= 'x' || c == 'z':
+		goto yystate51
 	}
 
 yystate68:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'N' || c == 'n':
-		goto yystate69
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
-		goto yystate49
+		goto yyrule105
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate69:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule30
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
-	}
-
-yystate70:
+		goto yyrule32
+	case c == 'C' || c == 'c':
+		goto yystate70
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
+		goto yystate51
+	}
+
+yystate70:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'G' || c == 'g':
-		goto yystate71
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'H' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'f' || c >= 'h' && c <= 'z':
-		goto yystate49
+		goto yyrule30
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate71:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'I' || c == 'i':
+		goto yyrule130
+	case c == 'T' || c == 't':
 		goto yystate72
-	case c == 'R' || c == 'r':
-		goto yystate75
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'q' || c >= 's' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate72:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'N' || c == 'n':
+		goto yyrule130
+	case c == 'A' || c == 'a':
 		goto yystate73
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
 	}
 
 yystate73:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
+		goto yyrule130
+	case c == 'C' || c == 'c':
 		goto yystate74
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
+		goto yystate51
 	}
 
 yystate74:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule68
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'H' || c == 'h':
+		goto yystate75
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'G' || c >= 'I' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'g' || c >= 'i' && c <= 'z':
+		goto yystate51
 	}
 
 yystate75:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'A' || c == 'a':
-		goto yystate76
-	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
-		goto yystate49
+		goto yyrule31
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate76:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
+		goto yyrule130
+	case c == 'E' || c == 'e':
 		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c == 'I' || c == 'i':
+		goto yystate86
+	case c == 'L' || c == 'l':
+		goto yystate94
+	case c == 'O' || c == 'o':
+		goto yystate97
+	case c == 'Y' || c == 'y':
+		goto yystate100
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'H' || c == 'J' || c == 'K' || c == 'M' || c == 'N' || c >= 'P' && c <= 'X' || c == 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'h' || c == 'j' || c == 'k' || c == 'm' || c == 'n' || c >= 'p' && c <= 'x' || c == 'z':
+		goto yystate51
 	}
 
 yystate77:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule69
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'G' || c == 'g':
+		goto yystate78
+	case c == 'T' || c == 't':
+		goto yystate81
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'H' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'f' || c >= 'h' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate78:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'O' || c == 'o':
+		goto yyrule130
+	case c == 'I' || c == 'i':
 		goto yystate79
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
 	}
 
 yystate79:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'B' || c == 'b':
+		goto yyrule130
+	case c == 'N' || c == 'n':
 		goto yystate80
-	case c >= '0' && c <= '9' || c == 'A' || c >= 'C' && c <= 'Z' || c == '_' || c == 'a' || c >= 'c' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
 	}
 
 yystate80:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule70
+		goto yyrule33
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
 yystate81:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'O' || c == 'o':
+		goto yyrule130
+	case c == 'W' || c == 'w':
 		goto yystate82
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'V' || c >= 'X' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'v' || c >= 'x' && c <= 'z':
+		goto yystate51
 	}
 
 yystate82:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'L' || c == 'l':
+		goto yyrule130
+	case c == 'E' || c == 'e':
 		goto yystate83
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate83:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule71
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate84
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate84:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule31
-	case c == 'T' || c == 't':
+		goto yyrule130
+	case c == 'N' || c == 'n':
 		goto yystate85
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
 	}
 
 yystate85:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
-		goto yystate86
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+		goto yyrule34
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate86:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule72
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'G' || c == 'g':
+		goto yystate87
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'H' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'f' || c >= 'h' && c <= 'z':
+		goto yystate51
 	}
 
 yystate87:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'O' || c == 'o':
+		goto yyrule130
+	case c == 'I' || c == 'i':
 		goto yystate88
 	case c == 'R' || c == 'r':
-		goto yystate106
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c == 'P' || c == 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c == 'p' || c == 'q' || c >= 's' && c <= 'z':
-		goto yystate49
+		goto yystate91
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
 	}
 
 yystate88:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'L' || c == 'l':
+		goto yyrule130
+	case c == 'N' || c == 'n':
 		goto yystate89
-	case c == 'M' || c == 'm':
-		goto yystate93
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'n' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
 	}
 
 yystate89:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'U' || c == 'u':
+		goto yyrule130
+	case c == 'T' || c == 't':
 		goto yystate90
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate90:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'M' || c == 'm':
-		goto yystate91
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z':
-		goto yystate49
+		goto yyrule106
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate91:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'N' || c == 'n':
+		goto yyrule130
+	case c == 'A' || c == 'a':
 		goto yystate92
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
 	}
 
 yystate92:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule32
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate93
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate93:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'M' || c == 'm':
-		goto yystate94
-	case c == 'P' || c == 'p':
-		goto yystate97
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c == 'N' || c == 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c == 'n' || c == 'o' || c >= 'q' && c <= 'z':
-		goto yystate49
+		goto yyrule107
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate94:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'I' || c == 'i':
+		goto yyrule130
+	case c == 'O' || c == 'o':
 		goto yystate95
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
+		goto yystate51
 	}
 
 yystate95:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
+		goto yyrule130
+	case c == 'B' || c == 'b':
 		goto yystate96
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c == 'A' || c >= 'C' && c <= 'Z' || c == '_' || c == 'a' || c >= 'c' && c <= 'z':
+		goto yystate51
 	}
 
 yystate96:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule33
+		goto yyrule108
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
 yystate97:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'L' || c == 'l':
+		goto yyrule130
+	case c == 'O' || c == 'o':
 		goto yystate98
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
+		goto yystate51
 	}
 
 yystate98:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
+		goto yyrule130
+	case c == 'L' || c == 'l':
 		goto yystate99
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
 	}
 
 yystate99:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'X' || c == 'x':
-		goto yystate100
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z':
-		goto yystate49
+		goto yyrule109
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate100:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == '0' || c >= '2' && c <= '5' || c >= '7' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
-	case c == '1':
+		goto yyrule35
+	case c == 'T' || c == 't':
 		goto yystate101
-	case c == '6':
-		goto yystate104
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate101:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == '0' || c == '1' || c >= '3' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
-	case c == '2':
+		goto yyrule130
+	case c == 'E' || c == 'e':
 		goto yystate102
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate102:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == '8':
-		goto yystate103
-	case c >= '0' && c <= '7' || c == '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule110
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate103:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule73
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate104
+	case c == 'O' || c == 'o':
+		goto yystate107
+	case c == 'R' || c == 'r':
+		goto yystate138
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'N' || c == 'P' || c == 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'n' || c == 'p' || c == 'q' || c >= 's' && c <= 'z':
+		goto yystate51
 	}
 
 yystate104:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == '4':
+		goto yyrule130
+	case c == 'S' || c == 's':
 		goto yystate105
-	case c >= '0' && c <= '3' || c >= '5' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yystate51
 	}
 
 yystate105:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule74
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate106
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate106:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
-		goto yystate107
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+		goto yyrule36
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate107:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'A' || c == 'a':
+		goto yyrule130
+	case c == 'L' || c == 'l':
 		goto yystate108
-	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
-		goto yystate49
+	case c == 'M' || c == 'm':
+		goto yystate116
+	case c == 'N' || c == 'n':
+		goto yystate132
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'o' && c <= 'z':
+		goto yystate51
 	}
 
 yystate108:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
+		goto yyrule130
+	case c == 'L' || c == 'l':
 		goto yystate109
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c == 'U' || c == 'u':
+		goto yystate113
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 't' || c >= 'v' && c <= 'z':
+		goto yystate51
 	}
 
 yystate109:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
+		goto yyrule130
+	case c == 'A' || c == 'a':
 		goto yystate110
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
 	}
 
 yystate110:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule34
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate111
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate111:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'E' || c == 'e':
 		goto yystate112
-	case c == 'I' || c == 'i':
-		goto yystate119
-	case c == 'R' || c == 'r':
-		goto yystate126
-	case c == 'U' || c == 'u':
-		goto yystate129
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'H' || c >= 'J' && c <= 'Q' || c == 'S' || c == 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'h' || c >= 'j' && c <= 'q' || c == 's' || c == 't' || c >= 'v' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate112:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'L' || c == 'l':
-		goto yystate113
-	case c == 'S' || c == 's':
-		goto yystate117
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'r' || c >= 't' && c <= 'z':
-		goto yystate49
+		goto yyrule37
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate113:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
+		goto yyrule130
+	case c == 'M' || c == 'm':
 		goto yystate114
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z':
+		goto yystate51
 	}
 
 yystate114:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
+		goto yyrule130
+	case c == 'N' || c == 'n':
 		goto yystate115
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
 	}
 
 yystate115:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
-		goto yystate116
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+		goto yyrule38
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate116:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule35
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'M' || c == 'm':
+		goto yystate117
+	case c == 'P' || c == 'p':
+		goto yystate123
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c == 'N' || c == 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c == 'n' || c == 'o' || c >= 'q' && c <= 'z':
+		goto yystate51
 	}
 
 yystate117:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'C' || c == 'c':
+		goto yyrule130
+	case c == 'E' || c == 'e':
 		goto yystate118
-	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
-		goto yystate49
+	case c == 'I' || c == 'i':
+		goto yystate121
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
 	}
 
 yystate118:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule36
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'N' || c == 'n':
+		goto yystate119
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
 	}
 
 yystate119:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'S' || c == 's':
+		goto yyrule130
+	case c == 'T' || c == 't':
 		goto yystate120
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate120:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
-		goto yystate121
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+		goto yyrule39
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate121:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'I' || c == 'i':
+		goto yyrule130
+	case c == 'T' || c == 't':
 		goto yystate122
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate122:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'N' || c == 'n':
-		goto yystate123
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
-		goto yystate49
+		goto yyrule40
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate123:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'C' || c == 'c':
+		goto yyrule130
+	case c == 'L' || c == 'l':
 		goto yystate124
-	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
 	}
 
 yystate124:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
+		goto yyrule130
+	case c == 'E' || c == 'e':
 		goto yystate125
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate125:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule37
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'X' || c == 'x':
+		goto yystate126
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z':
+		goto yystate51
 	}
 
 yystate126:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'O' || c == 'o':
+		goto yyrule130
+	case c == '0' || c >= '2' && c <= '5' || c >= '7' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	case c == '1':
 		goto yystate127
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
-		goto yystate49
+	case c == '6':
+		goto yystate130
 	}
 
 yystate127:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'P' || c == 'p':
+		goto yyrule130
+	case c == '0' || c == '1' || c >= '3' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	case c == '2':
 		goto yystate128
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z':
-		goto yystate49
 	}
 
 yystate128:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule38
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == '8':
+		goto yystate129
+	case c >= '0' && c <= '7' || c == '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate129:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'R' || c == 'r':
-		goto yystate130
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
-		goto yystate49
+		goto yyrule111
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate130:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'A' || c == 'a':
+		goto yyrule130
+	case c == '4':
 		goto yystate131
-	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '3' || c >= '5' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate131:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
-		goto yystate132
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+		goto yyrule112
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate132:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'I' || c == 'i':
+		goto yyrule130
+	case c == 'F' || c == 'f':
 		goto yystate133
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'z':
+		goto yystate51
 	}
 
 yystate133:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'O' || c == 'o':
+		goto yyrule130
+	case c == 'L' || c == 'l':
 		goto yystate134
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
 	}
 
 yystate134:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'N' || c == 'n':
+		goto yyrule130
+	case c == 'I' || c == 'i':
 		goto yystate135
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
 	}
 
 yystate135:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule75
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'C' || c == 'c':
+		goto yystate136
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
+		goto yystate51
 	}
 
 yystate136:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'X' || c == 'x':
+		goto yyrule130
+	case c == 'T' || c == 't':
 		goto yystate137
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate137:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'I' || c == 'i':
-		goto yystate138
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
-		goto yystate49
+		goto yyrule41
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate138:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'S' || c == 's':
+		goto yyrule130
+	case c == 'E' || c == 'e':
 		goto yystate139
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate139:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
+		goto yyrule130
+	case c == 'A' || c == 'a':
 		goto yystate140
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
 	}
 
 yystate140:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'S' || c == 's':
+		goto yyrule130
+	case c == 'T' || c == 't':
 		goto yystate141
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate141:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule39
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate142
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate142:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'A' || c == 'a':
-		goto yystate143
-	case c == 'L' || c == 'l':
-		goto yystate147
-	case c == 'R' || c == 'r':
-		goto yystate155
-	case c >= '0' && c <= '9' || c >= 'B' && c <= 'K' || c >= 'M' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'k' || c >= 'm' && c <= 'q' || c >= 's' && c <= 'z':
-		goto yystate49
+		goto yyrule42
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate143:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'L' || c == 'l':
+		goto yyrule130
+	case c == 'A' || c == 'a':
 		goto yystate144
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
-		goto yystate49
+	case c == 'E' || c == 'e':
+		goto yystate151
+	case c == 'I' || c == 'i':
+		goto yystate162
+	case c == 'O' || c == 'o':
+		goto yystate177
+	case c == 'R' || c == 'r':
+		goto yystate178
+	case c == 'U' || c == 'u':
+		goto yystate181
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'D' || c >= 'F' && c <= 'H' || c >= 'J' && c <= 'N' || c == 'P' || c == 'Q' || c == 'S' || c == 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'd' || c >= 'f' && c <= 'h' || c >= 'j' && c <= 'n' || c == 'p' || c == 'q' || c == 's' || c == 't' || c >= 'v' && c <= 'z':
+		goto yystate51
 	}
 
 yystate144:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'S' || c == 's':
+		goto yyrule130
+	case c == 'T' || c == 't':
 		goto yystate145
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate145:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
+		goto yyrule130
+	case c == 'A' || c == 'a':
 		goto yystate146
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
 	}
 
 yystate146:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule66
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'B' || c == 'b':
+		goto yystate147
+	case c >= '0' && c <= '9' || c == 'A' || c >= 'C' && c <= 'Z' || c == '_' || c == 'a' || c >= 'c' && c <= 'z':
+		goto yystate51
 	}
 
 yystate147:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'O' || c == 'o':
+		goto yyrule130
+	case c == 'A' || c == 'a':
 		goto yystate148
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
 	}
 
 yystate148:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'A' || c == 'a':
+		goto yyrule130
+	case c == 'S' || c == 's':
 		goto yystate149
-	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yystate51
 	}
 
 yystate149:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
+		goto yyrule130
+	case c == 'E' || c == 'e':
 		goto yystate150
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate150:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule76
-	case c == '3':
-		goto yystate151
-	case c == '6':
-		goto yystate153
-	case c >= '0' && c <= '2' || c == '4' || c == '5' || c >= '7' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule43
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate151:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == '0' || c == '1' || c >= '3' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
-	case c == '2':
+		goto yyrule130
+	case c == 'L' || c == 'l':
 		goto yystate152
+	case c == 'S' || c == 's':
+		goto yystate156
+	case c == 'T' || c == 't':
+		goto yystate158
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'R' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'r' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate152:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate153
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate153:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == '4':
+		goto yyrule130
+	case c == 'T' || c == 't':
 		goto yystate154
-	case c >= '0' && c <= '3' || c >= '5' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate154:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule78
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate155
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate155:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'O' || c == 'o':
-		goto yystate156
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
-		goto yystate49
+		goto yyrule44
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate156:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'M' || c == 'm':
+		goto yyrule130
+	case c == 'C' || c == 'c':
 		goto yystate157
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
+		goto yystate51
 	}
 
 yystate157:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule40
+		goto yyrule45
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
 yystate158:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'R' || c == 'r':
+		goto yyrule130
+	case c == 'A' || c == 'a':
 		goto yystate159
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
 	}
 
 yystate159:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'O' || c == 'o':
+		goto yyrule130
+	case c == 'C' || c == 'c':
 		goto yystate160
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
+		goto yystate51
 	}
 
 yystate160:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'U' || c == 'u':
+		goto yyrule130
+	case c == 'H' || c == 'h':
 		goto yystate161
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'G' || c >= 'I' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'g' || c >= 'i' && c <= 'z':
+		goto yystate51
 	}
 
 yystate161:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'P' || c == 'p':
-		goto yystate162
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z':
-		goto yystate49
+		goto yyrule46
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate162:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule41
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'C' || c == 'c':
+		goto yystate163
+	case c == 'S' || c == 's':
+		goto yystate171
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yystate51
 	}
 
 yystate163:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate164
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate164:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'F' || c == 'f':
+		goto yyrule130
+	case c == 'I' || c == 'i':
 		goto yystate165
-	case c == 'N' || c == 'n':
-		goto yystate166
-	case c == 'S' || c == 's':
-		goto yystate183
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'M' || c >= 'O' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'm' || c >= 'o' && c <= 'r' || c >= 't' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
 	}
 
 yystate165:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule42
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'O' || c == 'o':
+		goto yystate166
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
+		goto yystate51
 	}
 
 yystate166:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule46
-	case c == 'D' || c == 'd':
+		goto yyrule130
+	case c == 'N' || c == 'n':
 		goto yystate167
-	case c == 'S' || c == 's':
-		goto yystate170
-	case c == 'T' || c == 't':
-		goto yystate174
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'R' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'r' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
 	}
 
 yystate167:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
+		goto yyrule130
+	case c == 'A' || c == 'a':
 		goto yystate168
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
 	}
 
 yystate168:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'X' || c == 'x':
+		goto yyrule130
+	case c == 'R' || c == 'r':
 		goto yystate169
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
 	}
 
 yystate169:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule43
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'Y' || c == 'y':
+		goto yystate170
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'X' || c == 'Z' || c == '_' || c >= 'a' && c <= 'x' || c == 'z':
+		goto yystate51
 	}
 
 yystate170:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
-		goto yystate171
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+		goto yyrule47
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate171:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'R' || c == 'r':
+		goto yyrule130
+	case c == 'T' || c == 't':
 		goto yystate172
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate172:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
+		goto yyrule130
+	case c == 'I' || c == 'i':
 		goto yystate173
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
 	}
 
 yystate173:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule44
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'N' || c == 'n':
+		goto yystate174
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
 	}
 
 yystate174:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule79
-	case c == '0' || c == '2' || c == '4' || c == '5' || c == '7' || c == '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
-		goto yystate49
-	case c == '1':
+		goto yyrule130
+	case c == 'C' || c == 'c':
 		goto yystate175
-	case c == '3':
-		goto yystate177
-	case c == '6':
-		goto yystate179
-	case c == '8':
-		goto yystate181
-	case c == 'O' || c == 'o':
-		goto yystate182
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
+		goto yystate51
 	}
 
 yystate175:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == '6':
+		goto yyrule130
+	case c == 'T' || c == 't':
 		goto yystate176
-	case c >= '0' && c <= '5' || c >= '7' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate176:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule80
+		goto yyrule48
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
 yystate177:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == '0' || c == '1' || c >= '3' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
-	case c == '2':
-		goto yystate178
+		goto yyrule49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate178:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule81
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'O' || c == 'o':
+		goto yystate179
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
+		goto yystate51
 	}
 
 yystate179:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == '4':
+		goto yyrule130
+	case c == 'P' || c == 'p':
 		goto yystate180
-	case c >= '0' && c <= '3' || c >= '5' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z':
+		goto yystate51
 	}
 
 yystate180:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule82
+		goto yyrule50
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
 yystate181:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule83
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'R' || c == 'r':
+		goto yystate182
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
 	}
 
 yystate182:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule45
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate183
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
 	}
 
 yystate183:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule47
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate184
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate184:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'I' || c == 'i':
 		goto yystate185
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
 yystate185:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'K' || c == 'k':
+		goto yyrule130
+	case c == 'O' || c == 'o':
 		goto yystate186
-	case c == 'M' || c == 'm':
-		goto yystate188
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'J' || c == 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'j' || c == 'l' || c >= 'n' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
+		goto yystate51
 	}
 
 yystate186:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
+		goto yyrule130
+	case c == 'N' || c == 'n':
 		goto yystate187
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
 	}
 
 yystate187:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule48
+		goto yyrule113
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
 yystate188:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'I' || c == 'i':
+		goto yyrule130
+	case c == 'S' || c == 's':
 		goto yystate189
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
-		goto yystate49
+	case c == 'X' || c == 'x':
+		goto yystate194
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'w' || c == 'y' || c == 'z':
+		goto yystate51
 	}
 
 yystate189:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
+		goto yyrule130
+	case c == 'C' || c == 'c':
 		goto yystate190
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
+		goto yystate51
 	}
 
 yystate190:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule49
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate191
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
 	}
 
 yystate191:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'O' || c == 'o':
+		goto yyrule130
+	case c == 'P' || c == 'p':
 		goto yystate192
-	case c == 'U' || c == 'u':
-		goto yystate194
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 't' || c >= 'v' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z':
+		goto yystate51
 	}
 
 yystate192:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
+		goto yyrule130
+	case c == 'E' || c == 'e':
 		goto yystate193
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate193:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule50
+		goto yyrule51
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
 yystate194:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'L' || c == 'l':
+		goto yyrule130
+	case c == 'I' || c == 'i':
 		goto yystate195
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
 	}
 
 yystate195:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'L' || c == 'l':
+		goto yyrule130
+	case c == 'S' || c == 's':
 		goto yystate196
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yystate51
 	}
 
 yystate196:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule65
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate197
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate197:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'F' || c == 'f':
+		goto yyrule130
+	case c == 'S' || c == 's':
 		goto yystate198
-	case c == 'N' || c == 'n':
-		goto yystate203
-	case c == 'R' || c == 'r':
-		goto yystate204
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'M' || c >= 'O' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'm' || c >= 'o' && c <= 'q' || c >= 's' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yystate51
 	}
 
 yystate198:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'F' || c == 'f':
-		goto yystate199
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'z':
-		goto yystate49
+		goto yyrule52
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate199:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'S' || c == 's':
+		goto yyrule130
+	case c == 'A' || c == 'a':
 		goto yystate200
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
-		goto yystate49
+	case c == 'L' || c == 'l':
+		goto yystate204
+	case c == 'O' || c == 'o':
+		goto yystate212
+	case c == 'R' || c == 'r':
+		goto yystate214
+	case c == 'U' || c == 'u':
+		goto yystate217
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'K' || c == 'M' || c == 'N' || c == 'P' || c == 'Q' || c == 'S' || c == 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'k' || c == 'm' || c == 'n' || c == 'p' || c == 'q' || c == 's' || c == 't' || c >= 'v' && c <= 'z':
+		goto yystate51
 	}
 
 yystate200:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
+		goto yyrule130
+	case c == 'L' || c == 'l':
 		goto yystate201
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
 	}
 
 yystate201:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
+		goto yyrule130
+	case c == 'S' || c == 's':
 		goto yystate202
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yystate51
 	}
 
 yystate202:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule51
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate203
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate203:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule52
+		goto yyrule103
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
 yystate204:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule53
-	case c == 'D' || c == 'd':
+		goto yyrule130
+	case c == 'O' || c == 'o':
 		goto yystate205
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
+		goto yystate51
 	}
 
 yystate205:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
+		goto yyrule130
+	case c == 'A' || c == 'a':
 		goto yystate206
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
 	}
 
 yystate206:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'R' || c == 'r':
+		goto yyrule130
+	case c == 'T' || c == 't':
 		goto yystate207
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate207:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule54
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule114
+	case c == '3':
+		goto yystate208
+	case c == '6':
+		goto yystate210
+	case c >= '0' && c <= '2' || c == '4' || c == '5' || c >= '7' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate208:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'O' || c == 'o':
+		goto yyrule130
+	case c == '0' || c == '1' || c >= '3' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	case c == '2':
 		goto yystate209
-	case c == 'U' || c == 'u':
-		goto yystate216
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 't' || c >= 'v' && c <= 'z':
-		goto yystate49
 	}
 
 yystate209:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'L' || c == 'l':
-		goto yystate210
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
-		goto yystate49
+		goto yyrule115
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate210:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'L' || c == 'l':
+		goto yyrule130
+	case c == '4':
 		goto yystate211
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '3' || c >= '5' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate211:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'B' || c == 'b':
-		goto yystate212
-	case c >= '0' && c <= '9' || c == 'A' || c >= 'C' && c <= 'Z' || c == '_' || c == 'a' || c >= 'c' && c <= 'z':
-		goto yystate49
+		goto yyrule116
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate212:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'A' || c == 'a':
+		goto yyrule130
+	case c == 'R' || c == 'r':
 		goto yystate213
-	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
 	}
 
 yystate213:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'C' || c == 'c':
-		goto yystate214
-	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
-		goto yystate49
+		goto yyrule53
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate214:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'K' || c == 'k':
+		goto yyrule130
+	case c == 'O' || c == 'o':
 		goto yystate215
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'J' || c >= 'L' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'j' || c >= 'l' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
+		goto yystate51
 	}
 
 yystate215:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule55
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'M' || c == 'm':
+		goto yystate216
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z':
+		goto yystate51
 	}
 
 yystate216:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'N' || c == 'n':
-		goto yystate217
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
-		goto yystate49
+		goto yyrule54
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate217:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
+		goto yyrule130
+	case c == 'L' || c == 'l':
 		goto yystate218
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
 	}
 
 yystate218:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule84
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'L' || c == 'l':
+		goto yystate219
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
 	}
 
 yystate219:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
-		goto yystate220
+		goto yyrule130
 	case c == 'T' || c == 't':
-		goto yystate226
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+		goto yystate220
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate220:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'L' || c == 'l':
+		goto yyrule130
+	case c == 'E' || c == 'e':
 		goto yystate221
-	case c == 'T' || c == 't':
-		goto yystate225
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
 yystate221:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
+		goto yyrule130
+	case c == 'X' || c == 'x':
 		goto yystate222
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z':
+		goto yystate51
 	}
 
 yystate222:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'C' || c == 'c':
+		goto yyrule130
+	case c == 'T' || c == 't':
 		goto yystate223
-	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
 yystate223:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'T' || c == 't':
-		goto yystate224
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+		goto yyrule55
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate224:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule56
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'R' || c == 'r':
+		goto yystate225
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
 	}
 
 yystate225:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule57
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'O' || c == 'o':
+		goto yystate226
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
+		goto yystate51
 	}
 
 yystate226:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'R' || c == 'r':
+		goto yyrule130
+	case c == 'U' || c == 'u':
 		goto yystate227
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z':
+		goto yystate51
 	}
 
 yystate227:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'I' || c == 'i':
+		goto yyrule130
+	case c == 'P' || c == 'p':
 		goto yystate228
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z':
+		goto yystate51
 	}
 
 yystate228:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'N' || c == 'n':
-		goto yystate229
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
-		goto yystate49
+		goto yyrule56
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate229:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'G' || c == 'g':
+		goto yyrule130
+	case c == 'A' || c == 'a':
 		goto yystate230
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'H' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'f' || c >= 'h' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
 	}
 
 yystate230:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule85
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'S' || c == 's':
+		goto yystate231
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yystate51
 	}
 
 yystate231:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'A' || c == 'a':
+		goto yyrule130
+	case c == 'H' || c == 'h':
 		goto yystate232
-	case c == 'I' || c == 'i':
-		goto yystate236
-	case c == 'R' || c == 'r':
-		goto yystate239
-	case c >= '0' && c <= '9' || c >= 'B' && c <= 'H' || c >= 'J' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'h' || c >= 'j' && c <= 'q' || c >= 's' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'G' || c >= 'I' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'g' || c >= 'i' && c <= 'z':
+		goto yystate51
 	}
 
 yystate232:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'B' || c == 'b':
-		goto yystate233
-	case c >= '0' && c <= '9' || c == 'A' || c >= 'C' && c <= 'Z' || c == '_' || c == 'a' || c >= 'c' && c <= 'z':
-		goto yystate49
+		goto yyrule57
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate233:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'L' || c == 'l':
+		goto yyrule130
+	case c == 'F' || c == 'f':
 		goto yystate234
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
-		goto yystate49
+	case c == 'G' || c == 'g':
+		goto yystate235
+	case c == 'L' || c == 'l':
+		goto yystate240
+	case c == 'N' || c == 'n':
+		goto yystate244
+	case c == 'S' || c == 's':
+		goto yystate261
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'H' && c <= 'K' || c == 'M' || c >= 'O' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'h' && c <= 'k' || c == 'm' || c >= 'o' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yystate51
 	}
 
 yystate234:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
-		goto yystate235
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+		goto yyrule58
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
 yystate235:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule58
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'N' || c == 'n':
+		goto yystate236
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
 	}
 
 yystate236:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'M' || c == 'm':
+		goto yyrule130
+	case c == 'O' || c == 'o':
 		goto yystate237
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
+		goto yystate51
 	}
 
 yystate237:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
+		goto yyrule130
+	case c == 'R' || c == 'r':
 		goto yystate238
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
 	}
 
 yystate238:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule86
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate239
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate239:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule59
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate240:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'I' || c == 'i':
+		goto yystate241
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
+	}
+
+yystate241:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'K' || c == 'k':
+		goto yystate242
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'J' || c >= 'L' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'j' || c >= 'l' && c <= 'z':
+		goto yystate51
+	}
+
+yystate242:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate243
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate243:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule60
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate244:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule64
+	case c == 'D' || c == 'd':
+		goto yystate245
+	case c == 'S' || c == 's':
+		goto yystate248
+	case c == 'T' || c == 't':
+		goto yystate252
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'R' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'r' || c >= 'u' && c <= 'z':
+		goto yystate51
+	}
+
+yystate245:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate246
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate246:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'X' || c == 'x':
+		goto yystate247
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z':
+		goto yystate51
+	}
+
+yystate247:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule61
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate248:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate249
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate249:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'R' || c == 'r':
+		goto yystate250
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
+	}
+
+yystate250:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate251
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
+	}
+
+yystate251:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule62
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate252:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule117
+	case c == '0' || c == '2' || c == '4' || c == '5' || c == '7' || c == '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
+		goto yystate51
+	case c == '1':
+		goto yystate253
+	case c == '3':
+		goto yystate255
+	case c == '6':
+		goto yystate257
+	case c == '8':
+		goto yystate259
+	case c == 'O' || c == 'o':
+		goto yystate260
+	}
+
+yystate253:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == '6':
+		goto yystate254
+	case c >= '0' && c <= '5' || c >= '7' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate254:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule118
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate255:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == '0' || c == '1' || c >= '3' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	case c == '2':
+		goto yystate256
+	}
+
+yystate256:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule119
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate257:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == '4':
+		goto yystate258
+	case c >= '0' && c <= '3' || c >= '5' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate258:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule120
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate259:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule121
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate260:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule63
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate261:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule65
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate262:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate263:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate264
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate264:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'Y' || c == 'y':
+		goto yystate265
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'X' || c == 'Z' || c == '_' || c >= 'a' && c <= 'x' || c == 'z':
+		goto yystate51
+	}
+
+yystate265:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule66
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate266:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate267
+	case c == 'I' || c == 'i':
+		goto yystate270
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
+	}
+
+yystate267:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'S' || c == 's':
+		goto yystate268
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yystate51
+	}
+
+yystate268:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'S' || c == 's':
+		goto yystate269
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yystate51
+	}
+
+yystate269:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule67
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate270:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'K' || c == 'k':
+		goto yystate271
+	case c == 'M' || c == 'm':
+		goto yystate273
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'J' || c == 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'j' || c == 'l' || c >= 'n' && c <= 'z':
+		goto yystate51
+	}
+
+yystate271:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate272
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate272:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule68
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate273:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'I' || c == 'i':
+		goto yystate274
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
+	}
+
+yystate274:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate275
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
+	}
+
+yystate275:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule69
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate276:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate277
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
+	}
+
+yystate277:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate278
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
+	}
+
+yystate278:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'C' || c == 'c':
+		goto yystate279
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
+		goto yystate51
+	}
+
+yystate279:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'H' || c == 'h':
+		goto yystate280
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'G' || c >= 'I' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'g' || c >= 'i' && c <= 'z':
+		goto yystate51
+	}
+
+yystate280:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule70
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate281:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'O' || c == 'o':
+		goto yystate282
+	case c == 'U' || c == 'u':
+		goto yystate284
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 't' || c >= 'v' && c <= 'z':
+		goto yystate51
+	}
+
+yystate282:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate283
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
+	}
+
+yystate283:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule71
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate284:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'L' || c == 'l':
+		goto yystate285
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
+	}
+
+yystate285:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'L' || c == 'l':
+		goto yystate286
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
+	}
+
+yystate286:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule102
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate287:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'F' || c == 'f':
+		goto yystate288
+	case c == 'N' || c == 'n':
+		goto yystate293
+	case c == 'R' || c == 'r':
+		goto yystate294
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'M' || c >= 'O' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'm' || c >= 'o' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
+	}
+
+yystate288:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'F' || c == 'f':
+		goto yystate289
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'E' || c >= 'G' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'z':
+		goto yystate51
+	}
+
+yystate289:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'S' || c == 's':
+		goto yystate290
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yystate51
+	}
+
+yystate290:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate291
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate291:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate292
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
+	}
+
+yystate292:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule72
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate293:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule73
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate294:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule74
+	case c == 'D' || c == 'd':
+		goto yystate295
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z':
+		goto yystate51
+	}
+
+yystate295:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate296
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate296:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'R' || c == 'r':
+		goto yystate297
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
+	}
+
+yystate297:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule75
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate298:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate299
+	case c == 'E' || c == 'e':
+		goto yystate308
+	case c == 'R' || c == 'r':
+		goto yystate314
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'D' || c >= 'F' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'd' || c >= 'f' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
+	}
+
+yystate299:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'R' || c == 'r':
+		goto yystate300
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
+	}
+
+yystate300:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate301
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
+	}
+
+yystate301:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'I' || c == 'i':
+		goto yystate302
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
+	}
+
+yystate302:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate303
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
+	}
+
+yystate303:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'I' || c == 'i':
+		goto yystate304
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
+	}
+
+yystate304:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'O' || c == 'o':
+		goto yystate305
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
+		goto yystate51
+	}
+
+yystate305:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'N' || c == 'n':
+		goto yystate306
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
+	}
+
+yystate306:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule76
+	case c == 'S' || c == 's':
+		goto yystate307
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yystate51
+	}
+
+yystate307:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule77
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate308:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'R' || c == 'r':
+		goto yystate309
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
+	}
+
+yystate309:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'C' || c == 'c':
+		goto yystate310
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
+		goto yystate51
+	}
+
+yystate310:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate311
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate311:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'N' || c == 'n':
+		goto yystate312
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
+	}
+
+yystate312:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate313
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
+	}
+
+yystate313:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule78
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate314:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate315
+	case c == 'I' || c == 'i':
+		goto yystate319
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
+	}
+
+yystate315:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'G' || c == 'g':
+		goto yystate316
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'H' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'f' || c >= 'h' && c <= 'z':
+		goto yystate51
+	}
+
+yystate316:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'M' || c == 'm':
+		goto yystate317
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z':
+		goto yystate51
+	}
+
+yystate317:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate318
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
+	}
+
+yystate318:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule79
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate319:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'M' || c == 'm':
+		goto yystate320
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z':
+		goto yystate51
+	}
+
+yystate320:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate321
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
+	}
+
+yystate321:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'R' || c == 'r':
+		goto yystate322
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
+	}
+
+yystate322:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'Y' || c == 'y':
+		goto yystate323
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'X' || c == 'Z' || c == '_' || c >= 'a' && c <= 'x' || c == 'z':
+		goto yystate51
+	}
+
+yystate323:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule80
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate324:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate325
+	case c == 'E' || c == 'e':
+		goto yystate329
+	case c == 'O' || c == 'o':
+		goto yystate354
+	case c == 'U' || c == 'u':
+		goto yystate364
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'D' || c >= 'F' && c <= 'N' || c >= 'P' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'd' || c >= 'f' && c <= 'n' || c >= 'p' && c <= 't' || c >= 'v' && c <= 'z':
+		goto yystate51
+	}
+
+yystate325:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'N' || c == 'n':
+		goto yystate326
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
+	}
+
+yystate326:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'G' || c == 'g':
+		goto yystate327
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'H' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'f' || c >= 'h' && c <= 'z':
+		goto yystate51
+	}
+
+yystate327:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate328
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate328:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule81
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate329:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'I' || c == 'i':
+		goto yystate330
+	case c == 'P' || c == 'p':
+		goto yystate335
+	case c == 'T' || c == 't':
+		goto yystate347
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'O' || c >= 'Q' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'o' || c >= 'q' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
+	}
+
+yystate330:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'N' || c == 'n':
+		goto yystate331
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
+	}
+
+yystate331:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'D' || c == 'd':
+		goto yystate332
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z':
+		goto yystate51
+	}
+
+yystate332:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate333
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate333:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'X' || c == 'x':
+		goto yystate334
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'W' || c == 'Y' || c == 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z':
+		goto yystate51
+	}
+
+yystate334:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule82
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate335:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate336
+	case c == 'L' || c == 'l':
+		goto yystate343
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
+	}
+
+yystate336:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate337
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
+	}
+
+yystate337:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate338
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
+	}
+
+yystate338:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate339
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
+	}
+
+yystate339:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'B' || c == 'b':
+		goto yystate340
+	case c >= '0' && c <= '9' || c == 'A' || c >= 'C' && c <= 'Z' || c == '_' || c == 'a' || c >= 'c' && c <= 'z':
+		goto yystate51
+	}
+
+yystate340:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'L' || c == 'l':
+		goto yystate341
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
+	}
+
+yystate341:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate342
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate342:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule83
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate343:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate344
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
+	}
+
+yystate344:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'C' || c == 'c':
+		goto yystate345
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
+		goto yystate51
+	}
+
+yystate345:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate346
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate346:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule84
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate347:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'U' || c == 'u':
+		goto yystate348
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z':
+		goto yystate51
+	}
+
+yystate348:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'R' || c == 'r':
+		goto yystate349
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
+	}
+
+yystate349:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'N' || c == 'n':
+		goto yystate350
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
+	}
+
+yystate350:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'I' || c == 'i':
+		goto yystate351
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
+	}
+
+yystate351:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'N' || c == 'n':
+		goto yystate352
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
+	}
+
+yystate352:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'G' || c == 'g':
+		goto yystate353
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'H' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'f' || c >= 'h' && c <= 'z':
+		goto yystate51
+	}
+
+yystate353:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule85
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate354:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'L' || c == 'l':
+		goto yystate355
+	case c == 'W' || c == 'w':
+		goto yystate361
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'V' || c >= 'X' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'v' || c >= 'x' && c <= 'z':
+		goto yystate51
+	}
+
+yystate355:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'L' || c == 'l':
+		goto yystate356
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
+	}
+
+yystate356:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'B' || c == 'b':
+		goto yystate357
+	case c >= '0' && c <= '9' || c == 'A' || c >= 'C' && c <= 'Z' || c == '_' || c == 'a' || c >= 'c' && c <= 'z':
+		goto yystate51
+	}
+
+yystate357:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate358
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
+	}
+
+yystate358:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'C' || c == 'c':
+		goto yystate359
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
+		goto yystate51
+	}
+
+yystate359:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'K' || c == 'k':
+		goto yystate360
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'J' || c >= 'L' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'j' || c >= 'l' && c <= 'z':
+		goto yystate51
+	}
+
+yystate360:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule86
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate361:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'I' || c == 'i':
+		goto yystate362
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
+	}
+
+yystate362:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'D' || c == 'd':
+		goto yystate363
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z':
+		goto yystate51
+	}
+
+yystate363:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule87
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate364:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'N' || c == 'n':
+		goto yystate365
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
+	}
+
+yystate365:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate366
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate366:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule122
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate367:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate368
+	case c == 'T' || c == 't':
+		goto yystate374
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
+	}
+
+yystate368:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'L' || c == 'l':
+		goto yystate369
+	case c == 'T' || c == 't':
+		goto yystate373
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
+	}
+
+yystate369:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate370
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate370:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'C' || c == 'c':
+		goto yystate371
+	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
+		goto yystate51
+	}
+
+yystate371:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate372
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
-yystate239:
+yystate372:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule88
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate373:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule89
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate374:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'O' || c == 'o':
+		goto yystate375
+	case c == 'R' || c == 'r':
+		goto yystate379
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c == 'P' || c == 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c == 'p' || c == 'q' || c >= 's' && c <= 'z':
+		goto yystate51
+	}
+
+yystate375:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'R' || c == 'r':
+		goto yystate376
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
+	}
+
+yystate376:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate377
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate377:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'D' || c == 'd':
+		goto yystate378
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z':
+		goto yystate51
+	}
+
+yystate378:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule90
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate379:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'I' || c == 'i':
+		goto yystate380
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
+	}
+
+yystate380:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'N' || c == 'n':
+		goto yystate381
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
+	}
+
+yystate381:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'G' || c == 'g':
+		goto yystate382
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'H' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'f' || c >= 'h' && c <= 'z':
+		goto yystate51
+	}
+
+yystate382:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule123
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate383:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate384
+	case c == 'H' || c == 'h':
+		goto yystate394
+	case c == 'I' || c == 'i':
+		goto yystate397
+	case c == 'R' || c == 'r':
+		goto yystate400
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'G' || c >= 'J' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'g' || c >= 'j' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
+	}
+
+yystate384:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'B' || c == 'b':
+		goto yystate385
+	case c >= '0' && c <= '9' || c == 'A' || c >= 'C' && c <= 'Z' || c == '_' || c == 'a' || c >= 'c' && c <= 'z':
+		goto yystate51
+	}
+
+yystate385:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'L' || c == 'l':
+		goto yystate386
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
+	}
+
+yystate386:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate387
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate387:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule91
+	case c == 'S' || c == 's':
+		goto yystate388
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yystate51
+	}
+
+yystate388:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate389
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
+	}
+
+yystate389:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'M' || c == 'm':
+		goto yystate390
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z':
+		goto yystate51
+	}
+
+yystate390:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'P' || c == 'p':
+		goto yystate391
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z':
+		goto yystate51
+	}
+
+yystate391:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'L' || c == 'l':
+		goto yystate392
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
+	}
+
+yystate392:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate393
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate393:
 	c = l.next()
 	switch {
 	default:
 		goto yyrule92
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate394:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
 	case c == 'A' || c == 'a':
-		goto yystate240
+		goto yystate395
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
+		goto yystate51
+	}
+
+yystate395:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'N' || c == 'n':
+		goto yystate396
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
+		goto yystate51
+	}
+
+yystate396:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule93
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate397:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'M' || c == 'm':
+		goto yystate398
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'L' || c >= 'N' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'l' || c >= 'n' && c <= 'z':
+		goto yystate51
+	}
+
+yystate398:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate399
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate399:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule124
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate400:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate401
 	case c == 'U' || c == 'u':
-		goto yystate249
+		goto yystate410
 	case c >= '0' && c <= '9' || c >= 'B' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'b' && c <= 't' || c >= 'v' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate240:
+yystate401:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'N' || c == 'n':
-		goto yystate241
+		goto yystate402
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate241:
+yystate402:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'S' || c == 's':
-		goto yystate242
+		goto yystate403
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate242:
+yystate403:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'A' || c == 'a':
-		goto yystate243
+		goto yystate404
 	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate243:
+yystate404:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'C' || c == 'c':
-		goto yystate244
+		goto yystate405
 	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate244:
+yystate405:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'T' || c == 't':
-		goto yystate245
+		goto yystate406
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate245:
+yystate406:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'I' || c == 'i':
-		goto yystate246
+		goto yystate407
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate246:
+yystate407:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'O' || c == 'o':
-		goto yystate247
+		goto yystate408
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate247:
+yystate408:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'N' || c == 'n':
-		goto yystate248
+		goto yystate409
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate248:
+yystate409:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule59
+		goto yyrule94
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate249:
+yystate410:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'E' || c == 'e':
-		goto yystate250
+		goto yystate411
 	case c == 'N' || c == 'n':
-		goto yystate251
+		goto yystate412
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'm' || c >= 'o' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate250:
+yystate411:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule67
+		goto yyrule104
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate251:
+yystate412:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'C' || c == 'c':
-		goto yystate252
+		goto yystate413
 	case c >= '0' && c <= '9' || c == 'A' || c == 'B' || c >= 'D' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate252:
+yystate413:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'A' || c == 'a':
-		goto yystate253
+		goto yystate414
 	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate253:
+yystate414:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'T' || c == 't':
-		goto yystate254
+		goto yystate415
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate254:
+yystate415:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'E' || c == 'e':
-		goto yystate255
+		goto yystate416
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate255:
+yystate416:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule60
+		goto yyrule95
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate256:
+yystate417:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'I' || c == 'i':
-		goto yystate257
+		goto yystate418
 	case c == 'N' || c == 'n':
-		goto yystate267
+		goto yystate428
 	case c == 'P' || c == 'p':
-		goto yystate272
+		goto yystate433
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'M' || c == 'O' || c >= 'Q' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'm' || c == 'o' || c >= 'q' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate257:
+yystate418:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'N' || c == 'n':
-		goto yystate258
+		goto yystate419
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'M' || c >= 'O' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate258:
+yystate419:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'T' || c == 't':
-		goto yystate259
+		goto yystate420
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate259:
+yystate420:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule87
+		goto yyrule125
 	case c == '0' || c == '2' || c == '4' || c == '5' || c == '7' || c == '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	case c == '1':
-		goto yystate260
+		goto yystate421
 	case c == '3':
-		goto yystate262
+		goto yystate423
 	case c == '6':
-		goto yystate264
+		goto yystate425
 	case c == '8':
-		goto yystate266
+		goto yystate427
 	}
 
-yystate260:
+yystate421:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == '6':
-		goto yystate261
+		goto yystate422
 	case c >= '0' && c <= '5' || c >= '7' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate261:
+yystate422:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule88
+		goto yyrule126
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate262:
+yystate423:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == '0' || c == '1' || c >= '3' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	case c == '2':
-		goto yystate263
+		goto yystate424
 	}
 
-yystate263:
+yystate424:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule89
+		goto yyrule127
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate264:
+yystate425:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == '4':
-		goto yystate265
+		goto yystate426
 	case c >= '0' && c <= '3' || c >= '5' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate265:
+yystate426:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule90
+		goto yyrule128
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate266:
+yystate427:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule91
+		goto yyrule129
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate267:
+yystate428:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'I' || c == 'i':
-		goto yystate268
+		goto yystate429
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate268:
+yystate429:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'Q' || c == 'q':
-		goto yystate269
+		goto yystate430
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'P' || c >= 'R' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'p' || c >= 'r' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate269:
+yystate430:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'U' || c == 'u':
-		goto yystate270
+		goto yystate431
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate270:
+yystate431:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'E' || c == 'e':
-		goto yystate271
+		goto yystate432
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate271:
+yystate432:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule62
+		goto yyrule97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate272:
+yystate433:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'D' || c == 'd':
-		goto yystate273
+		goto yystate434
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'C' || c >= 'E' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate273:
+yystate434:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'A' || c == 'a':
-		goto yystate274
+		goto yystate435
 	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate274:
+yystate435:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'T' || c == 't':
-		goto yystate275
+		goto yystate436
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate275:
+yystate436:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'E' || c == 'e':
-		goto yystate276
+		goto yystate437
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate276:
+yystate437:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule61
+		goto yyrule96
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate277:
+yystate438:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'A' || c == 'a':
-		goto yystate278
+		goto yystate439
+	case c == 'I' || c == 'i':
+		goto yystate444
+	case c >= '0' && c <= '9' || c >= 'B' && c <= 'H' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'h' || c >= 'j' && c <= 'z':
+		goto yystate51
+	}
+
+yystate439:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'L' || c == 'l':
+		goto yystate440
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
+	}
+
+yystate440:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'U' || c == 'u':
+		goto yystate441
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z':
+		goto yystate51
+	}
+
+yystate441:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate442
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
+	}
+
+yystate442:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'S' || c == 's':
+		goto yystate443
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
+		goto yystate51
+	}
+
+yystate443:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule98
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate444:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'R' || c == 'r':
+		goto yystate445
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
+	}
+
+yystate445:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate446
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
+	}
+
+yystate446:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'U' || c == 'u':
+		goto yystate447
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z':
+		goto yystate51
+	}
+
+yystate447:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'A' || c == 'a':
+		goto yystate448
 	case c >= '0' && c <= '9' || c >= 'B' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z':
-		goto yystate49
+		goto yystate51
+	}
+
+yystate448:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'L' || c == 'l':
+		goto yystate449
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
+		goto yystate51
+	}
+
+yystate449:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule99
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
+
+yystate450:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule130
+	case c == 'H' || c == 'h':
+		goto yystate451
+	case c == 'I' || c == 'i':
+		goto yystate455
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'G' || c >= 'J' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'g' || c >= 'j' && c <= 'z':
+		goto yystate51
 	}
 
-yystate278:
+yystate451:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'L' || c == 'l':
-		goto yystate279
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'K' || c >= 'M' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'E' || c == 'e':
+		goto yystate452
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
+		goto yystate51
 	}
 
-yystate279:
+yystate452:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'U' || c == 'u':
-		goto yystate280
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'R' || c == 'r':
+		goto yystate453
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
+		goto yystate51
 	}
 
-yystate280:
+yystate453:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'E' || c == 'e':
-		goto yystate281
+		goto yystate454
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate281:
+yystate454:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'S' || c == 's':
-		goto yystate282
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'R' || c >= 'T' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z':
-		goto yystate49
+		goto yyrule100
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
 	}
 
-yystate282:
+yystate455:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule63
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate456
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
-yystate283:
+yystate456:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
+		goto yyrule130
 	case c == 'H' || c == 'h':
-		goto yystate284
+		goto yystate457
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'G' || c >= 'I' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'g' || c >= 'i' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate284:
+yystate457:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
-		goto yystate285
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'O' || c == 'o':
+		goto yystate458
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'N' || c >= 'P' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z':
+		goto yystate51
 	}
 
-yystate285:
+yystate458:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'R' || c == 'r':
-		goto yystate286
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Q' || c >= 'S' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'U' || c == 'u':
+		goto yystate459
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'T' || c >= 'V' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z':
+		goto yystate51
 	}
 
-yystate286:
+yystate459:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule92
-	case c == 'E' || c == 'e':
-		goto yystate287
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'D' || c >= 'F' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z':
-		goto yystate49
+		goto yyrule130
+	case c == 'T' || c == 't':
+		goto yystate460
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'S' || c >= 'U' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z':
+		goto yystate51
 	}
 
-yystate287:
+yystate460:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule64
+		goto yyrule101
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
-		goto yystate49
+		goto yystate51
 	}
 
-yystate288:
+yystate461:
 	c = l.next()
-	goto yyrule11
+	switch {
+	default:
+		goto yyrule130
+	case c == '\'':
+		goto yystate462
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z':
+		goto yystate51
+	}
 
-yystate289:
+yystate462:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule94
+		goto yyabort
+	case c == '\'':
+		goto yystate463
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'a' && c <= 'f':
+		goto yystate462
+	}
+
+yystate463:
+	c = l.next()
+	goto yyrule10
+
+yystate464:
+	c = l.next()
+	goto yyrule12
+
+yystate465:
+	c = l.next()
+	switch {
+	default:
+		goto yyrule132
 	case c == '|':
-		goto yystate290
+		goto yystate466
 	}
 
-yystate290:
+yystate466:
 	c = l.next()
-	goto yyrule22
+	goto yyrule24
 
-	goto yystate291 // silence unused label error
-yystate291:
+	goto yystate467 // silence unused label error
+yystate467:
 	c = l.next()
-yystart291:
+yystart467:
 	switch {
 	default:
-		goto yystate292 // c >= '\x01' && c <= '!' || c >= '#' && c <= '[' || c >= ']' && c <= 'ÿ'
+		goto yystate468 // c >= '\x01' && c <= '!' || c >= '#' && c <= '[' || c >= ']' && c <= 'ÿ'
 	case c == '"':
-		goto yystate293
+		goto yystate469
 	case c == '\\':
-		goto yystate294
+		goto yystate470
 	case c == '\x00':
 		goto yystate2
 	}
 
-yystate292:
+yystate468:
 	c = l.next()
 	switch {
 	default:
 		goto yyabort
 	case c == '"':
-		goto yystate293
+		goto yystate469
 	case c == '\\':
-		goto yystate294
+		goto yystate470
 	case c >= '\x01' && c <= '!' || c >= '#' && c <= '[' || c >= ']' && c <= 'ÿ':
-		goto yystate292
+		goto yystate468
 	}
 
-yystate293:
+yystate469:
 	c = l.next()
-	goto yyrule13
+	goto yyrule14
 
-yystate294:
+yystate470:
 	c = l.next()
 	switch {
 	default:
 		goto yyabort
 	case c == '"':
-		goto yystate295
+		goto yystate471
 	case c == '\\':
-		goto yystate294
+		goto yystate470
 	case c >= '\x01' && c <= '!' || c >= '#' && c <= '[' || c >= ']' && c <= 'ÿ':
-		goto yystate292
+		goto yystate468
 	}
 
-yystate295:
+yystate471:
 	c = l.next()
 	switch {
 	default:
-		goto yyrule13
+		goto yyrule14
 	case c == '"':
-		goto yystate293
+		goto yystate469
 	case c == '\\':
-		goto yystate294
+		goto yystate470
 	case c >= '\x01' && c <= '!' || c >= '#' && c <= '[' || c >= ']' && c <= 'ÿ':
-		goto yystate292
+		goto yystate468
 	}
 
-	goto yystate296 // silence unused label error
-yystate296:
+	goto yystate472 // silence unused label error
+yystate472:
 	c = l.next()
-yystart296:
+yystart472:
 	switch {
 	default:
-		goto yystate297 // c >= '\x01' && c <= '_' || c >= 'a' && c <= 'ÿ'
+		goto yystate473 // c >= '\x01' && c <= '_' || c >= 'a' && c <= 'ÿ'
 	case c == '\x00':
 		goto yystate2
 	case c == '`':
-		goto yystate298
+		goto yystate474
 	}
 
-yystate297:
+yystate473:
 	c = l.next()
 	switch {
 	default:
 		goto yyabort
 	case c == '`':
-		goto yystate298
+		goto yystate474
 	case c >= '\x01' && c <= '_' || c >= 'a' && c <= 'ÿ':
-		goto yystate297
+		goto yystate473
 	}
 
-yystate298:
+yystate474:
 	c = l.next()
-	goto yyrule14
+	goto yyrule15
 
 yyrule1: // \0
 	{
@@ -3339,17 +5266,21 @@ yyrule9: // {float_lit}
 	{
 		return l.float(lval, false)
 	}
-yyrule10: // \"
+yyrule10: // [xX]'[0-9a-fA-F]*'
+	{
+		return l.blob(lval)
+	}
+yyrule11: // \"
 	{
 		l.sc = S1
 		goto yystate0
 	}
-yyrule11: // `
+yyrule12: // `
 	{
 		l.sc = S2
 		goto yystate0
 	}
-yyrule12: // '(\\.|[^'])*'
+yyrule13: // '(\\.|[^'])*'
 	{
 		if ret := l.str(lval, ""); ret != stringLit {
 			return ret
@@ -3357,361 +5288,545 @@ yyrule12: // '(\\.|[^'])*'
 		lval.item = idealRune(lval.item.(string)[0])
 		return intLit
 	}
-yyrule13: // (\\.|[^\"])*\"
+yyrule14: // (\\.|[^\"])*\"
 	{
 		return l.str(lval, "\"")
 	}
-yyrule14: // ([^`]|\n)*`
+yyrule15: // ([^`]|\n)*`
 	{
 		return l.str(lval, "`")
 	}
-yyrule15: // "&&"
+yyrule16: // "&&"
 	{
 		return andand
 	}
-yyrule16: // "&^"
+yyrule17: // "::"
+	{
+		return dcolon
+	}
+yyrule18: // "&^"
 	{
 		return andnot
 	}
-yyrule17: // "<<"
+yyrule19: // "<<"
 	{
 		return lsh
 	}
-yyrule18: // "<="
+yyrule20: // "<="
 	{
 		return le
 	}
-yyrule19: // "=="
+yyrule21: // "=="
 	{
 		return eq
 	}
-yyrule20: // ">="
+yyrule22: // ">="
 	{
 		return ge
 	}
-yyrule21: // "!="
+yyrule23: // "!="
 	{
 		return neq
 	}
-yyrule22: // "||"
+yyrule24: // "||"
 	{
 		return oror
 	}
-yyrule23: // ">>"
+yyrule25: // ">>"
 	{
 		return rsh
 	}
-yyrule24: // {add}
+yyrule26: // {add}
 	{
 		return add
 	}
-yyrule25: // {alter}
+yyrule27: // {alter}
 	{
 		return alter
 	}
-yyrule26: // {and}
+yyrule28: // {analyze}
+	{
+		lval.item = string(l.val)
+		return analyze
+	}
+yyrule29: // {and}
 	{
 		return and
 	}
-yyrule27: // {asc}
+yyrule30: // {asc}
 	{
 		return asc
 	}
-yyrule28: // {as}
+yyrule31: // {attach}
+	{
+		lval.item = string(l.val)
+		return attach
+	}
+yyrule32: // {as}
 	{
 		return as
 	}
-yyrule29: // {begin}
+yyrule33: // {begin}
 	{
 		return begin
 	}
-yyrule30: // {between}
+yyrule34: // {between}
 	{
 		return between
 	}
-yyrule31: // {by}
+yyrule35: // {by}
 	{
 		return by
 	}
-yyrule32: // {column}
+yyrule36: // {cast}
+	{
+		lval.item = string(l.val)
+		return castKwd
+	}
+yyrule37: // {collate}
+	{
+		lval.item = string(l.val)
+		return collateKwd
+	}
+yyrule38: // {column}
 	{
 		return column
 	}
-yyrule33: // {commit}
+yyrule39: // {comment}
+	{
+		lval.item = string(l.val)
+		return comment
+	}
+yyrule40: // {commit}
 	{
 		return commit
 	}
-yyrule34: // {create}
+yyrule41: // {conflict}
+	{
+		lval.item = string(l.val)
+		return conflict
+	}
+yyrule42: // {create}
 	{
 		return create
 	}
-yyrule35: // {delete}
+yyrule43: // {database}
+	{
+		lval.item = string(l.val)
+		return database
+	}
+yyrule44: // {delete}
 	{
 		return deleteKwd
 	}
-yyrule36: // {desc}
+yyrule45: // {desc}
 	{
 		return desc
 	}
-yyrule37: // {distinct}
+yyrule46: // {detach}
+	{
+		lval.item = string(l.val)
+		return detach
+	}
+yyrule47: // {dictionary}
+	{
+		lval.item = string(l.val)
+		return dictionaryKwd
+	}
+yyrule48: // {distinct}
 	{
 		return distinct
 	}
-yyrule38: // {drop}
+yyrule49: // {do}
+	{
+		lval.item = string(l.val)
+		return do
+	}
+yyrule50: // {drop}
 	{
 		return drop
 	}
-yyrule39: // {exists}
+yyrule51: // {escape}
+	{
+		lval.item = string(l.val)
+		return escape
+	}
+yyrule52: // {exists}
 	{
 		return exists
 	}
-yyrule40: // {from}
+yyrule53: // {for}
+	{
+		lval.item = string(l.val)
+		return forKwd
+	}
+yyrule54: // {from}
 	{
 		return from
 	}
-yyrule41: // {group}
+yyrule55: // {fulltext}
+	{
+		lval.item = string(l.val)
+		return fulltext
+	}
+yyrule56: // {group}
 	{
 		return group
 	}
-yyrule42: // {if}
+yyrule57: // {hash}
+	{
+		lval.item = string(l.val)
+		return hash
+	}
+yyrule58: // {if}
 	{
 		return ifKwd
 	}
-yyrule43: // {index}
+yyrule59: // {ignore}
+	{
+		lval.item = string(l.val)
+		return ignore
+	}
+yyrule60: // {ilike}
+	{
+		lval.item = string(l.val)
+		return ilike
+	}
+yyrule61: // {index}
 	{
 		return index
 	}
-yyrule44: // {insert}
+yyrule62: // {insert}
 	{
 		return insert
 	}
-yyrule45: // {into}
+yyrule63: // {into}
 	{
 		return into
 	}
-yyrule46: // {in}
+yyrule64: // {in}
 	{
 		return in
 	}
-yyrule47: // {is}
+yyrule65: // {is}
 	{
 		return is
 	}
-yyrule48: // {like}
+yyrule66: // {key}
+	{
+		lval.item = string(l.val)
+		return key
+	}
+yyrule67: // {less}
+	{
+		lval.item = string(l.val)
+		return less
+	}
+yyrule68: // {like}
 	{
 		return like
 	}
-yyrule49: // {limit}
+yyrule69: // {limit}
 	{
 		return limit
 	}
-yyrule50: // {not}
+yyrule70: // {match}
+	{
+		lval.item = string(l.val)
+		return match
+	}
+yyrule71: // {not}
 	{
 		return not
 	}
-yyrule51: // {offset}
+yyrule72: // {offset}
 	{
 		return offset
 	}
-yyrule52: // {on}
+yyrule73: // {on}
 	{
 		return on
 	}
-yyrule53: // {or}
+yyrule74: // {or}
 	{
 		return or
 	}
-yyrule54: // {order}
+yyrule75: // {order}
 	{
 		return order
 	}
-yyrule55: // {rollback}
+yyrule76: // {partition}
+	{
+		lval.item = string(l.val)
+		return partitionKwd
+	}
+yyrule77: // {partitions}
+	{
+		lval.item = string(l.val)
+		return partitionsKwd
+	}
+yyrule78: // {percent}
+	{
+		lval.item = string(l.val)
+		return percent
+	}
+yyrule79: // {pragma}
+	{
+		lval.item = string(l.val)
+		return pragma
+	}
+yyrule80: // {primary}
+	{
+		lval.item = string(l.val)
+		return primary
+	}
+yyrule81: // {range}
+	{
+		lval.item = string(l.val)
+		return rangeKwd
+	}
+yyrule82: // {reindex}
+	{
+		lval.item = string(l.val)
+		return reindex
+	}
+yyrule83: // {repeatable}
+	{
+		lval.item = string(l.val)
+		return repeatable
+	}
+yyrule84: // {replace}
+	{
+		lval.item = string(l.val)
+		return replace
+	}
+yyrule85: // {returning}
+	{
+		lval.item = string(l.val)
+		return returning
+	}
+yyrule86: // {rollback}
 	{
 		return rollback
 	}
-yyrule56: // {select}
+yyrule87: // {rowid}
+	{
+		lval.item = string(l.val)
+		return rowid
+	}
+yyrule88: // {select}
 	{
 		l.agg = append(l.agg, false)
 		return selectKwd
 	}
-yyrule57: // {set}
+yyrule89: // {set}
 	{
 		return set
 	}
-yyrule58: // {table}
+yyrule90: // {stored}
+	{
+		lval.item = string(l.val)
+		return stored
+	}
+yyrule91: // {table}
 	{
 		return tableKwd
 	}
-yyrule59: // {transaction}
+yyrule92: // {tablesample}
+	{
+		lval.item = string(l.val)
+		return tablesample
+	}
+yyrule93: // {than}
+	{
+		lval.item = string(l.val)
+		return than
+	}
+yyrule94: // {transaction}
 	{
 		return transaction
 	}
-yyrule60: // {truncate}
+yyrule95: // {truncate}
 	{
 		return truncate
 	}
-yyrule61: // {update}
+yyrule96: // {update}
 	{
 		return update
 	}
-yyrule62: // {unique}
+yyrule97: // {unique}
 	{
 		return unique
 	}
-yyrule63: // {values}
+yyrule98: // {values}
 	{
 		return values
 	}
-yyrule64: // {where}
+yyrule99: // {virtual}
+	{
+		lval.item = string(l.val)
+		return virtual
+	}
+yyrule100: // {where}
 	{
 		return where
 	}
-yyrule65: // {null}
+yyrule101: // {without}
+	{
+		lval.item = string(l.val)
+		return without
+	}
+yyrule102: // {null}
 	{
 		lval.item = nil
 		return null
 	}
-yyrule66: // {false}
+yyrule103: // {false}
 	{
 		lval.item = false
 		return falseKwd
 	}
-yyrule67: // {true}
+yyrule104: // {true}
 	{
 		lval.item = true
 		return trueKwd
 	}
-yyrule68: // {bigint}
+yyrule105: // {array}
+	{
+		lval.item = string(l.val)
+		return arrayType
+	}
+yyrule106: // {bigint}
 	{
 		lval.item = qBigInt
 		return bigIntType
 	}
-yyrule69: // {bigrat}
+yyrule107: // {bigrat}
 	{
 		lval.item = qBigRat
 		return bigRatType
 	}
-yyrule70: // {blob}
+yyrule108: // {blob}
 	{
 		lval.item = qBlob
 		return blobType
 	}
-yyrule71: // {bool}
+yyrule109: // {bool}
 	{
 		lval.item = qBool
 		return boolType
 	}
-yyrule72: // {byte}
+yyrule110: // {byte}
 	{
 		lval.item = qUint8
 		return byteType
 	}
-yyrule73: // {complex}128
+yyrule111: // {complex}128
 	{
 		lval.item = qComplex128
 		return complex128Type
 	}
-yyrule74: // {complex}64
+yyrule112: // {complex}64
 	{
 		lval.item = qComplex64
 		return complex64Type
 	}
-yyrule75: // {duration}
+yyrule113: // {duration}
 	{
 		lval.item = qDuration
 		return durationType
 	}
-yyrule76: // {float}
+yyrule114: // {float}
 	{
 		lval.item = qFloat64
 		return floatType
 	}
-yyrule77: // {float}32
+yyrule115: // {float}32
 	{
 		lval.item = qFloat32
 		return float32Type
 	}
-yyrule78: // {float}64
+yyrule116: // {float}64
 	{
 		lval.item = qFloat64
 		return float64Type
 	}
-yyrule79: // {int}
+yyrule117: // {int}
 	{
 		lval.item = qInt64
 		return intType
 	}
-yyrule80: // {int}16
+yyrule118: // {int}16
 	{
 		lval.item = qInt16
 		return int16Type
 	}
-yyrule81: // {int}32
+yyrule119: // {int}32
 	{
 		lval.item = qInt32
 		return int32Type
 	}
-yyrule82: // {int}64
+yyrule120: // {int}64
 	{
 		lval.item = qInt64
 		return int64Type
 	}
-yyrule83: // {int}8
+yyrule121: // {int}8
 	{
 		lval.item = qInt8
 		return int8Type
 	}
-yyrule84: // {rune}
+yyrule122: // {rune}
 	{
 		lval.item = qInt32
 		return runeType
 	}
-yyrule85: // {string}
+yyrule123: // {string}
 	{
 		lval.item = qString
 		return stringType
 	}
-yyrule86: // {time}
+yyrule124: // {time}
 	{
 		lval.item = qTime
 		return timeType
 	}
-yyrule87: // {uint}
+yyrule125: // {uint}
 	{
 		lval.item = qUint64
 		return uintType
 	}
-yyrule88: // {uint}16
+yyrule126: // {uint}16
 	{
 		lval.item = qUint16
 		return uint16Type
 	}
-yyrule89: // {uint}32
+yyrule127: // {uint}32
 	{
 		lval.item = qUint32
 		return uint32Type
 	}
-yyrule90: // {uint}64
+yyrule128: // {uint}64
 	{
 		lval.item = qUint64
 		return uint64Type
 	}
-yyrule91: // {uint}8
+yyrule129: // {uint}8
 	{
 		lval.item = qUint8
 		return uint8Type
 	}
-yyrule92: // {ident}
+yyrule130: // {ident}
 	{
 		lval.item = string(l.val)
 		return identifier
 	}
-yyrule93: // ($|\?){D}
+yyrule131: // ($|\?){D}
 	{
 		lval.item, _ = strconv.Atoi(string(l.val[1:]))
 		return qlParam
 	}
-yyrule94: // .
+yyrule132: // .
 	{
 		return c0
 	}
@@ -3741,7 +5856,27 @@ func (l *lexer) str(lval *yySymType, pref string) int {
 	}
 
 	lval.item = s
-	return stringLit
+	if pref == "" || pref[0] != l.quote {
+		return stringLit
+	}
+
+	if s == "" || strings.Contains(s, ".") {
+		l.err("invalid quoted identifier %q", s)
+		return int(unicode.ReplacementChar)
+	}
+
+	return identifier
+}
+
+func (l *lexer) blob(lval *yySymType) int {
+	b, err := hex.DecodeString(string(l.val[2 : len(l.val)-1]))
+	if err != nil {
+		l.err("blob literal: %v", err)
+		return int(unicode.ReplacementChar)
+	}
+
+	lval.item = b
+	return blobLit
 }
 
 func (l *lexer) int(lval *yySymType, im bool) int {
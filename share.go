@@ -0,0 +1,101 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+)
+
+var (
+	sharedDBs     = map[string]*DB{}           // Open DB files by canonical path, see OpenFile.
+	sharedMu      sync.Mutex                   // Guards sharedDBs and sharedOpening.
+	sharedOpening = map[string]chan struct{}{} // DB files being opened, closed by opened.
+)
+
+// canonicalPath returns the absolute path of the file name with symbolic
+// links resolved. The links of a file which does not exist yet are not
+// resolved.
+func canonicalPath(name string) (string, error) {
+	p, err := filepath.Abs(name)
+	if err != nil {
+		return "", err
+	}
+
+	if q, err := filepath.EvalSymlinks(p); err == nil {
+		p = q
+	}
+	return p, nil
+}
+
+// shared returns the open DB of the DB file path and counts the open. If the
+// DB file is not open, shared returns nil and the caller must open it, make it
+// shared by share and call opened, even if opening fails. Meanwhile the other
+// calls of shared for path wait until opened is called or ctx is done.
+func shared(ctx context.Context, path string) (*DB, error) {
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+
+	for {
+		if db := sharedDBs[path]; db != nil {
+			db.refs++
+			return db, nil
+		}
+
+		c := sharedOpening[path]
+		if c == nil {
+			sharedOpening[path] = make(chan struct{})
+			return nil, nil
+		}
+
+		sharedMu.Unlock()
+		select {
+		case <-c:
+		case <-ctx.Done():
+			sharedMu.Lock()
+			return nil, ctx.Err()
+		}
+		sharedMu.Lock()
+	}
+}
+
+// opened ends opening the DB file path, see shared.
+func opened(path string) {
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+
+	close(sharedOpening[path])
+	delete(sharedOpening, path)
+}
+
+// share makes db the shared DB of the DB file path.
+func (db *DB) share(path string) {
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+
+	if sharedDBs[path] == nil {
+		sharedDBs[path] = db
+		db.path, db.refs = path, 1
+	}
+}
+
+// release counts a Close of db. It returns true if db is still shared by other
+// opens and must not be closed yet. Otherwise db stops being shared.
+func (db *DB) release() bool {
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+
+	if db.refs > 1 {
+		db.refs--
+		return true
+	}
+
+	if sharedDBs[db.path] == db {
+		delete(sharedDBs, db.path)
+	}
+	db.refs = 0
+	return false
+}
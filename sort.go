@@ -0,0 +1,213 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"container/heap"
+	"io"
+)
+
+// sortFanIn is the maximum number of sorted runs kept by a sortTemp. Reaching
+// it merges the runs into one.
+const sortFanIn = 64
+
+// sortTemp sorts the records of ORDER BY in a DB file by an external merge
+// sort. The records are collected in an in-memory temp which, once it outgrows
+// Options.TempSpillThreshold, becomes a sorted run in a temp file and is
+// replaced by a new, empty one. SeekFirst merges the runs. Unlike a single
+// temp moved to a temp file, the runs are written once, sequentially, instead
+// of being updated at random offsets by every following record.
+type sortTemp struct {
+	asc  bool
+	cur  *fileTemp   // Kept in memory.
+	runs []*fileTemp // Sorted runs in temp files.
+	src  *file
+}
+
+func (s *file) CreateSorter(asc bool) (sorter, error) {
+	t, err := s.CreateTemp(asc)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.tempSpill < 0 { // Nothing is kept in memory.
+		return t, nil
+	}
+
+	return &sortTemp{asc: asc, cur: t.(*fileTemp), src: s}, nil
+}
+
+func (t *sortTemp) Set(k, v []interface{}) (err error) {
+	if err = t.cur.Set(k, v); err != nil || t.cur.mf != nil {
+		return
+	}
+
+	// cur was moved to a temp file.
+	t.runs = append(t.runs, t.cur)
+	t.cur = nil
+	if len(t.runs) == sortFanIn {
+		r, err := t.merge(t.runs)
+		if err != nil {
+			return err
+		}
+
+		runs := t.runs
+		t.runs = []*fileTemp{r}
+		for _, v := range runs {
+			errSet(&err, v.Drop())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	x, err := t.src.CreateTemp(t.asc)
+	if err != nil {
+		return
+	}
+
+	t.cur = x.(*fileTemp)
+	return
+}
+
+// merge returns a new run having the records of runs.
+func (t *sortTemp) merge(runs []*fileTemp) (r *fileTemp, err error) {
+	x, err := t.src.CreateTemp(t.asc)
+	if err != nil {
+		return
+	}
+
+	r = x.(*fileTemp)
+	defer func() {
+		if err != nil {
+			r.Drop()
+			r = nil
+		}
+	}()
+
+	if r.mf != nil {
+		if err = r.spill(); err != nil {
+			return
+		}
+	}
+
+	it, err := t.seekFirst(runs)
+	if err != nil {
+		if err != io.EOF {
+			return
+		}
+
+		it = &mergeIterator{}
+	}
+
+	for {
+		k, v, err := it.Next()
+		if err != nil {
+			return r, noEOF(err)
+		}
+
+		if err = r.Set(k, v); err != nil {
+			return r, err // Dropped by the deferred function.
+		}
+	}
+}
+
+func (t *sortTemp) Drop() (err error) {
+	for _, v := range t.runs {
+		errSet(&err, v.Drop())
+	}
+	t.runs = nil
+	if t.cur != nil {
+		errSet(&err, t.cur.Drop())
+		t.cur = nil
+	}
+	return
+}
+
+func (t *sortTemp) SeekFirst() (btreeIterator, error) {
+	if len(t.runs) == 0 {
+		return t.cur.SeekFirst()
+	}
+
+	return t.seekFirst(append(t.runs[:len(t.runs):len(t.runs)], t.cur))
+}
+
+// seekFirst returns an iterator merging the runs. It returns io.EOF if all
+// the runs are empty.
+func (t *sortTemp) seekFirst(runs []*fileTemp) (btreeIterator, error) {
+	it := &mergeIterator{k: 1}
+	if !t.asc {
+		it.k = -1
+	}
+	for _, v := range runs {
+		x, err := v.SeekFirst()
+		if err != nil {
+			if err == io.EOF {
+				continue
+			}
+
+			return nil, err
+		}
+
+		h := &mergeHead{it: x}
+		if h.k, h.v, err = x.Next(); err != nil {
+			if err == io.EOF {
+				continue
+			}
+
+			return nil, err
+		}
+
+		it.heads = append(it.heads, h)
+	}
+	if len(it.heads) == 0 {
+		return nil, io.EOF
+	}
+
+	heap.Init(it)
+	return it, nil
+}
+
+type mergeHead struct {
+	it   btreeIterator
+	k, v []interface{} // The next record of it.
+}
+
+// mergeIterator merges the records of sorted iterators. It implements
+// heap.Interface over their next records.
+type mergeIterator struct {
+	heads []*mergeHead
+	k     int // -1 for a descending order.
+}
+
+func (it *mergeIterator) Len() int           { return len(it.heads) }
+func (it *mergeIterator) Less(i, j int) bool { return it.k*collate(it.heads[i].k, it.heads[j].k) < 0 }
+func (it *mergeIterator) Swap(i, j int)      { it.heads[i], it.heads[j] = it.heads[j], it.heads[i] }
+func (it *mergeIterator) Push(x interface{}) { it.heads = append(it.heads, x.(*mergeHead)) }
+
+func (it *mergeIterator) Pop() interface{} {
+	n := len(it.heads) - 1
+	h := it.heads[n]
+	it.heads = it.heads[:n]
+	return h
+}
+
+func (it *mergeIterator) Next() (k, v []interface{}, err error) {
+	if len(it.heads) == 0 {
+		return nil, nil, io.EOF
+	}
+
+	h := it.heads[0]
+	k, v = h.k, h.v
+	switch h.k, h.v, err = h.it.Next(); {
+	case err == io.EOF:
+		heap.Pop(it)
+	case err != nil:
+		return nil, nil, err
+	default:
+		heap.Fix(it, 0)
+	}
+	return k, v, nil
+}
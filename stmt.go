@@ -8,22 +8,31 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 // NOTE: all stmt implementations must be safe for concurrent use by multiple
 // goroutines.  If the exec method requires any execution domain local data,
 // they must be held out of the implementing instance.
 var (
+	_ stmt = (*alterTableAddPartitionStmt)(nil)
 	_ stmt = (*alterTableAddStmt)(nil)
 	_ stmt = (*alterTableDropColumnStmt)(nil)
+	_ stmt = (*alterTableDropPartitionStmt)(nil)
+	_ stmt = (*analyzeStmt)(nil)
+	_ stmt = (*attachStmt)(nil)
 	_ stmt = (*createIndexStmt)(nil)
 	_ stmt = (*createTableStmt)(nil)
 	_ stmt = (*deleteStmt)(nil) //TODO use indices (need dlist)
+	_ stmt = (*detachStmt)(nil)
 	_ stmt = (*dropIndexStmt)(nil)
 	_ stmt = (*dropTableStmt)(nil)
 	_ stmt = (*insertIntoStmt)(nil)
+	_ stmt = (*pragmaStmt)(nil)
+	_ stmt = (*reindexStmt)(nil)
 	_ stmt = (*selectStmt)(nil)
 	_ stmt = (*truncateTableStmt)(nil)
 	_ stmt = (*updateStmt)(nil) //TODO use indices
@@ -48,18 +57,111 @@ type stmt interface {
 }
 
 type execCtx struct { //LATER +shared temp
-	db  *DB
-	arg []interface{}
+	db     *DB
+	arg    []interface{}
+	outer  map[interface{}]interface{} // Current row of the enclosing query, if any.
+	corr   bool                        // Subquery refers to outer.
+	temps  *[]temp                     // Materialized subqueries, dropped by drop.
+	tmo    *timeout                    // Statement execution deadline, if any.
+	strict bool                        // See Options.StrictConversions.
+}
+
+func newExecCtx(db *DB, arg []interface{}, tmo *timeout) *execCtx {
+	return &execCtx{db: db, arg: arg, temps: &[]temp{}, tmo: tmo, strict: db.config().strictConversions}
+}
+
+// timeout records the deadline of executing a statement list.
+type timeout struct {
+	d        time.Duration
+	deadline time.Time
+}
+
+// check returns a *TimeoutError if the deadline of x has passed.
+func (x *execCtx) check() error {
+	if x.tmo != nil && !time.Now().Before(x.tmo.deadline) {
+		return &TimeoutError{x.tmo.d}
+	}
+
+	return nil
+}
+
+// timed returns f amended to check the deadline of x before passing every
+// row.
+func (x *execCtx) timed(f func(id interface{}, data []interface{}) (more bool, err error)) func(id interface{}, data []interface{}) (more bool, err error) {
+	if x.tmo == nil {
+		return f
+	}
+
+	return func(id interface{}, data []interface{}) (more bool, err error) {
+		if err = x.check(); err != nil {
+			return false, err
+		}
+
+		return f(id, data)
+	}
+}
+
+// sub returns a context for executing a subquery of the query whose current
+// row is in outer.
+func (x *execCtx) sub(outer map[interface{}]interface{}) *execCtx {
+	return &execCtx{db: x.db, arg: x.arg, outer: outer, temps: x.temps, tmo: x.tmo, strict: x.strict}
+}
+
+// run executes the statements of l, which maintain the internal tables of
+// the database, with the arguments arg. The rows affected by them and the ids
+// of the rows inserted by them are not reported by the TCtx of x.
+func (x *execCtx) run(l List, arg ...interface{}) (err error) {
+	cc := *x
+	cc.arg = arg
+	tc, root := x.db.cc, x.db.root
+	rows, id := tc.RowsAffected, root.lastInsertID
+	defer func() { tc.RowsAffected, root.lastInsertID = rows, id }()
+
+	for _, v := range l.l {
+		if _, err = v.exec(&cc); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// outerField returns the value of the field name of the row of the nearest
+// enclosing query having such field.
+func (x *execCtx) outerField(name string) (v interface{}, ok bool) {
+	var a []*execCtx
+	for x != nil && x.outer != nil {
+		a = append(a, x)
+		if v, ok = x.outer[name]; ok {
+			for _, x := range a {
+				x.corr = true
+			}
+			return
+		}
+
+		x, _ = x.outer["$ctx"].(*execCtx)
+	}
+	return nil, false
+}
+
+func (x *execCtx) drop() (err error) {
+	for _, t := range *x.temps {
+		if e := t.Drop(); e != nil && err == nil {
+			err = e
+		}
+	}
+	*x.temps = nil
+	return
 }
 
 type updateStmt struct {
 	tableName string
 	list      []assignment
 	where     expression
+	returning []*fld // RETURNING clause, if not nil.
 }
 
 func (s *updateStmt) String() string {
-	u := fmt.Sprintf("UPDATE TABLE %s", s.tableName)
+	u := fmt.Sprintf("UPDATE TABLE %s", quoteIdent(s.tableName))
 	a := make([]string, len(s.list))
 	for i, v := range s.list {
 		a[i] = v.String()
@@ -68,43 +170,96 @@ func (s *updateStmt) String() string {
 	if s.where != nil {
 		w = fmt.Sprintf(" WHERE %s", s.where)
 	}
-	return fmt.Sprintf("%s %s%s", u, strings.Join(a, ", "), w)
+	return fmt.Sprintf("%s %s%s%s", u, strings.Join(a, ", "), w, returningString(s.returning))
+}
+
+func (s *updateStmt) exec(ctx *execCtx) (Recordset, error) {
+	defer changePartitions(ctx, s.tableName)
+	ret := newReturningRset(s.returning)
+	if err := s.update(ctx, ret); err != nil {
+		return nil, err
+	}
+
+	return ret.recordset(ctx), nil
 }
 
-func (s *updateStmt) exec(ctx *execCtx) (_ Recordset, err error) {
+// update updates the rows of s.tableName, adding them to ret, if not nil,
+// as they are after the update.
+func (s *updateStmt) update(ctx *execCtx, ret *returningRset) (err error) {
 	t, ok := ctx.db.root.tables[s.tableName]
 	if !ok {
-		return nil, fmt.Errorf("UPDATE: table %s does not exist", s.tableName)
+		return fmt.Errorf("UPDATE: table %s does not exist", s.tableName)
 	}
 
+	ret.init(t)
+
 	tcols := make([]*col, len(s.list))
 	for i, asgn := range s.list {
 		col := findCol(t.cols, asgn.colName)
 		if col == nil {
-			return nil, fmt.Errorf("UPDATE: unknown column %s", asgn.colName)
+			return fmt.Errorf("UPDATE: unknown column %s", asgn.colName)
+		}
+
+		if col.gen != nil {
+			return fmt.Errorf("UPDATE: cannot assign to generated column %s", asgn.colName)
 		}
+
 		tcols[i] = col
 	}
 
-	m := map[interface{}]interface{}{}
+	p, err := ctx.db.partitioning(t)
+	if err != nil {
+		return err
+	}
+
+	if p != nil {
+		for _, c := range tcols {
+			if c == p.col {
+				return fmt.Errorf("UPDATE: cannot assign to partitioning column %s", c.name)
+			}
+		}
+
+		for _, x := range p.prune(ctx, t, s.where) {
+			u := *s
+			u.tableName = x.t.name
+			if err = u.update(ctx, ret); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if selectsNone(s.where) {
+		return nil
+	}
+
+	m := map[interface{}]interface{}{"$ctx": ctx}
 	var nh int64
 	expr := s.where
-	blobCols := t.blobCols()
 	cc := ctx.db.cc
-	var old []interface{}
-	var touched []bool
-	if t.hasIndices() {
-		old = make([]interface{}, len(t.cols0))
-		touched = make([]bool, len(t.cols0))
-	}
+	vals := make([]interface{}, len(s.list))
+	virtual := t.hasGen(false)
 	for h := t.head; h != 0; h = nh {
+		if err = ctx.check(); err != nil {
+			return err
+		}
+
 		// Read can return lazily expanded chunks
 		data, err := t.store.Read(nil, h, t.cols...)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		nh = data[0].(int64)
+		if n := len(t.cols0) + 2 - len(data); n > 0 {
+			data = append(data, make([]interface{}, n)...)
+		}
+		if virtual {
+			if err = t.genRow(data[2:], false); err != nil {
+				return err
+			}
+		}
+
 		for _, col := range t.cols {
 			m[col.name] = data[2+col.index]
 		}
@@ -112,7 +267,7 @@ func (s *updateStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 		if expr != nil {
 			val, err := s.where.eval(m, ctx.arg)
 			if err != nil {
-				return nil, err
+				return err
 			}
 
 			if val == nil {
@@ -121,7 +276,7 @@ func (s *updateStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 
 			x, ok := val.(bool)
 			if !ok {
-				return nil, fmt.Errorf("invalid WHERE expression %s (value of type %T)", val, val)
+				return fmt.Errorf("invalid WHERE expression %s (value of type %T)", val, val)
 			}
 
 			if !x {
@@ -131,53 +286,34 @@ func (s *updateStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 
 		// hit
 		for i, asgn := range s.list {
-			val, err := asgn.expr.eval(m, ctx.arg)
-			if err != nil {
-				return nil, err
-			}
-
-			colIndex := tcols[i].index
-			if t.hasIndices() {
-				old[colIndex] = data[2+colIndex]
-				touched[colIndex] = true
+			if vals[i], err = asgn.expr.eval(m, ctx.arg); err != nil {
+				return err
 			}
-			data[2+colIndex] = val
 		}
-		if err = typeCheck(data[2:], t.cols); err != nil {
-			return nil, err
+		ctx.db.normalizeRow(vals)
+		if err = ctx.db.checkFloats(vals); err != nil {
+			return err
 		}
 
-		for i, v := range t.indices {
-			if i == 0 { // id() N/A
-				continue
-			}
-
-			if v == nil || !touched[i-1] {
-				continue
-			}
-
-			if err = v.x.Delete(old[i-1], h); err != nil {
-				return nil, err
-			}
+		old, err := ctx.db.changeRow(t, data)
+		if err != nil {
+			return err
 		}
 
-		if err = t.store.UpdateRow(h, blobCols, data...); err != nil { //LATER detect which blobs are actually affected
-			return nil, err
+		if err = t.updateRecord(h, data, tcols, vals); err != nil {
+			return err
 		}
 
-		for i, v := range t.indices {
-			if i == 0 { // id() N/A
-				continue
-			}
-
-			if v == nil || !touched[i-1] {
-				continue
-			}
+		if err = ret.add(t, data); err != nil {
+			return err
+		}
 
-			if err = v.x.Create(data[2+i-1], h); err != nil {
-				return nil, err
-			}
+		row, err := ctx.db.changeRow(t, data)
+		if err != nil {
+			return err
 		}
+
+		ctx.db.change(t, ChangeUpdate, data[1], old, row)
 		cc.RowsAffected++
 	}
 	return
@@ -187,30 +323,92 @@ func (s *updateStmt) isUpdating() bool { return true }
 
 type deleteStmt struct {
 	tableName string
-	where     expression
+	where     expression // Nil if all rows are deleted.
+	returning []*fld     // RETURNING clause, if not nil.
 }
 
 func (s *deleteStmt) String() string {
 	switch {
 	case s.where == nil:
-		return fmt.Sprintf("DELETE FROM %s;", s.tableName)
+		return fmt.Sprintf("DELETE FROM %s%s;", quoteIdent(s.tableName), returningString(s.returning))
 	default:
-		return fmt.Sprintf("DELETE FROM %s WHERE %s;", s.tableName, s.where)
+		return fmt.Sprintf("DELETE FROM %s WHERE %s%s;", quoteIdent(s.tableName), s.where, returningString(s.returning))
 	}
 }
 
-func (s *deleteStmt) exec(ctx *execCtx) (_ Recordset, err error) {
+func (s *deleteStmt) exec(ctx *execCtx) (Recordset, error) {
+	defer changePartitions(ctx, s.tableName)
+	ret := newReturningRset(s.returning)
+	if err := s.delete(ctx, ret); err != nil {
+		return nil, err
+	}
+
+	return ret.recordset(ctx), nil
+}
+
+// delete deletes the rows of s.tableName, adding them to ret, if not nil, as
+// they are before the delete.
+
+func (s *deleteStmt) delete(ctx *execCtx, ret *returningRset) (err error) {
 	t, ok := ctx.db.root.tables[s.tableName]
 	if !ok {
-		return nil, fmt.Errorf("DELETE FROM: table %s does not exist", s.tableName)
+		return fmt.Errorf("DELETE FROM: table %s does not exist", s.tableName)
+	}
+
+	ret.init(t)
+
+	p, err := ctx.db.partitioning(t)
+	if err != nil {
+		return err
+	}
+
+	if p != nil {
+		for _, x := range p.prune(ctx, t, s.where) {
+			d := *s
+			d.tableName = x.t.name
+			if err = d.delete(ctx, ret); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if selectsNone(s.where) {
+		return nil
+	}
+
+	ids, indexed, err := s.indexedIDs(ctx, t)
+	if err != nil {
+		return err
 	}
 
-	m := map[interface{}]interface{}{}
+	m := map[interface{}]interface{}{"$ctx": ctx}
 	var ph, h, nh int64
 	var data []interface{}
 	blobCols := t.blobCols()
 	cc := ctx.db.cc
 	for h = t.head; h != 0; ph, h = h, nh {
+		if err = ctx.check(); err != nil {
+			return err
+		}
+
+		if indexed {
+			if len(ids) == 0 { // All found rows are removed.
+				break
+			}
+
+			rec, err := t.store.Read(nil, h)
+			if err != nil {
+				return err
+			}
+
+			if nh = rec[0].(int64); !ids[rec[1]] {
+				continue
+			}
+
+			delete(ids, rec[1])
+		}
+
 		for i, v := range data {
 			c, ok := v.(chunk)
 			if !ok {
@@ -222,33 +420,52 @@ func (s *deleteStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 		// Read can return lazily expanded chunks
 		data, err = t.store.Read(nil, h, t.cols...)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		nh = data[0].(int64)
-		for _, col := range t.cols {
-			m[col.name] = data[2+col.index]
-		}
-		m["$id"] = data[1]
-		val, err := s.where.eval(m, ctx.arg)
-		if err != nil {
-			return nil, err
-		}
+		if !indexed && s.where != nil {
+			if t.hasGen(false) {
+				if n := len(t.cols0) + 2 - len(data); n > 0 {
+					data = append(data, make([]interface{}, n)...)
+				}
+				if err = t.genRow(data[2:], false); err != nil {
+					return err
+				}
+			}
+			for _, col := range t.cols {
+				m[col.name] = data[2+col.index]
+			}
+			m["$id"] = data[1]
+			val, err := s.where.eval(m, ctx.arg)
+			if err != nil {
+				return err
+			}
 
-		if val == nil {
-			continue
+			if val == nil {
+				continue
+			}
+
+			x, ok := val.(bool)
+			if !ok {
+				return fmt.Errorf("invalid WHERE expression %s (value of type %T)", val, val)
+			}
+
+			if !x {
+				continue
+			}
 		}
 
-		x, ok := val.(bool)
-		if !ok {
-			return nil, fmt.Errorf("invalid WHERE expression %s (value of type %T)", val, val)
+		// hit
+		old, err := ctx.db.changeRow(t, data)
+		if err != nil {
+			return err
 		}
 
-		if !x {
-			continue
+		if err = ret.add(t, data); err != nil {
+			return err
 		}
 
-		// hit
 		for i, v := range t.indices {
 			if v == nil {
 				continue
@@ -256,22 +473,23 @@ func (s *deleteStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 
 			// overflow chunks left in place
 			if err = v.x.Delete(data[i+1], h); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		// overflow chunks freed here
-		if err = t.store.Delete(h, blobCols...); err != nil {
-			return nil, err
+		if err = t.deleteRow(h, blobCols); err != nil {
+			return err
 		}
 
+		ctx.db.change(t, ChangeDelete, data[1], old, nil)
 		cc.RowsAffected++
 		switch {
 		case ph == 0 && nh == 0: // "only"
 			fallthrough
 		case ph == 0 && nh != 0: // "first"
 			if err = t.store.Update(t.hhead, nh); err != nil {
-				return nil, err
+				return err
 			}
 
 			t.head, h = nh, 0
@@ -280,7 +498,7 @@ func (s *deleteStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 		case ph != 0 && nh != 0: // "inner"
 			pdata, err := t.store.Read(nil, ph, t.cols...)
 			if err != nil {
-				return nil, err
+				return err
 			}
 
 			for i, v := range pdata {
@@ -289,8 +507,8 @@ func (s *deleteStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 				}
 			}
 			pdata[0] = nh
-			if err = t.store.Update(ph, pdata...); err != nil {
-				return nil, err
+			if err = t.updateRow(ph, nil, pdata); err != nil {
+				return err
 			}
 
 			h = ph
@@ -300,31 +518,220 @@ func (s *deleteStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 	return
 }
 
+// indexedIDs returns the ids of the rows of t matching the WHERE clause of s
+// and true if the WHERE clause can use an index of t, the same way it does in
+// SELECT. The rows of a table are a singly linked list, so removing them
+// still walks the list up to the last found row, but it only reads the
+// records without evaluating the WHERE clause.
+func (s *deleteStmt) indexedIDs(ctx *execCtx, t *table) (map[interface{}]bool, bool, error) {
+	if s.where == nil || t.withoutRowID || !t.hasIndices() {
+		return nil, false, nil
+	}
+
+	r := &whereRset{expr: s.where, src: &crossJoinRset{sources: []interface{}{[]interface{}{s.tableName, ""}}}}
+	ids := map[interface{}]bool{}
+	ok, err := r.tryUseIndex(ctx, ctx.timed(func(id interface{}, data []interface{}) (bool, error) {
+		if id != nil { // Not the field names.
+			ids[id] = true
+		}
+		return true, nil
+	}))
+	if !ok || err != nil {
+		return nil, false, err
+	}
+
+	return ids, true, nil
+}
+
 func (s *deleteStmt) isUpdating() bool { return true }
 
 type truncateTableStmt struct {
 	tableName string
 }
 
-func (s *truncateTableStmt) String() string { return fmt.Sprintf("TRUNCATE TABLE %s;", s.tableName) }
+func (s *truncateTableStmt) String() string {
+	return fmt.Sprintf("TRUNCATE TABLE %s;", quoteIdent(s.tableName))
+}
 
 func (s *truncateTableStmt) exec(ctx *execCtx) (Recordset, error) {
+	defer changePartitions(ctx, s.tableName)
 	t, ok := ctx.db.root.tables[s.tableName]
 	if !ok {
 		return nil, fmt.Errorf("TRUNCATE TABLE: table %s does not exist", s.tableName)
 	}
 
+	p, err := ctx.db.partitioning(t)
+	if err != nil {
+		return nil, err
+	}
+
+	if p != nil {
+		for _, x := range p.parts {
+			if _, err = (&truncateTableStmt{x.t.name}).exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+
+	if ctx.db.cdc {
+		for h := t.head; h != 0; {
+			var err error
+			if h, err = ctx.db.deleted(t, h); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return nil, t.truncate()
 }
 
 func (s *truncateTableStmt) isUpdating() bool { return true }
 
+type pragmaStmt struct {
+	name string
+	expr expression // nil: read the value
+}
+
+func (s *pragmaStmt) String() string {
+	if s.expr == nil {
+		return fmt.Sprintf("PRAGMA %s;", s.name)
+	}
+
+	return fmt.Sprintf("PRAGMA %s = %s;", s.name, s.expr)
+}
+
+func (s *pragmaStmt) exec(ctx *execCtx) (Recordset, error) {
+	if s.expr == nil {
+		v, err := ctx.db.pragma(s.name)
+		if err != nil {
+			return nil, err
+		}
+
+		return recordset{ctx, pragmaRset{s.name, v}, nil}, nil
+	}
+
+	v, err := s.expr.eval(map[interface{}]interface{}{"$ctx": ctx}, ctx.arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, ctx.db.setPragma(s.name, v)
+}
+
+func (s *pragmaStmt) isUpdating() bool { return false }
+
+// pragmaRset is the single row record set of reading a setting.
+type pragmaRset struct {
+	name string
+	val  interface{}
+}
+
+func (r pragmaRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	m, err := f(nil, []interface{}{[]*fld{{name: r.name}}})
+	if onlyNames || !m || err != nil {
+		return
+	}
+
+	_, err = f(nil, []interface{}{r.val})
+	return
+}
+
+type attachStmt struct {
+	file expression
+	name string
+}
+
+func (s *attachStmt) String() string {
+	return fmt.Sprintf("ATTACH DATABASE %s AS %s;", s.file, quoteIdent(s.name))
+}
+
+func (s *attachStmt) exec(ctx *execCtx) (Recordset, error) {
+	v, err := s.file.eval(map[interface{}]interface{}{"$ctx": ctx}, ctx.arg)
+	if err != nil {
+		return nil, err
+	}
+
+	file, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("ATTACH: invalid file name %v (type %T)", v, v)
+	}
+
+	return nil, ctx.db.attach(file, s.name)
+}
+
+func (s *attachStmt) isUpdating() bool { return false }
+
+type detachStmt struct {
+	name string
+}
+
+func (s *detachStmt) String() string { return fmt.Sprintf("DETACH DATABASE %s;", quoteIdent(s.name)) }
+
+func (s *detachStmt) exec(ctx *execCtx) (Recordset, error) {
+	return nil, ctx.db.detach(s.name)
+}
+
+func (s *detachStmt) isUpdating() bool { return false }
+
+type reindexStmt struct {
+	tableName string
+}
+
+func (s *reindexStmt) String() string { return fmt.Sprintf("REINDEX %s;", quoteIdent(s.tableName)) }
+
+func (s *reindexStmt) exec(ctx *execCtx) (Recordset, error) {
+	t, ok := ctx.db.root.tables[s.tableName]
+	if !ok {
+		return nil, fmt.Errorf("REINDEX: table %s does not exist", s.tableName)
+	}
+
+	var rows [][]interface{}
+	for i, v := range t.indices {
+		if v == nil {
+			continue
+		}
+
+		if err := v.x.Clear(); err != nil {
+			return nil, fmt.Errorf("REINDEX %s: %v", v.name, err)
+		}
+
+		n, err := t.fillIndex(ctx, v.x, i-1)
+		if err != nil {
+			return nil, fmt.Errorf("REINDEX %s: %v", v.name, err)
+		}
+
+		rows = append(rows, []interface{}{v.name, n})
+	}
+	return recordset{ctx, reindexRset(rows), nil}, nil
+}
+
+func (s *reindexStmt) isUpdating() bool { return true }
+
+// reindexRset is the record set of REINDEX, one row per rebuilt index.
+type reindexRset [][]interface{}
+
+func (r reindexRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	m, err := f(nil, []interface{}{[]*fld{{name: "Index"}, {name: "Rows"}}})
+	if onlyNames || !m || err != nil {
+		return
+	}
+
+	for _, v := range r {
+		if m, err = f(nil, v); !m || err != nil {
+			return
+		}
+	}
+	return
+}
+
 type dropIndexStmt struct {
 	ifExists  bool
 	indexName string
 }
 
-func (s *dropIndexStmt) String() string { return fmt.Sprintf("DROP INDEX %s;", s.indexName) }
+func (s *dropIndexStmt) String() string {
+	return fmt.Sprintf("DROP INDEX %s;", quoteIdent(s.indexName))
+}
 
 func (s *dropIndexStmt) exec(ctx *execCtx) (Recordset, error) {
 	t, x := ctx.db.root.findIndexByName(s.indexName)
@@ -337,10 +744,14 @@ func (s *dropIndexStmt) exec(ctx *execCtx) (Recordset, error) {
 	}
 
 	for i, v := range t.indices {
-		if v == nil {
+		if v != x {
 			continue
 		}
 
+		if i > 0 && t.cols0[i-1].pk != nil {
+			return nil, fmt.Errorf("DROP INDEX: cannot drop the primary key index %s", s.indexName)
+		}
+
 		return nil, t.dropIndex(i)
 	}
 
@@ -354,7 +765,9 @@ type dropTableStmt struct {
 	tableName string
 }
 
-func (s *dropTableStmt) String() string { return fmt.Sprintf("DROP TABLE %s;", s.tableName) }
+func (s *dropTableStmt) String() string {
+	return fmt.Sprintf("DROP TABLE %s;", quoteIdent(s.tableName))
+}
 
 func (s *dropTableStmt) exec(ctx *execCtx) (Recordset, error) {
 	t, ok := ctx.db.root.tables[s.tableName]
@@ -366,6 +779,48 @@ func (s *dropTableStmt) exec(ctx *execCtx) (Recordset, error) {
 		return nil, fmt.Errorf("DROP TABLE: table %s does not exist", s.tableName)
 	}
 
+	if s.tableName == partitionTable {
+		if err := ctx.db.dropPartitions(); err != nil {
+			return nil, err
+		}
+	}
+
+	p, err := ctx.db.partitioning(t)
+	if err != nil {
+		return nil, err
+	}
+
+	// The statistics must not be picked up by a table created later under
+	// the same name.
+	stats := ctx.db.root.tables[statsTable] != nil && s.tableName != statsTable
+	if p != nil {
+		for _, x := range p.parts {
+			if err = ctx.db.root.dropTable(x.t); err != nil {
+				return nil, err
+			}
+
+			if stats {
+				if err = ctx.run(statsDelete, x.t.name); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if err = ctx.run(partitionDeleteTable, s.tableName); err != nil {
+			return nil, err
+		}
+	}
+
+	if stats {
+		if err = ctx.run(statsDelete, s.tableName); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = deleteMeta(ctx, s.tableName, ""); err != nil {
+		return nil, err
+	}
+
 	return nil, ctx.db.root.dropTable(t)
 }
 
@@ -376,15 +831,20 @@ type alterTableDropColumnStmt struct {
 }
 
 func (s *alterTableDropColumnStmt) String() string {
-	return fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", s.tableName, s.colName)
+	return fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", quoteIdent(s.tableName), quoteIdent(s.colName))
 }
 
 func (s *alterTableDropColumnStmt) exec(ctx *execCtx) (Recordset, error) {
+	defer changePartitions(ctx, s.tableName)
 	t, ok := ctx.db.root.tables[s.tableName]
 	if !ok {
 		return nil, fmt.Errorf("ALTER TABLE: table %s does not exist", s.tableName)
 	}
 
+	if err := checkNotPartitioned(ctx, t); err != nil {
+		return nil, fmt.Errorf("ALTER TABLE %s DROP COLUMN: %v", s.tableName, err)
+	}
+
 	cols := t.cols
 	for _, c := range cols {
 		if c.name == s.colName {
@@ -392,6 +852,35 @@ func (s *alterTableDropColumnStmt) exec(ctx *execCtx) (Recordset, error) {
 				return nil, fmt.Errorf("ALTER TABLE %s DROP COLUMN: cannot drop the only column: %s", s.tableName, s.colName)
 			}
 
+			if pk := t.pkCol(); pk != nil {
+				for _, i := range pk.pk {
+					if i == c.index {
+						return nil, fmt.Errorf("ALTER TABLE %s DROP COLUMN: column %s is part of the primary key", s.tableName, s.colName)
+					}
+				}
+			}
+
+			for _, d := range cols {
+				if d.gen == nil {
+					continue
+				}
+
+				refs, _ := genRefs(d.gen, false)
+				for _, nm := range refs {
+					if nm == s.colName {
+						return nil, fmt.Errorf("ALTER TABLE %s DROP COLUMN: column %s is used by generated column %s", s.tableName, s.colName, d.name)
+					}
+				}
+			}
+
+			if err := deleteMeta(ctx, s.tableName, s.colName); err != nil {
+				return nil, err
+			}
+
+			if err := t.dropDict(c.index); err != nil {
+				return nil, err
+			}
+
 			c.name = ""
 			t.cols0[c.index].name = ""
 			if t.hasIndices() {
@@ -416,33 +905,95 @@ type alterTableAddStmt struct {
 }
 
 func (s *alterTableAddStmt) String() string {
-	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", s.tableName, s.c.name)
+	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s%s%s%s%s;", quoteIdent(s.tableName), quoteIdent(s.c.name), typeStr(s.c.typ), s.c.dictString(), s.c.genString(), s.c.notNullString(), s.c.commentString())
 }
 
-func (s *alterTableAddStmt) exec(ctx *execCtx) (Recordset, error) {
-	t, ok := ctx.db.root.tables[s.tableName]
-	if !ok {
-		return nil, fmt.Errorf("ALTER TABLE: table %s does not exist", s.tableName)
-	}
+func (s *alterTableAddStmt) exec(ctx *execCtx) (Recordset, error) {
+	defer changePartitions(ctx, s.tableName)
+	t, ok := ctx.db.root.tables[s.tableName]
+	if !ok {
+		return nil, fmt.Errorf("ALTER TABLE: table %s does not exist", s.tableName)
+	}
+
+	if err := checkNotPartitioned(ctx, t); err != nil {
+		return nil, fmt.Errorf("ALTER TABLE %s ADD COLUMN: %v", s.tableName, err)
+	}
+
+	cols := t.cols
+	for _, c := range cols {
+		nm := c.name
+		if nm == s.c.name {
+			return nil, fmt.Errorf("ALTER TABLE %s ADD COLUMN %s: column exists", s.tableName, nm)
+		}
+	}
+
+	if s.c.gen != nil {
+		if err := checkGen(s.c, cols); err != nil {
+			return nil, fmt.Errorf("ALTER TABLE %s ADD COLUMN: %v", s.tableName, err)
+		}
+	}
+
+	if err := checkDict(s.c); err != nil {
+		return nil, fmt.Errorf("ALTER TABLE %s ADD COLUMN: %v", s.tableName, err)
+	}
+
+	if s.c.notNull && (s.c.gen == nil || !s.c.stored) && t.head != 0 {
+		return nil, fmt.Errorf("ALTER TABLE %s ADD COLUMN %s: cannot add a NOT NULL column to a table having rows", s.tableName, s.c.name)
+	}
+
+	if t.hasIndices() {
+		t.indices = append(t.indices, nil)
+		t.xroots = append(t.xroots, 0)
+		if err := t.store.Update(t.hxroots, t.xroots...); err != nil {
+			return nil, err
+		}
+	}
+
+	t.cols0 = append(t.cols0, s.c)
+	if err := t.createDicts(); err != nil {
+		return nil, err
+	}
+
+	if err := t.updated(); err != nil {
+		return nil, err
+	}
+
+	if s.c.comment != "" {
+		if err := ctx.run(metaSet, s.tableName, s.c.name, commentKey, s.c.comment); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.c.gen == nil || !s.c.stored {
+		return nil, nil
+	}
+
+	// Must fill the new stored column.
+	blobCols := t.blobCols()
+	for h := t.head; h != 0; {
+		data, err := t.store.Read(nil, h, t.cols...)
+		if err != nil {
+			return nil, err
+		}
+
+		if n := len(t.cols0) + 2 - len(data); n > 0 {
+			data = append(data, make([]interface{}, n)...)
+		}
+		if err = t.genRow(data[2:], true); err != nil {
+			return nil, err
+		}
 
-	cols := t.cols
-	for _, c := range cols {
-		nm := c.name
-		if nm == s.c.name {
-			return nil, fmt.Errorf("ALTER TABLE %s ADD COLUMN %s: column exists", s.tableName, nm)
+		if err = t.checkNotNull(data[2:]); err != nil {
+			return nil, err
 		}
-	}
 
-	if t.hasIndices() {
-		t.indices = append(t.indices, nil)
-		t.xroots = append(t.xroots, 0)
-		if err := t.store.Update(t.hxroots, t.xroots...); err != nil {
+		if err = t.updateRow(h, blobCols, data); err != nil {
 			return nil, err
 		}
-	}
 
-	t.cols0 = append(t.cols0, s.c)
-	return nil, t.updated()
+		h = data[0].(int64)
+	}
+	return nil, nil
 }
 
 func (s *alterTableAddStmt) isUpdating() bool { return true }
@@ -450,6 +1001,7 @@ func (s *alterTableAddStmt) isUpdating() bool { return true }
 type selectStmt struct {
 	distinct      bool
 	flds          []*fld
+	forUpdate     bool
 	from          *crossJoinRset
 	group         *groupByRset
 	hasAggregates bool
@@ -466,20 +1018,7 @@ func (s *selectStmt) String() string {
 	if s.distinct {
 		b.WriteString(" DISTINCT")
 	}
-	switch {
-	case len(s.flds) == 0:
-		b.WriteString(" *")
-	default:
-		a := make([]string, len(s.flds))
-		for i, v := range s.flds {
-			s := v.expr.String()
-			if v.name != "" {
-				s += " AS " + v.name
-			}
-			a[i] = s
-		}
-		b.WriteString(" " + strings.Join(a, ", "))
-	}
+	b.WriteString(" " + fieldsString(s.flds))
 	b.WriteString(" FROM ")
 	b.WriteString(s.from.String())
 	if s.where != nil {
@@ -488,7 +1027,7 @@ func (s *selectStmt) String() string {
 	}
 	if s.group != nil {
 		b.WriteString(" GROUP BY ")
-		b.WriteString(strings.Join(s.group.colNames, ", "))
+		b.WriteString(quoteIdentList(s.group.colNames))
 	}
 	if s.order != nil {
 		b.WriteString(" ORDER BY ")
@@ -502,10 +1041,31 @@ func (s *selectStmt) String() string {
 		b.WriteString(" OFFSET ")
 		b.WriteString(s.offset.expr.String())
 	}
+	if s.forUpdate {
+		b.WriteString(" FOR UPDATE")
+	}
 	b.WriteRune(';')
 	return b.String()
 }
 
+// fieldsString returns the field list flds of SELECT or of a RETURNING
+// clause, "*" if flds is empty.
+func fieldsString(flds []*fld) string {
+	if len(flds) == 0 {
+		return "*"
+	}
+
+	a := make([]string, len(flds))
+	for i, v := range flds {
+		s := v.expr.String()
+		if v.name != "" {
+			s += " AS " + quoteIdent(v.name)
+		}
+		a[i] = s
+	}
+	return strings.Join(a, ", ")
+}
+
 func (s *selectStmt) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
 	return s.exec0().do(ctx, onlyNames, f)
 }
@@ -514,7 +1074,9 @@ func (s *selectStmt) exec0() (r rset) { //LATER overlapping goroutines/pipelines
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	r = rset(s.from)
-	if w := s.where; w != nil {
+	if from := s.pushWhere(); from != nil {
+		r = rset(from)
+	} else if w := s.where; w != nil {
 		switch ok, list := isPossiblyRewriteableCrossJoinWhereExpression(w.expr); ok && len(s.from.sources) > 1 {
 		case true:
 			//dbg("====(in, %d)\n%s\n----", len(list), s)
@@ -564,7 +1126,7 @@ func (s *selectStmt) exec0() (r rset) { //LATER overlapping goroutines/pipelines
 
 			fallthrough
 		default:
-			r = &whereRset{expr: w.expr, src: r}
+			r = &whereRset{expr: w.expr, src: r, only: s.onlyColumn()}
 		}
 	}
 	switch {
@@ -576,6 +1138,9 @@ func (s *selectStmt) exec0() (r rset) { //LATER overlapping goroutines/pipelines
 	case s.hasAggregates && s.group != nil:
 		r = &groupByRset{colNames: s.group.colNames, src: r}
 	}
+	if g, ok := r.(*groupByRset); ok && len(g.colNames) == 1 && s.where == nil {
+		g.table, _ = s.from.isSingleTable()
+	}
 	r = &selectRset{flds: s.flds, src: r}
 	if s.distinct {
 		r = &distinctRset{src: r}
@@ -592,6 +1157,119 @@ func (s *selectStmt) exec0() (r rset) { //LATER overlapping goroutines/pipelines
 	return
 }
 
+// pushWhere returns the FROM clause of s with the WHERE clause of s moved into
+// it if that is a single subquery returning the rows of its source unchanged,
+// so that the subquery can use an index of its table. The subquery must not
+// use DISTINCT, GROUP BY, aggregate functions, LIMIT or OFFSET and the WHERE
+// clause may refer only to the fields of the subquery which are its columns.
+// The WHERE clause of the subquery, if any, is joined by &&. Neither s nor the
+// subquery are modified, the rewritten FROM clause holds a copy of the
+// subquery. pushWhere returns nil if the WHERE clause cannot be moved.
+func (s *selectStmt) pushWhere() *crossJoinRset {
+	if s.where == nil || len(s.from.sources) != 1 {
+		return nil
+	}
+
+	src := s.from.sources[0].([]interface{})
+	sel, ok := src[0].(*selectStmt)
+	if !ok {
+		return nil
+	}
+
+	sel.mu.Lock()
+	defer sel.mu.Unlock()
+	if sel.distinct || sel.hasAggregates || sel.group != nil || sel.limit != nil || sel.offset != nil {
+		return nil
+	}
+
+	refs, err := genRefs(s.where.expr, true)
+	if err != nil {
+		return nil
+	}
+
+	if len(sel.flds) != 0 { // Not SELECT *.
+		for _, nm := range refs {
+			if !sel.isColumnField(nm) {
+				return nil
+			}
+		}
+	}
+
+	expr := s.where.expr
+	if sel.where != nil {
+		expr = &binaryOperation{andand, sel.where.expr, expr}
+	}
+	sub := &selectStmt{
+		flds:      sel.flds,
+		forUpdate: sel.forUpdate,
+		from:      sel.from,
+		order:     sel.order,
+		where:     &whereRset{expr: expr},
+	}
+	return &crossJoinRset{sources: []interface{}{[]interface{}{sub, src[1]}}}
+}
+
+// isColumnField reports whether nm is the name of exactly one field of s
+// and that field is the column of the same name.
+func (s *selectStmt) isColumnField(nm string) bool {
+	n := 0
+	for _, f := range s.flds {
+		x, ok := f.expr.(*ident)
+		switch {
+		case ok && x.s == nm && (f.name == "" || f.name == nm):
+			n++
+		case f.name == nm:
+			return false
+		}
+	}
+	return n == 1
+}
+
+// onlyColumn returns the name of the only column the fields, the arguments of
+// the aggregate functions, the WHERE clause and the GROUP BY clause of s refer
+// to, if s selects from a single table. The rows found by an index of that
+// column are then made of the index keys without reading the records, see
+// whereRset.doIndexed. onlyColumn returns "" if there is no such column, in
+// particular if s refers to more than one column, as no index covers them.
+func (s *selectStmt) onlyColumn() (nm string) {
+	if _, ok := s.from.isSingleTable(); !ok || len(s.flds) == 0 { // SELECT *
+		return ""
+	}
+
+	var exprs []expression
+	for _, f := range s.flds {
+		if x, ok := f.expr.(*call); ok && builtin[x.f].isAggregate {
+			exprs = append(exprs, x.arg...)
+			continue
+		}
+
+		exprs = append(exprs, f.expr)
+	}
+	if s.where != nil {
+		exprs = append(exprs, s.where.expr)
+	}
+	if s.group != nil {
+		for _, v := range s.group.colNames {
+			exprs = append(exprs, &ident{s: v})
+		}
+	}
+	for _, e := range exprs {
+		refs, err := genRefs(e, true)
+		if err != nil {
+			return ""
+		}
+
+		for _, v := range refs {
+			if nm != "" && v != nm {
+				return ""
+			}
+
+			nm = v
+		}
+	}
+	return nm
+}
+
 func (s *selectStmt) exec(ctx *execCtx) (rs Recordset, err error) {
 	return recordset{ctx, s.exec0(), nil}, nil
 }
@@ -600,19 +1278,21 @@ func (s *selectStmt) isUpdating() bool { return false }
 
 type insertIntoStmt struct {
 	colNames  []string
+	conflict  int // conflictAbort, ...
 	lists     [][]expression
 	sel       *selectStmt
 	tableName string
+	upsert    *upsert // ON CONFLICT clause, if any.
 }
 
 func (s *insertIntoStmt) String() string {
 	cn := ""
 	if len(s.colNames) != 0 {
-		cn = fmt.Sprintf(" (%s)", strings.Join(s.colNames, ", "))
+		cn = fmt.Sprintf(" (%s)", quoteIdentList(s.colNames))
 	}
 	switch {
 	case s.sel != nil:
-		return fmt.Sprintf("INSERT INTO %s%s (%s);", s.tableName, cn, s.sel)
+		return fmt.Sprintf("INSERT%s INTO %s%s (%s)%s;", conflictString(s.conflict), quoteIdent(s.tableName), cn, s.sel, s.upsert)
 	default:
 		a := make([]string, len(s.lists))
 		for i, v := range s.lists {
@@ -622,51 +1302,45 @@ func (s *insertIntoStmt) String() string {
 			}
 			a[i] = fmt.Sprintf("(%s)", strings.Join(b, ", "))
 		}
-		return fmt.Sprintf("INSERT INTO %s%s VALUES %s;", s.tableName, cn, strings.Join(a, ", "))
+		return fmt.Sprintf("INSERT%s INTO %s%s VALUES %s%s;", conflictString(s.conflict), quoteIdent(s.tableName), cn, strings.Join(a, ", "), s.upsert)
 	}
 }
 
-func (s *insertIntoStmt) execSelect(t *table, cols []*col, ctx *execCtx) (_ Recordset, err error) {
+func (s *insertIntoStmt) execSelect(t *table, p *partitioning, cols []*col, ctx *execCtx) (_ Recordset, err error) {
 	r := s.sel.exec0()
 	ok := false
 	h := t.head
 	data0 := make([]interface{}, len(t.cols0)+2)
-	cc := ctx.db.cc
 	if err = r.do(ctx, false, func(id interface{}, data []interface{}) (more bool, err error) {
 		if ok {
 			for i, d := range data {
 				data0[cols[i].index+2] = d
 			}
+			ctx.db.normalizeRow(data0[2:])
 			if err = typeCheck(data0[2:], cols); err != nil {
 				return
 			}
 
-			id, err := t.store.ID()
-			if err != nil {
-				return false, err
+			if err = ctx.db.checkFloats(data0[2:]); err != nil {
+				return
 			}
 
-			data0[0] = h
-			data0[1] = id
+			if err = t.genRow(data0[2:], true); err != nil {
+				return
+			}
 
-			// Any overflow chunks are written here.
-			if h, err = t.store.Create(data0...); err != nil {
-				return false, err
+			if err = t.checkNotNull(data0[2:]); err != nil {
+				return
 			}
 
-			for i, v := range t.indices {
-				if v == nil {
-					continue
-				}
+			if p != nil {
+				return true, s.insertPartition(ctx, p, data0)
+			}
 
-				// Any overflow chunks are shared with the BTree key
-				if err = v.x.Create(data0[i+1], h); err != nil {
-					return false, err
-				}
+			if h, err = s.insert(ctx, t, h, data0); err != nil {
+				return false, err
 			}
 
-			cc.RowsAffected++
-			ctx.db.root.lastInsertID = id
 			return true, nil
 		}
 
@@ -689,7 +1363,165 @@ func (s *insertIntoStmt) execSelect(t *table, cols []*col, ctx *execCtx) (_ Reco
 	return
 }
 
+// insert writes the record data to t, linked to the record head, and adds it
+// to the indices of t. Fields 0 and 1 of data, the next record handle and the
+// id, are set by insert, the other fields are the data row. It returns the
+// new head of the record list of t, usually the handle of the new record,
+// which becomes effective once the caller updates t.hhead. A new record
+// conflicting with an existing record on the conflict target of the ON
+// CONFLICT clause of s updates that record instead. A new record conflicting
+// with existing records otherwise is handled by resolve, unless the conflict
+// resolution of s is conflictAbort.
+//
+// The storage fields of a record are
+//
+//	0: next record handle int64
+//	1: record id          int64, NULL in a table WITHOUT ROWID
+//	2...: data row
+func (s *insertIntoStmt) insert(ctx *execCtx, t *table, head int64, data []interface{}) (h int64, err error) {
+	if s.upsert != nil {
+		h, err := s.upsert.find(t, data)
+		if err != nil {
+			return head, err
+		}
+
+		if h != 0 {
+			return head, s.upsert.update(ctx, t, h, data)
+		}
+	}
+
+	if s.conflict != conflictAbort {
+		hs, err := t.conflicts(data)
+		if err != nil {
+			return head, err
+		}
+
+		if len(hs) != 0 {
+			return s.resolve(ctx, t, head, data, hs)
+		}
+	}
+
+	id, err := t.nextID()
+	if err != nil {
+		return
+	}
+
+	data[0] = head
+	data[1] = id
+
+	// Any overflow chunks are written here.
+	if h, err = t.create(data); err != nil {
+		return
+	}
+
+	for i, v := range t.indices {
+		if v == nil {
+			continue
+		}
+
+		// Any overflow chunks are shared with the BTree key
+		if err = v.x.Create(data[i+1], h); err != nil {
+			return
+		}
+	}
+
+	row, err := ctx.db.changeRow(t, data)
+	if err != nil {
+		return
+	}
+
+	ctx.db.change(t, ChangeInsert, id, nil, row)
+	ctx.db.cc.RowsAffected++
+	if !t.withoutRowID {
+		ctx.db.root.lastInsertID = id.(int64)
+	}
+	return
+}
+
+// copySource returns the source table of s, if s is INSERT INTO t ... SELECT *
+// FROM src with no other clauses and the columns of src match the columns
+// inserted into t, cols, by number and type. Otherwise it returns nil. The
+// records of such source table are copied by execCopy without evaluating the
+// SELECT statement.
+func (s *insertIntoStmt) copySource(ctx *execCtx, t *table, cols []*col) *table {
+	sel := s.sel
+	if sel.distinct || len(sel.flds) != 0 || sel.hasAggregates || sel.where != nil ||
+		sel.group != nil || sel.order != nil || sel.limit != nil || sel.offset != nil ||
+		len(sel.from.sources) != 1 {
+		return nil
+	}
+
+	nm, ok := sel.from.sources[0].([]interface{})[0].(string)
+	if !ok || nm == t.name {
+		return nil
+	}
+
+	src := ctx.db.root.tables[nm]
+	if src == nil || len(src.cols) != len(cols) || src.hasGen(false) {
+		return nil
+	}
+
+	if p, err := ctx.db.partitioning(src); p != nil || err != nil {
+		return nil
+	}
+
+	for i, c := range src.cols {
+		if c.typ != cols[i].typ {
+			return nil
+		}
+	}
+	return src
+}
+
+// execCopy inserts the records of src, see copySource, to t.
+func (s *insertIntoStmt) execCopy(src, t *table, cols []*col, ctx *execCtx) (_ Recordset, err error) {
+	h := t.head
+	data := make([]interface{}, len(t.cols0)+2)
+	var rec []interface{}
+	for sh := src.head; sh != 0; sh = rec[0].(int64) {
+		if err = ctx.check(); err != nil {
+			return
+		}
+
+		if rec, err = src.store.Read(rec, sh, src.cols...); err != nil {
+			return
+		}
+
+		for i, c := range src.cols {
+			var v interface{}
+			if x := c.index + 2; x < len(rec) {
+				v = rec[x]
+			}
+			data[cols[i].index+2] = v
+		}
+		ctx.db.normalizeRow(data[2:])
+		if err = ctx.db.checkFloats(data[2:]); err != nil {
+			return
+		}
+
+		if err = t.genRow(data[2:], true); err != nil {
+			return
+		}
+
+		if err = t.checkNotNull(data[2:]); err != nil {
+			return
+		}
+
+		if h, err = s.insert(ctx, t, h, data); err != nil {
+			return
+		}
+	}
+
+	if err = t.store.Update(t.hhead, h); err != nil {
+		return
+	}
+
+	t.head = h
+	return
+}
+
 func (s *insertIntoStmt) exec(ctx *execCtx) (_ Recordset, err error) {
+	defer changePartitions(ctx, s.tableName)
 	t, ok := ctx.db.root.tables[s.tableName]
 	if !ok {
 		return nil, fmt.Errorf("INSERT INTO %s: table does not exist", s.tableName)
@@ -698,10 +1530,18 @@ func (s *insertIntoStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 	var cols []*col
 	switch len(s.colNames) {
 	case 0:
-		cols = t.cols
+		for _, col := range t.cols {
+			if col.gen == nil {
+				cols = append(cols, col)
+			}
+		}
 	default:
 		for _, colName := range s.colNames {
 			if col := findCol(t.cols, colName); col != nil {
+				if col.gen != nil {
+					return nil, fmt.Errorf("INSERT INTO %s: cannot assign to generated column %s", s.tableName, colName)
+				}
+
 				cols = append(cols, col)
 				continue
 			}
@@ -710,8 +1550,23 @@ func (s *insertIntoStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 		}
 	}
 
+	if s.upsert != nil {
+		if err = s.upsert.check(t); err != nil {
+			return nil, fmt.Errorf("INSERT INTO %s: %v", s.tableName, err)
+		}
+	}
+
+	p, err := ctx.db.partitioning(t)
+	if err != nil {
+		return nil, err
+	}
+
 	if s.sel != nil {
-		return s.execSelect(t, cols, ctx)
+		if src := s.copySource(ctx, t, cols); src != nil && p == nil {
+			return s.execCopy(src, t, cols, ctx)
+		}
+
+		return s.execSelect(t, p, cols, ctx)
 	}
 
 	for _, list := range s.lists {
@@ -721,30 +1576,54 @@ func (s *insertIntoStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 	}
 
 	arg := ctx.arg
-	root := ctx.db.root
-	cc := ctx.db.cc
-	r := make([]interface{}, len(t.cols0))
+	h := t.head
+	data := make([]interface{}, len(t.cols0)+2)
+	r := data[2:]
+	m := map[interface{}]interface{}{"$ctx": ctx}
 	for _, list := range s.lists {
 		for i, expr := range list {
-			val, err := expr.eval(nil, arg)
+			val, err := expr.eval(m, arg)
 			if err != nil {
 				return nil, err
 			}
 
 			r[cols[i].index] = val
 		}
+		ctx.db.normalizeRow(r)
 		if err = typeCheck(r, cols); err != nil {
 			return
 		}
 
-		id, err := t.addRecord(r)
-		if err != nil {
-			return nil, err
+		if err = ctx.db.checkFloats(r); err != nil {
+			return
 		}
 
-		cc.RowsAffected++
-		root.lastInsertID = id
+		if err = t.genRow(r, true); err != nil {
+			return
+		}
+
+		if err = t.checkNotNull(r); err != nil {
+			return
+		}
+
+		if p != nil {
+			if err = s.insertPartition(ctx, p, data); err != nil {
+				return
+			}
+
+			continue
+		}
+
+		if h, err = s.insert(ctx, t, h, data); err != nil {
+			return
+		}
+	}
+
+	if err = t.store.Update(t.hhead, h); err != nil {
+		return
 	}
+
+	t.head = h
 	return
 }
 
@@ -788,6 +1667,7 @@ func (rollbackStmt) isUpdating() bool {
 
 type createIndexStmt struct {
 	colName     string // alt. "id()" for index on id()
+	fulltext    bool
 	ifNotExists bool
 	indexName   string
 	tableName   string
@@ -796,14 +1676,21 @@ type createIndexStmt struct {
 
 func (s *createIndexStmt) String() string {
 	u := ""
-	if s.unique {
+	switch {
+	case s.unique:
 		u = "UNIQUE "
+	case s.fulltext:
+		u = "FULLTEXT "
 	}
 	e := ""
 	if s.ifNotExists {
 		e = "IF NOT EXISTS "
 	}
-	return fmt.Sprintf("CREATE %sINDEX %s%s ON %s (%s);", u, e, s.indexName, s.tableName, s.colName)
+	cn := s.colName
+	if cn != "id()" {
+		cn = quoteIdent(cn)
+	}
+	return fmt.Sprintf("CREATE %sINDEX %s%s ON %s (%s);", u, e, quoteIdent(s.indexName), quoteIdent(s.tableName), cn)
 }
 
 func (s *createIndexStmt) exec(ctx *execCtx) (Recordset, error) {
@@ -825,12 +1712,20 @@ func (s *createIndexStmt) exec(ctx *execCtx) (Recordset, error) {
 		return nil, fmt.Errorf("CREATE INDEX: table does not exist %s", s.tableName)
 	}
 
+	if err := checkNotPartitioned(ctx, t); err != nil {
+		return nil, fmt.Errorf("CREATE INDEX: %v", err)
+	}
+
 	if findCol(t.cols, s.indexName) != nil {
 		return nil, fmt.Errorf("CREATE INDEX: index name collision with existing column: %s", s.indexName)
 	}
 
 	if s.colName == "id()" {
-		if err := t.addIndex(s.unique, s.indexName, -1); err != nil {
+		if t.withoutRowID {
+			return nil, fmt.Errorf("CREATE INDEX: table %s has no id()", s.tableName)
+		}
+
+		if err := t.addIndex(ctx, s.unique, false, s.indexName, -1); err != nil {
 			return nil, fmt.Errorf("CREATE INDEX: %v", err)
 		}
 
@@ -842,7 +1737,15 @@ func (s *createIndexStmt) exec(ctx *execCtx) (Recordset, error) {
 		return nil, fmt.Errorf("CREATE INDEX: column does not exist: %s", s.colName)
 	}
 
-	if err := t.addIndex(s.unique, s.indexName, c.index); err != nil {
+	if c.gen != nil && !c.stored {
+		return nil, fmt.Errorf("CREATE INDEX: cannot index virtual generated column %s", s.colName)
+	}
+
+	if s.fulltext && c.typ != qString {
+		return nil, fmt.Errorf("CREATE FULLTEXT INDEX: column %s is not of type string: %s", s.colName, typeStr(c.typ))
+	}
+
+	if err := t.addIndex(ctx, s.unique, s.fulltext, s.indexName, c.index); err != nil {
 		return nil, fmt.Errorf("CREATE INDEX: %v", err)
 	}
 
@@ -852,21 +1755,35 @@ func (s *createIndexStmt) exec(ctx *execCtx) (Recordset, error) {
 func (s *createIndexStmt) isUpdating() bool { return true }
 
 type createTableStmt struct {
-	ifNotExists bool
-	tableName   string
-	cols        []*col
+	ifNotExists  bool
+	tableName    string
+	cols         []*col
+	pk           []string // Primary key column names, if any.
+	withoutRowID bool
+	part         *partitionBy // PARTITION BY clause, if any.
+	comment      string       // COMMENT clause, if any.
 }
 
 func (s *createTableStmt) String() string {
 	a := make([]string, len(s.cols))
 	for i, v := range s.cols {
-		a[i] = fmt.Sprintf("%s %s", v.name, typeStr(v.typ))
+		a[i] = fmt.Sprintf("%s %s%s%s%s%s", quoteIdent(v.name), typeStr(v.typ), v.dictString(), v.genString(), v.notNullString(), v.commentString())
+	}
+	if len(s.pk) != 0 {
+		a = append(a, fmt.Sprintf("PRIMARY KEY (%s)", quoteIdentList(s.pk)))
 	}
 	e := ""
 	if s.ifNotExists {
 		e = "IF NOT EXISTS "
 	}
-	return fmt.Sprintf("CREATE TABLE %s%s (%s);", e, s.tableName, strings.Join(a, ", "))
+	o := ""
+	if s.withoutRowID {
+		o = " WITHOUT ROWID"
+	}
+	if s.comment != "" {
+		o += " COMMENT " + strconv.Quote(s.comment)
+	}
+	return fmt.Sprintf("CREATE TABLE %s%s (%s)%s%s;", e, quoteIdent(s.tableName), strings.Join(a, ", "), o, s.part)
 }
 
 func (s *createTableStmt) exec(ctx *execCtx) (_ Recordset, err error) {
@@ -890,11 +1807,68 @@ func (s *createTableStmt) exec(ctx *execCtx) (_ Recordset, err error) {
 			return nil, fmt.Errorf("CREATE TABLE: duplicate column %s", nm)
 		}
 
+		if err := checkDict(c); err != nil {
+			return nil, fmt.Errorf("CREATE TABLE: %v", err)
+		}
+
 		m[nm] = true
 		c.index = i
 	}
-	_, err = root.createTable(s.tableName, s.cols)
-	return
+	for _, c := range s.cols {
+		if c.gen == nil {
+			continue
+		}
+
+		if err = checkGen(c, s.cols); err != nil {
+			return nil, fmt.Errorf("CREATE TABLE: %v", err)
+		}
+	}
+	if len(s.pk) == 0 {
+		if s.withoutRowID {
+			return nil, fmt.Errorf("CREATE TABLE: table %s WITHOUT ROWID must have a primary key", s.tableName)
+		}
+
+		t, err := root.createTable(s.tableName, s.cols)
+		if err != nil {
+			return nil, err
+		}
+
+		if s.part != nil {
+			if err = s.createPartitions(ctx, t); err != nil {
+				return nil, err
+			}
+		}
+
+		return nil, s.setComments(ctx)
+	}
+
+	if s.part != nil {
+		return nil, fmt.Errorf("CREATE TABLE: partitioned table %s cannot have a primary key", s.tableName)
+	}
+
+	pk, err := newPK(s.cols, s.pk)
+	if err != nil {
+		return nil, fmt.Errorf("CREATE TABLE: %v", err)
+	}
+
+	xname := pkIndexName(s.tableName)
+	if t, x := root.findIndexByName(xname); x != nil {
+		return nil, fmt.Errorf("CREATE TABLE: table %s has index %s", t.name, xname)
+	}
+
+	t, err := root.createTable(s.tableName, append(s.cols[:len(s.cols):len(s.cols)], pk))
+	if err != nil {
+		return
+	}
+
+	t.withoutRowID = s.withoutRowID
+	x, err := t.addIndex0(true, false, xname, pk.index)
+	if err != nil {
+		return
+	}
+
+	t.indices[pk.index+1].x = pkIndex{x}
+	return nil, s.setComments(ctx)
 }
 
 func (s *createTableStmt) isUpdating() bool { return true }
@@ -17,14 +17,21 @@ type storage interface {
 	Commit() error
 	Create(data ...interface{}) (h int64, err error)
 	CreateIndex(unique bool) (handle int64, x btreeIndex, err error)
+	CreateSorter(asc bool) (sorter, error) // Supports ORDER BY.
 	CreateTemp(asc bool) (bt temp, err error)
 	Delete(h int64, blobCols ...*col) error //LATER split the nil blobCols case
+	FreeSpace() (*SpaceInfo, error)
+	Header(off int) int32 // Returns the header field at offset off, see hdrAppID.
 	ID() (id int64, err error)
+	IDPolicy() (p IDPolicy, base int64) // See Options.IDPolicy and Options.IDBase.
 	Name() string
 	OpenIndex(unique bool, handle int64) (btreeIndex, error) // Never called on the memory backend.
 	Read(dst []interface{}, h int64, cols ...*col) (data []interface{}, err error)
 	ResetID() (err error)
 	Rollback() error
+	Size() (int64, error)
+	SetHeader(off int, v int32) error // Sets the header field at offset off, see hdrAppID.
+	Sync() error                      // Writes the committed state to stable storage, see DB.Flush.
 	Update(h int64, data ...interface{}) error
 	UpdateRow(h int64, blobCols []*col, data ...interface{}) error
 	Verify() (allocs int64, err error)
@@ -44,6 +51,12 @@ type temp interface {
 	Set(k, v []interface{}) (err error)
 }
 
+type sorter interface {
+	Drop() (err error)
+	SeekFirst() (e btreeIterator, err error)
+	Set(k, v []interface{}) (err error)
+}
+
 type indexIterator interface {
 	Next() (k interface{}, h int64, err error)
 	Prev() (k interface{}, h int64, err error)
@@ -60,10 +73,11 @@ type btreeIndex interface {
 }
 
 type indexedCol struct {
-	name   string
-	unique bool
-	x      btreeIndex
-	xroot  int64
+	name     string
+	unique   bool
+	x        btreeIndex
+	xroot    int64
+	fulltext bool
 }
 
 type indexKey struct {
@@ -78,20 +92,23 @@ type indexKey struct {
 // 3: name  string
 // 4: indices string - optional
 // 5: hxroots int64 - optional
+// 6...: generated columns string - optional, one per physical column
 type table struct {
-	cols    []*col // logical
-	cols0   []*col // physical
-	h       int64  //
-	head    int64  // head of the single linked record list
-	hhead   int64  // handle of the head of the single linked record list
-	hxroots int64
-	indices []*indexedCol
-	name    string
-	next    int64 // single linked table list
-	store   storage
-	tnext   *table
-	tprev   *table
-	xroots  []interface{}
+	cols         []*col // logical
+	cols0        []*col // physical
+	h            int64  //
+	head         int64  // head of the single linked record list
+	hhead        int64  // handle of the head of the single linked record list
+	dicts        map[int]*dictionary
+	hxroots      int64
+	indices      []*indexedCol
+	name         string
+	next         int64 // single linked table list
+	store        storage
+	tnext        *table
+	tprev        *table
+	withoutRowID bool // Records have no id, they are scanned in primary key order.
+	xroots       []interface{}
 }
 
 func (t *table) hasIndices() bool { return len(t.indices) != 0 }
@@ -121,6 +138,12 @@ func (t *table) clone() *table {
 	}
 	r.xroots = make([]interface{}, len(t.xroots))
 	copy(r.xroots, t.xroots)
+	if t.dicts != nil {
+		r.dicts = make(map[int]*dictionary, len(t.dicts))
+		for k, v := range t.dicts {
+			r.dicts[k] = v
+		}
+	}
 	r.tnext, r.tprev = nil, nil
 	return r
 }
@@ -141,9 +164,9 @@ func (t *table) load() (err error) {
 	}
 
 	var hasIndices bool
-	switch n := len(data); n {
-	case 4:
-	case 6:
+	switch n := len(data); {
+	case n == 4:
+	case n >= 6:
 		hasIndices = true
 	default:
 		return fmt.Errorf("corrupted DB: table data len %d", n)
@@ -202,6 +225,14 @@ func (t *table) load() (err error) {
 		return fmt.Errorf("corrupted DB: table data[5] of type %T", data[5])
 	}
 
+	if err = t.loadGen(data[6:]); err != nil {
+		return
+	}
+
+	if t.hxroots == 0 {
+		return
+	}
+
 	xroots, err := t.store.Read(nil, t.hxroots)
 	if err != nil {
 		return err
@@ -241,13 +272,19 @@ func (t *table) load() (err error) {
 			return fmt.Errorf("corrupted DB: missing root for index %s", nm)
 		}
 
-		unique := v[0] == 'u'
+		unique, fulltext := v[0] == 'u', v[0] == 'f'
 		x, err := t.store.OpenIndex(unique, h)
 		if err != nil {
 			return err
 		}
 
-		t.indices[i] = &indexedCol{nm, unique, x, h}
+		switch {
+		case fulltext:
+			x = fulltextIndex{x}
+		case i > 0 && t.cols0[i-1].pk != nil:
+			x = pkIndex{x}
+		}
+		t.indices[i] = &indexedCol{nm, unique, x, h, fulltext}
 	}
 	t.xroots = xroots
 
@@ -276,13 +313,20 @@ func newTable(store storage, name string, next int64, cols []*col, tprev, tnext
 		tnext: tnext,
 		tprev: tprev,
 	}
-	return t.updateCols(), nil
+	if err = t.updateCols().createDicts(); err != nil {
+		return nil, err
+	}
+
+	if t.genMeta() != nil {
+		err = t.updated()
+	}
+	return
 }
 
 func (t *table) blobCols() (r []*col) {
 	for _, c := range t.cols0 {
 		switch c.typ {
-		case qBlob, qBigInt, qBigRat, qTime, qDuration:
+		case qBlob, qBigInt, qBigRat, qTime, qDuration, qArray:
 			r = append(r, c)
 		}
 	}
@@ -319,11 +363,87 @@ func (t *table) truncate() (err error) {
 			return err
 		}
 	}
+	if err = t.clearDicts(); err != nil {
+		return
+	}
+
 	t.head = 0
 	return t.updated()
 }
 
-func (t *table) addIndex0(unique bool, indexName string, colIndex int) (btreeIndex, error) {
+// updateRecord assigns the values vals to the columns cols of the record data
+// of t having the handle h, computes its stored generated columns and writes
+// it, updating the indices of t. The fields of data are laid out as described
+// at insertIntoStmt.insert.
+func (t *table) updateRecord(h int64, data []interface{}, cols []*col, vals []interface{}) (err error) {
+	var old []interface{}
+	var touched []bool
+	if t.hasIndices() {
+		old = make([]interface{}, len(t.cols0))
+		touched = make([]bool, len(t.cols0))
+	}
+	for i, c := range cols {
+		if t.hasIndices() {
+			old[c.index] = data[2+c.index]
+			touched[c.index] = true
+		}
+		data[2+c.index] = vals[i]
+	}
+	t.clearVirtual(data[2:])
+	if err = typeCheck(data[2:], t.cols); err != nil {
+		return
+	}
+
+	if t.hasGen(true) {
+		for _, c := range t.cols0 {
+			if (c.gen != nil && c.stored && c.name != "" || c.pk != nil) && t.hasIndices() {
+				old[c.index] = data[2+c.index]
+				touched[c.index] = true
+			}
+		}
+		if err = t.genRow(data[2:], true); err != nil {
+			return
+		}
+	}
+	if err = t.checkNotNull(data[2:]); err != nil {
+		return
+	}
+
+	for i, v := range t.indices {
+		if i == 0 { // id() N/A
+			continue
+		}
+
+		if v == nil || !touched[i-1] {
+			continue
+		}
+
+		if err = v.x.Delete(old[i-1], h); err != nil {
+			return
+		}
+	}
+
+	if err = t.updateRow(h, t.blobCols(), data); err != nil { //LATER detect which blobs are actually affected
+		return
+	}
+
+	for i, v := range t.indices {
+		if i == 0 { // id() N/A
+			continue
+		}
+
+		if v == nil || !touched[i-1] {
+			continue
+		}
+
+		if err = v.x.Create(data[2+i-1], h); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (t *table) addIndex0(unique, fulltext bool, indexName string, colIndex int) (btreeIndex, error) {
 	switch len(t.indices) {
 	case 0:
 		indices := make([]*indexedCol, len(t.cols0)+1)
@@ -332,7 +452,10 @@ func (t *table) addIndex0(unique bool, indexName string, colIndex int) (btreeInd
 			return nil, err
 		}
 
-		indices[colIndex+1] = &indexedCol{indexName, unique, x, h}
+		if fulltext {
+			x = fulltextIndex{x}
+		}
+		indices[colIndex+1] = &indexedCol{indexName, unique, x, h, fulltext}
 		xroots := make([]interface{}, len(indices))
 		xroots[colIndex+1] = h
 		hx, err := t.store.Create(xroots...)
@@ -357,29 +480,53 @@ func (t *table) addIndex0(unique bool, indexName string, colIndex int) (btreeInd
 			return nil, err
 		}
 
+		if fulltext {
+			x = fulltextIndex{x}
+		}
 		t.xroots[colIndex+1] = h
 		if err := t.store.Update(t.hxroots, t.xroots...); err != nil {
 			return nil, err
 		}
 
-		t.indices[colIndex+1] = &indexedCol{indexName, unique, x, h}
+		t.indices[colIndex+1] = &indexedCol{indexName, unique, x, h, fulltext}
 		return x, t.updated()
 	}
 }
 
-func (t *table) addIndex(unique bool, indexName string, colIndex int) error {
-	x, err := t.addIndex0(unique, indexName, colIndex)
+// addIndex creates the index of the column having index colIndex, or of id()
+// if colIndex is -1, and fills it with the existing rows of t. The rows added
+// are reported as MetricIndexRows. If filling the index fails, the index is
+// dropped.
+func (t *table) addIndex(ctx *execCtx, unique, fulltext bool, indexName string, colIndex int) error {
+	x, err := t.addIndex0(unique, fulltext, indexName, colIndex)
 	if err != nil {
 		return err
 	}
 
-	// Must fill the new index.
+	if _, err = t.fillIndex(ctx, x, colIndex); err != nil {
+		if e := t.dropIndex(colIndex + 1); e != nil {
+			return fmt.Errorf("%v; cannot drop the index: %v", err, e)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// fillIndex adds the rows of t to x, the index of the column having index
+// colIndex, and returns the number of rows added.
+func (t *table) fillIndex(ctx *execCtx, x btreeIndex, colIndex int) (r int64, err error) {
 	ncols := len(t.cols0)
 	h, store := t.head, t.store
 	for h != 0 {
+		if err = ctx.check(); err != nil {
+			return
+		}
+
 		rec, err := store.Read(nil, h, t.cols...)
 		if err != nil {
-			return err
+			return r, err
 		}
 
 		if n := ncols + 2 - len(rec); n > 0 {
@@ -387,14 +534,18 @@ func (t *table) addIndex(unique bool, indexName string, colIndex int) error {
 		}
 
 		if err = x.Create(rec[colIndex+2], h); err != nil {
-			return err
+			return r, err
 		}
 
+		ctx.db.metrics.Inc(MetricIndexRows, 1)
+		r++
 		h = rec[0].(int64)
 	}
-	return nil
+	return
 }
 
+// dropIndex drops the index xIndex of t and frees its storage. Dropping the
+// last index of t frees also the record of the index roots.
 func (t *table) dropIndex(xIndex int) error {
 	t.xroots[xIndex] = 0
 	if err := t.indices[xIndex].x.Drop(); err != nil {
@@ -402,10 +553,23 @@ func (t *table) dropIndex(xIndex int) error {
 	}
 
 	t.indices[xIndex] = nil
+	for _, v := range t.indices {
+		if v != nil {
+			return t.updated()
+		}
+	}
+
+	if err := t.store.Delete(t.hxroots); err != nil {
+		return err
+	}
+
+	t.hxroots, t.xroots, t.indices = 0, nil, nil
 	return t.updated()
 }
 
 func (t *table) updated() (err error) {
+	scols := cols2meta(t.updateCols().cols0)
+	gen := t.genMeta()
 	switch {
 	case len(t.indices) != 0:
 		a := []string{}
@@ -416,48 +580,60 @@ func (t *table) updated() (err error) {
 			}
 
 			s := "n"
-			if v.unique {
+			switch {
+			case v.unique:
 				s = "u"
+			case v.fulltext:
+				s = "f"
 			}
 			a = append(a, s+v.name)
 		}
-		return t.store.Update(t.h, t.next, cols2meta(t.updateCols().cols0), t.hhead, t.name, strings.Join(a, "|"), t.hxroots)
+		return t.store.Update(t.h, append([]interface{}{t.next, scols, t.hhead, t.name, strings.Join(a, "|"), t.hxroots}, gen...)...)
+	case len(gen) != 0:
+		return t.store.Update(t.h, append([]interface{}{t.next, scols, t.hhead, t.name, "", int64(0)}, gen...)...)
 	default:
-		return t.store.Update(t.h, t.next, cols2meta(t.updateCols().cols0), t.hhead, t.name)
+		return t.store.Update(t.h, t.next, scols, t.hhead, t.name)
 	}
 }
 
-// storage fields
-// 0: next record handle int64
-// 1: record id          int64
-// 2...: data row
-func (t *table) addRecord(r []interface{}) (id int64, err error) {
-	if id, err = t.store.ID(); err != nil {
-		return
+// nextID returns the id of a new record of t, or nil if t is a table WITHOUT
+// ROWID.
+func (t *table) nextID() (interface{}, error) {
+	if t.withoutRowID {
+		return nil, nil
 	}
 
-	r = append([]interface{}{t.head, id}, r...)
-	h, err := t.store.Create(r...)
+	if p, base := t.store.IDPolicy(); p == IDReuseLowest && t.hasIndices() && t.indices[0] != nil && !isPartitionName(t.name) {
+		return t.lowestFreeID(base)
+	}
+
+	return t.store.ID()
+}
+
+// lowestFreeID returns the lowest id, not less than base, of no record of t,
+// see IDReuseLowest. It walks the index on id() of t, which must exist.
+func (t *table) lowestFreeID(base int64) (int64, error) {
+	if base < 1 {
+		base = 1
+	}
+	en, _, err := t.indices[0].x.Seek(base)
 	if err != nil {
-		return
+		return 0, noEOF(err)
 	}
 
-	for i, v := range t.indices {
-		if v == nil {
-			continue
+	for {
+		k, _, err := en.Next()
+		if err != nil {
+			return base, noEOF(err)
 		}
 
-		if err = v.x.Create(r[i+1], h); err != nil {
-			return
+		switch id, _ := k.(int64); {
+		case id == base:
+			base++
+		case id > base:
+			return base, nil
 		}
 	}
-
-	if err = t.store.Update(t.hhead, h); err != nil {
-		return
-	}
-
-	t.head = h
-	return
 }
 
 func (t *table) flds() (r []*fld) {
@@ -481,11 +657,16 @@ func (t *table) updateCols() *table {
 
 // storage fields
 // 0: handle of first table in DB int64
+// 1: recordFormat int64, only if field 2 is present
+// 2: seq int64, only if not zero
 type root struct {
-	head         int64 // Single linked table list
+	changes      []ChangeEvent // Of the transaction, see Options.OnChange.
+	head         int64         // Single linked table list
 	lastInsertID int64
 	parent       *root
+	parts        partitionCache
 	rowsAffected int64 //LATER implement
+	seq          int64 // Of the last commit reporting changes, see DB.Changes.
 	store        storage
 	tables       map[string]*table
 	thead        *table
@@ -516,18 +697,22 @@ func newRoot(store storage) (r *root, err error) {
 			store:  store,
 			tables: map[string]*table{},
 		}, nil
-	case 1: // existing DB, load tables
-		if len(data) != 1 {
+	case 1, 3: // existing DB, load tables
+		p, ok := data[0].(int64)
+		if !ok {
 			return nil, fmt.Errorf("corrupted DB") //LATER these messages must be distinct
 		}
 
-		p, ok := data[0].(int64)
-		if !ok {
-			return nil, fmt.Errorf("corrupted DB")
+		var seq int64
+		if len(data) == 3 {
+			if seq, ok = data[2].(int64); !ok {
+				return nil, fmt.Errorf("corrupted DB")
+			}
 		}
 
 		r := &root{
 			head:   p,
+			seq:    seq,
 			store:  store,
 			tables: map[string]*table{},
 		}
@@ -576,7 +761,16 @@ func (r *root) findIndexByName(name string) (*table, *indexedCol) {
 }
 
 func (r *root) updated() (err error) {
-	return r.store.Update(1, r.head)
+	return r.update(r.head)
+}
+
+// update writes the root record of r having the table list starting at head.
+func (r *root) update(head int64) error {
+	if r.seq == 0 {
+		return r.store.Update(1, head)
+	}
+
+	return r.store.Update(1, head, int64(recordFormat), r.seq)
 }
 
 func (r *root) createTable(name string, cols []*col) (t *table, err error) {
@@ -588,7 +782,7 @@ func (r *root) createTable(name string, cols []*col) (t *table, err error) {
 		return nil, err
 	}
 
-	if err = r.store.Update(1, t.h); err != nil {
+	if err = r.update(t.h); err != nil {
 		return nil, err
 	}
 
@@ -596,6 +790,7 @@ func (r *root) createTable(name string, cols []*col) (t *table, err error) {
 		p.tprev = t
 	}
 	r.tables[name], r.head, r.thead = t, t.h, t
+	r.partitionsChanged()
 	return
 }
 
@@ -606,6 +801,7 @@ func (r *root) dropTable(t *table) (err error) {
 		}
 
 		delete(r.tables, t.name)
+		r.partitionsChanged()
 	}()
 
 	if err = t.truncate(); err != nil {
@@ -628,6 +824,12 @@ func (r *root) dropTable(t *table) (err error) {
 		}
 	}
 
+	for i := range t.dicts {
+		if err = t.dropDict(i); err != nil {
+			return
+		}
+	}
+
 	if h := t.hxroots; h != 0 {
 		if err = t.store.Delete(h); err != nil {
 			return
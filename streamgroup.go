@@ -0,0 +1,160 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+)
+
+// indexScanRset passes the rows of t to f in the order of the index x.
+type indexScanRset struct {
+	t *table
+	x *indexedCol
+}
+
+func (r *indexScanRset) do(ctx *execCtx, onlyNames bool, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	f = ctx.timed(f)
+	m, err := f(nil, []interface{}{r.t.flds()})
+	if onlyNames || !m || err != nil {
+		return err
+	}
+
+	en, err := r.x.x.SeekFirst()
+	if err != nil {
+		return noEOF(err)
+	}
+
+	for {
+		_, h, err := en.Next()
+		if err != nil {
+			return noEOF(err)
+		}
+
+		if h, err = tableRset("").doOne(r.t, h, f); err != nil || h < 0 {
+			return err
+		}
+	}
+}
+
+// sorted returns a source of the rows of r ordered by its only grouping
+// column, if that is a column of r.table having an index. Otherwise sorted
+// returns nil.
+func (r *groupByRset) sorted(ctx *execCtx) *indexScanRset {
+	if r.table == "" {
+		return nil
+	}
+
+	db, nm, err := ctx.db.resolve(r.table)
+	if err != nil || isSystemName[nm] {
+		return nil
+	}
+
+	t := db.root.tables[nm]
+	if t == nil || !t.hasIndices() {
+		return nil
+	}
+
+	c := findCol(t.cols, r.colNames[0])
+	if c == nil || c.gen != nil && !c.stored {
+		return nil
+	}
+
+	x := t.indices[c.index+1]
+	if x == nil || x.fulltext {
+		return nil
+	}
+
+	return &indexScanRset{t, x}
+}
+
+// doStream is like doGroup, but the rows of src arrive ordered by the only
+// grouping column of grp. The groups are then aggregated one after another
+// as their rows arrive instead of collecting all groups in a temp table
+// first.
+func (r *selectRset) doStream(grp *groupByRset, src rset, ctx *execCtx, f func(id interface{}, data []interface{}) (more bool, err error)) (err error) {
+	var flds []*fld
+	var key interface{}
+	var out []interface{}
+	m := map[interface{}]interface{}{"$ctx": ctx}
+	emit := func() (bool, error) {
+		m["$agg"] = true
+		for i, fld := range r.flds {
+			v, err := fld.expr.eval(m, ctx.arg)
+			if err != nil {
+				return false, err
+			}
+
+			out[i] = v
+		}
+		return f(nil, out)
+	}
+
+	gi := -1
+	groups := 0
+	more := true
+	if err = src.do(ctx, false, func(_ interface{}, in []interface{}) (bool, error) {
+		if flds == nil {
+			flds = in[0].([]*fld)
+			if gi = findFldIndex(flds, grp.colNames[0]); gi < 0 {
+				return false, fmt.Errorf("unknown column %s", grp.colNames[0])
+			}
+
+			if len(r.flds) == 0 {
+				r.flds = make([]*fld, len(flds))
+				for i, v := range flds {
+					r.flds[i] = &fld{expr: &ident{v.name}, name: v.name}
+				}
+			}
+			out = make([]interface{}, len(r.flds))
+			more, err = f(nil, []interface{}{r.flds})
+			return more, err
+		}
+
+		k, err := expand1(in[gi], nil)
+		if err != nil {
+			return false, err
+		}
+
+		if groups == 0 || collate1(k, key) != 0 {
+			if groups != 0 {
+				if more, err = emit(); !more || err != nil {
+					return false, err
+				}
+
+				m = map[interface{}]interface{}{"$ctx": ctx}
+			}
+			groups++
+			key = k
+		}
+		for i, fld := range flds {
+			if nm := fld.name; nm != "" {
+				m[nm] = in[i]
+			}
+		}
+		m["$id"] = nil // As in doGroup, the groups have no id.
+		for _, fld := range r.flds {
+			if _, err = fld.expr.eval(m, ctx.arg); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}); err != nil || !more {
+		return
+	}
+
+	if groups != 0 {
+		_, err = emit()
+		return
+	}
+
+	m = map[interface{}]interface{}{"$ctx": ctx, "$agg0": true} // aggregate empty record set
+	for i, fld := range r.flds {
+		if out[i], err = fld.expr.eval(m, ctx.arg); err != nil {
+			return
+		}
+	}
+	_, err = f(nil, out)
+	return
+}
@@ -0,0 +1,94 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"github.com/cznic/exp/lldb"
+)
+
+// limitedTempFile is a temp file of a DB file whose size is accounted against
+// Options.MaxTempBytes. A write growing the file beyond the limit fails
+// without writing anything.
+type limitedTempFile struct {
+	lldb.OSFile
+	off  int64 // Offset of Write.
+	size int64 // Accounted bytes.
+	src  *file
+}
+
+// grow accounts the size of f growing to n bytes.
+func (f *limitedTempFile) grow(n int64) error {
+	if n <= f.size {
+		return nil
+	}
+
+	s := f.src
+	s.tmu.Lock()
+	defer s.tmu.Unlock()
+	if max := s.maxTempSize; max > 0 && s.tempBytes+n-f.size > max {
+		return &TempLimitError{Limit: max}
+	}
+
+	s.tempBytes += n - f.size
+	f.size = n
+	return nil
+}
+
+// shrink accounts the size of f shrinking to n bytes.
+func (f *limitedTempFile) shrink(n int64) {
+	if n >= f.size {
+		return
+	}
+
+	s := f.src
+	s.tmu.Lock()
+	s.tempBytes -= f.size - n
+	s.tmu.Unlock()
+	f.size = n
+}
+
+func (f *limitedTempFile) Close() error {
+	f.shrink(0)
+	return f.OSFile.Close()
+}
+
+func (f *limitedTempFile) Seek(offset int64, whence int) (int64, error) {
+	n, err := f.OSFile.Seek(offset, whence)
+	if err == nil {
+		f.off = n
+	}
+	return n, err
+}
+
+func (f *limitedTempFile) Truncate(size int64) error {
+	if err := f.grow(size); err != nil {
+		return err
+	}
+
+	if err := f.OSFile.Truncate(size); err != nil {
+		return err
+	}
+
+	f.shrink(size)
+	return nil
+}
+
+func (f *limitedTempFile) Write(b []byte) (int, error) {
+	if err := f.grow(f.off + int64(len(b))); err != nil {
+		return 0, err
+	}
+
+	n, err := f.OSFile.Write(b)
+	f.off += int64(n)
+	return n, err
+}
+
+func (f *limitedTempFile) WriteAt(b []byte, off int64) (int, error) {
+	if err := f.grow(off + int64(len(b))); err != nil {
+		return 0, err
+	}
+
+	return f.OSFile.WriteAt(b, off)
+}
@@ -0,0 +1,115 @@
+// Copyright 2014 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ql
+
+import (
+	"fmt"
+)
+
+// Tx is a transaction started by DB.Begin. All statements executed by the
+// methods of Tx run in that transaction, which ends by calling either Commit
+// or Rollback. After that the methods of Tx return an error.
+//
+// The Recordsets produced by a Tx can be iterated before the transaction
+// ends, for example
+//
+//	tx, err := db.Begin()
+//	if err != nil {
+//		...
+//	}
+//
+//	if _, _, err = tx.Run("INSERT INTO t VALUES ($1);", int64(42)); err != nil {
+//		tx.Rollback()
+//		...
+//	}
+//
+//	rows, err := tx.Query("SELECT count() FROM t;")
+//	...
+//	rows.Close()
+//	if err = tx.Commit(); err != nil {
+//		...
+//	}
+//
+// While such iteration is in progress the other methods of Tx and DB block.
+// A Tx is not safe for concurrent use by multiple goroutines.
+type Tx struct {
+	ctx  *TCtx
+	db   *DB
+	done bool
+}
+
+// Begin starts a new transaction of db. See also Tx.
+func (db *DB) Begin() (*Tx, error) {
+	ctx := NewRWCtx()
+	if _, _, err := db.Execute(ctx, txBegin); err != nil {
+		return nil, err
+	}
+
+	return &Tx{ctx: ctx, db: db}, nil
+}
+
+// Ctx returns the transaction context of tx, which reports LastInsertID and
+// RowsAffected of the last statement list executed by tx.
+func (tx *Tx) Ctx() *TCtx { return tx.ctx }
+
+// Execute executes the statement list l in tx like DB.Execute. The list
+// cannot contain the BEGIN TRANSACTION, COMMIT and ROLLBACK statements. A
+// failing statement does not end tx, the caller should roll it back.
+func (tx *Tx) Execute(l List, arg ...interface{}) (rs []Recordset, index int, err error) {
+	if tx.done {
+		return nil, -1, errTxDone
+	}
+
+	for i, s := range l.l {
+		switch s.(type) {
+		case beginTransactionStmt, commitStmt, rollbackStmt:
+			return nil, i, fmt.Errorf("cannot execute %s in a Tx, use Tx.Commit or Tx.Rollback", s)
+		}
+	}
+
+	return tx.db.Execute(tx.ctx, l, arg...)
+}
+
+// Run compiles and executes the statement list ql in tx. See Tx.Execute.
+func (tx *Tx) Run(ql string, arg ...interface{}) (rs []Recordset, index int, err error) {
+	l, err := tx.db.compile(ql)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	return tx.Execute(l, arg...)
+}
+
+// Query executes the statement list ql in tx like DB.Query.
+func (tx *Tx) Query(ql string, arg ...interface{}) (*Rows, error) {
+	rs, _, err := tx.Run(ql, arg...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rs) == 0 {
+		return nil, fmt.Errorf("Query: no statement produces a recordset: %s", ql)
+	}
+
+	return newRows(rs[len(rs)-1])
+}
+
+// Commit commits tx. It is an error to commit a Tx which was already
+// committed or rolled back.
+func (tx *Tx) Commit() error { return tx.end(txCommit) }
+
+// Rollback rolls back tx. It is an error to roll back a Tx which was already
+// committed or rolled back.
+func (tx *Tx) Rollback() error { return tx.end(txRollback) }
+
+func (tx *Tx) end(l List) error {
+	if tx.done {
+		return errTxDone
+	}
+
+	tx.done = true
+	_, _, err := tx.db.Execute(tx.ctx, l)
+	return err
+}